@@ -0,0 +1,289 @@
+package cobracli
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	libp2pcrypto "github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/spf13/cobra"
+
+	"github.com/sourcenetwork/orbis-go/config"
+	"github.com/sourcenetwork/orbis-go/pkg/backup"
+	"github.com/sourcenetwork/orbis-go/pkg/crypto"
+	"github.com/sourcenetwork/orbis-go/pkg/custody"
+	"github.com/sourcenetwork/orbis-go/pkg/db"
+	"github.com/sourcenetwork/orbis-go/pkg/dkg"
+	"github.com/sourcenetwork/orbis-go/pkg/host"
+	"github.com/sourcenetwork/orbis-go/pkg/types"
+)
+
+// backupPasswordEnv holds the password of a backup sealed
+// for a single custodian, when no password file is given.
+const backupPasswordEnv = envPrefix + "_BACKUP_PASSWORD"
+
+// BackupCmd returns a Cobra command for exporting the ring shares
+// and identity of a stopped node into encrypted backups, and for
+// restoring them.
+func BackupCmd(archivers ...dkg.Archiver) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "backup",
+		Short: "Export and restore encrypted node backups",
+		Long: "Export and restore the ring shares and identity of a node. " +
+			"The node must be stopped, since it holds its DB. Passwords are read from " +
+			"the password files, one per custodian, or from " + backupPasswordEnv + ".",
+	}
+
+	var (
+		configFile    string
+		passwordFiles []string
+	)
+	cmd.PersistentFlags().StringVar(&configFile, "config", defaultConfigName+".yaml", "Config filename")
+	cmd.PersistentFlags().StringSliceVar(&passwordFiles, "password-file", nil, "Files with the backup passwords, one per custodian")
+
+	var (
+		out       string
+		threshold int
+	)
+	exportCmd := &cobra.Command{
+		Use:   "export",
+		Short: "Export an encrypted backup of the node",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := readConfigFile(configFile)
+			if err != nil {
+				return fmt.Errorf("read config file: %w", err)
+			}
+			identity, err := nodeIdentity(cfg)
+			if err != nil {
+				return err
+			}
+
+			passwords, err := readPasswords(passwordFiles)
+			if err != nil {
+				return err
+			}
+
+			dbOpts, closer, err := dbOptions(cmd.Context(), cfg)
+			if err != nil {
+				return err
+			}
+			defer closer()
+			d, err := db.New(cfg.DB.Path, dbOpts...)
+			if err != nil {
+				return fmt.Errorf("open db: %w", err)
+			}
+			b, err := backup.Export(cmd.Context(), d, identity, archivers...)
+			if err != nil {
+				return err
+			}
+
+			var files []*backup.File
+			if len(passwords) == 1 && threshold <= 1 {
+				f, err := backup.Seal(b, passwords[0])
+				if err != nil {
+					return err
+				}
+				files = []*backup.File{f}
+			} else {
+				files, err = backup.SealShared(b, passwords, threshold)
+				if err != nil {
+					return err
+				}
+			}
+
+			for i, f := range files {
+				path := out
+				if len(files) > 1 {
+					ext := filepath.Ext(out)
+					path = fmt.Sprintf("%s.%d%s", strings.TrimSuffix(out, ext), i+1, ext)
+				}
+				err := backup.WriteFile(path, f)
+				if err != nil {
+					return err
+				}
+				fmt.Fprintf(cmd.OutOrStdout(), "wrote %s\n", path)
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "backed up node %s with %d rings\n", b.Node, len(b.Rings))
+			return nil
+		},
+	}
+	exportCmd.Flags().StringVar(&out, "out", "orbis-backup.json", "Backup file, numbered per custodian")
+	exportCmd.Flags().IntVar(&threshold, "threshold", 1, "Custodians needed to open the backup")
+
+	var ringKeys map[string]string
+	restoreCmd := &cobra.Command{
+		Use:   "restore <file>...",
+		Short: "Restore the node from an encrypted backup, or a threshold of its custodian files",
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := readConfigFile(configFile)
+			if err != nil {
+				return fmt.Errorf("read config file: %w", err)
+			}
+
+			passwords, err := readPasswords(passwordFiles)
+			if err != nil {
+				return err
+			}
+
+			pinned := make(map[types.RingID]crypto.PublicKey, len(ringKeys))
+			for rid, key := range ringKeys {
+				pinned[types.RingID(rid)], err = parseRingKey(key)
+				if err != nil {
+					return fmt.Errorf("ring %s: %w", rid, err)
+				}
+			}
+
+			files := make([]*backup.File, len(args))
+			for i, path := range args {
+				files[i], err = backup.ReadFile(path)
+				if err != nil {
+					return err
+				}
+			}
+			b, err := backup.Open(files, passwords)
+			if err != nil {
+				return err
+			}
+
+			err = restoreIdentity(cfg, b)
+			if err != nil {
+				return err
+			}
+
+			dbOpts, closer, err := dbOptions(cmd.Context(), cfg)
+			if err != nil {
+				return err
+			}
+			defer closer()
+			d, err := db.New(cfg.DB.Path, dbOpts...)
+			if err != nil {
+				return fmt.Errorf("open db: %w", err)
+			}
+			err = backup.Restore(cmd.Context(), d, b, pinned, archivers...)
+			if err != nil {
+				return err
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "restored node %s with %d rings\n", b.Node, len(b.Rings))
+			return nil
+		},
+	}
+
+	restoreCmd.Flags().StringToStringVar(&ringKeys, "ring-key", nil, "Pinned ring public keys, as <ring id>=<key> shown by `orbisd ring pubkey` on the other ring nodes, checked against the restored shares")
+
+	cmd.AddCommand(exportCmd, restoreCmd)
+
+	return cmd
+}
+
+// nodeIdentity returns the identity the node starts with,
+// without generating a key file.
+func nodeIdentity(cfg config.Config) (libp2pcrypto.PrivKey, error) {
+	switch cfg.Custody.Provider {
+	case "", "plain":
+	case "file":
+		password, err := custody.ReadPassword(cfg.Custody.File.PasswordFile)
+		if err != nil {
+			return nil, err
+		}
+		return custody.ReadFile(cfg.Custody.File.Path, password)
+	default:
+		return nil, fmt.Errorf("can't back up the identity held by the %s custody", cfg.Custody.Provider)
+	}
+
+	if file := cfg.Host.Crypto.KeyFile; file != "" {
+		return host.ReadKeyFile(file)
+	}
+	if cfg.Host.Crypto.Seed == 0 {
+		return nil, fmt.Errorf("the node identity is random on each start, set host.crypto.key_file to keep it")
+	}
+	return host.PrivateKey(cfg.Host)
+}
+
+// restoreIdentity writes the backed up identity to the host key
+// file, or checks it's the identity the config generates.
+func restoreIdentity(cfg config.Config, b *backup.Backup) error {
+	identity, err := b.PrivateKey()
+	if err != nil {
+		return err
+	}
+
+	switch cfg.Custody.Provider {
+	case "", "plain":
+	case "file":
+		password, err := custody.ReadPassword(cfg.Custody.File.PasswordFile)
+		if err != nil {
+			return err
+		}
+		return restoreKeyFile(cfg.Custody.File.Path, identity, b.Node,
+			func() (libp2pcrypto.PrivKey, error) { return custody.ReadFile(cfg.Custody.File.Path, password) },
+			func() error { return custody.WriteFile(cfg.Custody.File.Path, identity, password) },
+		)
+	default:
+		return fmt.Errorf("can't restore the identity into the %s custody", cfg.Custody.Provider)
+	}
+
+	file := cfg.Host.Crypto.KeyFile
+	if file == "" {
+		if cfg.Host.Crypto.Seed == 0 {
+			return fmt.Errorf("set host.crypto.key_file to restore the identity of node %s", b.Node)
+		}
+		current, err := host.PrivateKey(cfg.Host)
+		if err != nil {
+			return fmt.Errorf("host private key: %w", err)
+		}
+		if !current.Equals(identity) {
+			pid, _ := peer.IDFromPrivateKey(current)
+			return fmt.Errorf("configured identity %s isn't the backed up node %s, set host.crypto.key_file to restore it", pid, b.Node)
+		}
+		return nil
+	}
+
+	return restoreKeyFile(file, identity, b.Node,
+		func() (libp2pcrypto.PrivKey, error) { return host.ReadKeyFile(file) },
+		func() error { return host.WriteKeyFile(file, identity) },
+	)
+}
+
+// restoreKeyFile writes the identity to a missing key file, or
+// checks an existing key file holds it.
+func restoreKeyFile(file string, identity libp2pcrypto.PrivKey, node string, read func() (libp2pcrypto.PrivKey, error), write func() error) error {
+	if _, err := os.Stat(file); err == nil {
+		current, err := read()
+		if err != nil {
+			return err
+		}
+		if !current.Equals(identity) {
+			return fmt.Errorf("key file %s holds another identity than the backed up node %s", file, node)
+		}
+		return nil
+	}
+	return write()
+}
+
+// readPasswords reads one password per file, or the password
+// from the environment if there are no files.
+func readPasswords(files []string) ([][]byte, error) {
+	if len(files) == 0 {
+		password, ok := os.LookupEnv(backupPasswordEnv)
+		if !ok || password == "" {
+			return nil, fmt.Errorf("missing --password-file or %s", backupPasswordEnv)
+		}
+		return [][]byte{[]byte(password)}, nil
+	}
+
+	passwords := make([][]byte, len(files))
+	for i, file := range files {
+		buf, err := os.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("read password file: %w", err)
+		}
+		passwords[i] = bytes.TrimRight(buf, "\r\n")
+	}
+	return passwords, nil
+}
@@ -0,0 +1,271 @@
+package cobracli
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"text/tabwriter"
+	"time"
+
+	icpb "github.com/libp2p/go-libp2p/core/crypto/pb"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/sourcenetwork/orbis-go/config"
+	"github.com/sourcenetwork/orbis-go/pkg/client"
+	"github.com/sourcenetwork/orbis-go/pkg/crypto"
+)
+
+const (
+	outputJSON  = "json"
+	outputTable = "table"
+
+	defaultKeyfile = "key.json" // Relative to the orbis home dir.
+)
+
+// clientFlags are the flags shared by all the client commands.
+type clientFlags struct {
+	serverAddr string
+	keyfile    string
+	output     string
+	timeout    time.Duration
+
+	tls       bool
+	tlsCA     string
+	tlsCert   string
+	tlsKey    string
+	tlsServer string
+	insecure  bool
+
+	ringKey string
+}
+
+func (f *clientFlags) register(cmd *cobra.Command) {
+	cmd.PersistentFlags().StringVar(&f.serverAddr, "server-addr", defaultServerAddr(), "Orbis gRPC server address")
+	cmd.PersistentFlags().StringVar(&f.keyfile, "keyfile", defaultKeyfilePath(), "Client identity keyfile")
+	cmd.PersistentFlags().StringVarP(&f.output, "output", "o", outputTable, "Output format (json|table)")
+	cmd.PersistentFlags().DurationVar(&f.timeout, "timeout", 30*time.Second, "Request timeout")
+	cmd.PersistentFlags().BoolVar(&f.tls, "tls", false, "Connect to the server with TLS")
+	cmd.PersistentFlags().StringVar(&f.tlsCA, "tls-ca", "", "CA certificate file to verify the server, implies --tls")
+	cmd.PersistentFlags().StringVar(&f.tlsCert, "tls-cert", "", "Client certificate file for mTLS, implies --tls")
+	cmd.PersistentFlags().StringVar(&f.tlsKey, "tls-key", "", "Client key file for mTLS")
+	cmd.PersistentFlags().StringVar(&f.tlsServer, "tls-server-name", "", "Override the server name used to verify the server certificate")
+	cmd.PersistentFlags().BoolVar(&f.insecure, "insecure", false, "Connect to the server without TLS, sending the authentication tokens in plaintext")
+	cmd.PersistentFlags().StringVar(&f.ringKey, "ring-key", "", "Pinned ring public key, as shown by `orbisd ring pubkey` and confirmed with the ring operators")
+}
+
+// dial connects to the orbis server, authenticating with the keyfile
+// identity if it exists.
+func (f *clientFlags) dial(ctx context.Context, opts ...client.Option) (*client.Client, error) {
+	sk, err := client.LoadKeyfile(f.keyfile)
+	if err == nil {
+		opts = append(opts, client.WithIdentity(sk))
+	} else if !errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("load keyfile: %w", err)
+	}
+
+	return f.connect(ctx, f.serverAddr, opts...)
+}
+
+// connect dials the orbis server at addr, with TLS unless --insecure
+// is set.
+func (f *clientFlags) connect(ctx context.Context, addr string, opts ...client.Option) (*client.Client, error) {
+	if f.insecure {
+		opts = append(opts, client.WithInsecure())
+		return client.New(ctx, addr, opts...)
+	}
+
+	tlsCfg, err := f.tlsConfig()
+	if err != nil {
+		return nil, err
+	}
+	if tlsCfg != nil {
+		opts = append(opts, client.WithTLS(tlsCfg))
+	}
+
+	return client.New(ctx, addr, opts...)
+}
+
+// pinRingKey pins the --ring-key public key of the ring, which is
+// required to encrypt secrets to the ring.
+func (f *clientFlags) pinRingKey(ringID string) ([]client.Option, error) {
+	if f.ringKey == "" {
+		return nil, fmt.Errorf("the ring public key must be pinned with --ring-key, see `orbisd ring pubkey`")
+	}
+
+	pk, err := parseRingKey(f.ringKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return []client.Option{client.WithRingKey(ringID, pk)}, nil
+}
+
+// formatRingKey encodes a ring public key for --ring-key.
+func formatRingKey(pk crypto.PublicKey) (string, error) {
+	pkProto, err := crypto.PublicKeyToProto(pk)
+	if err != nil {
+		return "", fmt.Errorf("ring public key to proto: %w", err)
+	}
+	buf, err := proto.Marshal(pkProto)
+	if err != nil {
+		return "", fmt.Errorf("marshal ring public key: %w", err)
+	}
+	return base64.StdEncoding.EncodeToString(buf), nil
+}
+
+func parseRingKey(s string) (crypto.PublicKey, error) {
+	buf, err := base64.StdEncoding.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("decode --ring-key: %w", err)
+	}
+	pkProto := &icpb.PublicKey{}
+	err = proto.Unmarshal(buf, pkProto)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal --ring-key: %w", err)
+	}
+	pk, err := crypto.PublicKeyFromProto(pkProto)
+	if err != nil {
+		return nil, fmt.Errorf("--ring-key: %w", err)
+	}
+	return pk, nil
+}
+
+// tlsConfig builds the client TLS config from the flags, or
+// returns nil if TLS isn't enabled.
+func (f *clientFlags) tlsConfig() (*tls.Config, error) {
+	if !f.tls && f.tlsCA == "" && f.tlsCert == "" {
+		return nil, nil
+	}
+
+	cfg := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		ServerName: f.tlsServer,
+	}
+
+	if f.tlsCA != "" {
+		buf, err := os.ReadFile(f.tlsCA)
+		if err != nil {
+			return nil, fmt.Errorf("read tls CA: %w", err)
+		}
+		cfg.RootCAs = x509.NewCertPool()
+		if !cfg.RootCAs.AppendCertsFromPEM(buf) {
+			return nil, fmt.Errorf("no certificates found in %s", f.tlsCA)
+		}
+	}
+
+	if f.tlsCert != "" || f.tlsKey != "" {
+		cert, err := tls.LoadX509KeyPair(f.tlsCert, f.tlsKey)
+		if err != nil {
+			return nil, fmt.Errorf("load tls client certificate: %w", err)
+		}
+		cfg.Certificates = []tls.Certificate{cert}
+	}
+
+	return cfg, nil
+}
+
+// identity loads the keyfile identity, which is required for
+// authenticated commands.
+func (f *clientFlags) identity() (crypto.PrivateKey, error) {
+	sk, err := client.LoadKeyfile(f.keyfile)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("keyfile %s not found, create one with `orbisd keys gen`", f.keyfile)
+	} else if err != nil {
+		return nil, fmt.Errorf("load keyfile: %w", err)
+	}
+
+	return sk, nil
+}
+
+func (f *clientFlags) context(cmd *cobra.Command) (context.Context, context.CancelFunc) {
+	return context.WithTimeout(cmd.Context(), f.timeout)
+}
+
+// print writes v in the selected output format. For tables, the
+// header and rows are used, otherwise v is encoded as JSON.
+func (f *clientFlags) print(w io.Writer, v any, header []string, rows [][]string) error {
+	switch f.output {
+	case outputJSON:
+		return printJSON(w, v)
+	case outputTable:
+		return printTable(w, header, rows)
+	default:
+		return fmt.Errorf("unknown output format %q", f.output)
+	}
+}
+
+func printJSON(w io.Writer, v any) error {
+	var (
+		buf []byte
+		err error
+	)
+	if m, ok := v.(proto.Message); ok {
+		buf, err = protojson.MarshalOptions{Multiline: true, EmitUnpopulated: true}.Marshal(m)
+	} else {
+		buf, err = json.MarshalIndent(v, "", "  ")
+	}
+	if err != nil {
+		return fmt.Errorf("marshal output: %w", err)
+	}
+
+	_, err = fmt.Fprintln(w, string(buf))
+	return err
+}
+
+func printTable(w io.Writer, header []string, rows [][]string) error {
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	for _, row := range append([][]string{header}, rows...) {
+		for i, col := range row {
+			if i > 0 {
+				fmt.Fprint(tw, "\t")
+			}
+			fmt.Fprint(tw, col)
+		}
+		fmt.Fprintln(tw)
+	}
+	return tw.Flush()
+}
+
+// defaultServerAddr returns the gRPC address a node
+// listens on by default.
+func defaultServerAddr() string {
+	grpcCfg, err := config.Default[config.GRPC]()
+	if err != nil {
+		return ""
+	}
+	return grpcCfg.GRPCURL
+}
+
+func defaultKeyfilePath() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return defaultKeyfile
+	}
+	return filepath.Join(home, ".orbis", defaultKeyfile)
+}
+
+// readInput reads from the named file, or stdin if the name is empty or "-".
+func readInput(cmd *cobra.Command, name string) ([]byte, error) {
+	if name == "" || name == "-" {
+		return io.ReadAll(cmd.InOrStdin())
+	}
+	return os.ReadFile(name)
+}
+
+// writeOutput writes to the named file, or stdout if the name is empty or "-".
+func writeOutput(cmd *cobra.Command, name string, data []byte) error {
+	if name == "" || name == "-" {
+		_, err := cmd.OutOrStdout().Write(data)
+		return err
+	}
+	return os.WriteFile(name, data, 0o600)
+}
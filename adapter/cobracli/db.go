@@ -0,0 +1,200 @@
+package cobracli
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/sourcenetwork/orbis-go/config"
+	"github.com/sourcenetwork/orbis-go/pkg/custody"
+	"github.com/sourcenetwork/orbis-go/pkg/db"
+)
+
+// dbNewPasswordEnv holds the new DB passphrase of a rewrap,
+// when no new password file is given.
+const dbNewPasswordEnv = envPrefix + "_DB_NEW_PASSWORD"
+
+// DBCmd returns a Cobra command for encrypting the DB of a stopped
+// node, and rotating its keys.
+func DBCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "db",
+		Short: "Manage the encryption of the node DB",
+		Long: "Encrypt the node DB, and rotate its keys. The node must be stopped, since it holds its DB. " +
+			"The DB keys are wrapped as configured in db.encryption, with the passphrase read from the " +
+			"password file or " + custody.DBPasswordEnv + ", or with the node key custody.",
+	}
+
+	var configFile string
+	cmd.PersistentFlags().StringVar(&configFile, "config", defaultConfigName+".yaml", "Config filename")
+
+	encryptCmd := &cobra.Command{
+		Use:   "encrypt",
+		Short: "Seal the plaintext records of the DB under a new keyring",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := readConfigFile(configFile)
+			if err != nil {
+				return fmt.Errorf("read config file: %w", err)
+			}
+			w, closer, err := dbWrapper(cmd.Context(), cfg)
+			if err != nil {
+				return err
+			}
+			defer closer()
+
+			n, err := db.Encrypt(cfg.DB.Path, w)
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "encrypted %d records\n", n)
+			return nil
+		},
+	}
+
+	var reseal bool
+	rotateCmd := &cobra.Command{
+		Use:   "rotate",
+		Short: "Add a new data key sealing the records written from then on",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, keys, closer, err := openDBKeyring(cmd.Context(), configFile)
+			if err != nil {
+				return err
+			}
+			defer closer()
+
+			id, err := keys.Rotate()
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "rotated to data key %d\n", id)
+
+			if !reseal {
+				return nil
+			}
+			n, err := db.Reseal(cfg.DB.Path, keys)
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "resealed %d records, and retired the previous keys\n", n)
+			return nil
+		},
+	}
+	rotateCmd.Flags().BoolVar(&reseal, "reseal", false, "Reseal every record with the new key, and retire the previous keys")
+
+	var (
+		to              string
+		newPasswordFile string
+	)
+	rewrapCmd := &cobra.Command{
+		Use:   "rewrap",
+		Short: "Wrap the DB data keys under a new passphrase, or the node key custody",
+		Long: "Wrap the DB data keys under a new passphrase, read from the new password file or " +
+			dbNewPasswordEnv + ", or under the node key custody. Update db.encryption afterwards.",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, keys, closer, err := openDBKeyring(cmd.Context(), configFile)
+			if err != nil {
+				return err
+			}
+			defer closer()
+
+			var w db.Wrapper
+			switch to {
+			case "password":
+				password, err := custody.ReadPasswordEnv(newPasswordFile, dbNewPasswordEnv)
+				if err != nil {
+					return err
+				}
+				w = db.PasswordWrapper(password)
+			case "custody":
+				target := cfg
+				target.DB.Encryption.Wrapper = to
+				var wcloser func()
+				w, wcloser, err = dbWrapper(cmd.Context(), target)
+				if err != nil {
+					return err
+				}
+				defer wcloser()
+			default:
+				return fmt.Errorf("unsupported db key wrapper %q", to)
+			}
+
+			err = keys.Rewrap(w)
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "wrapped the db keys, set db.encryption.wrapper to %s\n", to)
+			return nil
+		},
+	}
+	rewrapCmd.Flags().StringVar(&to, "to", "password", "New wrapper of the data keys, password or custody")
+	rewrapCmd.Flags().StringVar(&newPasswordFile, "new-password-file", "", "File with the new DB passphrase")
+
+	cmd.AddCommand(encryptCmd, rotateCmd, rewrapCmd)
+
+	return cmd
+}
+
+// openDBKeyring opens the keyring of the configured DB.
+func openDBKeyring(ctx context.Context, configFile string) (config.Config, *db.Keyring, func(), error) {
+	cfg, err := readConfigFile(configFile)
+	if err != nil {
+		return cfg, nil, nil, fmt.Errorf("read config file: %w", err)
+	}
+	w, closer, err := dbWrapper(ctx, cfg)
+	if err != nil {
+		return cfg, nil, nil, err
+	}
+	path, err := db.KeyringPath(cfg.DB.Path)
+	if err != nil {
+		closer()
+		return cfg, nil, nil, err
+	}
+	keys, err := db.OpenKeyring(path, w)
+	if err != nil {
+		closer()
+		return cfg, nil, nil, err
+	}
+	return cfg, keys, closer, nil
+}
+
+// dbWrapper returns the configured wrapper of the DB keys, with
+// the key custody open if they're wrapped by the node key.
+func dbWrapper(ctx context.Context, cfg config.Config) (db.Wrapper, func(), error) {
+	closer := func() {}
+	if cfg.DB.Encryption.Wrapper == "" {
+		return nil, closer, fmt.Errorf("set db.encryption.wrapper to encrypt the db")
+	}
+
+	var keys custody.Custody
+	if cfg.DB.Encryption.Wrapper == "custody" {
+		var err error
+		keys, err = custody.New(ctx, cfg)
+		if err != nil {
+			return nil, closer, fmt.Errorf("open key custody: %w", err)
+		}
+		closer = func() { keys.Close() }
+	}
+
+	w, err := custody.DBWrapper(cfg, keys)
+	if err != nil {
+		closer()
+		return nil, func() {}, err
+	}
+	return w, closer, nil
+}
+
+// dbOptions returns the options opening the configured DB.
+func dbOptions(ctx context.Context, cfg config.Config) ([]db.Option, func(), error) {
+	if cfg.DB.Encryption.Wrapper == "" {
+		return nil, func() {}, nil
+	}
+	w, closer, err := dbWrapper(ctx, cfg)
+	if err != nil {
+		return nil, closer, err
+	}
+	return []db.Option{db.WithEncryption(w)}, closer, nil
+}
@@ -0,0 +1,62 @@
+package cobracli
+
+import (
+	"crypto/rand"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"go.dedis.ch/kyber/v3/suites"
+
+	"github.com/sourcenetwork/orbis-go/pkg/client"
+	"github.com/sourcenetwork/orbis-go/pkg/crypto"
+)
+
+// KeysCmd returns a Cobra command for managing the client identity keys.
+func KeysCmd() *cobra.Command {
+	var flags clientFlags
+
+	cmd := &cobra.Command{
+		Use:   "keys",
+		Short: "Manage client identity keys",
+	}
+	flags.register(cmd)
+
+	var force bool
+	genCmd := &cobra.Command{
+		Use:   "gen",
+		Short: "Generate a new identity keyfile",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if _, err := os.Stat(flags.keyfile); err == nil && !force {
+				return fmt.Errorf("keyfile %s already exists, use --force to overwrite", flags.keyfile)
+			}
+
+			sk, _, err := crypto.GenerateKeyPair(suites.MustFind("ed25519"), rand.Reader)
+			if err != nil {
+				return fmt.Errorf("generate key pair: %w", err)
+			}
+
+			kf, err := client.SaveKeyfile(flags.keyfile, sk)
+			if err != nil {
+				return err
+			}
+
+			out := map[string]string{
+				"did":        kf.DID,
+				"key_type":   kf.KeyType,
+				"public_key": kf.PublicKey,
+				"keyfile":    flags.keyfile,
+			}
+			return flags.print(cmd.OutOrStdout(), out,
+				[]string{"DID", "KEY TYPE", "PUBLIC KEY", "KEYFILE"},
+				[][]string{{kf.DID, kf.KeyType, kf.PublicKey, flags.keyfile}},
+			)
+		},
+	}
+	genCmd.Flags().BoolVar(&force, "force", false, "Overwrite an existing keyfile")
+
+	cmd.AddCommand(genCmd)
+
+	return cmd
+}
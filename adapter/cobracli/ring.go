@@ -0,0 +1,615 @@
+package cobracli
+
+import (
+	"encoding/base64"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/encoding/protojson"
+	"sigs.k8s.io/yaml"
+
+	authzv1alpha1 "github.com/sourcenetwork/orbis-go/gen/proto/orbis/authz/v1alpha1"
+	ringv1alpha1 "github.com/sourcenetwork/orbis-go/gen/proto/orbis/ring/v1alpha1"
+	transportv1alpha1 "github.com/sourcenetwork/orbis-go/gen/proto/orbis/transport/v1alpha1"
+	"github.com/sourcenetwork/orbis-go/pkg/client"
+	"github.com/sourcenetwork/orbis-go/pkg/crypto"
+	"github.com/sourcenetwork/orbis-go/pkg/types"
+)
+
+// RingCmd returns a Cobra command for managing rings.
+func RingCmd() *cobra.Command {
+	var flags clientFlags
+
+	cmd := &cobra.Command{
+		Use:   "ring",
+		Short: "Manage secret rings",
+	}
+	flags.register(cmd)
+
+	cmd.AddCommand(
+		ringCreateCmd(&flags),
+		ringListCmd(&flags),
+		ringStatusCmd(&flags),
+		ringPubkeyCmd(&flags),
+		ringWizardCmd(&flags),
+		ringProposeCmd(&flags),
+		ringProposalsCmd(&flags),
+		ringApproveCmd(&flags),
+		ringAuditCmd(&flags),
+		ringRelationshipCmd(&flags),
+	)
+
+	return cmd
+}
+
+func ringCreateCmd(flags *clientFlags) *cobra.Command {
+	var manifestFile string
+
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create a ring from a manifest file",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			manifest, err := readManifest(manifestFile)
+			if err != nil {
+				return err
+			}
+
+			ctx, cancel := flags.context(cmd)
+			defer cancel()
+
+			c, err := flags.dial(ctx)
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+
+			resp, err := c.Ring.CreateRing(ctx, &ringv1alpha1.CreateRingRequest{Manifest: manifest})
+			if err != nil {
+				return fmt.Errorf("create ring: %w", err)
+			}
+
+			return flags.print(cmd.OutOrStdout(), resp,
+				[]string{"ID"},
+				[][]string{{resp.Id}},
+			)
+		},
+	}
+	cmd.Flags().StringVar(&manifestFile, "manifest", "", "Ring manifest file (yaml or json)")
+	cmd.MarkFlagRequired("manifest") // nolint:errcheck
+
+	return cmd
+}
+
+func ringProposeCmd(flags *clientFlags) *cobra.Command {
+	var manifestFile string
+
+	cmd := &cobra.Command{
+		Use:   "propose",
+		Short: "Propose a ring to all the manifest nodes",
+		Long: `Propose a ring to all the manifest nodes. Each node joins the ring once
+it accepts the proposal, either automatically or with 'ring approve', and
+the ring DKG starts once every node has accepted.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			manifest, err := readManifest(manifestFile)
+			if err != nil {
+				return err
+			}
+
+			ctx, cancel := flags.context(cmd)
+			defer cancel()
+
+			c, err := flags.dial(ctx)
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+
+			resp, err := c.Ring.ProposeRing(ctx, &ringv1alpha1.ProposeRingRequest{Manifest: manifest})
+			if err != nil {
+				return fmt.Errorf("propose ring: %w", err)
+			}
+
+			return flags.print(cmd.OutOrStdout(), resp,
+				[]string{"ID"},
+				[][]string{{resp.RingId}},
+			)
+		},
+	}
+	cmd.Flags().StringVar(&manifestFile, "manifest", "", "Ring manifest file (yaml or json)")
+	cmd.MarkFlagRequired("manifest") // nolint:errcheck
+
+	return cmd
+}
+
+func ringProposalsCmd(flags *clientFlags) *cobra.Command {
+	return &cobra.Command{
+		Use:   "proposals",
+		Short: "List ring proposals for this node",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, cancel := flags.context(cmd)
+			defer cancel()
+
+			c, err := flags.dial(ctx)
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+
+			resp, err := c.Ring.ListProposals(ctx, &ringv1alpha1.ListProposalsRequest{})
+			if err != nil {
+				return fmt.Errorf("list proposals: %w", err)
+			}
+
+			rows := make([][]string, len(resp.Proposals))
+			for i, p := range resp.Proposals {
+				rows[i] = proposalRow(p)
+			}
+
+			return flags.print(cmd.OutOrStdout(), resp,
+				[]string{"ID", "PROPOSER", "STATUS", "ACKS"},
+				rows,
+			)
+		},
+	}
+}
+
+func ringApproveCmd(flags *clientFlags) *cobra.Command {
+	var id string
+
+	cmd := &cobra.Command{
+		Use:   "approve",
+		Short: "Approve a pending ring proposal",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, cancel := flags.context(cmd)
+			defer cancel()
+
+			c, err := flags.dial(ctx)
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+
+			resp, err := c.Ring.ApproveProposal(ctx, &ringv1alpha1.ApproveProposalRequest{RingId: id})
+			if err != nil {
+				return fmt.Errorf("approve proposal: %w", err)
+			}
+
+			return flags.print(cmd.OutOrStdout(), resp,
+				[]string{"ID", "PROPOSER", "STATUS", "ACKS"},
+				[][]string{proposalRow(resp.Proposal)},
+			)
+		},
+	}
+	cmd.Flags().StringVar(&id, "id", "", "Ring ID")
+	cmd.MarkFlagRequired("id") // nolint:errcheck
+
+	return cmd
+}
+
+func ringAuditCmd(flags *clientFlags) *cobra.Command {
+	var id, subject, secretID string
+
+	cmd := &cobra.Command{
+		Use:   "audit",
+		Short: "Show the secret access audit log of a ring",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, cancel := flags.context(cmd)
+			defer cancel()
+
+			c, err := flags.dial(ctx)
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+
+			resp, err := c.Ring.AuditLog(ctx, &ringv1alpha1.AuditLogRequest{
+				RingId:   id,
+				Subject:  subject,
+				SecretId: secretID,
+			})
+			if err != nil {
+				return fmt.Errorf("audit log: %w", err)
+			}
+			if !resp.ChainValid {
+				fmt.Fprintln(cmd.ErrOrStderr(), "WARNING: the audit log hash chain failed verification")
+			}
+
+			rows := make([][]string, len(resp.Records))
+			for i, r := range resp.Records {
+				rows[i] = []string{
+					strconv.FormatUint(r.Seq, 10),
+					time.Unix(0, r.Timestamp).UTC().Format(time.RFC3339),
+					r.Subject,
+					r.SecretId,
+					strconv.FormatBool(r.Authorized),
+					strconv.FormatBool(r.Reencrypted),
+					r.Error,
+				}
+			}
+
+			return flags.print(cmd.OutOrStdout(), resp,
+				[]string{"SEQ", "TIME", "SUBJECT", "SECRET", "AUTHORIZED", "REENCRYPTED", "ERROR"},
+				rows,
+			)
+		},
+	}
+	cmd.Flags().StringVar(&id, "id", "", "Ring ID")
+	cmd.Flags().StringVar(&subject, "subject", "", "Only show records of this subject")
+	cmd.Flags().StringVar(&secretID, "secret", "", "Only show records of this secret ID")
+	cmd.MarkFlagRequired("id") // nolint:errcheck
+
+	return cmd
+}
+
+func ringRelationshipCmd(flags *clientFlags) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "relationship",
+		Short: "Manage the relationships of a ring authorizer",
+	}
+
+	cmd.AddCommand(
+		ringRelationshipWriteCmd(flags, "write", "Write a relationship"),
+		ringRelationshipWriteCmd(flags, "delete", "Delete a relationship"),
+	)
+
+	return cmd
+}
+
+func ringRelationshipWriteCmd(flags *clientFlags, use, short string) *cobra.Command {
+	var id string
+
+	cmd := &cobra.Command{
+		Use:     use + " <policy>/<resource>:<resource_id>#<relation> <subject>",
+		Short:   short,
+		Example: "orbisd ring relationship " + use + " --id <ring> 1/secret:mysecret#collaborator user:did:key:z6Mk...",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			rel, err := parseRelationship(args[0], args[1])
+			if err != nil {
+				return err
+			}
+
+			ctx, cancel := flags.context(cmd)
+			defer cancel()
+
+			c, err := flags.dial(ctx)
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+
+			if use == "delete" {
+				_, err = c.Ring.DeleteRelationship(ctx, &ringv1alpha1.DeleteRelationshipRequest{RingId: id, Relationship: rel})
+			} else {
+				_, err = c.Ring.WriteRelationship(ctx, &ringv1alpha1.WriteRelationshipRequest{RingId: id, Relationship: rel})
+			}
+			if err != nil {
+				return fmt.Errorf("%s relationship: %w", use, err)
+			}
+
+			return flags.print(cmd.OutOrStdout(), rel,
+				[]string{"POLICY", "RESOURCE", "RESOURCE ID", "RELATION", "SUBJECT"},
+				[][]string{{rel.PolicyId, rel.Resource, rel.ResourceId, rel.Relation, rel.Subject}},
+			)
+		},
+	}
+	cmd.Flags().StringVar(&id, "id", "", "Ring ID")
+	cmd.MarkFlagRequired("id") // nolint:errcheck
+
+	return cmd
+}
+
+// parseRelationship parses an object in the authorization
+// permission format, policy/resource:resource_id#relation.
+func parseRelationship(object, subject string) (*authzv1alpha1.Relationship, error) {
+	policyID, rest, ok := strings.Cut(object, "/")
+	res, rest, ok2 := strings.Cut(rest, ":")
+	i := strings.LastIndex(rest, "#")
+	if !ok || !ok2 || i < 0 || policyID == "" || res == "" {
+		return nil, fmt.Errorf("invalid relationship object %q, expected <policy>/<resource>:<resource_id>#<relation>", object)
+	}
+
+	return &authzv1alpha1.Relationship{
+		PolicyId:   policyID,
+		Resource:   res,
+		ResourceId: rest[:i],
+		Relation:   rest[i+1:],
+		Subject:    subject,
+	}, nil
+}
+
+func proposalRow(p *ringv1alpha1.RingProposal) []string {
+	return []string{
+		p.GetRingId(),
+		p.GetProposer(),
+		p.GetStatus(),
+		fmt.Sprintf("%d/%d", len(p.GetAcks()), len(p.GetManifest().GetNodes())),
+	}
+}
+
+func ringListCmd(flags *clientFlags) *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "List rings",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, cancel := flags.context(cmd)
+			defer cancel()
+
+			c, err := flags.dial(ctx)
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+
+			resp, err := c.Ring.ListRings(ctx, &ringv1alpha1.ListRingsRequest{})
+			if err != nil {
+				return fmt.Errorf("list rings: %w", err)
+			}
+
+			rows := make([][]string, len(resp.Rings))
+			for i, r := range resp.Rings {
+				m := r.GetManifest()
+				rows[i] = []string{
+					r.Id,
+					strconv.Itoa(int(m.GetN())),
+					strconv.Itoa(int(m.GetT())),
+					m.GetDkg(),
+					m.GetPre(),
+					m.GetBulletin(),
+				}
+			}
+
+			return flags.print(cmd.OutOrStdout(), resp,
+				[]string{"ID", "N", "T", "DKG", "PRE", "BULLETIN"},
+				rows,
+			)
+		},
+	}
+}
+
+func ringStatusCmd(flags *clientFlags) *cobra.Command {
+	var id string
+
+	cmd := &cobra.Command{
+		Use:   "status",
+		Short: "Show the state of the ring services",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, cancel := flags.context(cmd)
+			defer cancel()
+
+			c, err := flags.dial(ctx)
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+
+			resp, err := c.Ring.State(ctx, &ringv1alpha1.StateRequest{Id: id})
+			if err != nil {
+				return fmt.Errorf("ring state: %w", err)
+			}
+
+			rows := make([][]string, len(resp.Services))
+			for i, s := range resp.Services {
+				rows[i] = []string{s.Name, s.State}
+			}
+
+			return flags.print(cmd.OutOrStdout(), resp,
+				[]string{"SERVICE", "STATE"},
+				rows,
+			)
+		},
+	}
+	cmd.Flags().StringVar(&id, "id", "", "Ring ID")
+	cmd.MarkFlagRequired("id") // nolint:errcheck
+
+	return cmd
+}
+
+func ringPubkeyCmd(flags *clientFlags) *cobra.Command {
+	var id string
+
+	cmd := &cobra.Command{
+		Use:   "pubkey",
+		Short: "Show the public key of the ring",
+		Long: "Show the public key of the ring reported by the node. A single node can't prove the ring key, " +
+			"confirm it with the ring operators before pinning it with --ring-key. If --ring-key is given, " +
+			"the reported key is checked against it.",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var opts []client.Option
+			if flags.ringKey != "" {
+				var err error
+				opts, err = flags.pinRingKey(id)
+				if err != nil {
+					return err
+				}
+			}
+
+			ctx, cancel := flags.context(cmd)
+			defer cancel()
+
+			c, err := flags.dial(ctx, opts...)
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+
+			var pk crypto.PublicKey
+			if flags.ringKey != "" {
+				pk, err = c.RingPublicKey(ctx, id)
+			} else {
+				pk, err = c.FetchRingPublicKey(ctx, id)
+			}
+			if err != nil {
+				return err
+			}
+
+			raw, err := pk.Raw()
+			if err != nil {
+				return fmt.Errorf("raw public key: %w", err)
+			}
+			pin, err := formatRingKey(pk)
+			if err != nil {
+				return err
+			}
+
+			out := map[string]string{
+				"ring_id":    id,
+				"key_type":   pk.Type().String(),
+				"public_key": base64.StdEncoding.EncodeToString(raw),
+				"ring_key":   pin,
+				"verified":   strconv.FormatBool(flags.ringKey != ""),
+			}
+			return flags.print(cmd.OutOrStdout(), out,
+				[]string{"RING ID", "KEY TYPE", "PUBLIC KEY", "RING KEY", "VERIFIED"},
+				[][]string{{id, out["key_type"], out["public_key"], pin, out["verified"]}},
+			)
+		},
+	}
+	cmd.Flags().StringVar(&id, "id", "", "Ring ID")
+	cmd.MarkFlagRequired("id") // nolint:errcheck
+
+	return cmd
+}
+
+func ringWizardCmd(flags *clientFlags) *cobra.Command {
+	var (
+		nodeAddrs []string
+		threshold int
+		out       string
+		manifest  = &ringv1alpha1.Manifest{}
+	)
+
+	cmd := &cobra.Command{
+		Use:   "wizard",
+		Short: "Build a ring manifest by querying the candidate nodes",
+		Long: `Build a ring manifest by querying the host information of each
+candidate node, given by their gRPC address. The manifest is validated
+locally, and dry-run validated by each of the nodes.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(nodeAddrs) == 0 {
+				return fmt.Errorf("at least one --node is required")
+			}
+
+			ctx, cancel := flags.context(cmd)
+			defer cancel()
+
+			manifest.N = int32(len(nodeAddrs))
+			manifest.T = int32(threshold)
+			if threshold == 0 {
+				// default to a majority threshold.
+				manifest.T = manifest.N/2 + 1
+			}
+
+			for _, addr := range nodeAddrs {
+				c, err := flags.connect(ctx, addr)
+				if err != nil {
+					return err
+				}
+
+				resp, err := c.Transport.GetHost(ctx, &transportv1alpha1.GetHostRequest{Transport: manifest.Transport})
+				c.Close()
+				if err != nil {
+					return fmt.Errorf("get host of %s: %w", addr, err)
+				}
+
+				manifest.Nodes = append(manifest.Nodes, &ringv1alpha1.Node{
+					Id:        resp.Node.Id,
+					Address:   resp.Node.Address,
+					PublicKey: resp.Node.PublicKey,
+				})
+			}
+
+			var violations []string
+			for _, me := range types.ManifestErrors(types.Manifest{Manifest: manifest}.Validate()) {
+				violations = append(violations, me.Error())
+			}
+
+			for _, addr := range nodeAddrs {
+				c, err := flags.connect(ctx, addr)
+				if err != nil {
+					return err
+				}
+
+				resp, err := c.Ring.ValidateManifest(ctx, &ringv1alpha1.ValidateManifestRequest{Manifest: manifest})
+				c.Close()
+				if err != nil {
+					return fmt.Errorf("validate manifest on %s: %w", addr, err)
+				}
+
+				for _, v := range resp.Violations {
+					violations = append(violations, fmt.Sprintf("%s: %s: %s", addr, v.Field, v.Description))
+				}
+			}
+
+			buf, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(manifest)
+			if err != nil {
+				return fmt.Errorf("marshal manifest: %w", err)
+			}
+			buf, err = yaml.JSONToYAML(buf)
+			if err != nil {
+				return fmt.Errorf("marshal manifest: %w", err)
+			}
+
+			err = writeOutput(cmd, out, buf)
+			if err != nil {
+				return fmt.Errorf("write manifest: %w", err)
+			}
+
+			if len(violations) > 0 {
+				for _, v := range violations {
+					fmt.Fprintln(cmd.ErrOrStderr(), v)
+				}
+				return fmt.Errorf("manifest has %d violations", len(violations))
+			}
+
+			return nil
+		},
+	}
+	cmd.Flags().StringSliceVar(&nodeAddrs, "node", nil, "gRPC address of a candidate node, can be repeated")
+	cmd.Flags().IntVarP(&threshold, "threshold", "t", 0, "Ring threshold, defaults to a majority of the nodes")
+	cmd.Flags().StringVar(&out, "out", "-", "File to write the manifest to, defaults to stdout")
+	cmd.Flags().StringVar(&manifest.Dkg, "dkg", "rabin", "DKG service, rabin or pedersen")
+	cmd.Flags().StringVar(&manifest.Pss, "pss", "avpss", "PSS service")
+	cmd.Flags().StringVar(&manifest.Pre, "pre", "elgamal", "PRE service")
+	cmd.Flags().StringVar(&manifest.Bulletin, "bulletin", "p2pbb", "Bulletin service")
+	cmd.Flags().StringVar(&manifest.Transport, "transport", "p2p", "Transport service")
+	cmd.Flags().StringVar(&manifest.Authorization, "authorization", "zanzi", "Authorization service")
+	cmd.Flags().StringVar(&manifest.Authentication, "authentication", "jws-did", "Authentication service")
+
+	return cmd
+}
+
+// readManifest reads a yaml or json encoded ring manifest.
+func readManifest(file string) (*ringv1alpha1.Manifest, error) {
+	buf, err := os.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("read manifest: %w", err)
+	}
+
+	// yaml is a superset of json.
+	buf, err = yaml.YAMLToJSON(buf)
+	if err != nil {
+		return nil, fmt.Errorf("parse manifest: %w", err)
+	}
+
+	manifest := new(ringv1alpha1.Manifest)
+	err = protojson.Unmarshal(buf, manifest)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal manifest: %w", err)
+	}
+
+	return manifest, nil
+}
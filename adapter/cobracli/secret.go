@@ -0,0 +1,188 @@
+package cobracli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	ringv1alpha1 "github.com/sourcenetwork/orbis-go/gen/proto/orbis/ring/v1alpha1"
+	"github.com/sourcenetwork/orbis-go/pkg/client"
+	"github.com/sourcenetwork/orbis-go/pkg/crypto"
+)
+
+// SecretCmd returns a Cobra command for managing secrets.
+func SecretCmd() *cobra.Command {
+	var flags clientFlags
+
+	cmd := &cobra.Command{
+		Use:   "secret",
+		Short: "Store and retrieve secrets",
+	}
+	flags.register(cmd)
+
+	cmd.AddCommand(
+		secretPutCmd(&flags),
+		secretGetCmd(&flags),
+		secretListCmd(&flags),
+	)
+
+	return cmd
+}
+
+func secretPutCmd(flags *clientFlags) *cobra.Command {
+	var ringID, policyFile, in string
+
+	cmd := &cobra.Command{
+		Use:   "put",
+		Short: "Encrypt and store a secret in a ring",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			policy, err := readInput(cmd, policyFile)
+			if err != nil {
+				return fmt.Errorf("read policy: %w", err)
+			}
+
+			scrt, err := readInput(cmd, in)
+			if err != nil {
+				return fmt.Errorf("read secret: %w", err)
+			}
+
+			sk, err := flags.identity()
+			if err != nil {
+				return err
+			}
+
+			opts, err := flags.pinRingKey(ringID)
+			if err != nil {
+				return err
+			}
+
+			ctx, cancel := flags.context(cmd)
+			defer cancel()
+
+			c, err := flags.connect(ctx, flags.serverAddr, append(opts, client.WithIdentity(sk))...)
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+
+			sid, err := c.StoreSecret(ctx, ringID, scrt, strings.TrimSpace(string(policy)))
+			if err != nil {
+				return err
+			}
+
+			out := map[string]string{
+				"ring_id":   ringID,
+				"secret_id": sid,
+			}
+			return flags.print(cmd.OutOrStdout(), out,
+				[]string{"RING ID", "SECRET ID"},
+				[][]string{{ringID, sid}},
+			)
+		},
+	}
+	cmd.Flags().StringVar(&ringID, "ring", "", "Ring ID")
+	cmd.Flags().StringVar(&policyFile, "policy", "", "File with the authorization policy of the secret")
+	cmd.Flags().StringVar(&in, "in", "-", "File with the secret, defaults to stdin")
+	cmd.MarkFlagRequired("ring")   // nolint:errcheck
+	cmd.MarkFlagRequired("policy") // nolint:errcheck
+
+	return cmd
+}
+
+func secretGetCmd(flags *clientFlags) *cobra.Command {
+	var ringID, sid, keyfile, out string
+
+	cmd := &cobra.Command{
+		Use:   "get",
+		Short: "Retrieve and decrypt a secret from a ring",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var (
+				rdrSk crypto.PrivateKey
+				err   error
+			)
+			if keyfile != "" {
+				rdrSk, err = client.LoadKeyfile(keyfile)
+			} else {
+				rdrSk, err = flags.identity()
+			}
+			if err != nil {
+				return err
+			}
+
+			opts, err := flags.pinRingKey(ringID)
+			if err != nil {
+				return err
+			}
+
+			ctx, cancel := flags.context(cmd)
+			defer cancel()
+
+			c, err := flags.connect(ctx, flags.serverAddr, append(opts, client.WithIdentity(rdrSk))...)
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+
+			scrt, err := c.RetrieveSecret(ctx, ringID, sid, rdrSk)
+			if err != nil {
+				return err
+			}
+
+			return writeOutput(cmd, out, scrt)
+		},
+	}
+	cmd.Flags().StringVar(&ringID, "ring", "", "Ring ID")
+	cmd.Flags().StringVar(&sid, "id", "", "Secret ID")
+	cmd.Flags().StringVar(&keyfile, "key", "", "Reader keyfile, defaults to --keyfile")
+	cmd.Flags().StringVar(&out, "out", "-", "File to write the secret to, defaults to stdout")
+	cmd.MarkFlagRequired("ring") // nolint:errcheck
+	cmd.MarkFlagRequired("id")   // nolint:errcheck
+
+	return cmd
+}
+
+func secretListCmd(flags *clientFlags) *cobra.Command {
+	var ringID string
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List the secrets of a ring",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, cancel := flags.context(cmd)
+			defer cancel()
+
+			c, err := flags.dial(ctx)
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+
+			resp, err := c.Ring.ListSecrets(ctx, &ringv1alpha1.ListSecretsRequest{RingId: ringID})
+			if err != nil {
+				return fmt.Errorf("list secrets: %w", err)
+			}
+
+			rows := make([][]string, len(resp.Secrets))
+			for i, s := range resp.Secrets {
+				dem := s.Dem
+				if dem == "" {
+					dem = "-"
+				}
+				rows[i] = []string{resp.SecretIds[i], s.AuthzCtx, dem}
+			}
+
+			return flags.print(cmd.OutOrStdout(), resp,
+				[]string{"ID", "POLICY", "DEM"},
+				rows,
+			)
+		},
+	}
+	cmd.Flags().StringVar(&ringID, "ring", "", "Ring ID")
+	cmd.MarkFlagRequired("ring") // nolint:errcheck
+
+	return cmd
+}
@@ -0,0 +1,98 @@
+package cobracli
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+
+	"github.com/sourcenetwork/orbis-go/adapter/grpcserver"
+	"github.com/sourcenetwork/orbis-go/config"
+	custodyv1alpha1 "github.com/sourcenetwork/orbis-go/gen/proto/orbis/custody/v1alpha1"
+	"github.com/sourcenetwork/orbis-go/pkg/custody"
+)
+
+// SignerCmd returns a Cobra command serving a node identity key
+// to the remote custody of a node, from a password encrypted key
+// file, generated if missing.
+func SignerCmd() *cobra.Command {
+	var (
+		listen       string
+		keyFile      string
+		passwordFile string
+		tlsCert      string
+		tlsKey       string
+		tlsClientCA  string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "signer",
+		Short: "Serve a node identity key to a node with remote key custody",
+		Long: "Serve a node identity key to a node configured with the remote custody provider. " +
+			"The key never leaves the signer. The password of the key file is read from the " +
+			"password file, or from " + custody.PasswordEnv + ". Set a client CA to only sign for " +
+			"nodes with a trusted certificate.",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			password, err := custody.ReadPassword(passwordFile)
+			if err != nil {
+				return err
+			}
+			hostCfg, err := config.Default[config.Host]()
+			if err != nil {
+				return fmt.Errorf("default host config: %w", err)
+			}
+			keys, err := custody.OpenFile(keyFile, password, hostCfg)
+			if err != nil {
+				return err
+			}
+
+			var grpcCfg config.GRPC
+			grpcCfg.TLS.CertFile = tlsCert
+			grpcCfg.TLS.KeyFile = tlsKey
+			grpcCfg.TLS.ClientCAFile = tlsClientCA
+			tls, err := grpcserver.NewTLS(grpcCfg)
+			if err != nil {
+				return fmt.Errorf("signer tls: %w", err)
+			}
+			var opts []grpc.ServerOption
+			if tls != nil {
+				defer tls.Close()
+				opts = append(opts, grpc.Creds(credentials.NewTLS(tls.ServerConfig())))
+			}
+
+			srv := grpc.NewServer(opts...)
+			custodyv1alpha1.RegisterSignerServiceServer(srv, custody.NewSignerServer(keys.PrivKey()))
+
+			lis, err := net.Listen("tcp", listen)
+			if err != nil {
+				return fmt.Errorf("listen: %w", err)
+			}
+			ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+			defer stop()
+			go func() {
+				<-ctx.Done()
+				srv.GracefulStop()
+			}()
+
+			pid, _ := peer.IDFromPrivateKey(keys.PrivKey())
+			fmt.Fprintf(cmd.OutOrStdout(), "signing for node %s on %s\n", pid, lis.Addr())
+			return srv.Serve(lis)
+		},
+	}
+
+	cmd.Flags().StringVar(&listen, "listen", "127.0.0.1:9500", "Signer gRPC listen address")
+	cmd.Flags().StringVar(&keyFile, "key-file", "identity.key.json", "Password encrypted identity key file, generated if missing")
+	cmd.Flags().StringVar(&passwordFile, "password-file", "", "File with the key file password")
+	cmd.Flags().StringVar(&tlsCert, "tls-cert", "", "TLS certificate file, enables TLS")
+	cmd.Flags().StringVar(&tlsKey, "tls-key", "", "TLS private key file")
+	cmd.Flags().StringVar(&tlsClientCA, "tls-client-ca", "", "CA certificate file verifying the node certificates, enables mTLS")
+
+	return cmd
+}
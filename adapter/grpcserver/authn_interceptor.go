@@ -0,0 +1,166 @@
+package grpcserver
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	ringv1alpha1 "github.com/sourcenetwork/orbis-go/gen/proto/orbis/ring/v1alpha1"
+	transportv1alpha1 "github.com/sourcenetwork/orbis-go/gen/proto/orbis/transport/v1alpha1"
+	utilityv1alpha1 "github.com/sourcenetwork/orbis-go/gen/proto/orbis/utility/v1alpha1"
+	"github.com/sourcenetwork/orbis-go/pkg/authn"
+)
+
+// methodPolicy is the authentication required to call an RPC.
+type methodPolicy int
+
+const (
+	// policyAuthenticated requires a valid credential. It is the
+	// default for any method missing from the policy table.
+	policyAuthenticated methodPolicy = iota
+	// policyPublic doesn't require a credential, but one is still
+	// verified and attached to the context if given.
+	policyPublic
+	// policyOperator requires a valid credential from one of the
+	// configured node operators.
+	policyOperator
+)
+
+// methodPolicies is the per method policy table.
+var methodPolicies = map[string]methodPolicy{
+	// ring admin
+	ringv1alpha1.RingService_CreateRing_FullMethodName:         policyOperator,
+	ringv1alpha1.RingService_ValidateManifest_FullMethodName:   policyOperator,
+	ringv1alpha1.RingService_ProposeRing_FullMethodName:        policyOperator,
+	ringv1alpha1.RingService_ListProposals_FullMethodName:      policyOperator,
+	ringv1alpha1.RingService_ApproveProposal_FullMethodName:    policyOperator,
+	ringv1alpha1.RingService_DeleteRing_FullMethodName:         policyOperator,
+	ringv1alpha1.RingService_Refresh_FullMethodName:            policyOperator,
+	ringv1alpha1.RingService_AuditLog_FullMethodName:           policyOperator,
+	ringv1alpha1.RingService_WriteRelationship_FullMethodName:  policyOperator,
+	ringv1alpha1.RingService_DeleteRelationship_FullMethodName: policyOperator,
+	ringv1alpha1.RingService_Sign_FullMethodName:               policyOperator,
+
+	// ring info
+	ringv1alpha1.RingService_ListRings_FullMethodName:           policyPublic,
+	ringv1alpha1.RingService_GetRing_FullMethodName:             policyPublic,
+	ringv1alpha1.RingService_PublicKey_FullMethodName:           policyPublic,
+	ringv1alpha1.RingService_State_FullMethodName:               policyPublic,
+	ringv1alpha1.RingService_VerifyRingSignature_FullMethodName: policyPublic,
+
+	// secrets
+	ringv1alpha1.RingService_ListSecrets_FullMethodName:     policyAuthenticated,
+	ringv1alpha1.RingService_StoreSecret_FullMethodName:     policyAuthenticated,
+	ringv1alpha1.RingService_ReencryptSecret_FullMethodName: policyAuthenticated,
+	ringv1alpha1.RingService_DecryptSecret_FullMethodName:   policyAuthenticated,
+	ringv1alpha1.RingService_DeleteSecret_FullMethodName:    policyAuthenticated,
+
+	transportv1alpha1.TransportService_GetHost_FullMethodName: policyPublic,
+
+	// local helpers, they don't touch any node state
+	utilityv1alpha1.UtilityService_CreateDID_FullMethodName:     policyPublic,
+	utilityv1alpha1.UtilityService_CreateJWT_FullMethodName:     policyPublic,
+	utilityv1alpha1.UtilityService_CreateKeypair_FullMethodName: policyPublic,
+	utilityv1alpha1.UtilityService_EncryptSecret_FullMethodName: policyPublic,
+	utilityv1alpha1.UtilityService_DecryptSecret_FullMethodName: policyPublic,
+}
+
+// publicServicePrefixes are services where all methods are public.
+var publicServicePrefixes = []string{
+	"/grpc.reflection.",
+	"/grpc.health.",
+}
+
+func policyForMethod(method string) methodPolicy {
+	if p, ok := methodPolicies[method]; ok {
+		return p
+	}
+	for _, prefix := range publicServicePrefixes {
+		if strings.HasPrefix(method, prefix) {
+			return policyPublic
+		}
+	}
+	return policyAuthenticated
+}
+
+// authnInterceptor authenticates every request with the credential
+// service, enforces the method policy, and attaches the authenticated
+// subject to the request context.
+type authnInterceptor struct {
+	creds     authn.CredentialService
+	operators authn.Operators
+}
+
+func newAuthnInterceptor(creds authn.CredentialService, operators []string) *authnInterceptor {
+	ops := authn.NewOperators(operators)
+	if len(ops) == 0 {
+		log.Warn("No operators configured, the ring admin RPCs are denied")
+	}
+
+	return &authnInterceptor{
+		creds:     creds,
+		operators: ops,
+	}
+}
+
+func (a *authnInterceptor) serverOptions() []grpc.ServerOption {
+	return []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(a.unary),
+		grpc.ChainStreamInterceptor(a.stream),
+	}
+}
+
+func (a *authnInterceptor) unary(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	ctx, err := a.authenticate(ctx, info.FullMethod)
+	if err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+func (a *authnInterceptor) stream(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	ctx, err := a.authenticate(ss.Context(), info.FullMethod)
+	if err != nil {
+		return err
+	}
+	return handler(srv, &authnServerStream{ServerStream: ss, ctx: ctx})
+}
+
+func (a *authnInterceptor) authenticate(ctx context.Context, method string) (context.Context, error) {
+	policy := policyForMethod(method)
+
+	token, err := a.creds.GetRequestToken(ctx)
+	if err != nil {
+		if policy == policyPublic {
+			return ctx, nil
+		}
+		return nil, status.Error(codes.Unauthenticated, "missing authentication token")
+	}
+
+	subject, err := a.creds.VerifyRequestSubject(ctx, token)
+	if err != nil {
+		log.Debugf("gRPC %s: verify request subject: %v", method, err)
+		return nil, status.Error(codes.Unauthenticated, "invalid authentication token")
+	}
+
+	// delegated credentials never carry the operator privileges.
+	if policy == policyOperator && (subject.Delegated() || !a.operators.Has(subject.Subject)) {
+		return nil, status.Errorf(codes.PermissionDenied, "%s is not an operator", subject.Subject)
+	}
+
+	return authn.ContextWithSubject(ctx, subject), nil
+}
+
+// authnServerStream overrides the stream context with
+// the authenticated one.
+type authnServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authnServerStream) Context() context.Context {
+	return s.ctx
+}
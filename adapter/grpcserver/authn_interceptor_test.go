@@ -0,0 +1,102 @@
+package grpcserver
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	ringv1alpha1 "github.com/sourcenetwork/orbis-go/gen/proto/orbis/ring/v1alpha1"
+	"github.com/sourcenetwork/orbis-go/pkg/authn"
+)
+
+type tokenCtxKey struct{}
+
+// testCredentials treats the token as the subject, "bad" tokens
+// as invalid, and "delegated:" prefixed ones as delegated.
+type testCredentials struct{}
+
+func (testCredentials) GetRequestToken(ctx context.Context) ([]byte, error) {
+	token, ok := ctx.Value(tokenCtxKey{}).(string)
+	if !ok {
+		return nil, fmt.Errorf("missing token")
+	}
+	return []byte(token), nil
+}
+
+func (testCredentials) VerifyRequestSubject(ctx context.Context, token []byte) (authn.SubjectInfo, error) {
+	if string(token) == "bad" {
+		return authn.SubjectInfo{}, fmt.Errorf("invalid token")
+	}
+	if subject, ok := strings.CutPrefix(string(token), "delegated:"); ok {
+		return authn.SubjectInfo{Type: "test", Subject: subject, Capabilities: []authn.Capability{}}, nil
+	}
+	return authn.SubjectInfo{Type: "test", Subject: string(token)}, nil
+}
+
+func TestAuthnInterceptor(t *testing.T) {
+	interceptor := newAuthnInterceptor(testCredentials{}, []string{"did:key:operator", ""})
+
+	tests := []struct {
+		name    string
+		method  string
+		token   string // empty for no token
+		code    codes.Code
+		subject string
+	}{
+		{"public without token", ringv1alpha1.RingService_ListRings_FullMethodName, "", codes.OK, ""},
+		{"public with token", ringv1alpha1.RingService_ListRings_FullMethodName, "did:key:alice", codes.OK, "did:key:alice"},
+		{"public with bad token", ringv1alpha1.RingService_ListRings_FullMethodName, "bad", codes.Unauthenticated, ""},
+		{"reflection without token", "/grpc.reflection.v1.ServerReflection/ServerReflectionInfo", "", codes.OK, ""},
+		{"authenticated without token", ringv1alpha1.RingService_StoreSecret_FullMethodName, "", codes.Unauthenticated, ""},
+		{"authenticated with bad token", ringv1alpha1.RingService_StoreSecret_FullMethodName, "bad", codes.Unauthenticated, ""},
+		{"authenticated", ringv1alpha1.RingService_StoreSecret_FullMethodName, "did:key:alice", codes.OK, "did:key:alice"},
+		{"unknown method defaults to authenticated", "/orbis.unknown.v1/Method", "", codes.Unauthenticated, ""},
+		{"operator without token", ringv1alpha1.RingService_CreateRing_FullMethodName, "", codes.Unauthenticated, ""},
+		{"operator with non operator", ringv1alpha1.RingService_CreateRing_FullMethodName, "did:key:alice", codes.PermissionDenied, ""},
+		{"operator", ringv1alpha1.RingService_CreateRing_FullMethodName, "did:key:operator", codes.OK, "did:key:operator"},
+		{"operator with delegated credential", ringv1alpha1.RingService_CreateRing_FullMethodName, "delegated:did:key:operator", codes.PermissionDenied, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.token != "" {
+				ctx = context.WithValue(ctx, tokenCtxKey{}, tt.token)
+			}
+
+			var subject authn.SubjectInfo
+			var authenticated bool
+			handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+				subject, authenticated = authn.SubjectFromContext(ctx)
+				return nil, nil
+			}
+
+			_, err := interceptor.unary(ctx, nil, &grpc.UnaryServerInfo{FullMethod: tt.method}, handler)
+			require.Equal(t, tt.code, status.Code(err))
+			require.Equal(t, tt.subject != "", authenticated)
+			require.Equal(t, tt.subject, subject.Subject)
+		})
+	}
+}
+
+func TestAuthnInterceptorNoOperators(t *testing.T) {
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) { return nil, nil }
+
+	for _, operators := range [][]string{nil, {""}, {" "}} {
+		interceptor := newAuthnInterceptor(testCredentials{}, operators)
+
+		// admin RPCs fail closed
+		ctx := context.WithValue(context.Background(), tokenCtxKey{}, "did:key:alice")
+		_, err := interceptor.unary(ctx, nil, &grpc.UnaryServerInfo{FullMethod: ringv1alpha1.RingService_CreateRing_FullMethodName}, handler)
+		require.Equal(t, codes.PermissionDenied, status.Code(err), "operators %q", operators)
+
+		_, err = interceptor.unary(ctx, nil, &grpc.UnaryServerInfo{FullMethod: ringv1alpha1.RingService_StoreSecret_FullMethodName}, handler)
+		require.NoError(t, err)
+	}
+}
@@ -28,5 +28,5 @@ func loggingInterceptor() grpc.ServerOption {
 		return resp, err
 	}
 
-	return grpc.UnaryInterceptor(interceptor)
+	return grpc.ChainUnaryInterceptor(interceptor)
 }
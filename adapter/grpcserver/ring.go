@@ -2,12 +2,20 @@ package grpcserver
 
 import (
 	"context"
+	"crypto/subtle"
+	"errors"
 	"fmt"
 
 	"github.com/sourcenetwork/orbis-go/app"
+	auditv1alpha1 "github.com/sourcenetwork/orbis-go/gen/proto/orbis/audit/v1alpha1"
+	authzv1alpha1 "github.com/sourcenetwork/orbis-go/gen/proto/orbis/authz/v1alpha1"
 	ringv1alpha1 "github.com/sourcenetwork/orbis-go/gen/proto/orbis/ring/v1alpha1"
+	"github.com/sourcenetwork/orbis-go/pkg/authn"
+	"github.com/sourcenetwork/orbis-go/pkg/authz"
 	"github.com/sourcenetwork/orbis-go/pkg/crypto"
 	"github.com/sourcenetwork/orbis-go/pkg/crypto/proof"
+	"github.com/sourcenetwork/orbis-go/pkg/db"
+	"github.com/sourcenetwork/orbis-go/pkg/tsig"
 	"github.com/sourcenetwork/orbis-go/pkg/types"
 
 	"google.golang.org/grpc/codes"
@@ -20,11 +28,15 @@ type ringService struct {
 	ringv1alpha1.UnimplementedRingServiceServer
 
 	app *app.App
+	// requireBinding requires re-encryption credentials
+	// to be bound to the request.
+	requireBinding bool
 }
 
-func newRingService(a *app.App) *ringService {
+func newRingService(a *app.App, requireBinding bool) *ringService {
 	return &ringService{
-		app: a,
+		app:            a,
+		requireBinding: requireBinding,
 	}
 }
 
@@ -52,7 +64,11 @@ func (s *ringService) CreateRing(ctx context.Context, req *ringv1alpha1.CreateRi
 
 	bgctx := context.Background()
 	r, err := s.app.JoinRing(bgctx, req.Manifest)
-	if err != nil {
+	if errors.Is(err, app.ErrInvalidManifest) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	} else if errors.Is(err, app.ErrPairingRingsOnly) {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	} else if err != nil {
 		return nil, fmt.Errorf("create ring: %w", err)
 	}
 
@@ -68,6 +84,67 @@ func (s *ringService) CreateRing(ctx context.Context, req *ringv1alpha1.CreateRi
 	return resp, nil
 }
 
+func (s *ringService) ValidateManifest(ctx context.Context, req *ringv1alpha1.ValidateManifestRequest) (*ringv1alpha1.ValidateManifestResponse, error) {
+
+	err := s.app.ValidateManifest(req.Manifest)
+
+	resp := &ringv1alpha1.ValidateManifestResponse{
+		Valid: err == nil,
+	}
+	if req.Manifest != nil {
+		resp.RingId = string(types.RingIDFromManifest(req.Manifest))
+	}
+	for _, me := range types.ManifestErrors(err) {
+		resp.Violations = append(resp.Violations, &ringv1alpha1.ManifestViolation{
+			Field:       me.Field,
+			Description: me.Err.Error(),
+		})
+	}
+
+	return resp, nil
+}
+
+func (s *ringService) ProposeRing(ctx context.Context, req *ringv1alpha1.ProposeRingRequest) (*ringv1alpha1.ProposeRingResponse, error) {
+
+	rid, err := s.app.ProposeRing(ctx, req.Manifest)
+	if errors.Is(err, app.ErrInvalidManifest) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	} else if errors.Is(err, app.ErrProposalExists) {
+		return nil, status.Error(codes.AlreadyExists, err.Error())
+	} else if errors.Is(err, app.ErrProposalsNotStarted) {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	} else if err != nil {
+		return nil, fmt.Errorf("propose ring: %w", err)
+	}
+
+	return &ringv1alpha1.ProposeRingResponse{
+		RingId: string(rid),
+	}, nil
+}
+
+func (s *ringService) ListProposals(ctx context.Context, req *ringv1alpha1.ListProposalsRequest) (*ringv1alpha1.ListProposalsResponse, error) {
+
+	return &ringv1alpha1.ListProposalsResponse{
+		Proposals: s.app.ListProposals(ctx),
+	}, nil
+}
+
+func (s *ringService) ApproveProposal(ctx context.Context, req *ringv1alpha1.ApproveProposalRequest) (*ringv1alpha1.ApproveProposalResponse, error) {
+
+	p, err := s.app.ApproveProposal(ctx, types.RingID(req.RingId))
+	if errors.Is(err, app.ErrProposalNotFound) {
+		return nil, status.Error(codes.NotFound, err.Error())
+	} else if errors.Is(err, app.ErrInvalidManifest) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	} else if err != nil {
+		return nil, fmt.Errorf("approve proposal: %w", err)
+	}
+
+	return &ringv1alpha1.ApproveProposalResponse{
+		Proposal: p,
+	}, nil
+}
+
 func (s *ringService) GetRing(ctx context.Context, req *ringv1alpha1.GetRingRequest) (*ringv1alpha1.GetRingResponse, error) {
 
 	ring, err := s.app.GetRing(ctx, req.Id)
@@ -146,7 +223,27 @@ func (s *ringService) State(ctx context.Context, req *ringv1alpha1.StateRequest)
 }
 
 func (s *ringService) ListSecrets(ctx context.Context, req *ringv1alpha1.ListSecretsRequest) (*ringv1alpha1.ListSecretsResponse, error) {
-	return nil, errUnimplemented
+
+	r, err := s.app.GetRing(ctx, req.RingId)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "ring not found")
+	}
+
+	sids, scrts, err := r.ListSecrets(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list secrets: %w", err)
+	}
+
+	resp := &ringv1alpha1.ListSecretsResponse{
+		Secrets:   make([]*ringv1alpha1.Secret, len(scrts)),
+		SecretIds: make([]string, len(sids)),
+	}
+	for i := range scrts {
+		resp.Secrets[i] = scrts[i].Secret
+		resp.SecretIds[i] = string(sids[i])
+	}
+
+	return resp, nil
 }
 
 func (s *ringService) StoreSecret(ctx context.Context, req *ringv1alpha1.StoreSecretRequest) (*ringv1alpha1.StoreSecretResponse, error) {
@@ -158,14 +255,28 @@ func (s *ringService) StoreSecret(ctx context.Context, req *ringv1alpha1.StoreSe
 
 	secret := &types.Secret{
 		Secret: &ringv1alpha1.Secret{
-			EncCmt:   req.Secret.EncCmt,
-			EncScrt:  req.Secret.EncScrt,
-			AuthzCtx: req.Secret.AuthzCtx,
+			EncCmt:     req.Secret.EncCmt,
+			EncScrt:    req.Secret.EncScrt,
+			AuthzCtx:   req.Secret.AuthzCtx,
+			Dem:        req.Secret.Dem,
+			EncDataCid: req.Secret.EncDataCid,
+			EncData:    req.Secret.EncData,
 		},
 	}
 
+	// the owner is the subject authenticated by the authn interceptor.
 	sid, err := r.StoreSecret(ctx, r.ID, secret)
-	if err != nil {
+	if errors.Is(err, app.ErrSecretOwnerMissing) {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	} else if errors.Is(err, app.ErrSecretUnauthorized) {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	} else if errors.Is(err, app.ErrOwnerNotRegistrable) {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	} else if errors.Is(err, crypto.ErrBadDEM) ||
+		errors.Is(err, app.ErrSecretDataMissing) ||
+		errors.Is(err, app.ErrSecretDataCidMismatch) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	} else if err != nil {
 		return nil, fmt.Errorf("store secret: %w", err)
 	}
 
@@ -183,22 +294,94 @@ func (s *ringService) ReencryptSecret(ctx context.Context, req *ringv1alpha1.Ree
 		return nil, status.Error(codes.NotFound, "ring not found")
 	}
 
-	token, err := r.Authn.GetRequestToken(ctx)
-	if err != nil {
+	// authenticated by the authn interceptor
+	authInfo, authenticated := authn.SubjectFromContext(ctx)
+	if !authenticated {
 		return nil, status.Error(codes.Unauthenticated, "missing authentication token")
 	}
 
-	authInfo, err := r.Authn.VerifyRequestSubject(ctx, token)
+	err = s.checkBinding(authInfo, req)
+	if err != nil {
+		return nil, err
+	}
+
+	rec := &auditv1alpha1.Record{
+		Subject:  authInfo.Subject,
+		SecretId: req.SecretId,
+	}
+	if authInfo.PubKey != nil {
+		rec.ReaderPublicKey, err = crypto.PublicKeyToProto(authInfo.PubKey)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid subject public key")
+		}
+	}
+
+	resp, err := s.reencryptSecret(ctx, r, authInfo, req, rec)
+	if err != nil {
+		rec.Error = err.Error()
+	} else {
+		rec.Reencrypted = true
+	}
+
+	// every decision must be audited, so fail the request
+	// if it can't be recorded in the local log.
+	auditErr := r.RecordAudit(ctx, rec)
+	if auditErr != nil {
+		log.Errorf("ReencryptSecret(): audit: %v", auditErr)
+		return nil, status.Error(codes.Internal, "failed to record audit log")
+	}
+
+	return resp, err
+}
+
+// checkBinding checks the secret is re-encrypted to the authenticated
+// subject key, and that a bound credential was issued for this secret
+// and reader key. Unbound credentials are rejected if bindings are
+// required.
+func (s *ringService) checkBinding(authInfo authn.SubjectInfo, req *ringv1alpha1.ReencryptSecretRequest) error {
+	if authInfo.PubKey == nil {
+		return status.Error(codes.InvalidArgument, "missing subject public key")
+	}
+	if req.RdrPk != nil {
+		rdrPk, err := crypto.PublicKeyFromProto(req.RdrPk)
+		if err != nil || !rdrPk.Equals(authInfo.PubKey) {
+			return status.Error(codes.InvalidArgument, "reader key doesn't match the subject key")
+		}
+	}
+
+	if authInfo.Binding == "" {
+		if s.requireBinding {
+			return status.Error(codes.Unauthenticated, "credential not bound to the request")
+		}
+		return nil
+	}
+
+	binding, err := authn.ReencryptBinding(req.SecretId, authInfo.PubKey)
 	if err != nil {
-		log.Error(err)
-		return nil, status.Error(codes.Unknown, "failed to verify token: %w")
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	if subtle.ConstantTimeCompare([]byte(binding), []byte(authInfo.Binding)) != 1 {
+		return status.Error(codes.Unauthenticated, "credential bound to another request")
 	}
 
+	return nil
+}
+
+func (s *ringService) reencryptSecret(ctx context.Context, r *app.Ring, authInfo authn.SubjectInfo, req *ringv1alpha1.ReencryptSecretRequest, rec *auditv1alpha1.Record) (*ringv1alpha1.ReencryptSecretResponse, error) {
 	log.Infof("ReencryptSecret(): get secret: secretid=%s", req.SecretId)
 	scrt, err := r.GetSecret(ctx, req.SecretId)
 	if err != nil {
 		return nil, err
 	}
+	rec.AuthzCtx = scrt.AuthzCtx
+
+	if len(req.AcpProof) > 0 {
+		ctx = authz.ContextWithProof(ctx, req.AcpProof)
+	}
+
+	if !authInfo.Allows(scrt.AuthzCtx) {
+		return nil, status.Error(codes.PermissionDenied, "permission not delegated to the credential")
+	}
 
 	log.Infof("ReencryptSecret(): authz.Check(): perm='%s' subject='%s'", scrt.AuthzCtx, authInfo.Subject)
 	ok, err := r.Authz.Check(ctx, scrt.AuthzCtx, "user:"+authInfo.Subject)
@@ -208,11 +391,14 @@ func (s *ringService) ReencryptSecret(ctx context.Context, req *ringv1alpha1.Ree
 	if !ok {
 		return nil, errUnAuthorized
 	}
+	rec.Authorized = true
 
 	var p proof.VerifiableEncryption
 	log.Infof("ReencryptSecret(): running reencryption")
 	xncCmt, encScrt, err := r.ReencryptSecret(ctx, authInfo.PubKey, types.SecretID(req.SecretId), p)
-	if err != nil {
+	if errors.Is(err, app.ErrRateLimited) {
+		return nil, status.Error(codes.ResourceExhausted, err.Error())
+	} else if err != nil {
 		return nil, fmt.Errorf("reencrypt secret: %w", err)
 	}
 
@@ -222,9 +408,210 @@ func (s *ringService) ReencryptSecret(ctx context.Context, req *ringv1alpha1.Ree
 		EncScrt: encScrt,
 	}
 
+	if scrt.Dem != "" {
+		encData, err := r.GetSecretData(ctx, scrt)
+		if err != nil {
+			return nil, fmt.Errorf("get secret data: %w", err)
+		}
+		resp.EncData = encData
+		resp.Dem = scrt.Dem
+		resp.EncCmt = scrt.EncCmt
+	}
+
 	return resp, nil
 }
 
+// DecryptSecret decrypts the secret on behalf of the authenticated
+// subject. It's disabled unless threshold decryption is enabled in
+// the node configuration, and every decision is audited.
+func (s *ringService) DecryptSecret(ctx context.Context, req *ringv1alpha1.DecryptSecretRequest) (*ringv1alpha1.DecryptSecretResponse, error) {
+	log.Infof("DecryptSecret(): request: ringid=%s secretid=%s", req.RingId, req.SecretId)
+	r, err := s.app.GetRing(ctx, req.RingId)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "ring not found")
+	}
+
+	if r.TDEC == nil {
+		return nil, status.Error(codes.Unimplemented, app.ErrDecryptDisabled.Error())
+	}
+
+	// authenticated by the authn interceptor
+	authInfo, authenticated := authn.SubjectFromContext(ctx)
+	if !authenticated {
+		return nil, status.Error(codes.Unauthenticated, "missing authentication token")
+	}
+
+	rec := &auditv1alpha1.Record{
+		Subject:  authInfo.Subject,
+		SecretId: req.SecretId,
+	}
+
+	resp, err := s.decryptSecret(ctx, r, authInfo, req, rec)
+	if err != nil {
+		rec.Error = err.Error()
+	} else {
+		rec.Decrypted = true
+	}
+
+	// every decision must be audited, so fail the request
+	// if it can't be recorded in the local log.
+	auditErr := r.RecordAudit(ctx, rec)
+	if auditErr != nil {
+		log.Errorf("DecryptSecret(): audit: %v", auditErr)
+		return nil, status.Error(codes.Internal, "failed to record audit log")
+	}
+
+	return resp, err
+}
+
+func (s *ringService) decryptSecret(ctx context.Context, r *app.Ring, authInfo authn.SubjectInfo, req *ringv1alpha1.DecryptSecretRequest, rec *auditv1alpha1.Record) (*ringv1alpha1.DecryptSecretResponse, error) {
+	scrt, err := r.GetSecret(ctx, req.SecretId)
+	if err != nil {
+		return nil, err
+	}
+	rec.AuthzCtx = scrt.AuthzCtx
+
+	if len(req.AcpProof) > 0 {
+		ctx = authz.ContextWithProof(ctx, req.AcpProof)
+	}
+
+	err = r.AuthorizeDecrypt(ctx, scrt, authInfo)
+	if err != nil {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+	rec.Authorized = true
+
+	plaintext, err := r.DecryptSecret(ctx, types.SecretID(req.SecretId), req.AcpProof)
+	if errors.Is(err, app.ErrRateLimited) {
+		return nil, status.Error(codes.ResourceExhausted, err.Error())
+	} else if errors.Is(err, app.ErrDecryptUnauthorized) {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	} else if err != nil {
+		return nil, fmt.Errorf("decrypt secret: %w", err)
+	}
+
+	return &ringv1alpha1.DecryptSecretResponse{
+		Secret: plaintext,
+	}, nil
+}
+
+func (s *ringService) AuditLog(ctx context.Context, req *ringv1alpha1.AuditLogRequest) (*ringv1alpha1.AuditLogResponse, error) {
+	r, err := s.app.GetRing(ctx, req.RingId)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "ring not found")
+	}
+
+	recs, valid, err := r.AuditRecords(ctx, db.AuditFilter{
+		Subject:  req.Subject,
+		SecretID: req.SecretId,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("audit records: %w", err)
+	}
+
+	return &ringv1alpha1.AuditLogResponse{
+		Records:    recs,
+		ChainValid: valid,
+	}, nil
+}
+
+func (s *ringService) Sign(ctx context.Context, req *ringv1alpha1.SignRequest) (*ringv1alpha1.SignResponse, error) {
+	r, err := s.app.GetRing(ctx, req.RingId)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "ring not found")
+	}
+
+	sig, scheme, err := r.Sign(ctx, req.Message)
+	if errors.Is(err, app.ErrSignUnsupported) {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	if errors.Is(err, app.ErrSignUnauthorized) {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+	if err != nil {
+		return nil, fmt.Errorf("sign: %w", err)
+	}
+
+	return &ringv1alpha1.SignResponse{
+		Signature: sig,
+		Scheme:    scheme,
+	}, nil
+}
+
+func (s *ringService) VerifyRingSignature(ctx context.Context, req *ringv1alpha1.VerifyRingSignatureRequest) (*ringv1alpha1.VerifyRingSignatureResponse, error) {
+	r, err := s.app.GetRing(ctx, req.RingId)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "ring not found")
+	}
+
+	scheme, err := r.VerifyRingSignature(req.Message, req.Signature)
+	if errors.Is(err, app.ErrSignUnsupported) {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	if err != nil && !errors.Is(err, tsig.ErrInvalidSignature) {
+		return nil, fmt.Errorf("verify ring signature: %w", err)
+	}
+
+	return &ringv1alpha1.VerifyRingSignatureResponse{
+		Valid:  err == nil,
+		Scheme: scheme,
+	}, nil
+}
+
+func (s *ringService) WriteRelationship(ctx context.Context, req *ringv1alpha1.WriteRelationshipRequest) (*ringv1alpha1.WriteRelationshipResponse, error) {
+	w, rel, err := s.relationshipWriter(ctx, req.RingId, req.Relationship)
+	if err != nil {
+		return nil, err
+	}
+
+	err = w.WriteRelationship(ctx, rel)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &ringv1alpha1.WriteRelationshipResponse{}, nil
+}
+
+func (s *ringService) DeleteRelationship(ctx context.Context, req *ringv1alpha1.DeleteRelationshipRequest) (*ringv1alpha1.DeleteRelationshipResponse, error) {
+	w, rel, err := s.relationshipWriter(ctx, req.RingId, req.Relationship)
+	if err != nil {
+		return nil, err
+	}
+
+	err = w.DeleteRelationship(ctx, rel)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &ringv1alpha1.DeleteRelationshipResponse{}, nil
+}
+
+// relationshipWriter returns the ring authorizer, if it
+// manages its own relationships, and the relationship.
+func (s *ringService) relationshipWriter(ctx context.Context, rid string, rel *authzv1alpha1.Relationship) (authz.RelationshipWriter, authz.Relationship, error) {
+	if rel == nil {
+		return nil, authz.Relationship{}, status.Error(codes.InvalidArgument, "missing relationship")
+	}
+
+	r, err := s.app.GetRing(ctx, rid)
+	if err != nil {
+		return nil, authz.Relationship{}, status.Error(codes.NotFound, "ring not found")
+	}
+
+	w, ok := r.Authz.(authz.RelationshipWriter)
+	if !ok {
+		return nil, authz.Relationship{}, status.Error(codes.Unimplemented, "ring authorizer doesn't manage relationships")
+	}
+
+	return w, authz.Relationship{
+		PolicyID:   rel.PolicyId,
+		Resource:   rel.Resource,
+		ResourceID: rel.ResourceId,
+		Relation:   rel.Relation,
+		Subject:    rel.Subject,
+	}, nil
+}
+
 func (s *ringService) DeleteSecret(ctx context.Context, req *ringv1alpha1.DeleteSecretRequest) (*emptypb.Empty, error) {
 	return nil, errUnimplemented
 }
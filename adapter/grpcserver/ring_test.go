@@ -0,0 +1,53 @@
+package grpcserver
+
+import (
+	"crypto/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.dedis.ch/kyber/v3/suites"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	ringv1alpha1 "github.com/sourcenetwork/orbis-go/gen/proto/orbis/ring/v1alpha1"
+	"github.com/sourcenetwork/orbis-go/pkg/authn"
+	"github.com/sourcenetwork/orbis-go/pkg/crypto"
+)
+
+func TestCheckBinding(t *testing.T) {
+	_, pk, err := crypto.GenerateKeyPair(suites.MustFind("ed25519"), rand.Reader)
+	require.NoError(t, err)
+	_, otherPk, err := crypto.GenerateKeyPair(suites.MustFind("ed25519"), rand.Reader)
+	require.NoError(t, err)
+	protoPk, err := crypto.PublicKeyToProto(pk)
+	require.NoError(t, err)
+	protoOtherPk, err := crypto.PublicKeyToProto(otherPk)
+	require.NoError(t, err)
+
+	binding, err := authn.ReencryptBinding("s1", pk)
+	require.NoError(t, err)
+	bound := authn.SubjectInfo{Subject: "alice", PubKey: pk, Binding: binding}
+	unbound := authn.SubjectInfo{Subject: "alice", PubKey: pk}
+
+	tests := []struct {
+		name    string
+		require bool
+		info    authn.SubjectInfo
+		req     *ringv1alpha1.ReencryptSecretRequest
+		code    codes.Code
+	}{
+		{"bound", true, bound, &ringv1alpha1.ReencryptSecretRequest{SecretId: "s1", RdrPk: protoPk}, codes.OK},
+		{"bound to another secret", false, bound, &ringv1alpha1.ReencryptSecretRequest{SecretId: "s2"}, codes.Unauthenticated},
+		{"unbound", false, unbound, &ringv1alpha1.ReencryptSecretRequest{SecretId: "s1"}, codes.OK},
+		{"unbound but required", true, unbound, &ringv1alpha1.ReencryptSecretRequest{SecretId: "s1"}, codes.Unauthenticated},
+		{"another reader key", false, bound, &ringv1alpha1.ReencryptSecretRequest{SecretId: "s1", RdrPk: protoOtherPk}, codes.InvalidArgument},
+		{"missing subject key", false, authn.SubjectInfo{Subject: "alice"}, &ringv1alpha1.ReencryptSecretRequest{SecretId: "s1"}, codes.InvalidArgument},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newRingService(nil, tt.require)
+			err := s.checkBinding(tt.info, tt.req)
+			require.Equal(t, tt.code, status.Code(err))
+		})
+	}
+}
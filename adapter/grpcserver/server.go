@@ -12,7 +12,9 @@ import (
 	utilityv1alpha1 "github.com/sourcenetwork/orbis-go/gen/proto/orbis/utility/v1alpha1"
 
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
 
 	logging "github.com/ipfs/go-log"
@@ -20,24 +22,44 @@ import (
 
 var log = logging.Logger("orbis/grpc/server")
 
-func NewGRPCServer(cfg config.GRPC, a *app.App) *grpc.Server {
+// NewGRPCServer creates the gRPC server for the app services. The server
+// uses TLS if t is not nil. Every request is authenticated with the
+// configured credential service, according to the method policy.
+func NewGRPCServer(cfg config.GRPC, a *app.App, t *TLS) (*grpc.Server, error) {
+
+	creds, err := a.CredentialService(cfg.Authn.Service)
+	if err != nil {
+		return nil, fmt.Errorf("authentication service: %w", err)
+	}
 
 	var opts []grpc.ServerOption
 	if cfg.Logging {
 		opts = append(opts, loggingInterceptor())
 	}
+	opts = append(opts, newAuthnInterceptor(creds, cfg.Authn.Operators).serverOptions()...)
+	if t != nil {
+		opts = append(opts, grpc.Creds(credentials.NewTLS(t.ServerConfig())))
+	}
 
 	s := grpc.NewServer(opts...)
 
 	// Setup orbis service handlers to the server.
 	utilityv1alpha1.RegisterUtilityServiceServer(s, newUtilService(a))
 	transportv1alpha1.RegisterTransportServiceServer(s, newTransportService(a))
-	ringv1alpha1.RegisterRingServiceServer(s, newRingService(a))
+	ringv1alpha1.RegisterRingServiceServer(s, newRingService(a, cfg.Authn.RequireBinding))
 
-	return s
+	return s, nil
 }
 
-func NewGRPCGatewayServer(cfg config.GRPC) (*http.Server, error) {
+// NewGRPCGatewayServer creates the REST gateway server, proxying to the
+// gRPC server. If t is not nil, the gateway dials the gRPC server with
+// TLS, and the returned server is configured to serve TLS.
+func NewGRPCGatewayServer(cfg config.GRPC, t *TLS) (*http.Server, error) {
+
+	creds := insecure.NewCredentials()
+	if t != nil {
+		creds = credentials.NewTLS(t.ClientConfig())
+	}
 
 	ctx := context.Background()
 	// Create a client connection to the gRPC server we just started.
@@ -46,7 +68,7 @@ func NewGRPCGatewayServer(cfg config.GRPC) (*http.Server, error) {
 		ctx,
 		cfg.GRPCURL,
 		grpc.WithBlock(),
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithTransportCredentials(creds),
 	)
 	if err != nil {
 		return nil, fmt.Errorf("dial to gRPC server %s, %w", cfg.GRPCURL, err)
@@ -54,7 +76,7 @@ func NewGRPCGatewayServer(cfg config.GRPC) (*http.Server, error) {
 
 	mux := runtime.NewServeMux()
 	opts := []grpc.DialOption{
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithTransportCredentials(creds),
 	}
 
 	err = ringv1alpha1.RegisterRingServiceHandler(ctx, mux, conn)
@@ -88,10 +110,22 @@ func NewGRPCGatewayServer(cfg config.GRPC) (*http.Server, error) {
 		return nil, fmt.Errorf("register utility service handler from endpoint, %w", err)
 	}
 
+	if cfg.Metrics {
+		err = mux.HandlePath(http.MethodGet, "/metrics", func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+			promhttp.Handler().ServeHTTP(w, r)
+		})
+		if err != nil {
+			return nil, fmt.Errorf("register metrics handler, %w", err)
+		}
+	}
+
 	gw := &http.Server{
 		Addr:    cfg.RESTURL,
 		Handler: mux,
 	}
+	if t != nil {
+		gw.TLSConfig = t.ServerConfig()
+	}
 
 	return gw, nil
 }
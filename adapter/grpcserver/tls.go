@@ -0,0 +1,408 @@
+package grpcserver
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+
+	"github.com/sourcenetwork/orbis-go/config"
+)
+
+const selfSignedValidity = 365 * 24 * time.Hour
+
+var (
+	ErrMissingTLSKeyPair = fmt.Errorf("tls: both cert and key files are required")
+	ErrNoClientCACerts   = fmt.Errorf("tls: no certificates found in client CA file")
+	ErrUntrustedClient   = fmt.Errorf("tls: untrusted client certificate")
+)
+
+// TLS holds the TLS credentials shared by the gRPC and REST gateway
+// servers. The certificate, key and client CA files are watched, and
+// reloaded when they change, so certificates can be rotated without
+// restarting the servers.
+type TLS struct {
+	certFile     string
+	keyFile      string
+	clientCAFile string
+
+	mu        sync.RWMutex
+	cert      *tls.Certificate
+	clientCAs *x509.CertPool
+
+	watcher *fsnotify.Watcher
+}
+
+// NewTLS loads the TLS credentials from the gRPC config. It returns
+// nil if TLS isn't configured. With SelfSigned enabled, a self-signed
+// certificate is generated, and written to the cert and key files if
+// set and missing.
+func NewTLS(cfg config.GRPC) (*TLS, error) {
+	c := cfg.TLS
+	if c.CertFile == "" && c.KeyFile == "" && !c.SelfSigned {
+		return nil, nil
+	}
+
+	t := &TLS{
+		certFile:     c.CertFile,
+		keyFile:      c.KeyFile,
+		clientCAFile: c.ClientCAFile,
+	}
+
+	if c.SelfSigned {
+		err := t.setupSelfSigned(cfg)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err := t.reload()
+	if err != nil {
+		return nil, err
+	}
+
+	err = t.watch()
+	if err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+// Close stops watching the certificate files.
+func (t *TLS) Close() error {
+	if t == nil || t.watcher == nil {
+		return nil
+	}
+	return t.watcher.Close()
+}
+
+// MutualTLS reports if client certificates are required.
+func (t *TLS) MutualTLS() bool {
+	return t.clientCAFile != ""
+}
+
+// ServerConfig returns the TLS config for the gRPC and
+// REST gateway listeners.
+func (t *TLS) ServerConfig() *tls.Config {
+	cfg := &tls.Config{
+		MinVersion:     tls.VersionTLS12,
+		GetCertificate: t.getCertificate,
+	}
+
+	if t.MutualTLS() {
+		// Client certificates are verified in verifyClient, against
+		// the current client CAs, so they can be reloaded. The
+		// server's own certificate is also accepted, which is what
+		// the REST gateway presents when proxying to the gRPC server.
+		cfg.ClientAuth = tls.RequireAnyClientCert
+		cfg.VerifyPeerCertificate = t.verifyClient
+	}
+
+	return cfg
+}
+
+// ClientConfig returns the TLS config the REST gateway uses to dial
+// the local gRPC server. Only the server's own certificate is trusted.
+func (t *TLS) ClientConfig() *tls.Config {
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		// The default verification is replaced by pinning
+		// the current server certificate in verifySelf.
+		InsecureSkipVerify:    true, // nolint:gosec
+		VerifyPeerCertificate: t.verifySelf,
+		GetClientCertificate: func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			return t.getCertificate(nil)
+		},
+	}
+}
+
+func (t *TLS) getCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.cert, nil
+}
+
+func (t *TLS) isSelf(rawCerts [][]byte) bool {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return len(rawCerts) > 0 && bytes.Equal(rawCerts[0], t.cert.Certificate[0])
+}
+
+func (t *TLS) verifySelf(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+	if !t.isSelf(rawCerts) {
+		return fmt.Errorf("tls: server certificate doesn't match local certificate")
+	}
+	return nil
+}
+
+func (t *TLS) verifyClient(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+	if len(rawCerts) == 0 {
+		return ErrUntrustedClient
+	}
+	if t.isSelf(rawCerts) {
+		return nil
+	}
+
+	certs := make([]*x509.Certificate, len(rawCerts))
+	for i, raw := range rawCerts {
+		cert, err := x509.ParseCertificate(raw)
+		if err != nil {
+			return fmt.Errorf("parse client certificate: %w", err)
+		}
+		certs[i] = cert
+	}
+
+	intermediates := x509.NewCertPool()
+	for _, cert := range certs[1:] {
+		intermediates.AddCert(cert)
+	}
+
+	t.mu.RLock()
+	roots := t.clientCAs
+	t.mu.RUnlock()
+
+	_, err := certs[0].Verify(x509.VerifyOptions{
+		Roots:         roots,
+		Intermediates: intermediates,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	})
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrUntrustedClient, err)
+	}
+
+	return nil
+}
+
+// reload (re)loads the certificate, key and client CA files.
+// The current credentials are kept if any of them fail to load.
+func (t *TLS) reload() error {
+	if t.certFile == "" || t.keyFile == "" {
+		t.mu.RLock()
+		loaded := t.cert != nil
+		t.mu.RUnlock()
+		if loaded { // in memory self-signed certificate
+			return nil
+		}
+		return ErrMissingTLSKeyPair
+	}
+
+	cert, err := tls.LoadX509KeyPair(t.certFile, t.keyFile)
+	if err != nil {
+		return fmt.Errorf("load tls key pair: %w", err)
+	}
+
+	var clientCAs *x509.CertPool
+	if t.clientCAFile != "" {
+		buf, err := os.ReadFile(t.clientCAFile)
+		if err != nil {
+			return fmt.Errorf("read client CA file: %w", err)
+		}
+		clientCAs = x509.NewCertPool()
+		if !clientCAs.AppendCertsFromPEM(buf) {
+			return ErrNoClientCACerts
+		}
+	}
+
+	t.mu.Lock()
+	t.cert = &cert
+	t.clientCAs = clientCAs
+	t.mu.Unlock()
+
+	return nil
+}
+
+// watch reloads the credentials when any of their files change. The
+// parent directories are watched, since certificates are often rotated
+// by replacing (renaming) the files, rather than writing to them.
+func (t *TLS) watch() error {
+	files := make(map[string]struct{})
+	dirs := make(map[string]struct{})
+	for _, f := range []string{t.certFile, t.keyFile, t.clientCAFile} {
+		if f == "" {
+			continue
+		}
+		f = filepath.Clean(f)
+		files[f] = struct{}{}
+		dirs[filepath.Dir(f)] = struct{}{}
+	}
+	if len(files) == 0 {
+		return nil
+	}
+
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return fmt.Errorf("create tls file watcher: %w", err)
+	}
+	for dir := range dirs {
+		err = watcher.Add(dir)
+		if err != nil {
+			watcher.Close()
+			return fmt.Errorf("watch %s: %w", dir, err)
+		}
+	}
+	t.watcher = watcher
+
+	go func() {
+		for {
+			select {
+			case evt, ok := <-watcher.Events:
+				if !ok {
+					return
+				}
+				if _, ok := files[filepath.Clean(evt.Name)]; !ok {
+					continue
+				}
+				if !evt.Has(fsnotify.Write) && !evt.Has(fsnotify.Create) && !evt.Has(fsnotify.Rename) {
+					continue
+				}
+				// the cert and key are usually updated one after the
+				// other, so a failed reload is expected until both are.
+				err := t.reload()
+				if err != nil {
+					log.Warnf("Reloading TLS certificates: %v", err)
+					continue
+				}
+				log.Infof("Reloaded TLS certificates after change to %s", evt.Name)
+			case err, ok := <-watcher.Errors:
+				if !ok {
+					return
+				}
+				log.Errorf("TLS file watcher: %v", err)
+			}
+		}
+	}()
+
+	return nil
+}
+
+// setupSelfSigned generates a self-signed certificate for the server
+// addresses. It is written to the cert and key files when they are set,
+// unless they already exist, otherwise it is only kept in memory.
+func (t *TLS) setupSelfSigned(cfg config.GRPC) error {
+	if t.certFile != "" || t.keyFile != "" {
+		if t.certFile == "" || t.keyFile == "" {
+			return ErrMissingTLSKeyPair
+		}
+		_, certErr := os.Stat(t.certFile)
+		_, keyErr := os.Stat(t.keyFile)
+		if certErr == nil && keyErr == nil {
+			return nil // reuse the existing certificate
+		}
+	}
+
+	certPEM, keyPEM, err := SelfSignedCert(hostsFromAddrs(cfg.GRPCURL, cfg.RESTURL), selfSignedValidity)
+	if err != nil {
+		return err
+	}
+
+	if t.certFile == "" {
+		cert, err := tls.X509KeyPair(certPEM, keyPEM)
+		if err != nil {
+			return fmt.Errorf("load self-signed key pair: %w", err)
+		}
+		t.cert = &cert
+		log.Warn("Using an in-memory self-signed TLS certificate")
+		return nil
+	}
+
+	for _, f := range []string{t.certFile, t.keyFile} {
+		err = os.MkdirAll(filepath.Dir(f), 0o700)
+		if err != nil {
+			return fmt.Errorf("create tls directory: %w", err)
+		}
+	}
+	err = os.WriteFile(t.certFile, certPEM, 0o644)
+	if err != nil {
+		return fmt.Errorf("write self-signed cert: %w", err)
+	}
+	err = os.WriteFile(t.keyFile, keyPEM, 0o600)
+	if err != nil {
+		return fmt.Errorf("write self-signed key: %w", err)
+	}
+	log.Warnf("Generated a self-signed TLS certificate %s", t.certFile)
+
+	return nil
+}
+
+// SelfSignedCert generates a PEM encoded self-signed ECDSA P-256
+// certificate and key, valid for the given hosts (DNS names or IPs)
+// as both a server and a client certificate.
+func SelfSignedCert(hosts []string, validity time.Duration) (certPEM []byte, keyPEM []byte, err error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, nil, fmt.Errorf("generate key: %w", err)
+	}
+
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, nil, fmt.Errorf("generate serial number: %w", err)
+	}
+
+	now := time.Now()
+	tmpl := &x509.Certificate{
+		SerialNumber:          serial,
+		Subject:               pkix.Name{Organization: []string{"Orbis"}, CommonName: "orbis self-signed"},
+		NotBefore:             now.Add(-time.Minute),
+		NotAfter:              now.Add(validity),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	for _, h := range hosts {
+		if ip := net.ParseIP(h); ip != nil {
+			tmpl.IPAddresses = append(tmpl.IPAddresses, ip)
+		} else if h != "" {
+			tmpl.DNSNames = append(tmpl.DNSNames, h)
+		}
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		return nil, nil, fmt.Errorf("create certificate: %w", err)
+	}
+
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return nil, nil, fmt.Errorf("marshal key: %w", err)
+	}
+
+	certPEM = pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPEM = pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+	return certPEM, keyPEM, nil
+}
+
+// hostsFromAddrs returns the hosts of the listen addresses, along
+// with localhost. Unspecified addresses (0.0.0.0) are skipped.
+func hostsFromAddrs(addrs ...string) []string {
+	hosts := []string{"localhost", "127.0.0.1", "::1"}
+	seen := map[string]bool{"localhost": true, "127.0.0.1": true, "::1": true}
+	for _, addr := range addrs {
+		h, _, err := net.SplitHostPort(addr)
+		if err != nil {
+			continue
+		}
+		if ip := net.ParseIP(h); h == "" || (ip != nil && ip.IsUnspecified()) {
+			continue
+		}
+		if !seen[h] {
+			seen[h] = true
+			hosts = append(hosts, h)
+		}
+	}
+	return hosts
+}
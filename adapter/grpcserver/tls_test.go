@@ -0,0 +1,194 @@
+package grpcserver
+
+import (
+	"bytes"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcenetwork/orbis-go/config"
+)
+
+func testGRPCConfig(dir string) config.GRPC {
+	var cfg config.GRPC
+	cfg.GRPCURL = "127.0.0.1:0"
+	cfg.RESTURL = "127.0.0.1:0"
+	cfg.TLS.CertFile = filepath.Join(dir, "cert.pem")
+	cfg.TLS.KeyFile = filepath.Join(dir, "key.pem")
+	return cfg
+}
+
+// handshake runs a TLS handshake between the server and client configs.
+func handshake(t *testing.T, server, client *tls.Config) error {
+	t.Helper()
+
+	lis, err := tls.Listen("tcp", "127.0.0.1:0", server)
+	require.NoError(t, err)
+	defer lis.Close()
+
+	srvErr := make(chan error, 1)
+	go func() {
+		conn, err := lis.Accept()
+		if err != nil {
+			srvErr <- err
+			return
+		}
+		defer conn.Close()
+		srvErr <- conn.(*tls.Conn).Handshake()
+	}()
+
+	conn, err := tls.Dial("tcp", lis.Addr().String(), client)
+	if err == nil {
+		err = conn.Handshake()
+		conn.Close()
+	}
+	if sErr := <-srvErr; err == nil {
+		err = sErr
+	}
+	return err
+}
+
+func TestTLSDisabled(t *testing.T) {
+	creds, err := NewTLS(config.GRPC{})
+	require.NoError(t, err)
+	require.Nil(t, creds)
+	require.NoError(t, creds.Close())
+}
+
+func TestTLSSelfSigned(t *testing.T) {
+	dir := t.TempDir()
+	cfg := testGRPCConfig(dir)
+	cfg.TLS.SelfSigned = true
+
+	creds, err := NewTLS(cfg)
+	require.NoError(t, err)
+	defer creds.Close()
+
+	certPEM, err := os.ReadFile(cfg.TLS.CertFile)
+	require.NoError(t, err)
+
+	// the written self-signed certificate can be used as a CA by clients
+	roots := x509.NewCertPool()
+	require.True(t, roots.AppendCertsFromPEM(certPEM))
+	err = handshake(t, creds.ServerConfig(), &tls.Config{RootCAs: roots, ServerName: "localhost"})
+	require.NoError(t, err)
+
+	// and the gateway loopback client trusts it
+	err = handshake(t, creds.ServerConfig(), creds.ClientConfig())
+	require.NoError(t, err)
+
+	// existing certificate files are reused
+	creds2, err := NewTLS(cfg)
+	require.NoError(t, err)
+	defer creds2.Close()
+	certPEM2, err := os.ReadFile(cfg.TLS.CertFile)
+	require.NoError(t, err)
+	require.Equal(t, certPEM, certPEM2)
+}
+
+func TestTLSMissingKeyPair(t *testing.T) {
+	var cfg config.GRPC
+	cfg.TLS.CertFile = filepath.Join(t.TempDir(), "cert.pem")
+
+	_, err := NewTLS(cfg)
+	require.ErrorIs(t, err, ErrMissingTLSKeyPair)
+}
+
+func TestTLSReload(t *testing.T) {
+	dir := t.TempDir()
+	cfg := testGRPCConfig(dir)
+
+	writeCert(t, cfg.TLS.CertFile, cfg.TLS.KeyFile)
+	creds, err := NewTLS(cfg)
+	require.NoError(t, err)
+	defer creds.Close()
+
+	old, err := creds.getCertificate(nil)
+	require.NoError(t, err)
+
+	// rotate by renaming new files in place
+	writeCert(t, cfg.TLS.KeyFile+".new", cfg.TLS.KeyFile+".tmp")
+	require.NoError(t, os.Rename(cfg.TLS.KeyFile+".tmp", cfg.TLS.KeyFile))
+	require.NoError(t, os.Rename(cfg.TLS.KeyFile+".new", cfg.TLS.CertFile))
+
+	require.Eventually(t, func() bool {
+		cur, _ := creds.getCertificate(nil)
+		return !bytes.Equal(cur.Certificate[0], old.Certificate[0])
+	}, 5*time.Second, 20*time.Millisecond)
+
+	// the gateway loopback client follows the rotated certificate
+	err = handshake(t, creds.ServerConfig(), creds.ClientConfig())
+	require.NoError(t, err)
+}
+
+func TestMutualTLS(t *testing.T) {
+	dir := t.TempDir()
+	cfg := testGRPCConfig(dir)
+	writeCert(t, cfg.TLS.CertFile, cfg.TLS.KeyFile)
+
+	caFile := filepath.Join(dir, "ca.pem")
+	caKeyFile := filepath.Join(dir, "ca-key.pem")
+	writeCert(t, caFile, caKeyFile)
+	cfg.TLS.ClientCAFile = caFile
+
+	creds, err := NewTLS(cfg)
+	require.NoError(t, err)
+	defer creds.Close()
+	require.True(t, creds.MutualTLS())
+
+	serverCfg := creds.ServerConfig()
+	clientCfg := func(certFile, keyFile string) *tls.Config {
+		c := &tls.Config{
+			InsecureSkipVerify: true, // nolint:gosec
+		}
+		if certFile != "" {
+			cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+			require.NoError(t, err)
+			c.Certificates = []tls.Certificate{cert}
+		}
+		return c
+	}
+
+	// trusted client (the self-signed CA cert is also a client cert)
+	require.NoError(t, handshake(t, serverCfg, clientCfg(caFile, caKeyFile)))
+
+	// gateway loopback client presents the server certificate
+	require.NoError(t, handshake(t, serverCfg, creds.ClientConfig()))
+
+	// untrusted client certificate
+	otherCert, otherKey := filepath.Join(dir, "other.pem"), filepath.Join(dir, "other-key.pem")
+	writeCert(t, otherCert, otherKey)
+	require.Error(t, handshake(t, serverCfg, clientCfg(otherCert, otherKey)))
+
+	// no client certificate
+	require.Error(t, handshake(t, serverCfg, clientCfg("", "")))
+}
+
+func TestSelfSignedCertHosts(t *testing.T) {
+	certPEM, _, err := SelfSignedCert(hostsFromAddrs("0.0.0.0:8080", "orbis.local:8090", "10.0.0.1:8081"), time.Hour)
+	require.NoError(t, err)
+
+	block, _ := pem.Decode(certPEM)
+	require.NotNil(t, block)
+	cert, err := x509.ParseCertificate(block.Bytes)
+	require.NoError(t, err)
+
+	require.ElementsMatch(t, []string{"localhost", "orbis.local"}, cert.DNSNames)
+	require.Len(t, cert.IPAddresses, 3) // 127.0.0.1, ::1, 10.0.0.1
+	require.NoError(t, cert.VerifyHostname("orbis.local"))
+	require.NoError(t, cert.VerifyHostname("10.0.0.1"))
+}
+
+func writeCert(t *testing.T, certFile, keyFile string) {
+	t.Helper()
+	certPEM, keyPEM, err := SelfSignedCert([]string{"localhost"}, time.Hour)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(certFile, certPEM, 0o644))
+	require.NoError(t, os.WriteFile(keyFile, keyPEM, 0o600))
+}
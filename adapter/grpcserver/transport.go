@@ -2,11 +2,13 @@ package grpcserver
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/samber/do"
 	"github.com/sourcenetwork/orbis-go/app"
 	transportv1alpha1 "github.com/sourcenetwork/orbis-go/gen/proto/orbis/transport/v1alpha1"
+	"github.com/sourcenetwork/orbis-go/pkg/crypto"
 	"github.com/sourcenetwork/orbis-go/pkg/transport"
 
 	libp2pcrypto "github.com/libp2p/go-libp2p/core/crypto/pb"
@@ -35,6 +37,17 @@ func (s *transportService) GetHost(ctx context.Context, req *transportv1alpha1.G
 		return nil, err
 	}
 
+	// nodes whose key is held by a signer with randomized
+	// signatures have no pairing key.
+	var protoPairingKey *libp2pcrypto.PublicKey
+	pairingKey, pairingKeySig, err := s.app.PairingKey()
+	if err == nil {
+		protoPairingKey, err = crypto.PublicKeyToProto(pairingKey)
+	}
+	if err != nil && !errors.Is(err, crypto.ErrKeyNotExportable) {
+		return nil, err
+	}
+
 	resp := &transportv1alpha1.GetHostResponse{
 		Node: &transportv1alpha1.Node{
 			Id:      tp.Host().ID(),
@@ -43,6 +56,8 @@ func (s *transportService) GetHost(ctx context.Context, req *transportv1alpha1.G
 				Type: libp2pcrypto.KeyType_Ed25519.Enum(),
 				Data: raw,
 			},
+			PairingKey:          protoPairingKey,
+			PairingKeySignature: pairingKeySig,
 		},
 	}
 
@@ -66,7 +66,8 @@ func (s *utilService) CreateDID(ctx context.Context, req *utilityv1alpha1.Create
 
 func (s *utilService) CreateJWT(ctx context.Context, req *utilityv1alpha1.CreateJWTRequest) (*utilityv1alpha1.CreateJWTResponse, error) {
 
-	claims := jwt.Claims{}
+	// any claims are signed, such as a jti or a request binding.
+	claims := map[string]any{}
 	err := json.Unmarshal([]byte(req.Claims), &claims)
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "unmarshal claims: %s", err)
@@ -142,7 +143,23 @@ func (s *utilService) EncryptSecret(ctx context.Context, req *utilityv1alpha1.En
 		return nil, status.Errorf(codes.InvalidArgument, "unmarshal dkgPk: %s", err)
 	}
 
-	encCmt, encScrt := elgamal.EncryptSecret(ste, dkgPk, req.Scrt)
+	var (
+		encCmt  kyber.Point
+		encScrt []kyber.Point
+		encData []byte
+	)
+	if req.Dem != "" {
+		dem, err := crypto.DEMFromString(req.Dem)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "unsupported dem: %s", err)
+		}
+		encCmt, encScrt, encData, err = elgamal.EncryptSecretHybrid(ste, dkgPk, dem, req.Scrt)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "encrypt secret: %s", err)
+		}
+	} else {
+		encCmt, encScrt = elgamal.EncryptSecret(ste, dkgPk, req.Scrt)
+	}
 
 	rawEncCmt, err := encCmt.MarshalBinary()
 	if err != nil {
@@ -162,6 +179,7 @@ func (s *utilService) EncryptSecret(ctx context.Context, req *utilityv1alpha1.En
 	resp := &utilityv1alpha1.EncryptSecretResponse{
 		EncCmt:  rawEncCmt,
 		EncScrt: rawEncScrt,
+		EncData: encData,
 	}
 	return resp, nil
 }
@@ -205,7 +223,24 @@ func (s *utilService) DecryptSecret(ctx context.Context, req *utilityv1alpha1.De
 	}
 	rdrSk := sk.Scalar()
 
-	scrt, err := elgamal.DecryptSecret(ste, encScrts, dkgPk, xncCmt, rdrSk)
+	var scrt []byte
+	if req.Dem != "" {
+		var dem crypto.DEM
+		dem, err = crypto.DEMFromString(req.Dem)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "unsupported dem: %s", err)
+		}
+
+		encCmt := ste.Point()
+		err = encCmt.UnmarshalBinary(req.EncCmt)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "unmarshal encCmt: %s", err)
+		}
+
+		scrt, err = elgamal.DecryptSecretHybrid(ste, dem, encCmt, encScrts, req.EncData, dkgPk, xncCmt, rdrSk)
+	} else {
+		scrt, err = elgamal.DecryptSecret(ste, encScrts, dkgPk, xncCmt, rdrSk)
+	}
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "decrypt secret: %s", err)
 	}
@@ -10,7 +10,11 @@ import (
 	logging "github.com/ipfs/go-log"
 	"github.com/sourcenetwork/orbis-go/config"
 	ringv1alpha1 "github.com/sourcenetwork/orbis-go/gen/proto/orbis/ring/v1alpha1"
+	"github.com/sourcenetwork/orbis-go/pkg/authn"
+	"github.com/sourcenetwork/orbis-go/pkg/bulletin"
+	"github.com/sourcenetwork/orbis-go/pkg/content"
 	"github.com/sourcenetwork/orbis-go/pkg/crypto"
+	"github.com/sourcenetwork/orbis-go/pkg/custody"
 	"github.com/sourcenetwork/orbis-go/pkg/db"
 	"github.com/sourcenetwork/orbis-go/pkg/host"
 	"github.com/sourcenetwork/orbis-go/pkg/types"
@@ -22,6 +26,8 @@ var (
 	ErrDuplicateRepo    = fmt.Errorf("duplicate named repos")
 	ErrFactoryEmptyName = fmt.Errorf("factory name can't be empty")
 	ErrKeyMissing       = fmt.Errorf("key missing or nil")
+	ErrInvalidManifest  = fmt.Errorf("invalid manifest")
+	ErrPairingRingsOnly = fmt.Errorf("the node identity key is held by a signer, so the node only joins pairing rings")
 )
 
 // App implements App all services.
@@ -32,11 +38,21 @@ type App struct {
 	inj *do.Injector
 
 	privateKey crypto.PrivateKey
+	custody    custody.Custody
 
 	ringRepo db.Repository[*ringv1alpha1.Ring]
 
+	content content.Store
+	audit   *db.AuditLog
+
 	rings map[types.RingID]*Ring
 
+	// ring proposals, guarded by pmu since accepting
+	// a proposal joins the ring under mu.
+	proposals  map[types.RingID]*proposal
+	proposalBB bulletin.Bulletin
+	pmu        sync.Mutex
+
 	// namespaced key => repoParam
 	// collected during app initialization
 	repoParams map[string]repoParam
@@ -50,7 +66,8 @@ type App struct {
 	// service
 	serviceRepos map[string][]string
 
-	config config.Config
+	config    config.Config
+	operators authn.Operators
 
 	mu sync.Mutex
 }
@@ -68,6 +85,21 @@ func (a *App) Injector() *do.Injector {
 	return a.inj
 }
 
+// PairingKey returns the node pairing public key, which the node
+// is listed with in the manifest of rings using pairing keys, and its
+// signature by the node identity key.
+func (a *App) PairingKey() (crypto.PublicKey, []byte, error) {
+	sk, err := a.custody.PairingKey()
+	if err != nil {
+		return nil, nil, err
+	}
+	sig, err := crypto.SignPairingKey(a.privateKey, sk.GetPublic())
+	if err != nil {
+		return nil, nil, fmt.Errorf("sign pairing key: %w", err)
+	}
+	return sk.GetPublic(), sig, nil
+}
+
 func New(ctx context.Context, host *host.Host, opts ...Option) (*App, error) {
 	if host == nil {
 		return nil, fmt.Errorf("host is nil")
@@ -90,6 +122,7 @@ func New(ctx context.Context, host *host.Host, opts ...Option) (*App, error) {
 		repoKeys:     make(map[string]db.RepoKey),
 		serviceRepos: make(map[string][]string),
 		rings:        make(map[types.RingID]*Ring),
+		proposals:    make(map[types.RingID]*proposal),
 	}
 
 	for _, opt := range opts {
@@ -98,12 +131,31 @@ func New(ctx context.Context, host *host.Host, opts ...Option) (*App, error) {
 			return nil, fmt.Errorf("apply orbis option: %w", err)
 		}
 	}
+	if a.custody == nil {
+		return nil, fmt.Errorf("%w: no key custody", ErrKeyMissing)
+	}
+	if !a.custody.PrivKey().GetPublic().Equals(hpk.GetPublic()) {
+		return nil, fmt.Errorf("the key custody doesn't hold the host key")
+	}
+	a.operators = authn.NewOperators(a.config.GRPC.Authn.Operators)
 
 	a.ringRepo, err = db.GetRepo(a.db, db.NewRepoKey("ring"), ringPkFunc)
 	if err != nil {
 		return nil, fmt.Errorf("get ring repo: %w", err)
 	}
 
+	a.content, err = content.NewDBStore(a.db)
+	if err != nil {
+		return nil, fmt.Errorf("create content store: %w", err)
+	}
+	do.ProvideValue(a.inj, a.content)
+
+	a.audit, err = db.NewAuditLog(a.db)
+	if err != nil {
+		return nil, fmt.Errorf("create audit log: %w", err)
+	}
+	do.ProvideValue(a.inj, a.audit)
+
 	return a, nil
 }
 
@@ -0,0 +1,94 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+
+	"google.golang.org/protobuf/proto"
+
+	auditv1alpha1 "github.com/sourcenetwork/orbis-go/gen/proto/orbis/audit/v1alpha1"
+	"github.com/sourcenetwork/orbis-go/pkg/db"
+	"github.com/sourcenetwork/orbis-go/pkg/types"
+)
+
+const auditMsgType = "audit"
+
+// auditMirrorTimeout bounds the retries of an audit record mirror.
+const auditMirrorTimeout = 10 * time.Minute
+
+// RecordAudit appends a secret access record to the ring audit log,
+// and mirrors it to the ring bulletin if enabled. The ring and node
+// ids of the record are set by the ring. The local log is the record
+// of truth, so the mirror is best effort, retried in the background.
+func (r *Ring) RecordAudit(ctx context.Context, rec *auditv1alpha1.Record) error {
+	rec.RingId = string(r.ID)
+	rec.NodeId = r.Transport.Host().ID()
+
+	err := r.Audit.Append(ctx, rec)
+	if err != nil {
+		return fmt.Errorf("append audit record: %w", err)
+	}
+
+	if r.app.config.Ring.Audit.Mirror {
+		go r.mirrorAudit(proto.Clone(rec).(*auditv1alpha1.Record))
+	}
+
+	return nil
+}
+
+// mirrorAudit posts the record to the ring bulletin, retrying with
+// an exponential backoff until it's posted or the timeout expires.
+func (r *Ring) mirrorAudit(rec *auditv1alpha1.Record) {
+	payload, err := proto.Marshal(rec)
+	if err != nil {
+		log.Errorf("Mirror audit record %d of ring %s: marshal: %v", rec.Seq, r.ID, err)
+		return
+	}
+
+	id := auditMsgID(r.ID, rec.NodeId, rec.Seq)
+	msg, err := r.Transport.NewMessage(r.ID, id, false, payload, auditMsgType, nil)
+	if err != nil {
+		log.Errorf("Mirror audit record %d of ring %s: create transport message: %v", rec.Seq, r.ID, err)
+		return
+	}
+
+	b := backoff.NewExponentialBackOff()
+	b.MaxElapsedTime = auditMirrorTimeout
+	err = backoff.RetryNotify(func() error {
+		_, err := r.Bulletin.Post(context.Background(), id, msg)
+		return err
+	}, b, func(err error, next time.Duration) {
+		log.Warnf("Mirror audit record %d of ring %s: %v, retry in %s", rec.Seq, r.ID, err, next)
+	})
+	if err != nil {
+		log.Errorf("Mirror audit record %d of ring %s: post to bulletin: %v", rec.Seq, r.ID, err)
+	}
+}
+
+// AuditRecords returns the ring audit records kept by this node that
+// match the filter, and whether the full ring chain is intact.
+func (r *Ring) AuditRecords(ctx context.Context, filter db.AuditFilter) ([]*auditv1alpha1.Record, bool, error) {
+	recs, err := r.Audit.Records(ctx, string(r.ID), filter)
+	if err != nil {
+		return nil, false, err
+	}
+
+	err = r.Audit.Verify(ctx, string(r.ID))
+	if err != nil {
+		log.Errorf("Audit log of ring %s failed verification: %v", r.ID, err)
+	}
+
+	return recs, err == nil, nil
+}
+
+func auditNamespace(rid types.RingID) string {
+	return fmt.Sprintf("/ring/%s/audit", rid)
+}
+
+// /ring/<ringID>/audit/<nodeID>/<seq>
+func auditMsgID(rid types.RingID, nodeID string, seq uint64) string {
+	return fmt.Sprintf("%s/%s/%d", auditNamespace(rid), nodeID, seq)
+}
@@ -0,0 +1,32 @@
+package app
+
+import (
+	"fmt"
+
+	"github.com/samber/do"
+
+	"github.com/sourcenetwork/orbis-go/pkg/authn"
+	"github.com/sourcenetwork/orbis-go/pkg/types"
+)
+
+// CredentialService returns the named credential service, used to
+// authenticate requests outside of any ring. It is either a registered
+// service, or created from the registered factory.
+func (a *App) CredentialService(name string) (authn.CredentialService, error) {
+	srv, err := do.InvokeNamed[authn.CredentialService](a.inj, name)
+	if err == nil {
+		return srv, nil
+	}
+
+	factory, err := do.InvokeNamed[types.Factory[authn.CredentialService]](a.inj, name)
+	if err != nil {
+		return nil, fmt.Errorf("invoke credential service %q: %w", name, err)
+	}
+
+	srv, err = factory.New(a.inj, a.repoKeysForService(factory.Name()), a.config)
+	if err != nil {
+		return nil, fmt.Errorf("create credential service %q: %w", name, err)
+	}
+
+	return srv, nil
+}
@@ -0,0 +1,234 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ipfs/go-cid"
+	"google.golang.org/protobuf/proto"
+
+	contentv1alpha1 "github.com/sourcenetwork/orbis-go/gen/proto/orbis/content/v1alpha1"
+	"github.com/sourcenetwork/orbis-go/pkg/content"
+	"github.com/sourcenetwork/orbis-go/pkg/transport"
+	"github.com/sourcenetwork/orbis-go/pkg/types"
+)
+
+// For P2P handlers
+const (
+	contentPushMsgType  = "contentpush"
+	contentFetchMsgType = "contentfetch"
+)
+
+// contentFetchTimeout bounds the wait for a
+// ring node to push content missing locally.
+const contentFetchTimeout = 30 * time.Second
+
+// putContent stores the data in the local content store, and pushes
+// it to the rest of the ring nodes. Nodes that miss the push fetch
+// the content from the ring when it's read.
+func (r *Ring) putContent(ctx context.Context, data []byte) (cid.Cid, error) {
+	c, err := r.Content.Put(ctx, data)
+	if err != nil {
+		return cid.Undef, fmt.Errorf("put content: %w", err)
+	}
+
+	payload, err := proto.Marshal(&contentv1alpha1.Block{
+		Cid:  c.String(),
+		Data: data,
+	})
+	if err != nil {
+		return cid.Undef, fmt.Errorf("marshal content block: %w", err)
+	}
+
+	for _, n := range r.nodes {
+		if n.ID() == r.Transport.Host().ID() {
+			continue
+		}
+
+		go r.sendContent(n, c.String(), contentPushMsgType, payload)
+	}
+
+	return c, nil
+}
+
+func (r *Ring) sendContent(n types.Node, id string, msgType string, payload []byte) {
+	msg, err := r.Transport.NewMessage(r.ID, id, false, payload, msgType, &n)
+	if err != nil {
+		log.Errorf("new transport message for %s: %s", msgType, err)
+		return
+	}
+
+	err = r.Transport.Send(context.Background(), &n, msg)
+	if err != nil {
+		log.Errorf("send %s to %s: %s", msgType, n.ID(), err)
+	}
+}
+
+// getContent reads the data identified by c from the local content
+// store, or fetches it from the ring nodes if it's missing.
+func (r *Ring) getContent(ctx context.Context, c cid.Cid) ([]byte, error) {
+	data, err := r.Content.Get(ctx, c)
+	if errors.Is(err, content.ErrNotFound) {
+		data, err = r.fetchContent(ctx, c)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// don't trust the local store blindly.
+	err = content.Verify(c, data)
+	if err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
+
+// fetchContent asks the ring nodes for the content identified by c,
+// and waits for the first of them to push it.
+func (r *Ring) fetchContent(ctx context.Context, c cid.Cid) ([]byte, error) {
+	log.Infof("fetching missing content %s from the ring", c)
+
+	// registered before the fetch, so no push is missed.
+	stored := make(chan struct{})
+	r.contentMu.Lock()
+	r.contentWaits[c.String()] = append(r.contentWaits[c.String()], stored)
+	r.contentMu.Unlock()
+
+	defer func() {
+		r.contentMu.Lock()
+		waits := r.contentWaits[c.String()]
+		for i, w := range waits {
+			if w == stored {
+				waits = append(waits[:i], waits[i+1:]...)
+				break
+			}
+		}
+		if len(waits) == 0 {
+			delete(r.contentWaits, c.String())
+		} else {
+			r.contentWaits[c.String()] = waits
+		}
+		r.contentMu.Unlock()
+	}()
+
+	// pushed while registering
+	if r.Content.Has(ctx, c) {
+		return r.Content.Get(ctx, c)
+	}
+
+	payload, err := proto.Marshal(&contentv1alpha1.Block{Cid: c.String()})
+	if err != nil {
+		return nil, fmt.Errorf("marshal content fetch: %w", err)
+	}
+	for _, n := range r.nodes {
+		if n.ID() == r.Transport.Host().ID() {
+			continue
+		}
+		go r.sendContent(n, c.String(), contentFetchMsgType, payload)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, contentFetchTimeout)
+	defer cancel()
+	select {
+	case <-stored:
+		return r.Content.Get(ctx, c)
+	case <-ctx.Done():
+		return nil, fmt.Errorf("fetch content %s: %w", c, errors.Join(content.ErrNotFound, ctx.Err()))
+	}
+}
+
+func (r *Ring) contentTransportMessageHandler(msg *transport.Message) error {
+	if msg.Type != contentPushMsgType && msg.Type != contentFetchMsgType {
+		return fmt.Errorf("unknown message type: %s, id: %s", msg.Type, msg.Id)
+	}
+
+	if msg.RingId != string(r.ID) {
+		return fmt.Errorf("content %s for unknown ring %s", msg.Type, msg.RingId)
+	}
+
+	if !r.isMember(msg.NodeId) {
+		return fmt.Errorf("content %s from non ring member %s", msg.Type, msg.NodeId)
+	}
+
+	var blk contentv1alpha1.Block
+	err := proto.Unmarshal(msg.Payload, &blk)
+	if err != nil {
+		return fmt.Errorf("unmarshal content block: %w", err)
+	}
+
+	c, err := cid.Decode(blk.Cid)
+	if err != nil {
+		return fmt.Errorf("decode content cid: %w", err)
+	}
+
+	if msg.Type == contentFetchMsgType {
+		return r.handleContentFetch(msg.NodeId, c)
+	}
+
+	err = content.Verify(c, blk.Data)
+	if err != nil {
+		return fmt.Errorf("verify content %s: %w", c, err)
+	}
+
+	_, err = r.Content.Put(context.Background(), blk.Data)
+	if err != nil {
+		return fmt.Errorf("put content: %w", err)
+	}
+
+	log.Infof("stored pushed content %s from %s", c, msg.NodeId)
+
+	r.contentMu.Lock()
+	for _, stored := range r.contentWaits[c.String()] {
+		close(stored)
+	}
+	delete(r.contentWaits, c.String())
+	r.contentMu.Unlock()
+
+	return nil
+}
+
+// handleContentFetch pushes the content identified by c to
+// the node fetching it, if it's stored locally.
+func (r *Ring) handleContentFetch(nodeID string, c cid.Cid) error {
+	data, err := r.Content.Get(context.Background(), c)
+	if errors.Is(err, content.ErrNotFound) {
+		log.Infof("content fetch from %s: %s isn't stored here", nodeID, c)
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("content fetch from %s: %w", nodeID, err)
+	}
+
+	err = content.Verify(c, data)
+	if err != nil {
+		return fmt.Errorf("content fetch from %s: %w", nodeID, err)
+	}
+
+	payload, err := proto.Marshal(&contentv1alpha1.Block{
+		Cid:  c.String(),
+		Data: data,
+	})
+	if err != nil {
+		return fmt.Errorf("marshal content block: %w", err)
+	}
+
+	for _, n := range r.nodes {
+		if n.ID() == nodeID {
+			r.sendContent(n, c.String(), contentPushMsgType, payload)
+			break
+		}
+	}
+	return nil
+}
+
+func (r *Ring) isMember(nodeID string) bool {
+	for _, n := range r.nodes {
+		if n.ID() == nodeID {
+			return true
+		}
+	}
+	return false
+}
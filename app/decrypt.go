@@ -0,0 +1,502 @@
+package app
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+
+	"go.dedis.ch/kyber/v3"
+	"go.dedis.ch/kyber/v3/share"
+	"go.dedis.ch/kyber/v3/suites"
+	"google.golang.org/protobuf/proto"
+
+	ringv1alpha1 "github.com/sourcenetwork/orbis-go/gen/proto/orbis/ring/v1alpha1"
+	"github.com/sourcenetwork/orbis-go/pkg/authn"
+	"github.com/sourcenetwork/orbis-go/pkg/authz"
+	"github.com/sourcenetwork/orbis-go/pkg/crypto"
+	"github.com/sourcenetwork/orbis-go/pkg/dkg"
+	"github.com/sourcenetwork/orbis-go/pkg/tdec"
+	tdecelgamal "github.com/sourcenetwork/orbis-go/pkg/tdec/elgamal"
+	"github.com/sourcenetwork/orbis-go/pkg/transport"
+	"github.com/sourcenetwork/orbis-go/pkg/types"
+)
+
+var (
+	ErrDecryptDisabled     = fmt.Errorf("threshold decryption disabled")
+	ErrDecryptUnauthorized = fmt.Errorf("subject not authorized to decrypt")
+)
+
+// decryptSession collects the partial decryptions of a secret.
+type decryptSession struct {
+	encCmt kyber.Point
+	decSki map[int]*share.PubShare // share index
+	done   bool
+	decCmt chan kyber.Point
+}
+
+// DecryptSecret decrypts the secret with threshold decryption, on behalf
+// of the subject authenticated in the context, and returns its plaintext.
+// The subject credential is forwarded to the ring nodes, and every node
+// verifies it, and checks on its own that decryption is enabled and that
+// the subject holds the decrypt permission, before releasing its partial
+// decryption. A credential bound to a request must be bound to this
+// decryption, see authn.DecryptBinding.
+//
+// Requests share the re-encryption rate limits, and concurrent
+// requests of a subject for the same secret are coalesced.
+func (r *Ring) DecryptSecret(ctx context.Context, sid types.SecretID, acpProof []byte) ([]byte, error) {
+	log.Infof("ring.DecryptSecret(): ringid=%s secretid=%s", r.ID, sid)
+
+	if r.TDEC == nil {
+		return nil, ErrDecryptDisabled
+	}
+
+	subject, ok := authn.SubjectFromContext(ctx)
+	if !ok || subject.Subject == "" {
+		return nil, fmt.Errorf("%w: missing subject", ErrDecryptUnauthorized)
+	}
+	if subject.Binding != "" && subject.Binding != authn.DecryptBinding(string(r.ID), string(sid)) {
+		return nil, fmt.Errorf("%w: credential bound to another request", ErrDecryptUnauthorized)
+	}
+	credential, err := r.Authn.GetRequestToken(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrDecryptUnauthorized, err)
+	}
+
+	err = r.allowReencrypt(subject.Subject, string(sid))
+	if err != nil {
+		return nil, err
+	}
+
+	scrt, err := r.GetSecret(ctx, string(sid))
+	if err != nil {
+		return nil, fmt.Errorf("get secret: %w", err)
+	}
+
+	req := &ringv1alpha1.DecryptSecretRequest{
+		RingId:     string(r.ID),
+		SecretId:   string(sid),
+		AcpProof:   acpProof,
+		Subject:    subject.Subject,
+		Credential: credential,
+	}
+
+	decryptMsgID := tdecDecryptMsgID(string(r.ID), string(sid), subject.Subject)
+	resCh := r.decryptFlight.DoChan(decryptMsgID, func() (interface{}, error) {
+		ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), reencryptTimeout)
+		defer cancel()
+		return r.decrypt(ctx, decryptMsgID, req, scrt)
+	})
+
+	var decCmt kyber.Point
+	select {
+	case res := <-resCh:
+		if res.Err != nil {
+			return nil, res.Err
+		}
+		decCmt = res.Val.(kyber.Point)
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	return r.openSecret(ctx, scrt, decCmt)
+}
+
+// AuthorizeDecrypt checks the subject holds the decrypt permission on
+// the authorization context object of the secret, and that it was
+// delegated to the subject credential.
+func (r *Ring) AuthorizeDecrypt(ctx context.Context, scrt types.Secret, subject authn.SubjectInfo) error {
+	obj, err := authz.ParsePermission(scrt.AuthzCtx)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrDecryptUnauthorized, err)
+	}
+	obj.Relation = r.decryptPerm
+
+	if !subject.Allows(obj.Permission()) {
+		return fmt.Errorf("%w: decrypt not delegated to the credential", ErrDecryptUnauthorized)
+	}
+
+	ok, err := r.Authz.Check(ctx, obj.Permission(), "user:"+subject.Subject)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrDecryptUnauthorized, err)
+	}
+	if !ok {
+		return ErrDecryptUnauthorized
+	}
+
+	return nil
+}
+
+// decrypt fans the request out to the ring nodes,
+// and waits for the recovered decryption point.
+func (r *Ring) decrypt(ctx context.Context, decryptMsgID string, req *ringv1alpha1.DecryptSecretRequest, scrt types.Secret) (kyber.Point, error) {
+	ste, err := r.ringSuite()
+	if err != nil {
+		return nil, err
+	}
+
+	encCmt := ste.Point()
+	err = encCmt.UnmarshalBinary(scrt.EncCmt)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal encrypted commitment: %w", err)
+	}
+
+	payload, err := proto.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("marshal decrypt secret request: %w", err)
+	}
+
+	// registered before the fan-out, so no share is missed.
+	sess := &decryptSession{
+		encCmt: encCmt,
+		decSki: make(map[int]*share.PubShare),
+		decCmt: make(chan kyber.Point, 1),
+	}
+	r.decMu.Lock()
+	r.decSessions[decryptMsgID] = sess
+	r.decMu.Unlock()
+
+	defer func() {
+		r.decMu.Lock()
+		delete(r.decSessions, decryptMsgID)
+		r.decMu.Unlock()
+	}()
+
+	for _, n := range r.nodes {
+		go func(n types.Node) {
+			msg, err := r.Transport.NewMessage(r.ID, decryptMsgID, false, payload, tdecelgamal.DecryptRequest, &n)
+			if err != nil {
+				log.Errorf("new transport message for decrypt request: %s", err)
+				return
+			}
+			r.sendDecryptMessage(ctx, n, msg)
+		}(n)
+	}
+
+	log.Infof("ring.DecryptSecret(): waiting for partial decryptions...")
+	select {
+	case decCmt := <-sess.decCmt:
+		log.Infof("ring.DecryptSecret(): decryption point recovered")
+		return decCmt, nil
+	case <-ctx.Done():
+		return nil, fmt.Errorf("wait for partial decryptions: %w", ctx.Err())
+	}
+}
+
+// openSecret decrypts the secret with its recovered decryption point.
+func (r *Ring) openSecret(ctx context.Context, scrt types.Secret, decCmt kyber.Point) ([]byte, error) {
+	ste, err := r.ringSuite()
+	if err != nil {
+		return nil, err
+	}
+
+	encScrt := make([]kyber.Point, len(scrt.EncScrt))
+	for i, buf := range scrt.EncScrt {
+		encScrt[i] = ste.Point()
+		err = encScrt[i].UnmarshalBinary(buf)
+		if err != nil {
+			return nil, fmt.Errorf("unmarshal encrypted secret: %w", err)
+		}
+	}
+
+	if scrt.Dem == "" {
+		return tdecelgamal.DecryptSecret(ste, encScrt, decCmt)
+	}
+
+	dem, err := crypto.DEMFromString(scrt.Dem)
+	if err != nil {
+		return nil, fmt.Errorf("secret dem %q: %w", scrt.Dem, err)
+	}
+
+	encData, err := r.GetSecretData(ctx, scrt)
+	if err != nil {
+		return nil, fmt.Errorf("get secret data: %w", err)
+	}
+
+	encCmt := ste.Point()
+	err = encCmt.UnmarshalBinary(scrt.EncCmt)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal encrypted commitment: %w", err)
+	}
+
+	return tdecelgamal.DecryptSecretHybrid(ste, dem, encCmt, encScrt, encData, decCmt)
+}
+
+// ringSuite returns the suite of the ring key.
+func (r *Ring) ringSuite() (suites.Suite, error) {
+	pk, err := r.PublicKey()
+	if err != nil {
+		return nil, fmt.Errorf("ring public key: %w", err)
+	}
+
+	ste, err := crypto.SuiteForType(pk.Type())
+	if err != nil {
+		return nil, fmt.Errorf("suite for type: %w", err)
+	}
+
+	return ste, nil
+}
+
+// sendDecryptMessage sends the message to the node, messages
+// to ourselves are handled without going through the transport.
+func (r *Ring) sendDecryptMessage(ctx context.Context, n types.Node, msg *transport.Message) {
+	if n.ID() == r.Transport.Host().ID() {
+		go func() {
+			err := r.decryptTransportMessageHandler(msg)
+			if err != nil {
+				log.Errorf("handle decrypt message: %s", err)
+			}
+		}()
+		return
+	}
+
+	err := r.Transport.Send(ctx, &n, msg)
+	if err != nil {
+		log.Errorf("send %s to %s: %s", msg.Type, n.ID(), err)
+	}
+}
+
+func (r *Ring) decryptTransportMessageHandler(msg *transport.Message) error {
+	log.Infof("ring.DecryptTransportHandler(): type=%s from=%s to=%s", msg.Type, msg.NodeId, msg.TargetId)
+
+	if msg.RingId != string(r.ID) {
+		return fmt.Errorf("decrypt message for unknown ring %s", msg.RingId)
+	}
+
+	if !r.isMember(msg.NodeId) {
+		return fmt.Errorf("decrypt message from non ring member %s", msg.NodeId)
+	}
+
+	switch msg.Type {
+	case tdecelgamal.DecryptRequest:
+		return r.handleDecryptRequest(msg)
+	case tdecelgamal.DecryptReply:
+		return r.handleDecryptedShare(msg)
+	default:
+		return fmt.Errorf("unknown message type: %s, id: %s", msg.Type, msg.Id)
+	}
+}
+
+// handleDecryptRequest releases our partial decryption of the secret,
+// if decryption is enabled on this node and the subject is authorized.
+// The requesting node isn't trusted to have checked either.
+func (r *Ring) handleDecryptRequest(msg *transport.Message) error {
+	var req ringv1alpha1.DecryptSecretRequest
+	err := proto.Unmarshal(msg.Payload, &req)
+	if err != nil {
+		return fmt.Errorf("unmarshal decrypt request: %w", err)
+	}
+	log.Infof("handling decrypt request: secretid=%s subject=%s from=%s", req.SecretId, req.Subject, msg.NodeId)
+
+	if r.TDEC == nil {
+		return fmt.Errorf("decrypt request from %s: %w", msg.NodeId, ErrDecryptDisabled)
+	}
+
+	if msg.Id != tdecDecryptMsgID(string(r.ID), req.SecretId, req.Subject) {
+		return fmt.Errorf("decrypt request id %s doesn't match the request", msg.Id)
+	}
+
+	// Requests from ourselves were already authenticated
+	// and limited by the entry node DecryptSecret.
+	subject := authn.SubjectInfo{Subject: req.Subject}
+	if msg.NodeId != r.Transport.Host().ID() {
+		subject, err = r.authenticateDecrypt(&req)
+		if err != nil {
+			return fmt.Errorf("decrypt request from %s: %w", msg.NodeId, err)
+		}
+
+		err = r.allowReencrypt(subject.Subject, req.SecretId)
+		if err != nil {
+			return fmt.Errorf("decrypt request from %s: %w", msg.NodeId, err)
+		}
+	}
+
+	if r.DKG.State() != dkg.CERTIFIED.String() {
+		return fmt.Errorf("dkg not certified yet: %s", r.DKG.State())
+	}
+
+	ctx := context.TODO()
+	scrt, err := r.GetSecret(ctx, req.SecretId)
+	if err != nil {
+		return fmt.Errorf("get secret: %w", err)
+	}
+
+	if len(req.AcpProof) > 0 {
+		ctx = authz.ContextWithProof(ctx, req.AcpProof)
+	}
+	err = r.AuthorizeDecrypt(ctx, scrt, subject)
+	if err != nil {
+		return fmt.Errorf("decrypt request from %s: %w", msg.NodeId, err)
+	}
+
+	ste, err := r.ringSuite()
+	if err != nil {
+		return err
+	}
+
+	dks, err := r.servableShare()
+	if err != nil {
+		return err
+	}
+
+	reply, err := r.TDEC.Decrypt(ste, dks, &scrt)
+	if err != nil {
+		return fmt.Errorf("decrypt: %w", err)
+	}
+
+	decski, err := reply.Share.V.MarshalBinary()
+	if err != nil {
+		return fmt.Errorf("marshal decski: %w", err)
+	}
+
+	chlgi, err := reply.Challenge.MarshalBinary()
+	if err != nil {
+		return fmt.Errorf("marshal chlgi: %w", err)
+	}
+
+	proofi, err := reply.Proof.MarshalBinary()
+	if err != nil {
+		return fmt.Errorf("marshal proofi: %w", err)
+	}
+
+	payload, err := proto.Marshal(&ringv1alpha1.DecryptedSecretShare{
+		RingId:   string(r.ID),
+		SecretId: req.SecretId,
+		Index:    int32(reply.Share.I),
+		DecSki:   decski,
+		Chlgi:    chlgi,
+		Proofi:   proofi,
+	})
+	if err != nil {
+		return fmt.Errorf("marshal decrypted secret share: %w", err)
+	}
+
+	var origNode types.Node
+	for _, n := range r.nodes {
+		if n.ID() == msg.NodeId {
+			origNode = n
+			break
+		}
+	}
+
+	replyMsg, err := r.Transport.NewMessage(r.ID, msg.Id, false, payload, tdecelgamal.DecryptReply, &origNode)
+	if err != nil {
+		return fmt.Errorf("new transport message for decrypted secret share: %w", err)
+	}
+
+	r.sendDecryptMessage(ctx, origNode, replyMsg)
+	return nil
+}
+
+// authenticateDecrypt verifies the credential forwarded with a decrypt
+// request, and checks it's the credential of the requesting subject,
+// and bound to the request if bound at all. The requesting node is a
+// ring member, but only the credential proves the subject asked for
+// the decryption.
+func (r *Ring) authenticateDecrypt(req *ringv1alpha1.DecryptSecretRequest) (authn.SubjectInfo, error) {
+	if len(req.Credential) == 0 {
+		return authn.SubjectInfo{}, fmt.Errorf("%w: missing credential", ErrDecryptUnauthorized)
+	}
+
+	subject, err := r.Authn.VerifyRequestSubject(context.TODO(), req.Credential)
+	if err != nil {
+		return authn.SubjectInfo{}, fmt.Errorf("%w: %w", ErrDecryptUnauthorized, err)
+	}
+
+	if subject.Subject != req.Subject {
+		return authn.SubjectInfo{}, fmt.Errorf("%w: credential of %s, not the requesting subject", ErrDecryptUnauthorized, subject.Subject)
+	}
+	if subject.Binding != "" && subject.Binding != authn.DecryptBinding(string(r.ID), req.SecretId) {
+		return authn.SubjectInfo{}, fmt.Errorf("%w: credential bound to another request", ErrDecryptUnauthorized)
+	}
+
+	return subject, nil
+}
+
+func (r *Ring) handleDecryptedShare(msg *transport.Message) error {
+	var resp ringv1alpha1.DecryptedSecretShare
+	err := proto.Unmarshal(msg.Payload, &resp)
+	if err != nil {
+		return fmt.Errorf("unmarshal decrypted secret share: %w", err)
+	}
+	log.Infof("handling decrypted secret share: secretid=%s from=%s", resp.SecretId, msg.NodeId)
+
+	r.decMu.Lock()
+	sess, ok := r.decSessions[msg.Id]
+	r.decMu.Unlock()
+	if !ok {
+		log.Infof("handling decrypted secret share: no pending request for %s, ignoring share", msg.Id)
+		return nil
+	}
+
+	ste, err := r.ringSuite()
+	if err != nil {
+		return err
+	}
+
+	reply := tdec.DecryptReply{
+		Share: share.PubShare{
+			I: int(resp.Index),
+			V: ste.Point(),
+		},
+		Challenge: ste.Scalar(),
+		Proof:     ste.Scalar(),
+	}
+
+	err = reply.Share.V.UnmarshalBinary(resp.DecSki)
+	if err != nil {
+		return fmt.Errorf("unmarshal decski: %w", err)
+	}
+
+	err = reply.Challenge.UnmarshalBinary(resp.Chlgi)
+	if err != nil {
+		return fmt.Errorf("unmarshal chlgi: %w", err)
+	}
+
+	err = reply.Proof.UnmarshalBinary(resp.Proofi)
+	if err != nil {
+		return fmt.Errorf("unmarshal proofi: %w", err)
+	}
+
+	poly, err := r.ringPubPoly()
+	if err != nil {
+		return err
+	}
+
+	err = r.TDEC.Verify(ste, poly, sess.encCmt, reply)
+	if err != nil {
+		return fmt.Errorf("verify decrypted secret share from %s: %w", msg.NodeId, err)
+	}
+
+	// shares are keyed by share index, so replayed
+	// shares don't count towards the threshold.
+	r.decMu.Lock()
+	if sess.done {
+		r.decMu.Unlock()
+		return nil
+	}
+	sess.decSki[reply.Share.I] = &reply.Share
+	if len(sess.decSki) < r.T {
+		log.Infof("shares to decrypt %d/%d", len(sess.decSki), r.T)
+		r.decMu.Unlock()
+		return nil
+	}
+	sess.done = true
+	decSki := make([]*share.PubShare, 0, len(sess.decSki))
+	for _, s := range sess.decSki {
+		decSki = append(decSki, s)
+	}
+	r.decMu.Unlock()
+
+	decCmt, err := r.TDEC.Recover(ste, decSki, r.T, r.N)
+	if err != nil {
+		return fmt.Errorf("recover decryption point: %w", err)
+	}
+
+	sess.decCmt <- decCmt
+	return nil
+}
+
+func tdecDecryptMsgID(rid string, sid string, subject string) string {
+	return fmt.Sprintf("/ring/%s/tdec/decrypt/%s/%x", rid, sid, sha256.Sum256([]byte(subject)))
+}
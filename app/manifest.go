@@ -1,75 +1,106 @@
 package app
 
 import (
+	"errors"
 	"fmt"
 
-	"github.com/fxamacker/cbor/v2"
-	cid "github.com/ipfs/go-cid"
-	mc "github.com/multiformats/go-multicodec"
-	mh "github.com/multiformats/go-multihash"
+	icpb "github.com/libp2p/go-libp2p/core/crypto/pb"
+	"github.com/samber/do"
 
 	ringv1alpha1 "github.com/sourcenetwork/orbis-go/gen/proto/orbis/ring/v1alpha1"
+	"github.com/sourcenetwork/orbis-go/pkg/authn"
+	"github.com/sourcenetwork/orbis-go/pkg/authz"
+	"github.com/sourcenetwork/orbis-go/pkg/bulletin"
+	"github.com/sourcenetwork/orbis-go/pkg/crypto"
+	"github.com/sourcenetwork/orbis-go/pkg/dkg"
+	"github.com/sourcenetwork/orbis-go/pkg/pre"
+	"github.com/sourcenetwork/orbis-go/pkg/pss"
+	"github.com/sourcenetwork/orbis-go/pkg/transport"
 	"github.com/sourcenetwork/orbis-go/pkg/types"
 )
 
-type manifest struct {
-	ID        string `json:"id"`
-	N         int32  `json:"n"`
-	T         int32  `json:"t"`
-	DKG       string `json:"dkg"`
-	PSS       string `json:"pss"`
-	PRE       string `json:"pre"`
-	Bulletin  string `json:"bulletin"`
-	Transport string `json:"transport"`
-	Nodes     []node `json:"nodes"`
+// ValidateManifest checks the manifest can be joined by this node,
+// without any side effects. On top of the static manifest checks, the
+// named services must be registered, the node must be a member of the
+// ring, and the ring must not be joined already.
+func (app *App) ValidateManifest(manifest *ringv1alpha1.Manifest) error {
+	app.mu.Lock()
+	defer app.mu.Unlock()
 
-	Authorization  string `json:"authorization"`
-	Authentication string `json:"authentication"`
+	return app.validateManifest(manifest)
 }
 
-type node struct {
-	ID      string `json:"id"`
-	Address string `json:"address"`
-}
+func (app *App) validateManifest(manifest *ringv1alpha1.Manifest) error {
+	err := types.Manifest{Manifest: manifest}.Validate()
+	if manifest == nil {
+		return err
+	}
 
-func ringID(r *ringv1alpha1.Manifest) types.RingID {
+	errs := []error{err}
+	fail := func(field string, err error) {
+		errs = append(errs, &types.ManifestError{Field: field, Err: err})
+	}
 
-	m := manifest{
-		N:              r.N,
-		T:              r.T,
-		DKG:            r.Dkg,
-		PSS:            r.Pss,
-		PRE:            r.Pre,
-		Bulletin:       r.Bulletin,
-		Transport:      r.Transport,
-		Nodes:          make([]node, len(r.Nodes)),
-		Authorization:  r.Authorization,
-		Authentication: r.Authentication,
+	if _, exists := app.rings[types.RingIDFromManifest(manifest)]; exists {
+		fail("manifest", fmt.Errorf("ring already joined"))
 	}
 
-	for i, n := range r.Nodes {
-		m.Nodes[i] = node{
-			ID:      n.Id,
-			Address: n.Address,
+	member := false
+	for i, n := range manifest.Nodes {
+		if n.GetId() == app.host.ID().String() {
+			member = true
+			if n.PairingKey != nil && !app.isPairingKey(n.PairingKey) {
+				fail(fmt.Sprintf("nodes[%d].pairing_key", i), fmt.Errorf("doesn't match the node pairing key"))
+			}
+			break
 		}
 	}
-
-	b, err := cbor.Marshal(m)
-	if err != nil {
-		panic(fmt.Errorf("marshal manifest: %w", err))
+	if !member {
+		fail("nodes", fmt.Errorf("node %s is not a member of the ring", app.host.ID()))
 	}
 
-	pref := cid.Prefix{
-		Version:  1,
-		Codec:    uint64(mc.Cbor),
-		MhType:   mh.SHA2_256,
-		MhLength: -1, // default length
+	checks := []struct {
+		field string
+		name  string
+		check func(*do.Injector, string) bool
+	}{
+		{"transport", manifest.Transport, isRegistered[transport.Transport]},
+		{"bulletin", manifest.Bulletin, isRegistered[bulletin.Bulletin]},
+		{"dkg", manifest.Dkg, isRegistered[types.Factory[dkg.DKG]]},
+		{"pss", manifest.Pss, isRegistered[types.Factory[pss.PSS]]},
+		{"pre", manifest.Pre, isRegistered[types.Factory[pre.PRE]]},
+		{"authentication", manifest.Authentication, isServiceOrFactory[authn.CredentialService]},
+		{"authorization", manifest.Authorization, isServiceOrFactory[authz.Authz]},
 	}
+	for _, c := range checks {
+		if c.name == "" {
+			continue // reported by the static checks.
+		}
+		if !c.check(app.inj, c.name) {
+			fail(c.field, fmt.Errorf("%q is not registered", c.name))
+		}
+	}
+
+	return errors.Join(errs...)
+}
 
-	cid, err := pref.Sum(b)
+// isPairingKey reports whether the key is the node pairing key.
+func (app *App) isPairingKey(key *icpb.PublicKey) bool {
+	pk, _, err := app.PairingKey()
 	if err != nil {
-		panic(fmt.Errorf("create cid: %w", err))
+		return false
 	}
+	other, err := crypto.PubKeyFromProto(key)
+	return err == nil && pk.Equals(other)
+}
+
+func isRegistered[T any](inj *do.Injector, name string) bool {
+	_, err := do.InvokeNamed[T](inj, name)
+	return err == nil
+}
 
-	return types.RingID(cid.String())
+// isServiceOrFactory mirrors joinRing, where authn/authz can either be
+// a global service or a factory.
+func isServiceOrFactory[T any](inj *do.Injector, name string) bool {
+	return isRegistered[T](inj, name) || isRegistered[types.Factory[T]](inj, name)
 }
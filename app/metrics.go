@@ -0,0 +1,22 @@
+package app
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	shareDegraded = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "orbis",
+		Subsystem: "ring",
+		Name:      "share_degraded",
+		Help:      "Whether the node share of the ring failed its last check against the public polynomial.",
+	}, []string{"ring"})
+
+	shareChecks = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "orbis",
+		Subsystem: "ring",
+		Name:      "share_checks_total",
+		Help:      "Checks of the node share of the ring against the public polynomial, by result.",
+	}, []string{"ring", "result"})
+)
@@ -6,6 +6,7 @@ import (
 	"github.com/samber/do"
 
 	"github.com/sourcenetwork/orbis-go/config"
+	"github.com/sourcenetwork/orbis-go/pkg/custody"
 	"github.com/sourcenetwork/orbis-go/pkg/db"
 	"github.com/sourcenetwork/orbis-go/pkg/types"
 )
@@ -19,9 +20,9 @@ func DefaultOptions(cfg config.Config) Option {
 	}
 }
 
-func WithDBData(path string) Option {
+func WithDBData(path string, opts ...db.Option) Option {
 	return func(a *App) error {
-		d, err := db.New(path)
+		d, err := db.New(path, opts...)
 		if err != nil {
 			return fmt.Errorf("create db: %w", err)
 		}
@@ -31,6 +32,15 @@ func WithDBData(path string) Option {
 	}
 }
 
+// WithCustody sets the custody of the node identity key, which
+// must be the key of the host. The ring DKGs use its pairing key.
+func WithCustody(keys custody.Custody) Option {
+	return func(a *App) error {
+		a.custody = keys
+		return nil
+	}
+}
+
 // WithService registers a service into the dependency injection system
 // so it can be used globally. Service values are shared, and not
 // (re)initialized on each `Invoke` call, so whatever type used here must
@@ -2,13 +2,19 @@ package app
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
+	"time"
 
+	"github.com/ipfs/go-cid"
 	"go.dedis.ch/kyber/v3"
 	"go.dedis.ch/kyber/v3/share"
 	"google.golang.org/protobuf/proto"
 
 	ringv1alpha1 "github.com/sourcenetwork/orbis-go/gen/proto/orbis/ring/v1alpha1"
+	"github.com/sourcenetwork/orbis-go/pkg/authn"
+	"github.com/sourcenetwork/orbis-go/pkg/authz"
 	"github.com/sourcenetwork/orbis-go/pkg/crypto"
 	"github.com/sourcenetwork/orbis-go/pkg/crypto/proof"
 	"github.com/sourcenetwork/orbis-go/pkg/dkg"
@@ -18,7 +24,69 @@ import (
 	"github.com/sourcenetwork/orbis-go/pkg/types"
 )
 
+// reencryptTimeout bounds how long a re-encryption
+// waits for the threshold of reencrypted shares.
+const reencryptTimeout = time.Minute
+
+var (
+	ErrSecretDataMissing     = fmt.Errorf("hybrid secret is missing its encrypted data")
+	ErrSecretDataCidMismatch = fmt.Errorf("encrypted data doesn't match its cid")
+	ErrRateLimited           = fmt.Errorf("re-encryption rate limit exceeded")
+	ErrSecretOwnerMissing    = fmt.Errorf("secret owner not authenticated")
+	ErrSecretUnauthorized    = fmt.Errorf("secret owner not authorized")
+	ErrOwnerNotRegistrable   = fmt.Errorf("authorizer can't register secret owners")
+)
+
+// reencryptSession collects the re-encrypted shares of a secret.
+type reencryptSession struct {
+	xncSki map[int]*share.PubShare // share index
+	done   bool
+	xncCmt chan kyber.Point
+}
+
+// StoreSecret stores the secret on behalf of the subject authenticated
+// in the context, which is recorded as the secret owner. The owner needs
+// the write permission on the authorization context object, which must
+// already be granted by the authorizer relationships, and is registered
+// as the object owner in the same step as the store.
+//
+// A secret posted to the bulletin can't be taken back, so the owner is
+// registered right before the post, and unregistered if the store fails.
+// Either both hold, or neither does.
 func (r *Ring) StoreSecret(ctx context.Context, rid types.RingID, scrt *types.Secret) (types.SecretID, error) {
+	owner, ok := authn.SubjectFromContext(ctx)
+	if !ok || owner.Subject == "" {
+		return "", ErrSecretOwnerMissing
+	}
+	scrt.Owner = owner.Subject
+
+	err := r.authorizeStore(ctx, scrt, owner)
+	if err != nil {
+		return "", err
+	}
+
+	registered, err := r.registerOwner(ctx, scrt)
+	if err != nil {
+		return "", err
+	}
+
+	sid, err := r.storeSecret(ctx, rid, scrt)
+	if err != nil && registered != nil {
+		r.unregisterOwner(ctx, *registered)
+	}
+
+	return sid, err
+}
+
+func (r *Ring) storeSecret(ctx context.Context, rid types.RingID, scrt *types.Secret) (types.SecretID, error) {
+	// hybrid secrets only keep the encapsulated key on the
+	// bulletin, the bulk ciphertext goes to the content store.
+	if scrt.Dem != "" || len(scrt.EncData) > 0 {
+		err := r.storeSecretData(ctx, scrt)
+		if err != nil {
+			return "", err
+		}
+	}
 
 	payload, err := proto.Marshal(scrt)
 	if err != nil {
@@ -49,8 +117,127 @@ func (r *Ring) StoreSecret(ctx context.Context, rid types.RingID, scrt *types.Se
 	return sid, nil
 }
 
+// authorizeStore checks the secret owner can write to the authorization
+// context object. Objects aren't claimed on first use, since a claim
+// would only hold on this node, so the write relationship must exist.
+func (r *Ring) authorizeStore(ctx context.Context, scrt *types.Secret, owner authn.SubjectInfo) error {
+	obj, err := authz.ParsePermission(scrt.AuthzCtx)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrSecretUnauthorized, err)
+	}
+	obj.Relation = r.writePerm
+
+	if !owner.Allows(obj.Permission()) {
+		return fmt.Errorf("%w: write not delegated to the credential", ErrSecretUnauthorized)
+	}
+
+	ok, err := r.Authz.Check(ctx, obj.Permission(), "user:"+scrt.Owner)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrSecretUnauthorized, err)
+	}
+	if !ok {
+		return ErrSecretUnauthorized
+	}
+
+	return nil
+}
+
+// registerOwner registers the secret owner on the authorization context
+// object. Objects already owned by another subject keep their owner, who
+// granted the write permission. It returns the registered relationship,
+// if it was written.
+func (r *Ring) registerOwner(ctx context.Context, scrt *types.Secret) (*authz.Relationship, error) {
+	reg, ok := r.Authz.(authz.ObjectRegistrar)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrOwnerNotRegistrable, r.Authz.Name())
+	}
+
+	rel, err := authz.ParsePermission(scrt.AuthzCtx)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrSecretUnauthorized, err)
+	}
+	rel.Relation = r.ownerRelation
+	rel.Subject = "user:" + scrt.Owner
+
+	created, err := reg.RegisterObject(ctx, rel)
+	if errors.Is(err, authz.ErrObjectOwned) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("register secret owner: %w", err)
+	}
+	if !created {
+		return nil, nil
+	}
+	return &rel, nil
+}
+
+// unregisterOwner rolls back a registered owner relationship.
+func (r *Ring) unregisterOwner(ctx context.Context, rel authz.Relationship) {
+	err := r.Authz.(authz.ObjectRegistrar).UnregisterObject(ctx, rel)
+	if err != nil {
+		log.Errorf("unregister owner %s@%s: %s", rel.Permission(), rel.Subject, err)
+	}
+}
+
+func (r *Ring) storeSecretData(ctx context.Context, scrt *types.Secret) error {
+	_, err := crypto.DEMFromString(scrt.Dem)
+	if err != nil {
+		return fmt.Errorf("secret dem %q: %w", scrt.Dem, err)
+	}
+
+	if len(scrt.EncData) == 0 {
+		return ErrSecretDataMissing
+	}
+
+	c, err := types.CidFromBytes(scrt.EncData)
+	if err != nil {
+		return fmt.Errorf("cid from bytes: %w", err)
+	}
+
+	if scrt.EncDataCid != "" && scrt.EncDataCid != c.String() {
+		return ErrSecretDataCidMismatch
+	}
+
+	_, err = r.putContent(ctx, scrt.EncData)
+	if err != nil {
+		return fmt.Errorf("store secret data: %w", err)
+	}
+
+	scrt.EncDataCid = c.String()
+	scrt.EncData = nil
+
+	return nil
+}
+
+// GetSecretData reads the encrypted data of a hybrid secret from
+// the content store.
+func (r *Ring) GetSecretData(ctx context.Context, scrt types.Secret) ([]byte, error) {
+	if scrt.EncDataCid == "" {
+		return nil, ErrSecretDataMissing
+	}
+
+	c, err := cid.Decode(scrt.EncDataCid)
+	if err != nil {
+		return nil, fmt.Errorf("decode secret data cid: %w", err)
+	}
+
+	return r.getContent(ctx, c)
+}
+
+// ReencryptSecret re-encrypts the secret to the reader public key. The
+// request subject, taken from the context, and the secret are rate limited,
+// and concurrent requests for the same secret and reader are coalesced into
+// a single ring-wide re-encryption.
 func (r *Ring) ReencryptSecret(ctx context.Context, rdrPk crypto.PublicKey, sid types.SecretID, p proof.VerifiableEncryption) (xncCmt []byte, encScrt [][]byte, err error) {
 	log.Infof("ring.ReencryptSecret(): ringid=%s secretid=%s", r.ID, sid)
+
+	subject, _ := authn.SubjectFromContext(ctx)
+	err = r.allowReencrypt(subject.Subject, string(sid))
+	if err != nil {
+		return nil, nil, err
+	}
+
 	protoRdrPk, err := crypto.PublicKeyToProto(rdrPk)
 	if err != nil {
 		return nil, nil, fmt.Errorf("public key to proto: %w", err)
@@ -59,14 +246,10 @@ func (r *Ring) ReencryptSecret(ctx context.Context, rdrPk crypto.PublicKey, sid
 	req := &ringv1alpha1.ReencryptSecretRequest{
 		SecretId: string(sid),
 		RdrPk:    protoRdrPk,
+		Subject:  subject.Subject,
 		// TODO: ACP proof
 	}
 
-	payload, err := proto.Marshal(req)
-	if err != nil {
-		return nil, nil, fmt.Errorf("marshal reencrypt secret request: %w", err)
-	}
-
 	rawRdrPk, err := proto.Marshal(req.RdrPk)
 	if err != nil {
 		return nil, nil, fmt.Errorf("marshal reader public key: %s", err)
@@ -74,12 +257,66 @@ func (r *Ring) ReencryptSecret(ctx context.Context, rdrPk crypto.PublicKey, sid
 
 	reencryptMsgID := preReencryptMsgID(string(r.ID), string(sid), rawRdrPk)
 	log.Infof("ring.ReencryptSecret(): reencrypt message request id=%s", reencryptMsgID)
+
+	// The re-encryption is shared by every coalesced caller,
+	// so it must outlive the context of the first one.
+	resCh := r.preFlight.DoChan(reencryptMsgID, func() (interface{}, error) {
+		ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), reencryptTimeout)
+		defer cancel()
+		return r.reencrypt(ctx, reencryptMsgID, req)
+	})
+
+	select {
+	case res := <-resCh:
+		if res.Err != nil {
+			return nil, nil, res.Err
+		}
+		if res.Shared {
+			log.Infof("ring.ReencryptSecret(): coalesced with in-flight request id=%s", reencryptMsgID)
+		}
+		xncCmt = res.Val.([]byte)
+	case <-ctx.Done():
+		return nil, nil, ctx.Err()
+	}
+
+	scrt, err := r.GetSecret(ctx, string(sid))
+	if err != nil {
+		return nil, nil, fmt.Errorf("encrypted secret for %s not found", string(sid))
+	}
+
+	return xncCmt, scrt.EncScrt, nil
+}
+
+// reencrypt fans the request out to the ring nodes,
+// and waits for the reencrypted commitment.
+func (r *Ring) reencrypt(ctx context.Context, reencryptMsgID string, req *ringv1alpha1.ReencryptSecretRequest) ([]byte, error) {
+	payload, err := proto.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("marshal reencrypt secret request: %w", err)
+	}
+
+	// registered before the fan-out, so no share is missed.
+	sess := &reencryptSession{
+		xncSki: make(map[int]*share.PubShare),
+		xncCmt: make(chan kyber.Point, 1),
+	}
+	r.preMu.Lock()
+	r.preSessions[reencryptMsgID] = sess
+	r.preMu.Unlock()
+
+	defer func() {
+		r.preMu.Lock()
+		delete(r.preSessions, reencryptMsgID)
+		r.preMu.Unlock()
+	}()
+
 	for _, n := range r.nodes {
 
 		go func(n types.Node) {
 			msg, err := r.Transport.NewMessage(r.ID, reencryptMsgID, false, payload, elgamal.EncryptedSecretRequest, &n)
 			if err != nil {
-				log.Errorf("new transport message for reencrypt request: %w", err)
+				log.Errorf("new transport message for reencrypt request: %s", err)
+				return
 			}
 
 			if n.ID() == r.Transport.Host().ID() {
@@ -94,25 +331,32 @@ func (r *Ring) ReencryptSecret(ctx context.Context, rdrPk crypto.PublicKey, sid
 		}(n)
 	}
 
-	if r.xncCmts[reencryptMsgID] == nil {
-		r.xncCmts[reencryptMsgID] = make(chan kyber.Point)
-	}
-
 	log.Infof("ring.ReencryptSecret(): waiting for proxy encryption...")
-	rawXncCmt := <-r.xncCmts[reencryptMsgID]
+	var rawXncCmt kyber.Point
+	select {
+	case rawXncCmt = <-sess.xncCmt:
+	case <-ctx.Done():
+		return nil, fmt.Errorf("wait for reencrypted shares: %w", ctx.Err())
+	}
 	log.Infof("ring.ReencryptSecret(): proxy encryption completed")
 
-	xncCmt, err = rawXncCmt.MarshalBinary()
+	xncCmt, err := rawXncCmt.MarshalBinary()
 	if err != nil {
-		return nil, nil, fmt.Errorf("marshal xncCmt: %w", err)
+		return nil, fmt.Errorf("marshal xncCmt: %w", err)
 	}
 
-	scrt, err := r.GetSecret(ctx, string(sid))
-	if err != nil {
-		return nil, nil, fmt.Errorf("encrypted secret for %s not found", string(sid))
-	}
+	return xncCmt, nil
+}
 
-	return xncCmt, scrt.EncScrt, nil
+// allowReencrypt checks the subject and secret re-encryption rate limits.
+func (r *Ring) allowReencrypt(subject, sid string) error {
+	if !r.subjectLimiter.Allow(subject) {
+		return fmt.Errorf("%w: subject %s", ErrRateLimited, subject)
+	}
+	if !r.secretLimiter.Allow(sid) {
+		return fmt.Errorf("%w: secret %s", ErrRateLimited, sid)
+	}
+	return nil
 }
 
 func (r *Ring) preTransportMessageHandler(msg *transport.Message) error {
@@ -156,7 +400,35 @@ func (r *Ring) handleReencryptRequest(msg *transport.Message) error {
 	if err != nil {
 		return fmt.Errorf("unmarshal reencrypt request: %s", err)
 	}
-	log.Infof("handling PRE request: secretid=%s", req.SecretId)
+	log.Infof("handling PRE request: secretid=%s subject=%s", req.SecretId, req.Subject)
+
+	// Requests from ourselves were already limited by the entry
+	// node ReencryptSecret. Peer requests are limited here too, by
+	// subject and secret, so a subject can't spread its requests
+	// over the entry nodes to get past the limits.
+	if msg.NodeId != string(r.Transport.Host().ID()) {
+		err := r.allowReencrypt(req.Subject, req.SecretId)
+		if err != nil {
+			return fmt.Errorf("reencrypt request from %s: %w", msg.NodeId, err)
+		}
+	}
+
+	// drop duplicates of a request we're already serving.
+	servingID := msg.Id + "/" + msg.NodeId
+	r.preMu.Lock()
+	_, serving := r.preServing[servingID]
+	r.preServing[servingID] = struct{}{}
+	r.preMu.Unlock()
+	if serving {
+		log.Infof("handling PRE request: dropping duplicate in-flight request id=%s from=%s", msg.Id, msg.NodeId)
+		return nil
+	}
+	defer func() {
+		r.preMu.Lock()
+		delete(r.preServing, servingID)
+		r.preMu.Unlock()
+	}()
+
 	resp, err := r.doProcessReencrypt(&req)
 	if err != nil {
 		return fmt.Errorf("do process reencrypt: %s", err)
@@ -272,13 +544,27 @@ func (r *Ring) handleReencryptedShare(msg *transport.Message) error {
 
 	reencryptMsgID := msg.Id
 
-	r.xncSki[reencryptMsgID] = append(r.xncSki[reencryptMsgID], &reply.Share)
-	xncSki := r.xncSki[reencryptMsgID]
-
-	if len(xncSki) < r.T {
-		log.Infof("not enough shares to recover %d/%d", len(xncSki), r.T)
+	// shares are keyed by share index, so replayed
+	// shares don't count towards the threshold.
+	r.preMu.Lock()
+	sess, ok := r.preSessions[reencryptMsgID]
+	if !ok || sess.done {
+		r.preMu.Unlock()
+		log.Infof("handling PRE response: no pending request for %s, ignoring share", reencryptMsgID)
 		return nil
 	}
+	sess.xncSki[reply.Share.I] = &reply.Share
+	if len(sess.xncSki) < r.T {
+		log.Infof("shares to recover %d/%d", len(sess.xncSki), r.T)
+		r.preMu.Unlock()
+		return nil
+	}
+	sess.done = true
+	xncSki := make([]*share.PubShare, 0, len(sess.xncSki))
+	for _, s := range sess.xncSki {
+		xncSki = append(xncSki, s)
+	}
+	r.preMu.Unlock()
 
 	log.Info("handling PRE response: recovering reencrypted commitment")
 	xncCmt, err := r.PRE.Recover(ste, xncSki, r.T, r.N)
@@ -286,12 +572,8 @@ func (r *Ring) handleReencryptedShare(msg *transport.Message) error {
 		return fmt.Errorf("recover reencrypt reply: %s", err)
 	}
 
-	ch, ok := r.xncCmts[reencryptMsgID]
-	if !ok {
-		return fmt.Errorf("xncCmt channel for %s not found", reencryptMsgID)
-	}
 	log.Info("handling PRE response: returning reencrypted commitment")
-	ch <- xncCmt
+	sess.xncCmt <- xncCmt
 	log.Info("handling PRE response: done!")
 	return nil
 }
@@ -312,7 +594,10 @@ func (r *Ring) doProcessReencrypt(req *ringv1alpha1.ReencryptSecretRequest) (*ri
 		return nil, fmt.Errorf("dkg not certified yet: %s", r.DKG.State())
 	}
 
-	share := r.DKG.Share()
+	share, err := r.servableShare()
+	if err != nil {
+		return nil, err
+	}
 	reply, err := r.PRE.Reencrypt(share, &scrt, rdrPk)
 	if err != nil {
 		return nil, fmt.Errorf("reencrypt: %w", err)
@@ -368,6 +653,43 @@ func (r *Ring) GetSecret(ctx context.Context, sid string) (types.Secret, error)
 	return scrt, nil
 }
 
+// ListSecrets returns the secrets stored in the ring, along
+// with their ids.
+func (r *Ring) ListSecrets(ctx context.Context) ([]types.SecretID, []types.Secret, error) {
+	query := preStoreMsgID(string(r.ID), "*")
+	respCh, err := r.Bulletin.Query(ctx, query)
+	if err != nil {
+		return nil, nil, fmt.Errorf("query bulletin: %w", err)
+	}
+
+	prefix := preStoreMsgID(string(r.ID), "")
+
+	var (
+		sids  []types.SecretID
+		scrts []types.Secret
+	)
+	for resp := range respCh {
+		if resp.Err != nil {
+			return nil, nil, fmt.Errorf("query bulletin: %w", resp.Err)
+		}
+
+		if resp.Resp.Data == nil {
+			continue
+		}
+
+		s := new(ringv1alpha1.Secret)
+		err = proto.Unmarshal(resp.Resp.Data.Payload, s)
+		if err != nil {
+			return nil, nil, fmt.Errorf("unmarshal encrypted secret: %w", err)
+		}
+
+		sids = append(sids, types.SecretID(strings.TrimPrefix(resp.Resp.ID, prefix)))
+		scrts = append(scrts, types.Secret{Secret: s})
+	}
+
+	return sids, scrts, nil
+}
+
 func preStoreMsgID(rid string, sid string) string {
 	return fmt.Sprintf("/ring/%s/pre/store/%s", rid, sid)
 }
@@ -0,0 +1,619 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	ic "github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/samber/do"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/sourcenetwork/eventbus-go"
+	ringv1alpha1 "github.com/sourcenetwork/orbis-go/gen/proto/orbis/ring/v1alpha1"
+	"github.com/sourcenetwork/orbis-go/pkg/bulletin"
+	"github.com/sourcenetwork/orbis-go/pkg/dkg"
+	"github.com/sourcenetwork/orbis-go/pkg/transport"
+	"github.com/sourcenetwork/orbis-go/pkg/types"
+)
+
+// Ring proposals are exchanged on a well-known bulletin namespace
+// shared by all the nodes, independent of any ring.
+//
+// /orbis/proposals/<ringID>              => RingProposal
+// /orbis/proposals/<ringID>/ack/<nodeID> => RingProposalAck
+const (
+	proposalNamespace  = "/orbis/proposals"
+	proposalMsgType    = "ringproposal"
+	proposalAckMsgType = "ringproposalack"
+
+	// acceptAnyProposer in the proposal allowlist accepts
+	// proposals from any node.
+	acceptAnyProposer = "*"
+
+	// Acks of unknown proposals are kept until the proposal arrives,
+	// for at most pendingAckTTL, and for at most maxPendingProposals
+	// rings of maxPendingAcks nodes, since anyone can send them.
+	pendingAckTTL       = 10 * time.Minute
+	maxPendingProposals = 256
+	maxPendingAcks      = 256
+)
+
+// Proposal status, as seen by this node.
+const (
+	ProposalPending  = "PENDING"  // waiting for local approval
+	ProposalAccepted = "ACCEPTED" // ring joined, waiting for all acks
+	ProposalStarted  = "STARTED"  // all nodes acked, ring started
+)
+
+var (
+	ErrProposalsNotStarted = fmt.Errorf("ring proposals not started")
+	ErrProposalNotFound    = fmt.Errorf("ring proposal not found")
+	ErrProposalExists      = fmt.Errorf("ring proposal already exists")
+	ErrTooManyPendingAcks  = fmt.Errorf("too many acks of unknown ring proposals")
+)
+
+type proposal struct {
+	ringID   types.RingID
+	manifest *ringv1alpha1.Manifest
+	proposer string
+	status   string
+
+	// set while joining the ring, so concurrent
+	// accepts don't join it twice.
+	accepting bool
+
+	// acks can arrive before the proposal itself, so
+	// they are kept until they can be verified against
+	// the node keys of the manifest.
+	acks        map[string]struct{}
+	pendingAcks map[string]*transport.Message // node id
+	// created is when the first message of the proposal arrived.
+	created time.Time
+}
+
+// StartProposals registers the ring proposal namespace on the configured
+// proposal bulletin, and starts processing proposals and acks from
+// the other nodes.
+func (app *App) StartProposals(ctx context.Context) error {
+	name := app.config.Ring.Proposals.Bulletin
+	bb, err := do.InvokeNamed[bulletin.Bulletin](app.inj, name)
+	if err != nil {
+		return fmt.Errorf("invoke proposal bulletin %q: %w", name, err)
+	}
+
+	err = bb.Register(ctx, proposalNamespace)
+	if err != nil && !errors.Is(err, bulletin.ErrDuplicateTopic) {
+		return fmt.Errorf("register proposal namespace: %w", err)
+	}
+
+	eventsCh, err := eventbus.Subscribe[bulletin.Event](bb.Events())
+	if err != nil {
+		return fmt.Errorf("subscribe to proposal bulletin: %w", err)
+	}
+
+	app.pmu.Lock()
+	app.proposalBB = bb
+	app.pmu.Unlock()
+
+	go func() {
+		for evt := range eventsCh {
+			if !strings.HasPrefix(evt.ID, proposalNamespace) {
+				continue
+			}
+			// process in a dedicated goroutine so we dont block
+			go app.handleProposalMessage(ctx, evt.Message)
+		}
+	}()
+
+	// pick up any proposals posted while we were offline
+	go func() {
+		resps, err := bb.Query(ctx, proposalNamespace+"*")
+		if err != nil {
+			log.Errorf("query proposal backlog: %v", err)
+			return
+		}
+		for resp := range resps {
+			if resp.Err != nil {
+				log.Errorf("query proposal backlog: %v", resp.Err)
+				return
+			}
+			app.handleProposalMessage(ctx, resp.Resp.Data)
+		}
+	}()
+
+	log.Infof("Registered to ring proposal namespace %s on %s", proposalNamespace, name)
+	return nil
+}
+
+// ProposeRing posts a ring proposal for the given manifest to all of its
+// nodes, and joins the ring locally. The ring is started once every
+// node in the manifest has acknowledged the proposal.
+func (app *App) ProposeRing(ctx context.Context, manifest *ringv1alpha1.Manifest) (types.RingID, error) {
+	app.mu.Lock()
+	err := app.validateManifest(manifest)
+	app.mu.Unlock()
+	if err != nil {
+		return "", fmt.Errorf("%w: %w", ErrInvalidManifest, err)
+	}
+
+	rid := types.RingIDFromManifest(manifest)
+
+	app.pmu.Lock()
+	bb := app.proposalBB
+	p, exists := app.proposals[rid]
+	if exists && p.manifest != nil {
+		app.pmu.Unlock()
+		return "", ErrProposalExists
+	}
+	app.pmu.Unlock()
+
+	if bb == nil {
+		return "", ErrProposalsNotStarted
+	}
+
+	// record the proposal before posting it, since the posted
+	// proposal is also delivered to our own event handler.
+	p = app.recordProposal(rid, manifest, app.host.ID().String())
+
+	payload, err := proto.Marshal(&ringv1alpha1.RingProposal{
+		RingId:   string(rid),
+		Manifest: manifest,
+		Proposer: app.host.ID().String(),
+	})
+	if err != nil {
+		return "", fmt.Errorf("marshal proposal: %w", err)
+	}
+
+	err = app.postProposalMessage(ctx, manifest, proposalMsgID(rid), proposalMsgType, payload)
+	if err != nil {
+		app.pmu.Lock()
+		p.manifest = nil
+		app.pmu.Unlock()
+		return "", fmt.Errorf("post proposal: %w", err)
+	}
+
+	err = app.acceptProposal(ctx, p)
+	if err != nil {
+		return "", fmt.Errorf("accept proposal: %w", err)
+	}
+
+	return rid, nil
+}
+
+// ApproveProposal accepts a pending ring proposal, joining the
+// ring and acknowledging it to the other nodes.
+func (app *App) ApproveProposal(ctx context.Context, rid types.RingID) (*ringv1alpha1.RingProposal, error) {
+	app.pmu.Lock()
+	p, exists := app.proposals[rid]
+	app.pmu.Unlock()
+	if !exists || p.manifest == nil {
+		return nil, ErrProposalNotFound
+	}
+
+	err := app.acceptProposal(ctx, p)
+	if err != nil {
+		return nil, err
+	}
+
+	app.pmu.Lock()
+	defer app.pmu.Unlock()
+	return p.toProto(), nil
+}
+
+// ListProposals returns all the known ring proposals that include this node.
+func (app *App) ListProposals(ctx context.Context) []*ringv1alpha1.RingProposal {
+	app.pmu.Lock()
+	defer app.pmu.Unlock()
+
+	var proposals []*ringv1alpha1.RingProposal
+	for _, p := range app.proposals {
+		if p.manifest == nil {
+			continue // only seen acks so far
+		}
+		proposals = append(proposals, p.toProto())
+	}
+	sort.Slice(proposals, func(i, j int) bool {
+		return proposals[i].RingId < proposals[j].RingId
+	})
+
+	return proposals
+}
+
+func (app *App) handleProposalMessage(ctx context.Context, msg *transport.Message) {
+	if msg == nil {
+		return
+	}
+
+	var err error
+	switch msg.Type {
+	case proposalMsgType:
+		err = app.handleProposal(ctx, msg)
+	case proposalAckMsgType:
+		err = app.handleProposalAck(ctx, msg)
+	default:
+		return
+	}
+	if err != nil {
+		log.Errorf("processing %s from %s: %v", msg.Type, msg.NodeId, err)
+	}
+}
+
+func (app *App) handleProposal(ctx context.Context, msg *transport.Message) error {
+	prop := new(ringv1alpha1.RingProposal)
+	err := proto.Unmarshal(msg.Payload, prop)
+	if err != nil {
+		return fmt.Errorf("unmarshal proposal: %w", err)
+	}
+
+	if prop.Manifest == nil {
+		return fmt.Errorf("proposal missing manifest")
+	}
+	rid := types.RingIDFromManifest(prop.Manifest)
+	if string(rid) != prop.RingId || msg.RingId != prop.RingId {
+		return fmt.Errorf("proposal ring id %s doesn't match manifest", prop.RingId)
+	}
+	if prop.Proposer != msg.NodeId {
+		return fmt.Errorf("proposal proposer %s doesn't match sender %s", prop.Proposer, msg.NodeId)
+	}
+	err = verifyProposalMessage(prop.Manifest, msg)
+	if err != nil {
+		return fmt.Errorf("proposal from %s: %w", msg.NodeId, err)
+	}
+
+	// not for us
+	if !manifestHasNode(prop.Manifest, app.host.ID().String()) {
+		return nil
+	}
+
+	app.pmu.Lock()
+	p, exists := app.proposals[rid]
+	seen := exists && p.manifest != nil
+	app.pmu.Unlock()
+	if seen {
+		return nil
+	}
+
+	// a ring we already joined was accepted before a restart
+	_, joinedErr := app.GetRing(ctx, string(rid))
+	if joinedErr != nil {
+		app.mu.Lock()
+		err = app.validateManifest(prop.Manifest)
+		app.mu.Unlock()
+		if err != nil {
+			return fmt.Errorf("proposal from %s: %w: %w", msg.NodeId, ErrInvalidManifest, err)
+		}
+	}
+
+	p = app.recordProposal(rid, prop.Manifest, prop.Proposer)
+
+	if joinedErr != nil && !app.acceptsProposer(prop.Proposer) {
+		log.Infof("Ring proposal %s from %s is pending approval", rid, prop.Proposer)
+		return nil
+	}
+
+	log.Infof("Automatically accepting ring proposal %s from %s", rid, prop.Proposer)
+	return app.acceptProposal(ctx, p)
+}
+
+func (app *App) handleProposalAck(ctx context.Context, msg *transport.Message) error {
+	ack := new(ringv1alpha1.RingProposalAck)
+	err := proto.Unmarshal(msg.Payload, ack)
+	if err != nil {
+		return fmt.Errorf("unmarshal proposal ack: %w", err)
+	}
+
+	if ack.NodeId != msg.NodeId || ack.RingId != msg.RingId {
+		return fmt.Errorf("proposal ack from %s doesn't match sender", ack.NodeId)
+	}
+
+	// the sender signs with the key of its node id, before
+	// the proposal tells the node is a member of the ring.
+	err = verifySenderMessage(msg)
+	if err != nil {
+		return fmt.Errorf("proposal ack from %s: %w", msg.NodeId, err)
+	}
+
+	rid := types.RingID(ack.RingId)
+
+	app.pmu.Lock()
+	p, exists := app.proposals[rid]
+	if !exists || p.manifest == nil {
+		// verified against the manifest once the proposal arrives
+		err = app.addPendingAck(rid, p, msg)
+		app.pmu.Unlock()
+		return err
+	}
+	manifest := p.manifest
+	app.pmu.Unlock()
+
+	err = verifyProposalMessage(manifest, msg)
+	if err != nil {
+		return fmt.Errorf("proposal ack from %s: %w", msg.NodeId, err)
+	}
+
+	app.pmu.Lock()
+	p.acks[ack.NodeId] = struct{}{}
+	app.pmu.Unlock()
+
+	return app.maybeStartProposedRing(ctx, p)
+}
+
+// addPendingAck keeps the ack of a proposal which hasn't arrived
+// yet, within the pending acks bounds. It's called with pmu held.
+func (app *App) addPendingAck(rid types.RingID, p *proposal, msg *transport.Message) error {
+	if p == nil {
+		pending := 0
+		now := time.Now()
+		for id, other := range app.proposals {
+			if other.manifest != nil {
+				continue
+			}
+			if now.Sub(other.created) > pendingAckTTL {
+				delete(app.proposals, id)
+				continue
+			}
+			pending++
+		}
+		if pending >= maxPendingProposals {
+			return fmt.Errorf("%w, dropping the ack of %s from %s", ErrTooManyPendingAcks, rid, msg.NodeId)
+		}
+		p = newProposal(rid)
+		app.proposals[rid] = p
+	}
+
+	if _, exists := p.pendingAcks[msg.NodeId]; !exists && len(p.pendingAcks) >= maxPendingAcks {
+		return fmt.Errorf("%w, dropping the ack of %s from %s", ErrTooManyPendingAcks, rid, msg.NodeId)
+	}
+	p.pendingAcks[msg.NodeId] = msg
+	return nil
+}
+
+func newProposal(rid types.RingID) *proposal {
+	return &proposal{
+		ringID:      rid,
+		acks:        make(map[string]struct{}),
+		pendingAcks: make(map[string]*transport.Message),
+		created:     time.Now(),
+	}
+}
+
+// recordProposal tracks a proposal, keeping the acks that
+// arrived before it and are signed by the manifest nodes.
+func (app *App) recordProposal(rid types.RingID, manifest *ringv1alpha1.Manifest, proposer string) *proposal {
+	app.pmu.Lock()
+	defer app.pmu.Unlock()
+
+	p, exists := app.proposals[rid]
+	if !exists {
+		p = newProposal(rid)
+		app.proposals[rid] = p
+	}
+	if p.manifest == nil {
+		p.manifest = manifest
+		p.proposer = proposer
+		p.status = ProposalPending
+
+		for nodeID, msg := range p.pendingAcks {
+			err := verifyProposalMessage(manifest, msg)
+			if err != nil {
+				log.Errorf("proposal ack from %s: %v", nodeID, err)
+				continue
+			}
+			p.acks[nodeID] = struct{}{}
+		}
+		p.pendingAcks = make(map[string]*transport.Message)
+	}
+
+	return p
+}
+
+func (app *App) acceptProposal(ctx context.Context, p *proposal) error {
+	app.pmu.Lock()
+	if p.status != ProposalPending || p.accepting {
+		app.pmu.Unlock()
+		return nil
+	}
+	p.accepting = true
+	app.pmu.Unlock()
+
+	_, err := app.GetRing(ctx, string(p.ringID))
+	if err != nil {
+		// rings are long lived, so we don't tie them
+		// to the proposal request context.
+		_, err = app.JoinRing(context.Background(), p.manifest)
+		if err != nil {
+			app.pmu.Lock()
+			p.accepting = false
+			app.pmu.Unlock()
+			return fmt.Errorf("join proposed ring: %w", err)
+		}
+	}
+
+	app.pmu.Lock()
+	p.status = ProposalAccepted
+	p.accepting = false
+	app.pmu.Unlock()
+
+	nodeID := app.host.ID().String()
+	payload, err := proto.Marshal(&ringv1alpha1.RingProposalAck{
+		RingId: string(p.ringID),
+		NodeId: nodeID,
+	})
+	if err != nil {
+		return fmt.Errorf("marshal proposal ack: %w", err)
+	}
+
+	err = app.postProposalMessage(ctx, p.manifest, proposalAckMsgID(p.ringID, nodeID), proposalAckMsgType, payload)
+	if err != nil && !errors.Is(err, bulletin.ErrDuplicateMessage) {
+		return fmt.Errorf("post proposal ack: %w", err)
+	}
+
+	// the other nodes might have acked before we accepted
+	return app.maybeStartProposedRing(ctx, p)
+}
+
+// maybeStartProposedRing starts the DKG of an accepted ring
+// once all of its nodes have acknowledged the proposal.
+func (app *App) maybeStartProposedRing(ctx context.Context, p *proposal) error {
+	app.pmu.Lock()
+	if p.manifest == nil || p.status != ProposalAccepted {
+		app.pmu.Unlock()
+		return nil
+	}
+	for _, n := range p.manifest.Nodes {
+		if _, acked := p.acks[n.Id]; !acked {
+			app.pmu.Unlock()
+			return nil
+		}
+	}
+	p.status = ProposalStarted
+	app.pmu.Unlock()
+
+	r, err := app.GetRing(ctx, string(p.ringID))
+	if err != nil {
+		return err
+	}
+
+	// rings rejoined from state might have already
+	// started before a restart.
+	if r.DKG.State() != dkg.INITIALIZED.String() {
+		return nil
+	}
+
+	log.Infof("All %d nodes acknowledged ring %s, starting", len(p.manifest.Nodes), p.ringID)
+	err = r.Start(context.Background())
+	if err != nil {
+		return fmt.Errorf("start ring: %w", err)
+	}
+
+	return nil
+}
+
+func (app *App) postProposalMessage(ctx context.Context, manifest *ringv1alpha1.Manifest, id string, msgType string, payload []byte) error {
+	tp, err := do.InvokeNamed[transport.Transport](app.inj, manifest.Transport)
+	if err != nil {
+		return fmt.Errorf("invoke transport: %w", err)
+	}
+
+	rid := types.RingIDFromManifest(manifest)
+	msg, err := tp.NewMessage(rid, id, false, payload, msgType, nil)
+	if err != nil {
+		return fmt.Errorf("create transport message: %w", err)
+	}
+
+	// the bulletin doesn't authenticate the sender
+	err = transport.SignMessage(tp.Host(), msg)
+	if err != nil {
+		return err
+	}
+
+	app.pmu.Lock()
+	bb := app.proposalBB
+	app.pmu.Unlock()
+	if bb == nil {
+		return ErrProposalsNotStarted
+	}
+
+	_, err = bb.Post(ctx, id, msg)
+	return err
+}
+
+func (app *App) acceptsProposer(proposer string) bool {
+	if proposer == app.host.ID().String() {
+		return true
+	}
+	for _, id := range app.config.Ring.Proposals.AcceptFrom {
+		id = strings.TrimSpace(id)
+		if id == acceptAnyProposer || (id != "" && id == proposer) {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *proposal) toProto() *ringv1alpha1.RingProposal {
+	acks := make([]string, 0, len(p.acks))
+	for id := range p.acks {
+		acks = append(acks, id)
+	}
+	sort.Strings(acks)
+
+	return &ringv1alpha1.RingProposal{
+		RingId:   string(p.ringID),
+		Manifest: p.manifest,
+		Proposer: p.proposer,
+		Acks:     acks,
+		Status:   p.status,
+	}
+}
+
+// verifyProposalMessage checks the message is signed by
+// its sender, with the key of the node in the manifest.
+func verifyProposalMessage(manifest *ringv1alpha1.Manifest, msg *transport.Message) error {
+	for _, n := range manifest.Nodes {
+		if n.Id != msg.NodeId {
+			continue
+		}
+
+		pk, err := manifestNodeKey(n)
+		if err != nil {
+			return fmt.Errorf("public key of node %s: %w", n.Id, err)
+		}
+
+		return transport.VerifyMessage(msg, pk)
+	}
+	return fmt.Errorf("node %s isn't in the manifest", msg.NodeId)
+}
+
+// verifySenderMessage checks the message is signed by its sender, with
+// the key embedded in its node id, or for the RSA node ids which only
+// hash their key, with the key of the message matching the id.
+func verifySenderMessage(msg *transport.Message) error {
+	id, err := peer.Decode(msg.NodeId)
+	if err != nil {
+		return fmt.Errorf("sender node id: %w", err)
+	}
+	pk, err := id.ExtractPublicKey()
+	if errors.Is(err, peer.ErrNoPublicKey) {
+		pk, err = ic.UnmarshalRsaPublicKey(msg.NodePubKey)
+	}
+	if err != nil {
+		return fmt.Errorf("public key of node %s: %w", msg.NodeId, err)
+	}
+	return transport.VerifyMessage(msg, pk)
+}
+
+// manifestNodeKey returns the public key of a manifest node, which
+// is embedded in the node id if the manifest doesn't have it.
+func manifestNodeKey(n *ringv1alpha1.Node) (ic.PubKey, error) {
+	if n.PublicKey != nil {
+		return ic.PublicKeyFromProto(n.PublicKey)
+	}
+	id, err := peer.Decode(n.Id)
+	if err != nil {
+		return nil, err
+	}
+	return id.ExtractPublicKey()
+}
+
+func manifestHasNode(manifest *ringv1alpha1.Manifest, id string) bool {
+	for _, n := range manifest.Nodes {
+		if n.Id == id {
+			return true
+		}
+	}
+	return false
+}
+
+func proposalMsgID(rid types.RingID) string {
+	return fmt.Sprintf("%s/%s", proposalNamespace, rid)
+}
+
+func proposalAckMsgID(rid types.RingID, nodeID string) string {
+	return fmt.Sprintf("%s/%s/ack/%s", proposalNamespace, rid, nodeID)
+}
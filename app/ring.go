@@ -4,27 +4,33 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/libp2p/go-libp2p/core/protocol"
 	ma "github.com/multiformats/go-multiaddr"
 	"github.com/samber/do"
-	"go.dedis.ch/kyber/v3"
-	"go.dedis.ch/kyber/v3/share"
+	"golang.org/x/sync/singleflight"
 
 	ringv1alpha1 "github.com/sourcenetwork/orbis-go/gen/proto/orbis/ring/v1alpha1"
 	"github.com/sourcenetwork/orbis-go/pkg/authn"
 	"github.com/sourcenetwork/orbis-go/pkg/authz"
 	"github.com/sourcenetwork/orbis-go/pkg/bulletin"
+	"github.com/sourcenetwork/orbis-go/pkg/content"
 	"github.com/sourcenetwork/orbis-go/pkg/crypto"
 	"github.com/sourcenetwork/orbis-go/pkg/db"
 	"github.com/sourcenetwork/orbis-go/pkg/dkg"
 	"github.com/sourcenetwork/orbis-go/pkg/pre"
 	"github.com/sourcenetwork/orbis-go/pkg/pre/elgamal"
 	"github.com/sourcenetwork/orbis-go/pkg/pss"
+	"github.com/sourcenetwork/orbis-go/pkg/ratelimit"
+	"github.com/sourcenetwork/orbis-go/pkg/tdec"
+	tdecelgamal "github.com/sourcenetwork/orbis-go/pkg/tdec/elgamal"
 	"github.com/sourcenetwork/orbis-go/pkg/transport"
 	p2ptransport "github.com/sourcenetwork/orbis-go/pkg/transport/p2p"
+	"github.com/sourcenetwork/orbis-go/pkg/tsig"
 	"github.com/sourcenetwork/orbis-go/pkg/types"
 )
 
@@ -36,6 +42,10 @@ type Ring struct {
 	PSS pss.PSS
 	PRE pre.PRE
 
+	// threshold decryption, only set
+	// if enabled on this node.
+	TDEC tdec.TDEC
+
 	Authz    authz.Authz
 	Authn    authn.CredentialService
 	Resolver authn.KeyResolver
@@ -48,6 +58,8 @@ type Ring struct {
 
 	Transport transport.Transport
 	Bulletin  bulletin.Bulletin
+	Content   content.Store
+	Audit     *db.AuditLog
 	DB        *db.DB
 
 	N int
@@ -58,8 +70,48 @@ type Ring struct {
 
 	preReqMsg chan *transport.Message
 
-	xncCmts map[string]chan kyber.Point  // preEncryptMsgID
-	xncSki  map[string][]*share.PubShare // preEncryptMsgID
+	// permission required to store a secret, and the relation
+	// registering its owner on new authorization objects.
+	writePerm     string
+	ownerRelation string
+
+	// re-encryption rate limits, keyed by subject and
+	// secret id. Peer requests are only limited by secret,
+	// since their subject is claimed by the entry node.
+	subjectLimiter *ratelimit.Limiter
+	secretLimiter  *ratelimit.Limiter
+
+	// coalesces concurrent re-encryptions
+	// of the same preReencryptMsgID.
+	preFlight singleflight.Group
+
+	preMu       sync.Mutex
+	preSessions map[string]*reencryptSession // preEncryptMsgID
+	preServing  map[string]struct{}          // preEncryptMsgID + origin node id
+
+	// reads waiting for content fetched from the ring.
+	contentMu    sync.Mutex
+	contentWaits map[string][]chan struct{} // cid
+
+	// coalesces concurrent signatures
+	// of the same message.
+	signFlight   singleflight.Group
+	signMu       sync.Mutex
+	signSessions map[string]*signSession // signMsgID
+	signNonces   map[string]signNonces   // signing session id
+
+	// coalesces concurrent decryptions
+	// of the same tdecDecryptMsgID.
+	decryptFlight singleflight.Group
+	decMu         sync.Mutex
+	decSessions   map[string]*decryptSession // tdecDecryptMsgID
+	decryptPerm   string
+
+	// result of the last check of our share
+	// against the public polynomial.
+	shareMu      sync.Mutex
+	shareChecked bool
+	shareErr     error // ErrShareDegraded if it failed
 }
 
 type State map[string]string
@@ -103,6 +155,11 @@ func (app *App) JoinRing(ctx context.Context, manifest *ringv1alpha1.Manifest) (
 	app.mu.Lock()
 	defer app.mu.Unlock()
 
+	err := app.validateManifest(manifest)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidManifest, err)
+	}
+
 	r, err := app.joinRing(ctx, manifest, false /* fromState */)
 	if err != nil {
 		return nil, fmt.Errorf("join ring: %w", err)
@@ -123,13 +180,19 @@ func (app *App) JoinRing(ctx context.Context, manifest *ringv1alpha1.Manifest) (
 
 func (app *App) joinRing(ctx context.Context, manifest *ringv1alpha1.Manifest, fromState bool) (*Ring, error) {
 
-	rid := ringID(manifest)
+	rid := types.RingIDFromManifest(manifest)
 	log.Infof("Joining ring %s, nodes: %v", rid, manifest.Nodes)
 
 	if _, exists := app.rings[rid]; exists {
 		return nil, fmt.Errorf("already joined ring %s", rid)
 	}
 
+	// the identity scalar of signer custodies is never released,
+	// so they take part in the DKGs with their pairing key only.
+	if _, err := app.privateKey.Raw(); err != nil && !isPairingManifest(manifest) {
+		return nil, fmt.Errorf("join ring %s: %w", rid, ErrPairingRingsOnly)
+	}
+
 	rs := &Ring{app: app}
 
 	// rings get their own cloned dependency injector handler,
@@ -144,6 +207,16 @@ func (app *App) joinRing(ctx context.Context, manifest *ringv1alpha1.Manifest, f
 		return nil, fmt.Errorf("invoke db: %w", err)
 	}
 
+	cs, err := do.Invoke[content.Store](inj)
+	if err != nil {
+		return nil, fmt.Errorf("invoke content store: %w", err)
+	}
+
+	audit, err := do.Invoke[*db.AuditLog](inj)
+	if err != nil {
+		return nil, fmt.Errorf("invoke audit log: %w", err)
+	}
+
 	// register configured generic transport locally
 	tp, err := do.InvokeNamed[transport.Transport](inj, manifest.Transport)
 	if err != nil {
@@ -221,7 +294,18 @@ func (app *App) joinRing(ctx context.Context, manifest *ringv1alpha1.Manifest, f
 		return nil, fmt.Errorf("convert nodes from ring ids")
 	}
 
-	err = dkgSrv.Init(ctx, app.privateKey, rid, tpNodes, manifest.N, manifest.T, fromState)
+	// pairing rings use the node pairing key, from the key custody,
+	// as the DKG long term key, so the DKG shares support threshold
+	// signing.
+	dkgKey := app.privateKey
+	if isPairingManifest(manifest) {
+		dkgKey, err = app.custody.PairingKey()
+		if err != nil {
+			return nil, fmt.Errorf("derive pairing key: %w", err)
+		}
+	}
+
+	err = dkgSrv.Init(ctx, dkgKey, rid, tpNodes, manifest.N, manifest.T, fromState)
 	if err != nil {
 		return nil, fmt.Errorf("initialize dkg: %w", err)
 	}
@@ -242,14 +326,30 @@ func (app *App) joinRing(ctx context.Context, manifest *ringv1alpha1.Manifest, f
 		return nil, fmt.Errorf("create pss service: %w", err)
 	}
 
+	var tdecSrv tdec.TDEC
+	if app.config.Ring.Decrypt.Enabled {
+		tdecSrv, err = serviceFromFactory[tdec.TDEC](rs, inj, app.config.Ring.Decrypt.Scheme)
+		if err != nil {
+			return nil, fmt.Errorf("invoke tdec service from factory: %w", err)
+		}
+
+		err = tdecSrv.Init(rid, manifest.N, manifest.T)
+		if err != nil {
+			return nil, fmt.Errorf("initialize tdec: %w", err)
+		}
+	}
+
 	rs = &Ring{
 		ID:        rid,
 		manifest:  manifest,
 		DKG:       dkgSrv,
 		PSS:       pssSrv,
 		PRE:       preSrv,
+		TDEC:      tdecSrv,
 		Transport: tp,
 		Bulletin:  bb,
+		Content:   cs,
+		Audit:     audit,
 		DB:        d,
 		inj:       inj,
 		N:         int(manifest.N),
@@ -258,16 +358,38 @@ func (app *App) joinRing(ctx context.Context, manifest *ringv1alpha1.Manifest, f
 		nodes:     nodes,
 		services:  rs.services, // this is dumb, but im being lazy, sorry.
 		preReqMsg: make(chan *transport.Message, 10),
-		xncCmts:   make(map[string]chan kyber.Point),
-		xncSki:    make(map[string][]*share.PubShare),
-		Authz:     authzSrv,
-		Authn:     authnSrv,
+
+		writePerm:     app.config.Authz.WritePermission,
+		ownerRelation: app.config.Authz.OwnerRelation,
+
+		subjectLimiter: ratelimit.New(app.config.Ring.RateLimit.SubjectRate, app.config.Ring.RateLimit.SubjectBurst),
+		secretLimiter:  ratelimit.New(app.config.Ring.RateLimit.SecretRate, app.config.Ring.RateLimit.SecretBurst),
+
+		preSessions: make(map[string]*reencryptSession),
+		preServing:  make(map[string]struct{}),
+		Authz:       authzSrv,
+		Authn:       authnSrv,
+
+		contentWaits: make(map[string][]chan struct{}),
+
+		signSessions: make(map[string]*signSession),
+		signNonces:   make(map[string]signNonces),
+
+		decSessions: make(map[string]*decryptSession),
+		decryptPerm: app.config.Ring.Decrypt.Permission,
 	}
 
 	go rs.preReencryptMessageHandler()
 
 	tp.AddHandler(protocol.ID(elgamal.EncryptedSecretRequest), rs.preTransportMessageHandler)
 	tp.AddHandler(protocol.ID(elgamal.EncryptedSecretReply), rs.preTransportMessageHandler)
+	tp.AddHandler(protocol.ID(contentPushMsgType), rs.contentTransportMessageHandler)
+	tp.AddHandler(protocol.ID(contentFetchMsgType), rs.contentTransportMessageHandler)
+	tp.AddHandler(protocol.ID(tsig.SignRequest), rs.signTransportMessageHandler)
+	tp.AddHandler(protocol.ID(tsig.SigningPackage), rs.signTransportMessageHandler)
+	tp.AddHandler(protocol.ID(tsig.SignReply), rs.signTransportMessageHandler)
+	tp.AddHandler(protocol.ID(tdecelgamal.DecryptRequest), rs.decryptTransportMessageHandler)
+	tp.AddHandler(protocol.ID(tdecelgamal.DecryptReply), rs.decryptTransportMessageHandler)
 
 	bbnamespace := fmt.Sprintf("/ring/%s/pre/store", string(rid))
 	err = bb.Register(ctx, bbnamespace)
@@ -275,6 +397,23 @@ func (app *App) joinRing(ctx context.Context, manifest *ringv1alpha1.Manifest, f
 		return nil, fmt.Errorf("register bulletin: %w", err)
 	}
 
+	err = bb.Register(ctx, tsigNamespace(rid))
+	if err != nil {
+		return nil, fmt.Errorf("register signing bulletin: %w", err)
+	}
+
+	err = rs.watchNonceCommitments()
+	if err != nil {
+		return nil, fmt.Errorf("watch nonce commitments: %w", err)
+	}
+
+	if app.config.Ring.Audit.Mirror {
+		err = bb.Register(ctx, auditNamespace(rid))
+		if err != nil {
+			return nil, fmt.Errorf("register audit bulletin: %w", err)
+		}
+	}
+
 	// TODO: this is a hack to wait for the bulletin to be registered
 	time.Sleep(1 * time.Second)
 	log.Infof("registered to namespace %s", bbnamespace)
@@ -309,6 +448,14 @@ func nodesFromIDs(nodes []*ringv1alpha1.Node) ([]transport.Node, error) {
 			return nil, fmt.Errorf("extract publick key from id: %w", err)
 		}
 
+		// the long term key of pairing ring nodes is their pairing key.
+		if n.PairingKey != nil {
+			pubKey, err = crypto.PubKeyFromProto(n.PairingKey)
+			if err != nil {
+				return nil, fmt.Errorf("invalid pairing key: %w", err)
+			}
+		}
+
 		key, err := crypto.PublicKeyFromLibP2P(pubKey)
 		if err != nil {
 			return nil, fmt.Errorf("invalid public key: %w", err)
@@ -326,6 +473,11 @@ func nodesFromIDs(nodes []*ringv1alpha1.Node) ([]transport.Node, error) {
 	return tNodes, nil
 }
 
+// isPairingManifest reports whether the ring nodes use pairing keys.
+func isPairingManifest(manifest *ringv1alpha1.Manifest) bool {
+	return len(manifest.Nodes) > 0 && manifest.Nodes[0].PairingKey != nil
+}
+
 func (r *Ring) Delete(context.Context, types.SecretID) error {
 	// TODO: implement
 	return nil
@@ -351,6 +503,26 @@ func (r *Ring) State() State {
 	for _, s := range r.services {
 		state[s.Name()] = s.State()
 	}
+
+	// nodes the DKG went on without
+	if ex, ok := r.DKG.(dkg.Excluder); ok {
+		if excluded := ex.Excluded(); len(excluded) > 0 {
+			ids := make([]string, len(excluded))
+			for i, n := range excluded {
+				ids[i] = n.ID()
+			}
+			state[r.DKG.Name()+"/excluded"] = strings.Join(ids, ",")
+		}
+	}
+
+	r.shareMu.Lock()
+	if r.shareErr != nil {
+		state["share"] = r.shareErr.Error()
+	} else if r.shareChecked {
+		state["share"] = "verified"
+	}
+	r.shareMu.Unlock()
+
 	return state
 }
 
@@ -389,6 +561,10 @@ func (app *App) LoadRings(ctx context.Context) error {
 		}
 		ring.manifest = r.Manifest
 
+		// a share corrupted in the DB, or by a bad restore,
+		// degrades the ring instead of failing the load.
+		_ = ring.checkShare()
+
 		app.rings[ring.ID] = ring
 	}
 	log.Infof("Finished loading %d rings from state", len(rings))
@@ -0,0 +1,98 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/sourcenetwork/orbis-go/pkg/crypto"
+	"github.com/sourcenetwork/orbis-go/pkg/dkg"
+)
+
+var ErrShareDegraded = fmt.Errorf("ring share degraded")
+
+// checkShare verifies our certified share against the public
+// polynomial of the ring key. A share that fails the check marks
+// the ring degraded, and it stops serving the share until a
+// later check passes. Rings that haven't certified a share yet,
+// or whose DKG can't verify it, are left as they are.
+func (r *Ring) checkShare() error {
+	sv, ok := r.DKG.(dkg.ShareVerifier)
+	if !ok {
+		return nil
+	}
+
+	err := sv.VerifyShare()
+	if errors.Is(err, dkg.ErrNoShare) && r.DKG.State() != dkg.CERTIFIED.String() {
+		return nil
+	}
+	if err != nil {
+		err = fmt.Errorf("%w: %w", ErrShareDegraded, err)
+	}
+
+	r.shareMu.Lock()
+	wasDegraded := r.shareErr != nil
+	r.shareChecked = true
+	r.shareErr = err
+	r.shareMu.Unlock()
+
+	shareChecks.WithLabelValues(string(r.ID), checkResult(err)).Inc()
+	if err != nil {
+		shareDegraded.WithLabelValues(string(r.ID)).Set(1)
+		if !wasDegraded {
+			log.Errorf("Ring %s no longer serves its share: %v", r.ID, err)
+		}
+		return err
+	}
+
+	shareDegraded.WithLabelValues(string(r.ID)).Set(0)
+	if wasDegraded {
+		log.Infof("Ring %s share verified, serving it again", r.ID)
+	}
+	return nil
+}
+
+// servableShare returns our share of the ring key, unless the
+// ring is degraded.
+func (r *Ring) servableShare() (crypto.DistKeyShare, error) {
+	r.shareMu.Lock()
+	err := r.shareErr
+	r.shareMu.Unlock()
+	if err != nil {
+		return crypto.DistKeyShare{}, err
+	}
+	return r.DKG.Share(), nil
+}
+
+// StartShareChecks periodically checks the shares of the
+// joined rings, at the configured interval.
+func (app *App) StartShareChecks(ctx context.Context) {
+	interval := time.Duration(app.config.Ring.ShareCheck.Interval) * time.Second
+	if interval <= 0 {
+		return
+	}
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				for _, r := range app.ListRings() {
+					_ = r.checkShare()
+				}
+			}
+		}
+	}()
+}
+
+func checkResult(err error) string {
+	if err != nil {
+		return "degraded"
+	}
+	return "verified"
+}
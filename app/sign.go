@@ -0,0 +1,695 @@
+package app
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/sourcenetwork/eventbus-go"
+	"go.dedis.ch/kyber/v3/share"
+	"google.golang.org/protobuf/proto"
+
+	ringv1alpha1 "github.com/sourcenetwork/orbis-go/gen/proto/orbis/ring/v1alpha1"
+	"github.com/sourcenetwork/orbis-go/pkg/authn"
+	"github.com/sourcenetwork/orbis-go/pkg/bulletin"
+	"github.com/sourcenetwork/orbis-go/pkg/crypto"
+	"github.com/sourcenetwork/orbis-go/pkg/dkg"
+	"github.com/sourcenetwork/orbis-go/pkg/transport"
+	"github.com/sourcenetwork/orbis-go/pkg/tsig"
+	"github.com/sourcenetwork/orbis-go/pkg/tsig/frost"
+	"github.com/sourcenetwork/orbis-go/pkg/tsig/tbls"
+	"github.com/sourcenetwork/orbis-go/pkg/types"
+)
+
+// signTimeout bounds how long a signature waits
+// for the threshold of partial signatures.
+const signTimeout = time.Minute
+
+var (
+	ErrSignUnsupported  = fmt.Errorf("ring key doesn't support threshold signatures")
+	ErrSignUnauthorized = fmt.Errorf("subject not authorized to sign with the ring key")
+)
+
+// signSession collects the partial signatures of a message.
+type signSession struct {
+	msg      []byte
+	partials map[int][]byte // share index
+	done     bool
+	sig      chan []byte
+
+	// nonce commitments of interactive schemes, and the
+	// commitments of the signers, once a threshold committed.
+	cmts    map[int]tsig.Commitment // share index
+	signers []tsig.Commitment
+}
+
+// signNonces are the nonces of a node for an interactive
+// signing session, until they're used or expire. They only
+// sign the message of the session requester.
+type signNonces struct {
+	nonces    tsig.Nonces
+	msg       []byte
+	requester string
+	expires   time.Time
+}
+
+// Sign signs the message with the ring key. Every ring node signs the
+// message with its DKG share, and the first threshold of valid partial
+// signatures is recovered into a signature verifiable with the ring
+// public key. It returns the signature and the name of its scheme.
+//
+// Interactive schemes first collect the nonce commitments of the ring
+// nodes through the ring bulletin, and the first threshold of nodes
+// to commit sign the message.
+//
+// The credential of the operator authenticated in the context is
+// forwarded to the ring nodes, which only sign on behalf of their own
+// operators. A credential bound to a request must be bound to this
+// signature, see authn.SignBinding.
+//
+// Concurrent requests for the same message are coalesced into a
+// single ring-wide signature.
+func (r *Ring) Sign(ctx context.Context, msg []byte) ([]byte, string, error) {
+	log.Infof("ring.Sign(): ringid=%s", r.ID)
+
+	scheme, err := r.signatureScheme()
+	if err != nil {
+		return nil, "", err
+	}
+
+	subject, ok := authn.SubjectFromContext(ctx)
+	if !ok {
+		return nil, "", fmt.Errorf("%w: not authenticated", ErrSignUnauthorized)
+	}
+	if subject.Binding != "" && subject.Binding != authn.SignBinding(string(r.ID), msg) {
+		return nil, "", fmt.Errorf("%w: credential bound to another request", ErrSignUnauthorized)
+	}
+	credential, err := r.Authn.GetRequestToken(ctx)
+	if err != nil {
+		return nil, "", fmt.Errorf("%w: %w", ErrSignUnauthorized, err)
+	}
+
+	if r.DKG.State() != dkg.CERTIFIED.String() {
+		return nil, "", fmt.Errorf("dkg not certified yet: %s", r.DKG.State())
+	}
+
+	signMsgID := signMsgID(string(r.ID), msg)
+	resCh := r.signFlight.DoChan(signMsgID, func() (interface{}, error) {
+		ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), signTimeout)
+		defer cancel()
+
+		// interactive sessions use fresh nonces, so each
+		// signature of the same message is its own session.
+		if _, ok := scheme.(tsig.InteractiveScheme); ok {
+			sessionID, err := signSessionID(string(r.ID))
+			if err != nil {
+				return nil, err
+			}
+			return r.sign(ctx, sessionID, msg, credential)
+		}
+		return r.sign(ctx, signMsgID, msg, credential)
+	})
+
+	select {
+	case res := <-resCh:
+		if res.Err != nil {
+			return nil, "", res.Err
+		}
+		return res.Val.([]byte), scheme.Name(), nil
+	case <-ctx.Done():
+		return nil, "", ctx.Err()
+	}
+}
+
+// VerifyRingSignature checks the signature of the message by the ring
+// key, with the threshold signature scheme of the ring. It returns the
+// name of the scheme, and tsig.ErrInvalidSignature if it's invalid.
+func (r *Ring) VerifyRingSignature(msg []byte, sig []byte) (string, error) {
+	scheme, err := r.signatureScheme()
+	if err != nil {
+		return "", err
+	}
+
+	pk, err := r.PublicKey()
+	if err != nil {
+		return "", fmt.Errorf("ring public key: %w", err)
+	}
+
+	return scheme.Name(), scheme.Verify(pk, msg, sig)
+}
+
+// sign fans the request out to the ring nodes,
+// and waits for the recovered signature.
+func (r *Ring) sign(ctx context.Context, signMsgID string, msg []byte, credential []byte) ([]byte, error) {
+	payload, err := proto.Marshal(&ringv1alpha1.SignRequest{
+		RingId:     string(r.ID),
+		Message:    msg,
+		Credential: credential,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("marshal sign request: %w", err)
+	}
+
+	// registered before the fan-out, so no partial signature is missed.
+	sess := &signSession{
+		msg:      msg,
+		partials: make(map[int][]byte),
+		sig:      make(chan []byte, 1),
+		cmts:     make(map[int]tsig.Commitment),
+	}
+	r.signMu.Lock()
+	r.signSessions[signMsgID] = sess
+	r.signMu.Unlock()
+
+	defer func() {
+		r.signMu.Lock()
+		delete(r.signSessions, signMsgID)
+		r.signMu.Unlock()
+	}()
+
+	for _, n := range r.nodes {
+		go func(n types.Node) {
+			tmsg, err := r.Transport.NewMessage(r.ID, signMsgID, false, payload, tsig.SignRequest, &n)
+			if err != nil {
+				log.Errorf("new transport message for sign request: %s", err)
+				return
+			}
+			r.sendSignMessage(ctx, n, tmsg)
+		}(n)
+	}
+
+	log.Infof("ring.Sign(): waiting for partial signatures...")
+	select {
+	case sig := <-sess.sig:
+		log.Infof("ring.Sign(): signature recovered")
+		return sig, nil
+	case <-ctx.Done():
+		return nil, fmt.Errorf("wait for partial signatures: %w", ctx.Err())
+	}
+}
+
+// signatureScheme returns the threshold signature
+// scheme of the ring key, if it supports any.
+func (r *Ring) signatureScheme() (tsig.Verifier, error) {
+	pk, err := r.PublicKey()
+	if err != nil {
+		return nil, fmt.Errorf("ring public key: %w", err)
+	}
+
+	switch pk.Type() {
+	case crypto.BLS12381:
+		return tbls.New(), nil
+	case crypto.Ed25519, crypto.Secp256k1:
+		scheme, err := frost.New(pk.Type())
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrSignUnsupported, err)
+		}
+		return scheme, nil
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrSignUnsupported, pk.Type())
+	}
+}
+
+// ringPubPoly returns the public polynomial of the ring key.
+func (r *Ring) ringPubPoly() (crypto.PubPoly, error) {
+	ste, err := r.ringSuite()
+	if err != nil {
+		return crypto.PubPoly{}, err
+	}
+
+	return crypto.PubPoly{PubPoly: share.NewPubPoly(ste, nil, r.DKG.Share().Commits)}, nil
+}
+
+// sendSignMessage sends the message to the node, messages
+// to ourselves are handled without going through the transport.
+func (r *Ring) sendSignMessage(ctx context.Context, n types.Node, msg *transport.Message) {
+	if n.ID() == r.Transport.Host().ID() {
+		go func() {
+			err := r.signTransportMessageHandler(msg)
+			if err != nil {
+				log.Errorf("handle sign message: %s", err)
+			}
+		}()
+		return
+	}
+
+	err := r.Transport.Send(ctx, &n, msg)
+	if err != nil {
+		log.Errorf("send %s to %s: %s", msg.Type, n.ID(), err)
+	}
+}
+
+func (r *Ring) signTransportMessageHandler(msg *transport.Message) error {
+	log.Infof("ring.SignTransportHandler(): type=%s from=%s to=%s", msg.Type, msg.NodeId, msg.TargetId)
+
+	if msg.RingId != string(r.ID) {
+		return fmt.Errorf("sign message for unknown ring %s", msg.RingId)
+	}
+
+	if !r.isMember(msg.NodeId) {
+		return fmt.Errorf("sign message from non ring member %s", msg.NodeId)
+	}
+
+	switch msg.Type {
+	case tsig.SignRequest:
+		return r.handleSignRequest(msg)
+	case tsig.SigningPackage:
+		return r.handleSigningPackage(msg)
+	case tsig.SignReply:
+		return r.handlePartialSignature(msg)
+	default:
+		return fmt.Errorf("unknown message type: %s, id: %s", msg.Type, msg.Id)
+	}
+}
+
+func (r *Ring) handleSignRequest(msg *transport.Message) error {
+	var req ringv1alpha1.SignRequest
+	err := proto.Unmarshal(msg.Payload, &req)
+	if err != nil {
+		return fmt.Errorf("unmarshal sign request: %w", err)
+	}
+	log.Infof("handling sign request: from=%s", msg.NodeId)
+
+	// Requests from ourselves were already
+	// authorized by the entry node Sign.
+	if msg.NodeId != r.Transport.Host().ID() {
+		err = r.authorizeSign(&req)
+		if err != nil {
+			return fmt.Errorf("sign request from %s: %w", msg.NodeId, err)
+		}
+	}
+
+	if r.DKG.State() != dkg.CERTIFIED.String() {
+		return fmt.Errorf("dkg not certified yet: %s", r.DKG.State())
+	}
+
+	scheme, err := r.signatureScheme()
+	if err != nil {
+		return err
+	}
+
+	dks, err := r.servableShare()
+	if err != nil {
+		return err
+	}
+
+	switch scheme := scheme.(type) {
+	case tsig.Scheme:
+		partial, err := scheme.Sign(dks, req.Message)
+		if err != nil {
+			return fmt.Errorf("sign: %w", err)
+		}
+		return r.replyPartialSignature(msg, partial)
+	case tsig.InteractiveScheme:
+		return r.commitNonces(msg, req.Message, scheme)
+	default:
+		return fmt.Errorf("%w: %s", ErrSignUnsupported, scheme.Name())
+	}
+}
+
+// authorizeSign checks the credential forwarded with a sign request
+// is one of our operators, and bound to the request if bound at all.
+// The requesting node is a ring member, but only the credential
+// proves an operator asked for the signature.
+func (r *Ring) authorizeSign(req *ringv1alpha1.SignRequest) error {
+	if len(req.Credential) == 0 {
+		return fmt.Errorf("%w: missing credential", ErrSignUnauthorized)
+	}
+
+	subject, err := r.Authn.VerifyRequestSubject(context.TODO(), req.Credential)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrSignUnauthorized, err)
+	}
+
+	// delegated credentials never carry the operator privileges.
+	if subject.Delegated() || !r.app.operators.Has(subject.Subject) {
+		return fmt.Errorf("%w: %s is not an operator", ErrSignUnauthorized, subject.Subject)
+	}
+	if subject.Binding != "" && subject.Binding != authn.SignBinding(req.RingId, req.Message) {
+		return fmt.Errorf("%w: credential bound to another request", ErrSignUnauthorized)
+	}
+
+	return nil
+}
+
+// commitNonces generates our nonces for the signing session,
+// and posts their commitment to the ring bulletin.
+func (r *Ring) commitNonces(msg *transport.Message, signMsg []byte, scheme tsig.InteractiveScheme) error {
+	sessionID := msg.Id
+	if !strings.HasPrefix(sessionID, tsigNamespace(r.ID)) {
+		return fmt.Errorf("invalid signing session %s", sessionID)
+	}
+
+	r.signMu.Lock()
+	if _, ok := r.signNonces[sessionID]; ok {
+		r.signMu.Unlock()
+		return nil
+	}
+	r.signMu.Unlock()
+
+	dks, err := r.servableShare()
+	if err != nil {
+		return err
+	}
+
+	nonces, err := scheme.Commit(dks)
+	if err != nil {
+		return fmt.Errorf("commit nonces: %w", err)
+	}
+
+	now := time.Now()
+	r.signMu.Lock()
+	for id, n := range r.signNonces {
+		if now.After(n.expires) {
+			delete(r.signNonces, id)
+		}
+	}
+	r.signNonces[sessionID] = signNonces{
+		nonces:    nonces,
+		msg:       signMsg,
+		requester: msg.NodeId,
+		expires:   now.Add(signTimeout),
+	}
+	r.signMu.Unlock()
+
+	payload, err := proto.Marshal(&ringv1alpha1.NonceCommitment{
+		RingId:     string(r.ID),
+		SessionId:  sessionID,
+		Index:      int32(dks.PriShare.I),
+		Commitment: nonces.Commitment(),
+	})
+	if err != nil {
+		return fmt.Errorf("marshal nonce commitment: %w", err)
+	}
+
+	id := nonceCommitmentMsgID(sessionID, r.Transport.Host().ID())
+	tmsg, err := r.Transport.NewMessage(r.ID, id, false, payload, tsig.NonceCommitment, nil)
+	if err != nil {
+		return fmt.Errorf("new transport message for nonce commitment: %w", err)
+	}
+
+	_, err = r.Bulletin.Post(context.TODO(), id, tmsg)
+	if err != nil {
+		return fmt.Errorf("post nonce commitment to bulletin: %w", err)
+	}
+
+	return nil
+}
+
+// watchNonceCommitments handles the nonce commitments
+// posted to the ring bulletin by the ring nodes.
+func (r *Ring) watchNonceCommitments() error {
+	eventsCh, err := eventbus.Subscribe[bulletin.Event](r.Bulletin.Events())
+	if err != nil {
+		return fmt.Errorf("subscribe to bulletin: %w", err)
+	}
+
+	namespace := tsigNamespace(r.ID)
+	go func() {
+		for evt := range eventsCh {
+			if !strings.HasPrefix(evt.ID, namespace) || evt.Message.GetType() != tsig.NonceCommitment {
+				continue
+			}
+			go func(msg *transport.Message) {
+				err := r.handleNonceCommitment(msg)
+				if err != nil {
+					log.Errorf("handle nonce commitment: %s", err)
+				}
+			}(evt.Message)
+		}
+	}()
+
+	return nil
+}
+
+// handleNonceCommitment collects the nonce commitments of the signing
+// sessions we requested. Once a threshold of nodes committed, they're
+// sent the signing package.
+func (r *Ring) handleNonceCommitment(msg *transport.Message) error {
+	var cmt ringv1alpha1.NonceCommitment
+	err := proto.Unmarshal(msg.Payload, &cmt)
+	if err != nil {
+		return fmt.Errorf("unmarshal nonce commitment: %w", err)
+	}
+
+	r.signMu.Lock()
+	sess, ok := r.signSessions[cmt.SessionId]
+	r.signMu.Unlock()
+	if !ok {
+		return nil
+	}
+
+	if cmt.RingId != string(r.ID) || msg.Id != nonceCommitmentMsgID(cmt.SessionId, msg.NodeId) {
+		return fmt.Errorf("nonce commitment for another session: %s", msg.Id)
+	}
+
+	// nodes can only commit for their own share.
+	var signer *types.Node
+	for _, n := range r.nodes {
+		if n.ID() == msg.NodeId && n.Index() == int(cmt.Index) {
+			signer = &n
+			break
+		}
+	}
+	if signer == nil {
+		return fmt.Errorf("nonce commitment of share %d from %s", cmt.Index, msg.NodeId)
+	}
+	log.Infof("handling nonce commitment: from=%s", msg.NodeId)
+
+	r.signMu.Lock()
+	if sess.signers != nil {
+		r.signMu.Unlock()
+		return nil
+	}
+	if _, ok := sess.cmts[signer.Index()]; !ok {
+		sess.cmts[signer.Index()] = tsig.Commitment{Index: signer.Index(), Data: cmt.Commitment}
+	}
+	if len(sess.cmts) < r.T {
+		log.Infof("nonce commitments to sign %d/%d", len(sess.cmts), r.T)
+		r.signMu.Unlock()
+		return nil
+	}
+	signers := make([]tsig.Commitment, 0, len(sess.cmts))
+	for _, c := range sess.cmts {
+		signers = append(signers, c)
+	}
+	sort.Slice(signers, func(i, j int) bool { return signers[i].Index < signers[j].Index })
+	sess.signers = signers
+	r.signMu.Unlock()
+
+	pkg := &ringv1alpha1.SigningPackage{
+		RingId:  string(r.ID),
+		Message: sess.msg,
+	}
+	for _, c := range signers {
+		pkg.Commitments = append(pkg.Commitments, &ringv1alpha1.NonceCommitment{
+			RingId:     string(r.ID),
+			SessionId:  cmt.SessionId,
+			Index:      int32(c.Index),
+			Commitment: c.Data,
+		})
+	}
+	payload, err := proto.Marshal(pkg)
+	if err != nil {
+		return fmt.Errorf("marshal signing package: %w", err)
+	}
+
+	for _, c := range signers {
+		n := r.nodes[c.Index]
+		tmsg, err := r.Transport.NewMessage(r.ID, cmt.SessionId, false, payload, tsig.SigningPackage, &n)
+		if err != nil {
+			return fmt.Errorf("new transport message for signing package: %w", err)
+		}
+		r.sendSignMessage(context.TODO(), n, tmsg)
+	}
+
+	return nil
+}
+
+// handleSigningPackage signs the message of an interactive session,
+// with the nonces we committed to. Nonces are used at most once.
+func (r *Ring) handleSigningPackage(msg *transport.Message) error {
+	var pkg ringv1alpha1.SigningPackage
+	err := proto.Unmarshal(msg.Payload, &pkg)
+	if err != nil {
+		return fmt.Errorf("unmarshal signing package: %w", err)
+	}
+	log.Infof("handling signing package: from=%s", msg.NodeId)
+
+	r.signMu.Lock()
+	nonces, ok := r.signNonces[msg.Id]
+	delete(r.signNonces, msg.Id)
+	r.signMu.Unlock()
+	if !ok || time.Now().After(nonces.expires) {
+		return fmt.Errorf("no nonces for signing session %s", msg.Id)
+	}
+	if nonces.requester != msg.NodeId || !bytes.Equal(nonces.msg, pkg.Message) {
+		return fmt.Errorf("signing package doesn't match session %s", msg.Id)
+	}
+
+	scheme, err := r.signatureScheme()
+	if err != nil {
+		return err
+	}
+	is, ok := scheme.(tsig.InteractiveScheme)
+	if !ok {
+		return fmt.Errorf("%w: %s isn't interactive", ErrSignUnsupported, scheme.Name())
+	}
+
+	cmts := make([]tsig.Commitment, len(pkg.Commitments))
+	for i, c := range pkg.Commitments {
+		cmts[i] = tsig.Commitment{Index: int(c.Index), Data: c.Commitment}
+	}
+
+	dks, err := r.servableShare()
+	if err != nil {
+		return err
+	}
+
+	partial, err := is.Sign(dks, nonces.nonces, pkg.Message, cmts)
+	if err != nil {
+		return fmt.Errorf("sign: %w", err)
+	}
+
+	return r.replyPartialSignature(msg, partial)
+}
+
+// replyPartialSignature sends our partial signature
+// to the node which requested the signature.
+func (r *Ring) replyPartialSignature(msg *transport.Message, partial []byte) error {
+	payload, err := proto.Marshal(&ringv1alpha1.PartialSignature{
+		RingId:    string(r.ID),
+		Signature: partial,
+	})
+	if err != nil {
+		return fmt.Errorf("marshal partial signature: %w", err)
+	}
+
+	var origNode types.Node
+	for _, n := range r.nodes {
+		if n.ID() == msg.NodeId {
+			origNode = n
+			break
+		}
+	}
+
+	reply, err := r.Transport.NewMessage(r.ID, msg.Id, false, payload, tsig.SignReply, &origNode)
+	if err != nil {
+		return fmt.Errorf("new transport message for partial signature: %w", err)
+	}
+
+	r.sendSignMessage(context.TODO(), origNode, reply)
+	return nil
+}
+
+func (r *Ring) handlePartialSignature(msg *transport.Message) error {
+	var resp ringv1alpha1.PartialSignature
+	err := proto.Unmarshal(msg.Payload, &resp)
+	if err != nil {
+		return fmt.Errorf("unmarshal partial signature: %w", err)
+	}
+	log.Infof("handling partial signature: from=%s", msg.NodeId)
+
+	r.signMu.Lock()
+	sess, ok := r.signSessions[msg.Id]
+	var signers []tsig.Commitment
+	if ok {
+		signers = sess.signers
+	}
+	r.signMu.Unlock()
+	if !ok {
+		log.Infof("handling partial signature: no pending request for %s, ignoring", msg.Id)
+		return nil
+	}
+
+	scheme, err := r.signatureScheme()
+	if err != nil {
+		return err
+	}
+
+	poly, err := r.ringPubPoly()
+	if err != nil {
+		return err
+	}
+
+	// interactive sessions need the partials of all the signers,
+	// other schemes recover from any threshold of partials.
+	var i, needed int
+	switch scheme := scheme.(type) {
+	case tsig.Scheme:
+		i, err = scheme.VerifyPartial(poly, sess.msg, resp.Signature)
+		needed = r.T
+	case tsig.InteractiveScheme:
+		if signers == nil {
+			return fmt.Errorf("partial signature from %s before the signing package", msg.NodeId)
+		}
+		i, err = scheme.VerifyPartial(poly, sess.msg, signers, resp.Signature)
+		needed = len(signers)
+	default:
+		return fmt.Errorf("%w: %s", ErrSignUnsupported, scheme.Name())
+	}
+	if err != nil {
+		return fmt.Errorf("partial signature from %s: %w", msg.NodeId, err)
+	}
+
+	// partials are keyed by share index, so replayed
+	// partials don't count towards the threshold.
+	r.signMu.Lock()
+	if sess.done {
+		r.signMu.Unlock()
+		return nil
+	}
+	sess.partials[i] = resp.Signature
+	if len(sess.partials) < needed {
+		log.Infof("partial signatures to recover %d/%d", len(sess.partials), needed)
+		r.signMu.Unlock()
+		return nil
+	}
+	sess.done = true
+	partials := make([][]byte, 0, len(sess.partials))
+	for _, p := range sess.partials {
+		partials = append(partials, p)
+	}
+	r.signMu.Unlock()
+
+	var sig []byte
+	switch scheme := scheme.(type) {
+	case tsig.Scheme:
+		sig, err = scheme.Recover(poly, sess.msg, partials, r.T, r.N)
+	case tsig.InteractiveScheme:
+		sig, err = scheme.Recover(poly, sess.msg, signers, partials)
+	}
+	if err != nil {
+		return fmt.Errorf("recover signature: %w", err)
+	}
+
+	sess.sig <- sig
+	return nil
+}
+
+func signMsgID(rid string, msg []byte) string {
+	return fmt.Sprintf("/ring/%s/tsig/sign/%x", rid, sha256.Sum256(msg))
+}
+
+// signSessionID returns a new random interactive signing session id.
+func signSessionID(rid string) (string, error) {
+	var buf [16]byte
+	_, err := rand.Read(buf[:])
+	if err != nil {
+		return "", fmt.Errorf("read random bytes: %w", err)
+	}
+	return fmt.Sprintf("/ring/%s/tsig/session/%x", rid, buf), nil
+}
+
+func nonceCommitmentMsgID(sessionID string, nodeID string) string {
+	return fmt.Sprintf("%s/nonce/%s", sessionID, nodeID)
+}
+
+func tsigNamespace(rid types.RingID) string {
+	return fmt.Sprintf("/ring/%s/tsig", rid)
+}
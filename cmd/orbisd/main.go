@@ -4,6 +4,8 @@ import (
 	"time"
 
 	"github.com/sourcenetwork/orbis-go/adapter/cobracli"
+	"github.com/sourcenetwork/orbis-go/pkg/dkg/pedersen"
+	"github.com/sourcenetwork/orbis-go/pkg/dkg/rabin"
 
 	ringv1alpha1 "github.com/sourcenetwork/orbis-go/gen/proto/orbis/ring/v1alpha1"
 	transportv1alpha1 "github.com/sourcenetwork/orbis-go/gen/proto/orbis/transport/v1alpha1"
@@ -56,6 +58,16 @@ func main() {
 		client.WithTimeout(1 * time.Second),
 	}
 
+	// Setup friendly client commands.
+	rootCmd.AddCommand(
+		cobracli.RingCmd(),
+		cobracli.SecretCmd(),
+		cobracli.KeysCmd(),
+		cobracli.BackupCmd(rabin.Factory, pedersen.Factory),
+		cobracli.SignerCmd(),
+		cobracli.DBCmd(),
+	)
+
 	rootCmd.AddCommand(
 		utilityv1alpha1.UtilityServiceClientCommand(opts...),
 		ringv1alpha1.RingServiceClientCommand(opts...),
@@ -4,32 +4,59 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/TBD54566975/ssi-sdk/did/jwk"
 	"github.com/TBD54566975/ssi-sdk/did/key"
+	"github.com/TBD54566975/ssi-sdk/did/resolution"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 
 	"github.com/sourcenetwork/orbis-go/app"
 	"github.com/sourcenetwork/orbis-go/config"
 	"github.com/sourcenetwork/orbis-go/pkg/authn"
 	"github.com/sourcenetwork/orbis-go/pkg/authn/jws"
 	"github.com/sourcenetwork/orbis-go/pkg/authz"
+	"github.com/sourcenetwork/orbis-go/pkg/authz/local"
+	sourcehubacp "github.com/sourcenetwork/orbis-go/pkg/authz/sourcehub"
 	"github.com/sourcenetwork/orbis-go/pkg/authz/zanzi"
 	"github.com/sourcenetwork/orbis-go/pkg/bulletin"
 	p2pbb "github.com/sourcenetwork/orbis-go/pkg/bulletin/p2p"
 	"github.com/sourcenetwork/orbis-go/pkg/bulletin/sourcehub"
+	"github.com/sourcenetwork/orbis-go/pkg/custody"
 	"github.com/sourcenetwork/orbis-go/pkg/did"
 	"github.com/sourcenetwork/orbis-go/pkg/dkg"
+	"github.com/sourcenetwork/orbis-go/pkg/dkg/pedersen"
 	"github.com/sourcenetwork/orbis-go/pkg/dkg/rabin"
 	"github.com/sourcenetwork/orbis-go/pkg/host"
 	"github.com/sourcenetwork/orbis-go/pkg/pre"
 	"github.com/sourcenetwork/orbis-go/pkg/pre/elgamal"
 	"github.com/sourcenetwork/orbis-go/pkg/pss"
 	"github.com/sourcenetwork/orbis-go/pkg/pss/avpss"
+	"github.com/sourcenetwork/orbis-go/pkg/tdec"
+	tdecelgamal "github.com/sourcenetwork/orbis-go/pkg/tdec/elgamal"
 	"github.com/sourcenetwork/orbis-go/pkg/transport"
 	p2ptp "github.com/sourcenetwork/orbis-go/pkg/transport/p2p"
+	"github.com/sourcenetwork/orbis-go/pkg/util/cleaner"
 )
 
-func setupApp(ctx context.Context, cfg config.Config) (*app.App, error) {
+func setupApp(ctx context.Context, cfg config.Config, clnr *cleaner.Cleaner) (*app.App, error) {
 
-	host, err := host.New(ctx, cfg.Host)
+	keys, err := custody.New(ctx, cfg)
+	if err != nil {
+		return nil, fmt.Errorf("open key custody: %w", err)
+	}
+	clnr.Regster(func() {
+		err := keys.Close()
+		if err != nil {
+			log.Errorf("Close key custody: %s", err)
+		}
+	})
+
+	dbOpts, err := custody.DBOptions(cfg, keys)
+	if err != nil {
+		return nil, fmt.Errorf("db encryption: %w", err)
+	}
+
+	host, err := host.NewWithKey(ctx, cfg.Host, keys.PrivKey())
 	if err != nil {
 		return nil, fmt.Errorf("create host: %w", err)
 	}
@@ -49,6 +76,11 @@ func setupApp(ctx context.Context, cfg config.Config) (*app.App, error) {
 		return nil, fmt.Errorf("create sourcehub bulletin: %w", err)
 	}
 
+	resolver, err := setupDIDResolver(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("create did resolver: %w", err)
+	}
+
 	// Services & Factory Options
 	//
 	// Services are global instances that are shared between all
@@ -60,6 +92,7 @@ func setupApp(ctx context.Context, cfg config.Config) (*app.App, error) {
 	// Options are called in order, `app.DefaultOptions` *should* be called first.
 	opts := []app.Option{
 		app.DefaultOptions(cfg),
+		app.WithCustody(keys),
 
 		// shared global transport and bulletin.
 		app.WithService[transport.Transport](tp),
@@ -70,13 +103,18 @@ func setupApp(ctx context.Context, cfg config.Config) (*app.App, error) {
 		// Authentication and Authorization services
 		app.WithService(authz.NewAllow(authz.ALLOW_ALL)),
 		// app.WithService[authz.Authz](zanzi.NewGRPC(cfg.Authz.Address)),
-		app.WithService(did.NewResolver(key.Resolver{})),
+		app.WithService(resolver),
 		app.WithFactory[authn.CredentialService](jws.SelfSignedFactory),
+		app.WithFactory[authn.CredentialService](jws.DelegationFactory),
 		app.WithFactory[authz.Authz](zanzi.Factory),
+		app.WithFactory[authz.Authz](local.Factory),
+		app.WithFactory[authz.Authz](sourcehubacp.Factory),
 
-		// DKG, PRE, and PSS Factories
+		// DKG, PRE, TDEC, and PSS Factories
 		app.WithFactory[dkg.DKG](rabin.Factory),
+		app.WithFactory[dkg.DKG](pedersen.Factory),
 		app.WithFactory[pre.PRE](elgamal.Factory),
+		app.WithFactory[tdec.TDEC](tdecelgamal.Factory),
 		app.WithFactory[pss.PSS](avpss.Factory),
 
 		// TODO: Enable support the AVPSS, ECPSS, and CHURP based PSS systems.
@@ -84,7 +122,7 @@ func setupApp(ctx context.Context, cfg config.Config) (*app.App, error) {
 		// app.WithProactiveSecretSharing(vss.Provider),
 
 		// mount DB Tables
-		app.WithDBData(cfg.DB.Path),
+		app.WithDBData(cfg.DB.Path, dbOpts...),
 	}
 
 	app, err := app.New(ctx, host, opts...)
@@ -94,3 +132,30 @@ func setupApp(ctx context.Context, cfg config.Config) (*app.App, error) {
 
 	return app, nil
 }
+
+// setupDIDResolver returns a resolver of the configured DID methods.
+func setupDIDResolver(cfg config.Config) (authn.KeyResolver, error) {
+	var resolvers []resolution.Resolver
+	for _, method := range cfg.GRPC.Authn.DIDMethods {
+		switch method {
+		case "":
+			continue
+		case "key":
+			resolvers = append(resolvers, key.Resolver{})
+		case "jwk":
+			resolvers = append(resolvers, jwk.Resolver{})
+		case "web":
+			resolvers = append(resolvers, did.NewWebResolver(did.NewHTTPFetcher()))
+		case "sourcehub":
+			conn, err := grpc.Dial(cfg.GRPC.Authn.SourceHubAddress, grpc.WithTransportCredentials(insecure.NewCredentials()))
+			if err != nil {
+				return nil, fmt.Errorf("dial sourcehub: %w", err)
+			}
+			resolvers = append(resolvers, did.NewSourceHubResolver(did.NewAccountKeyFetcher(conn)))
+		default:
+			return nil, fmt.Errorf("unsupported did method %q", method)
+		}
+	}
+
+	return did.NewMultiMethodResolver(resolvers...)
+}
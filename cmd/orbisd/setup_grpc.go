@@ -24,15 +24,31 @@ import (
 
 func init() {
 
-	var key string
+	var (
+		key string
+		// TLS flags of the client commands, only
+		// the running command's flags are parsed.
+		tlsFlags []*pflag.Flag
+	)
 
 	client.RegisterFlagBinder(func(fs *pflag.FlagSet, namer naming.Namer) {
-		fs.StringVar(&key, namer("JWT"), key, "JWT")
+		fs.StringVar(&key, namer("JWT"), key, "JWT, sent only over TLS")
+		if f := fs.Lookup(namer("TLS")); f != nil {
+			tlsFlags = append(tlsFlags, f)
+		}
 	})
 
 	client.RegisterPreDialer(func(_ context.Context, opts *[]grpc.DialOption) error {
 
 		if key != "" {
+			tls := false
+			for _, f := range tlsFlags {
+				tls = tls || f.Value.String() == "true"
+			}
+			if !tls {
+				return fmt.Errorf("the JWT is a bearer token, enable TLS to send it")
+			}
+
 			cred, err := newJWTCred(key)
 			if err != nil {
 				return fmt.Errorf("jwt key: %v", err)
@@ -61,8 +77,10 @@ func (j jwtAccess) GetRequestMetadata(ctx context.Context, uri ...string) (map[s
 	}, nil
 }
 
+// RequireTransportSecurity keeps the bearer token
+// from being sent in plaintext.
 func (j jwtAccess) RequireTransportSecurity() bool {
-	return false
+	return true
 }
 
 func setupGRPCServer(cfg config.GRPC, errGrp *errgroup.Group, clnr *cleaner.Cleaner, a *app.App) error {
@@ -74,8 +92,17 @@ func setupGRPCServer(cfg config.GRPC, errGrp *errgroup.Group, clnr *cleaner.Clea
 	// dependency to live close to the request/server intialization flow.
 	do.ProvideValue[authn.RequestMetadataParser](a.Injector(), GRPCMetadataParser{})
 
+	// Load the TLS credentials, shared by both servers. nil if TLS is disabled.
+	tlsCreds, err := grpcserver.NewTLS(cfg)
+	if err != nil {
+		return fmt.Errorf("setup TLS: %w", err)
+	}
+
 	// Create a gRPC server object
-	s := grpcserver.NewGRPCServer(cfg, a)
+	s, err := grpcserver.NewGRPCServer(cfg, a, tlsCreds)
+	if err != nil {
+		return fmt.Errorf("create gRPC server: %w", err)
+	}
 
 	reflection.Register(s)
 
@@ -92,11 +119,16 @@ func setupGRPCServer(cfg config.GRPC, errGrp *errgroup.Group, clnr *cleaner.Clea
 	})
 
 	// Serve REST with gRPC-Gateway.
-	gwServer, err := grpcserver.NewGRPCGatewayServer(cfg)
+	gwServer, err := grpcserver.NewGRPCGatewayServer(cfg, tlsCreds)
 	if err != nil {
 		return fmt.Errorf("create gRPC gateway server: %w", err)
 	}
 	errGrp.Go(func() error {
+		if tlsCreds != nil {
+			log.Infof("Serving gRPC-Gateway on https://%s", cfg.RESTURL)
+			// certificates are provided by the server TLS config
+			return gwServer.ListenAndServeTLS("", "")
+		}
 		log.Infof("Serving gRPC-Gateway on http://%s", cfg.RESTURL)
 		return gwServer.ListenAndServe()
 	})
@@ -111,6 +143,11 @@ func setupGRPCServer(cfg config.GRPC, errGrp *errgroup.Group, clnr *cleaner.Clea
 
 		log.Infof("Shutting down gRPC server")
 		s.GracefulStop()
+
+		err = tlsCreds.Close()
+		if err != nil {
+			log.Errorf("Closing TLS certificate watcher: %s", err)
+		}
 	})
 
 	return nil
@@ -21,7 +21,7 @@ func setupServer(cfg config.Config) error {
 	clnr := cleaner.New()
 	defer clnr.CleanUp()
 
-	app, err := setupApp(ctx, cfg)
+	app, err := setupApp(ctx, cfg, clnr)
 	if err != nil {
 		return fmt.Errorf("setup app: %w", err)
 	}
@@ -43,6 +43,15 @@ func setupServer(cfg config.Config) error {
 		return fmt.Errorf("loading rings: %w", err)
 	}
 
+	// keep checking the ring shares against their public polynomials
+	app.StartShareChecks(ctx)
+
+	// start listening for ring proposals from other nodes
+	err = app.StartProposals(ctx)
+	if err != nil {
+		return fmt.Errorf("start ring proposals: %w", err)
+	}
+
 	// Catch and handle signals
 	sigs := make(chan os.Signal, 1)
 	signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
@@ -13,6 +13,7 @@ import (
 type Config struct {
 	GRPC      GRPC
 	Host      Host
+	Custody   Custody
 	DKG       DKG
 	Logger    Logger
 	Ring      Ring
@@ -24,7 +25,18 @@ type Config struct {
 }
 
 type Authz struct {
-	Address string `default:"127.0.0.1:8080" description:"GRPC server address"`
+	Address  string   `default:"127.0.0.1:8080" description:"GRPC server address"`
+	Policies []string `default:"" description:"Comma separated policy YAML files loaded by the local authorizer"`
+
+	WritePermission string `mapstructure:"write_permission" default:"write" description:"Permission required on the authorization context object to store a secret"`
+	OwnerRelation   string `mapstructure:"owner_relation" default:"owner" description:"Relation registering the secret owner on the authorization context object"`
+
+	SourceHub struct {
+		Address    string `default:"127.0.0.1:9090" description:"SourceHub gRPC address, used to query the ACP module"`
+		RPCAddress string `mapstructure:"rpc_address" default:"tcp://127.0.0.1:26657" description:"SourceHub CometBFT RPC address, used to verify ACP proofs"`
+		CacheTTL   int    `mapstructure:"cache_ttl" default:"5" description:"Seconds ACP access decisions are cached, 0 disables the cache"`
+		Proofs     bool   `default:"false" description:"Accept ACP proofs (access tickets) from re-encryption requests instead of querying SourceHub"`
+	} `mapstructure:"sourcehub"`
 }
 
 type Logger struct {
@@ -39,15 +51,55 @@ type GRPC struct {
 	GRPCURL string `default:"127.0.0.1:8080" description:"gRPC URL"`
 	RESTURL string `default:"127.0.0.1:8090" description:"REST URL"`
 	Logging bool   `default:"false" description:"debug mode"`
+	Metrics bool   `default:"false" description:"Serve Prometheus metrics on the REST server at /metrics"`
+	TLS     struct {
+		CertFile     string `mapstructure:"cert_file" default:"" description:"TLS certificate file, enables TLS on the gRPC and REST servers"`
+		KeyFile      string `mapstructure:"key_file" default:"" description:"TLS private key file"`
+		ClientCAFile string `mapstructure:"client_ca_file" default:"" description:"CA certificate file used to verify client certificates, enables mTLS"`
+		SelfSigned   bool   `mapstructure:"self_signed" default:"false" description:"Generate a self-signed certificate if the certificate files don't exist (development only)"`
+	}
+	Authn struct {
+		Service          string   `default:"jws-did" description:"Credential service used to authenticate requests, jws-did for self signed tokens, or jws-ucan for delegation chains"`
+		Operators        []string `default:"" description:"Comma separated operator DIDs allowed to call the ring admin RPCs. If empty, the ring admin RPCs are denied"`
+		DIDMethods       []string `mapstructure:"did_methods" default:"key,jwk,sourcehub" description:"Comma separated DID methods resolved to authenticate subjects, among key, jwk, web, and sourcehub. The web method makes the node fetch documents from the hosts callers name, only public ones, so it's opt-in"`
+		SourceHubAddress string   `mapstructure:"sourcehub_address" default:"127.0.0.1:9090" description:"SourceHub gRPC address, used to resolve did:sourcehub accounts"`
+		MaxTokenLifetime int      `mapstructure:"max_token_lifetime" default:"3600" description:"Maximum seconds between the issuance and expiry of tokens, 0 disables the limit"`
+		ReplayProtection bool     `mapstructure:"replay_protection" default:"false" description:"Require a jti claim in tokens, and reject tokens already seen until they expire"`
+		ReplayCacheSize  int      `mapstructure:"replay_cache_size" default:"100000" description:"Maximum unexpired token ids tracked by the replay protection, beyond which the ids closest to their expiry are forgotten"`
+		ReplayIssuerSize int      `mapstructure:"replay_issuer_size" default:"1000" description:"Maximum unexpired token ids tracked for each issuer, whose further tokens are rejected until some expire"`
+		RequireBinding   bool     `mapstructure:"require_binding" default:"false" description:"Require re-encryption tokens to be bound to the secret id and reader key"`
+	}
 }
 
 type DKG struct {
 	Repo      string `default:"simpledb" description:"DKG repo"`
 	Transport string `default:"p2ptp" description:"DKG transport"`
 	Bulletin  string `default:"p2pbb" description:"DKG Bulletin"`
+	Timeout   int    `default:"60" description:"Seconds to wait for every node in each DKG phase, before going on without the missing nodes, 0 waits for every node"`
 }
 
 type Ring struct {
+	Proposals struct {
+		Bulletin   string   `default:"p2pbb" description:"Bulletin used to exchange ring proposals"`
+		AcceptFrom []string `mapstructure:"accept_from" default:"" description:"Comma separated node IDs whose ring proposals are accepted automatically, '*' accepts any proposer"`
+	}
+	Audit struct {
+		Mirror bool `default:"false" description:"Mirror the secret access audit log to the ring bulletin"`
+	}
+	Decrypt struct {
+		Enabled    bool   `default:"false" description:"Serve threshold decryption requests, which release secret plaintexts to authorized subjects"`
+		Scheme     string `default:"threshold-elgamal" description:"Threshold decryption scheme"`
+		Permission string `default:"decrypt" description:"Permission required on the authorization context object to decrypt a secret"`
+	}
+	RateLimit struct {
+		SubjectRate  float64 `mapstructure:"subject_rate" default:"1" description:"Re-encryption requests per second allowed for each subject, on every ring node it reaches, 0 disables the limit"`
+		SubjectBurst int     `mapstructure:"subject_burst" default:"5" description:"Re-encryption request burst allowed for each subject"`
+		SecretRate   float64 `mapstructure:"secret_rate" default:"10" description:"Re-encryption requests per second allowed for each secret, 0 disables the limit"`
+		SecretBurst  int     `mapstructure:"secret_burst" default:"20" description:"Re-encryption request burst allowed for each secret"`
+	} `mapstructure:"rate_limit"`
+	ShareCheck struct {
+		Interval int `default:"300" description:"Seconds between the checks of the node ring shares against their public polynomial, 0 only checks them on load"`
+	} `mapstructure:"share_check"`
 }
 
 type Secret struct {
@@ -73,21 +125,48 @@ type Bulletin struct {
 
 type Host struct {
 	Crypto struct {
-		Type string `default:"ed25519" description:"crypto type"`
-		Bits int    `default:"-1" description:"crypto bits, if selectable"`
-		Seed int    `default:"0" description:"crypto seed"`
+		Type    string `default:"ed25519" description:"crypto type"`
+		Bits    int    `default:"-1" description:"crypto bits, if selectable"`
+		Seed    int    `default:"0" description:"crypto seed"`
+		KeyFile string `mapstructure:"key_file" default:"" description:"Host private key file, generated if missing. If empty, the key is generated from the crypto type and seed"`
 	}
 	ListenAddresses []string `default:"/ip4/0.0.0.0/tcp/9000" description:"Host listen address string"`
 	BootstrapPeers  []string `mapstructure:"bootstrap_peers" default:"" description:"Comma separated multiaddr strings of bootstrap peers. If empty, the node will run in bootstrap mode"`
 	// Rendezvous      string   `default:"orbis" description:"Rendezvous string"`
 }
 
+// Custody configures where the node identity key is held.
+type Custody struct {
+	Provider string `default:"plain" description:"Custody of the node identity key, plain for the host key settings, file for a password encrypted key file, remote for a remote signer, or pkcs11 for a PKCS#11 token"`
+	File     struct {
+		Path         string `default:"identity.key.json" description:"Password encrypted identity key file, generated if missing"`
+		PasswordFile string `mapstructure:"password_file" default:"" description:"File with the key file password. If empty, it's read from ORBIS_CUSTODY_PASSWORD"`
+	}
+	Remote struct {
+		Address  string `default:"127.0.0.1:9500" description:"Remote signer gRPC address"`
+		CAFile   string `mapstructure:"ca_file" default:"" description:"CA certificate file used to verify the remote signer, enables TLS"`
+		CertFile string `mapstructure:"cert_file" default:"" description:"Client certificate file presented to the remote signer"`
+		KeyFile  string `mapstructure:"key_file" default:"" description:"Client private key file"`
+		Timeout  int    `default:"5" description:"Seconds to wait for each remote signature"`
+	}
+	PKCS11 struct {
+		Module  string `default:"" description:"PKCS#11 module library of the token"`
+		Token   string `default:"" description:"Label of the token holding the identity key"`
+		Key     string `default:"orbis-identity" description:"Label of the identity key on the token, an Ed25519 or P-256 key"`
+		PinFile string `mapstructure:"pin_file" default:"" description:"File with the token user PIN. If empty, it's read from ORBIS_PKCS11_PIN"`
+	} `mapstructure:"pkcs11"`
+}
+
 type DB struct {
-	Path string `default:"data" description:"DB path"`
+	Path       string `default:"data" description:"DB path"`
+	Encryption struct {
+		Wrapper      string `default:"" description:"Seal the DB values under data keys wrapped by a passphrase (password) or the node key custody (custody), which needs a key file or an external custody, not a seeded key. If empty, values are stored in plaintext"`
+		PasswordFile string `mapstructure:"password_file" default:"" description:"File with the DB passphrase. If empty, it's read from ORBIS_DB_PASSWORD"`
+	}
 }
 
 type configTypes interface {
-	Host | DB | Bulletin | Transport | Secret | Ring | DKG | GRPC | Logger
+	Host | Custody | DB | Bulletin | Transport | Secret | Ring | DKG | GRPC | Logger
 }
 
 func Default[T configTypes]() (T, error) {
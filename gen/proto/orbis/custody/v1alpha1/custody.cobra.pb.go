@@ -0,0 +1,109 @@
+// Code generated by protoc-gen-cobra. DO NOT EDIT.
+
+package custodyv1alpha1
+
+import (
+	client "github.com/NathanBaulch/protoc-gen-cobra/client"
+	flag "github.com/NathanBaulch/protoc-gen-cobra/flag"
+	iocodec "github.com/NathanBaulch/protoc-gen-cobra/iocodec"
+	cobra "github.com/spf13/cobra"
+	grpc "google.golang.org/grpc"
+	proto "google.golang.org/protobuf/proto"
+)
+
+func SignerServiceClientCommand(options ...client.Option) *cobra.Command {
+	cfg := client.NewConfig(options...)
+	cmd := &cobra.Command{
+		Use:   cfg.CommandNamer("SignerService"),
+		Short: "SignerService service client",
+		Long:  "SignerService signs with a node identity key it never releases,\n for nodes whose key is held by a remote signer.",
+	}
+	cfg.BindFlags(cmd.PersistentFlags())
+	cmd.AddCommand(
+		_SignerServicePublicKeyCommand(cfg),
+		_SignerServiceSignCommand(cfg),
+	)
+	return cmd
+}
+
+func _SignerServicePublicKeyCommand(cfg *client.Config) *cobra.Command {
+	req := &PublicKeyRequest{}
+
+	cmd := &cobra.Command{
+		Use:   cfg.CommandNamer("PublicKey"),
+		Short: "PublicKey RPC client",
+		Long:  "PublicKey returns the public key of the held identity.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if cfg.UseEnvVars {
+				if err := flag.SetFlagsFromEnv(cmd.Parent().PersistentFlags(), true, cfg.EnvVarNamer, cfg.EnvVarPrefix, "SignerService"); err != nil {
+					return err
+				}
+				if err := flag.SetFlagsFromEnv(cmd.PersistentFlags(), false, cfg.EnvVarNamer, cfg.EnvVarPrefix, "SignerService", "PublicKey"); err != nil {
+					return err
+				}
+			}
+			return client.RoundTrip(cmd.Context(), cfg, func(cc grpc.ClientConnInterface, in iocodec.Decoder, out iocodec.Encoder) error {
+				cli := NewSignerServiceClient(cc)
+				v := &PublicKeyRequest{}
+
+				if err := in(v); err != nil {
+					return err
+				}
+				proto.Merge(v, req)
+
+				res, err := cli.PublicKey(cmd.Context(), v)
+
+				if err != nil {
+					return err
+				}
+
+				return out(res)
+
+			})
+		},
+	}
+
+	return cmd
+}
+
+func _SignerServiceSignCommand(cfg *client.Config) *cobra.Command {
+	req := &SignRequest{}
+
+	cmd := &cobra.Command{
+		Use:   cfg.CommandNamer("Sign"),
+		Short: "Sign RPC client",
+		Long:  "Sign signs a message with the held identity.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if cfg.UseEnvVars {
+				if err := flag.SetFlagsFromEnv(cmd.Parent().PersistentFlags(), true, cfg.EnvVarNamer, cfg.EnvVarPrefix, "SignerService"); err != nil {
+					return err
+				}
+				if err := flag.SetFlagsFromEnv(cmd.PersistentFlags(), false, cfg.EnvVarNamer, cfg.EnvVarPrefix, "SignerService", "Sign"); err != nil {
+					return err
+				}
+			}
+			return client.RoundTrip(cmd.Context(), cfg, func(cc grpc.ClientConnInterface, in iocodec.Decoder, out iocodec.Encoder) error {
+				cli := NewSignerServiceClient(cc)
+				v := &SignRequest{}
+
+				if err := in(v); err != nil {
+					return err
+				}
+				proto.Merge(v, req)
+
+				res, err := cli.Sign(cmd.Context(), v)
+
+				if err != nil {
+					return err
+				}
+
+				return out(res)
+
+			})
+		},
+	}
+
+	flag.BytesBase64Var(cmd.PersistentFlags(), &req.Message, cfg.FlagNamer("Message"), "")
+
+	return cmd
+}
@@ -0,0 +1,150 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: orbis/custody/v1alpha1/custody.proto
+
+package custodyv1alpha1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	SignerService_PublicKey_FullMethodName = "/orbis.custody.v1alpha1.SignerService/PublicKey"
+	SignerService_Sign_FullMethodName      = "/orbis.custody.v1alpha1.SignerService/Sign"
+)
+
+// SignerServiceClient is the client API for SignerService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type SignerServiceClient interface {
+	// PublicKey returns the public key of the held identity.
+	PublicKey(ctx context.Context, in *PublicKeyRequest, opts ...grpc.CallOption) (*PublicKeyResponse, error)
+	// Sign signs a message with the held identity.
+	Sign(ctx context.Context, in *SignRequest, opts ...grpc.CallOption) (*SignResponse, error)
+}
+
+type signerServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSignerServiceClient(cc grpc.ClientConnInterface) SignerServiceClient {
+	return &signerServiceClient{cc}
+}
+
+func (c *signerServiceClient) PublicKey(ctx context.Context, in *PublicKeyRequest, opts ...grpc.CallOption) (*PublicKeyResponse, error) {
+	out := new(PublicKeyResponse)
+	err := c.cc.Invoke(ctx, SignerService_PublicKey_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *signerServiceClient) Sign(ctx context.Context, in *SignRequest, opts ...grpc.CallOption) (*SignResponse, error) {
+	out := new(SignResponse)
+	err := c.cc.Invoke(ctx, SignerService_Sign_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SignerServiceServer is the server API for SignerService service.
+// All implementations must embed UnimplementedSignerServiceServer
+// for forward compatibility
+type SignerServiceServer interface {
+	// PublicKey returns the public key of the held identity.
+	PublicKey(context.Context, *PublicKeyRequest) (*PublicKeyResponse, error)
+	// Sign signs a message with the held identity.
+	Sign(context.Context, *SignRequest) (*SignResponse, error)
+	mustEmbedUnimplementedSignerServiceServer()
+}
+
+// UnimplementedSignerServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedSignerServiceServer struct {
+}
+
+func (UnimplementedSignerServiceServer) PublicKey(context.Context, *PublicKeyRequest) (*PublicKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublicKey not implemented")
+}
+func (UnimplementedSignerServiceServer) Sign(context.Context, *SignRequest) (*SignResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Sign not implemented")
+}
+func (UnimplementedSignerServiceServer) mustEmbedUnimplementedSignerServiceServer() {}
+
+// UnsafeSignerServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SignerServiceServer will
+// result in compilation errors.
+type UnsafeSignerServiceServer interface {
+	mustEmbedUnimplementedSignerServiceServer()
+}
+
+func RegisterSignerServiceServer(s grpc.ServiceRegistrar, srv SignerServiceServer) {
+	s.RegisterService(&SignerService_ServiceDesc, srv)
+}
+
+func _SignerService_PublicKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublicKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SignerServiceServer).PublicKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SignerService_PublicKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SignerServiceServer).PublicKey(ctx, req.(*PublicKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SignerService_Sign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SignerServiceServer).Sign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SignerService_Sign_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SignerServiceServer).Sign(ctx, req.(*SignRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SignerService_ServiceDesc is the grpc.ServiceDesc for SignerService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SignerService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "orbis.custody.v1alpha1.SignerService",
+	HandlerType: (*SignerServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "PublicKey",
+			Handler:    _SignerService_PublicKey_Handler,
+		},
+		{
+			MethodName: "Sign",
+			Handler:    _SignerService_Sign_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "orbis/custody/v1alpha1/custody.proto",
+}
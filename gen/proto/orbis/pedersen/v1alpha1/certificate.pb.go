@@ -0,0 +1,191 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.32.0
+// 	protoc        (unknown)
+// source: orbis/pedersen/v1alpha1/certificate.proto
+
+package pedersenv1alpha1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Certificate is a node's view of the DKG outcome, which the
+// nodes cross-check before certifying the group key.
+type Certificate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Index     uint32   `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`      // of the certifying node
+	Qual      []uint32 `protobuf:"varint,2,rep,packed,name=qual,proto3" json:"qual,omitempty"` // indexes of the dealers in the group key
+	PublicKey []byte   `protobuf:"bytes,3,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	Signature []byte   `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature,omitempty"` // by the certifying node long term key
+}
+
+func (x *Certificate) Reset() {
+	*x = Certificate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_orbis_pedersen_v1alpha1_certificate_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Certificate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Certificate) ProtoMessage() {}
+
+func (x *Certificate) ProtoReflect() protoreflect.Message {
+	mi := &file_orbis_pedersen_v1alpha1_certificate_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Certificate.ProtoReflect.Descriptor instead.
+func (*Certificate) Descriptor() ([]byte, []int) {
+	return file_orbis_pedersen_v1alpha1_certificate_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Certificate) GetIndex() uint32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *Certificate) GetQual() []uint32 {
+	if x != nil {
+		return x.Qual
+	}
+	return nil
+}
+
+func (x *Certificate) GetPublicKey() []byte {
+	if x != nil {
+		return x.PublicKey
+	}
+	return nil
+}
+
+func (x *Certificate) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+var File_orbis_pedersen_v1alpha1_certificate_proto protoreflect.FileDescriptor
+
+var file_orbis_pedersen_v1alpha1_certificate_proto_rawDesc = []byte{
+	0x0a, 0x29, 0x6f, 0x72, 0x62, 0x69, 0x73, 0x2f, 0x70, 0x65, 0x64, 0x65, 0x72, 0x73, 0x65, 0x6e,
+	0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x17, 0x6f, 0x72, 0x62,
+	0x69, 0x73, 0x2e, 0x70, 0x65, 0x64, 0x65, 0x72, 0x73, 0x65, 0x6e, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x22, 0x74, 0x0a, 0x0b, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x71, 0x75, 0x61,
+	0x6c, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x04, 0x71, 0x75, 0x61, 0x6c, 0x12, 0x1d, 0x0a,
+	0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x1c, 0x0a, 0x09,
+	0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x42, 0x83, 0x02, 0x0a, 0x1b, 0x63,
+	0x6f, 0x6d, 0x2e, 0x6f, 0x72, 0x62, 0x69, 0x73, 0x2e, 0x70, 0x65, 0x64, 0x65, 0x72, 0x73, 0x65,
+	0x6e, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x42, 0x10, 0x43, 0x65, 0x72, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x54,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x6f, 0x72, 0x62, 0x69, 0x73, 0x2d, 0x67,
+	0x6f, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6f, 0x72, 0x62, 0x69,
+	0x73, 0x2f, 0x70, 0x65, 0x64, 0x65, 0x72, 0x73, 0x65, 0x6e, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x3b, 0x70, 0x65, 0x64, 0x65, 0x72, 0x73, 0x65, 0x6e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0xa2, 0x02, 0x03, 0x4f, 0x50, 0x58, 0xaa, 0x02, 0x17, 0x4f, 0x72, 0x62,
+	0x69, 0x73, 0x2e, 0x50, 0x65, 0x64, 0x65, 0x72, 0x73, 0x65, 0x6e, 0x2e, 0x56, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0xca, 0x02, 0x17, 0x4f, 0x72, 0x62, 0x69, 0x73, 0x5c, 0x50, 0x65, 0x64,
+	0x65, 0x72, 0x73, 0x65, 0x6e, 0x5c, 0x56, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0xe2, 0x02,
+	0x23, 0x4f, 0x72, 0x62, 0x69, 0x73, 0x5c, 0x50, 0x65, 0x64, 0x65, 0x72, 0x73, 0x65, 0x6e, 0x5c,
+	0x56, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x19, 0x4f, 0x72, 0x62, 0x69, 0x73, 0x3a, 0x3a, 0x50, 0x65,
+	0x64, 0x65, 0x72, 0x73, 0x65, 0x6e, 0x3a, 0x3a, 0x56, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_orbis_pedersen_v1alpha1_certificate_proto_rawDescOnce sync.Once
+	file_orbis_pedersen_v1alpha1_certificate_proto_rawDescData = file_orbis_pedersen_v1alpha1_certificate_proto_rawDesc
+)
+
+func file_orbis_pedersen_v1alpha1_certificate_proto_rawDescGZIP() []byte {
+	file_orbis_pedersen_v1alpha1_certificate_proto_rawDescOnce.Do(func() {
+		file_orbis_pedersen_v1alpha1_certificate_proto_rawDescData = protoimpl.X.CompressGZIP(file_orbis_pedersen_v1alpha1_certificate_proto_rawDescData)
+	})
+	return file_orbis_pedersen_v1alpha1_certificate_proto_rawDescData
+}
+
+var file_orbis_pedersen_v1alpha1_certificate_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_orbis_pedersen_v1alpha1_certificate_proto_goTypes = []interface{}{
+	(*Certificate)(nil), // 0: orbis.pedersen.v1alpha1.Certificate
+}
+var file_orbis_pedersen_v1alpha1_certificate_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_orbis_pedersen_v1alpha1_certificate_proto_init() }
+func file_orbis_pedersen_v1alpha1_certificate_proto_init() {
+	if File_orbis_pedersen_v1alpha1_certificate_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_orbis_pedersen_v1alpha1_certificate_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Certificate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_orbis_pedersen_v1alpha1_certificate_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_orbis_pedersen_v1alpha1_certificate_proto_goTypes,
+		DependencyIndexes: file_orbis_pedersen_v1alpha1_certificate_proto_depIdxs,
+		MessageInfos:      file_orbis_pedersen_v1alpha1_certificate_proto_msgTypes,
+	}.Build()
+	File_orbis_pedersen_v1alpha1_certificate_proto = out.File
+	file_orbis_pedersen_v1alpha1_certificate_proto_rawDesc = nil
+	file_orbis_pedersen_v1alpha1_certificate_proto_goTypes = nil
+	file_orbis_pedersen_v1alpha1_certificate_proto_depIdxs = nil
+}
@@ -0,0 +1,304 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.32.0
+// 	protoc        (unknown)
+// source: orbis/pedersen/v1alpha1/justification.proto
+
+package pedersenv1alpha1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Justification is a dealer's answer to a complaint,
+// revealing the deal of the complaining node.
+type Justification struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Index       uint32     `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	SessionId   []byte     `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	TargetIndex uint32     `protobuf:"varint,3,opt,name=target_index,json=targetIndex,proto3" json:"target_index,omitempty"`
+	Deal        *PlainDeal `protobuf:"bytes,4,opt,name=deal,proto3" json:"deal,omitempty"`
+	Signature   []byte     `protobuf:"bytes,5,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (x *Justification) Reset() {
+	*x = Justification{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_orbis_pedersen_v1alpha1_justification_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Justification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Justification) ProtoMessage() {}
+
+func (x *Justification) ProtoReflect() protoreflect.Message {
+	mi := &file_orbis_pedersen_v1alpha1_justification_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Justification.ProtoReflect.Descriptor instead.
+func (*Justification) Descriptor() ([]byte, []int) {
+	return file_orbis_pedersen_v1alpha1_justification_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Justification) GetIndex() uint32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *Justification) GetSessionId() []byte {
+	if x != nil {
+		return x.SessionId
+	}
+	return nil
+}
+
+func (x *Justification) GetTargetIndex() uint32 {
+	if x != nil {
+		return x.TargetIndex
+	}
+	return 0
+}
+
+func (x *Justification) GetDeal() *PlainDeal {
+	if x != nil {
+		return x.Deal
+	}
+	return nil
+}
+
+func (x *Justification) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+type PlainDeal struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionId   []byte    `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	SecShare    *PriShare `protobuf:"bytes,2,opt,name=sec_share,json=secShare,proto3" json:"sec_share,omitempty"`
+	T           uint32    `protobuf:"varint,3,opt,name=t,proto3" json:"t,omitempty"`
+	Commitments [][]byte  `protobuf:"bytes,4,rep,name=commitments,proto3" json:"commitments,omitempty"`
+}
+
+func (x *PlainDeal) Reset() {
+	*x = PlainDeal{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_orbis_pedersen_v1alpha1_justification_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlainDeal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlainDeal) ProtoMessage() {}
+
+func (x *PlainDeal) ProtoReflect() protoreflect.Message {
+	mi := &file_orbis_pedersen_v1alpha1_justification_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlainDeal.ProtoReflect.Descriptor instead.
+func (*PlainDeal) Descriptor() ([]byte, []int) {
+	return file_orbis_pedersen_v1alpha1_justification_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PlainDeal) GetSessionId() []byte {
+	if x != nil {
+		return x.SessionId
+	}
+	return nil
+}
+
+func (x *PlainDeal) GetSecShare() *PriShare {
+	if x != nil {
+		return x.SecShare
+	}
+	return nil
+}
+
+func (x *PlainDeal) GetT() uint32 {
+	if x != nil {
+		return x.T
+	}
+	return 0
+}
+
+func (x *PlainDeal) GetCommitments() [][]byte {
+	if x != nil {
+		return x.Commitments
+	}
+	return nil
+}
+
+var File_orbis_pedersen_v1alpha1_justification_proto protoreflect.FileDescriptor
+
+var file_orbis_pedersen_v1alpha1_justification_proto_rawDesc = []byte{
+	0x0a, 0x2b, 0x6f, 0x72, 0x62, 0x69, 0x73, 0x2f, 0x70, 0x65, 0x64, 0x65, 0x72, 0x73, 0x65, 0x6e,
+	0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x6a, 0x75, 0x73, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x17, 0x6f,
+	0x72, 0x62, 0x69, 0x73, 0x2e, 0x70, 0x65, 0x64, 0x65, 0x72, 0x73, 0x65, 0x6e, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x1a, 0x21, 0x6f, 0x72, 0x62, 0x69, 0x73, 0x2f, 0x70, 0x65,
+	0x64, 0x65, 0x72, 0x73, 0x65, 0x6e, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f,
+	0x64, 0x6b, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xbd, 0x01, 0x0a, 0x0d, 0x4a, 0x75,
+	0x73, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64,
+	0x12, 0x21, 0x0a, 0x0c, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x49, 0x6e,
+	0x64, 0x65, 0x78, 0x12, 0x36, 0x0a, 0x04, 0x64, 0x65, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x22, 0x2e, 0x6f, 0x72, 0x62, 0x69, 0x73, 0x2e, 0x70, 0x65, 0x64, 0x65, 0x72, 0x73,
+	0x65, 0x6e, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x6c, 0x61, 0x69,
+	0x6e, 0x44, 0x65, 0x61, 0x6c, 0x52, 0x04, 0x64, 0x65, 0x61, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x73,
+	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09,
+	0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x9a, 0x01, 0x0a, 0x09, 0x50, 0x6c,
+	0x61, 0x69, 0x6e, 0x44, 0x65, 0x61, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x3e, 0x0a, 0x09, 0x73, 0x65, 0x63, 0x5f, 0x73, 0x68,
+	0x61, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6f, 0x72, 0x62, 0x69,
+	0x73, 0x2e, 0x70, 0x65, 0x64, 0x65, 0x72, 0x73, 0x65, 0x6e, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x50, 0x72, 0x69, 0x53, 0x68, 0x61, 0x72, 0x65, 0x52, 0x08, 0x73, 0x65,
+	0x63, 0x53, 0x68, 0x61, 0x72, 0x65, 0x12, 0x0c, 0x0a, 0x01, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x01, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x42, 0x85, 0x02, 0x0a, 0x1b, 0x63, 0x6f, 0x6d, 0x2e, 0x6f,
+	0x72, 0x62, 0x69, 0x73, 0x2e, 0x70, 0x65, 0x64, 0x65, 0x72, 0x73, 0x65, 0x6e, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x42, 0x12, 0x4a, 0x75, 0x73, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x54, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x6e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x6f, 0x72, 0x62, 0x69, 0x73, 0x2d, 0x67, 0x6f, 0x2f,
+	0x67, 0x65, 0x6e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6f, 0x72, 0x62, 0x69, 0x73, 0x2f,
+	0x70, 0x65, 0x64, 0x65, 0x72, 0x73, 0x65, 0x6e, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x3b, 0x70, 0x65, 0x64, 0x65, 0x72, 0x73, 0x65, 0x6e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0xa2, 0x02, 0x03, 0x4f, 0x50, 0x58, 0xaa, 0x02, 0x17, 0x4f, 0x72, 0x62, 0x69, 0x73,
+	0x2e, 0x50, 0x65, 0x64, 0x65, 0x72, 0x73, 0x65, 0x6e, 0x2e, 0x56, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0xca, 0x02, 0x17, 0x4f, 0x72, 0x62, 0x69, 0x73, 0x5c, 0x50, 0x65, 0x64, 0x65, 0x72,
+	0x73, 0x65, 0x6e, 0x5c, 0x56, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0xe2, 0x02, 0x23, 0x4f,
+	0x72, 0x62, 0x69, 0x73, 0x5c, 0x50, 0x65, 0x64, 0x65, 0x72, 0x73, 0x65, 0x6e, 0x5c, 0x56, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0xea, 0x02, 0x19, 0x4f, 0x72, 0x62, 0x69, 0x73, 0x3a, 0x3a, 0x50, 0x65, 0x64, 0x65,
+	0x72, 0x73, 0x65, 0x6e, 0x3a, 0x3a, 0x56, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_orbis_pedersen_v1alpha1_justification_proto_rawDescOnce sync.Once
+	file_orbis_pedersen_v1alpha1_justification_proto_rawDescData = file_orbis_pedersen_v1alpha1_justification_proto_rawDesc
+)
+
+func file_orbis_pedersen_v1alpha1_justification_proto_rawDescGZIP() []byte {
+	file_orbis_pedersen_v1alpha1_justification_proto_rawDescOnce.Do(func() {
+		file_orbis_pedersen_v1alpha1_justification_proto_rawDescData = protoimpl.X.CompressGZIP(file_orbis_pedersen_v1alpha1_justification_proto_rawDescData)
+	})
+	return file_orbis_pedersen_v1alpha1_justification_proto_rawDescData
+}
+
+var file_orbis_pedersen_v1alpha1_justification_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_orbis_pedersen_v1alpha1_justification_proto_goTypes = []interface{}{
+	(*Justification)(nil), // 0: orbis.pedersen.v1alpha1.Justification
+	(*PlainDeal)(nil),     // 1: orbis.pedersen.v1alpha1.PlainDeal
+	(*PriShare)(nil),      // 2: orbis.pedersen.v1alpha1.PriShare
+}
+var file_orbis_pedersen_v1alpha1_justification_proto_depIdxs = []int32{
+	1, // 0: orbis.pedersen.v1alpha1.Justification.deal:type_name -> orbis.pedersen.v1alpha1.PlainDeal
+	2, // 1: orbis.pedersen.v1alpha1.PlainDeal.sec_share:type_name -> orbis.pedersen.v1alpha1.PriShare
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_orbis_pedersen_v1alpha1_justification_proto_init() }
+func file_orbis_pedersen_v1alpha1_justification_proto_init() {
+	if File_orbis_pedersen_v1alpha1_justification_proto != nil {
+		return
+	}
+	file_orbis_pedersen_v1alpha1_dkg_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_orbis_pedersen_v1alpha1_justification_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Justification); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_orbis_pedersen_v1alpha1_justification_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlainDeal); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_orbis_pedersen_v1alpha1_justification_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_orbis_pedersen_v1alpha1_justification_proto_goTypes,
+		DependencyIndexes: file_orbis_pedersen_v1alpha1_justification_proto_depIdxs,
+		MessageInfos:      file_orbis_pedersen_v1alpha1_justification_proto_msgTypes,
+	}.Build()
+	File_orbis_pedersen_v1alpha1_justification_proto = out.File
+	file_orbis_pedersen_v1alpha1_justification_proto_rawDesc = nil
+	file_orbis_pedersen_v1alpha1_justification_proto_goTypes = nil
+	file_orbis_pedersen_v1alpha1_justification_proto_depIdxs = nil
+}
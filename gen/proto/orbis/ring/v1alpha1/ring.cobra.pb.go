@@ -7,6 +7,7 @@ import (
 	flag "github.com/NathanBaulch/protoc-gen-cobra/flag"
 	iocodec "github.com/NathanBaulch/protoc-gen-cobra/iocodec"
 	pb "github.com/libp2p/go-libp2p/core/crypto/pb"
+	v1alpha1 "github.com/sourcenetwork/orbis-go/gen/proto/orbis/authz/v1alpha1"
 	cobra "github.com/spf13/cobra"
 	grpc "google.golang.org/grpc"
 	proto "google.golang.org/protobuf/proto"
@@ -24,6 +25,10 @@ func RingServiceClientCommand(options ...client.Option) *cobra.Command {
 		_RingServiceListRingsCommand(cfg),
 		_RingServiceGetRingCommand(cfg),
 		_RingServiceCreateRingCommand(cfg),
+		_RingServiceValidateManifestCommand(cfg),
+		_RingServiceProposeRingCommand(cfg),
+		_RingServiceListProposalsCommand(cfg),
+		_RingServiceApproveProposalCommand(cfg),
 		_RingServiceDeleteRingCommand(cfg),
 		_RingServicePublicKeyCommand(cfg),
 		_RingServiceRefreshCommand(cfg),
@@ -31,6 +36,12 @@ func RingServiceClientCommand(options ...client.Option) *cobra.Command {
 		_RingServiceListSecretsCommand(cfg),
 		_RingServiceStoreSecretCommand(cfg),
 		_RingServiceReencryptSecretCommand(cfg),
+		_RingServiceDecryptSecretCommand(cfg),
+		_RingServiceAuditLogCommand(cfg),
+		_RingServiceWriteRelationshipCommand(cfg),
+		_RingServiceDeleteRelationshipCommand(cfg),
+		_RingServiceSignCommand(cfg),
+		_RingServiceVerifyRingSignatureCommand(cfg),
 		_RingServiceDeleteSecretCommand(cfg),
 	)
 	return cmd
@@ -180,6 +191,212 @@ func _RingServiceCreateRingCommand(cfg *client.Config) *cobra.Command {
 	return cmd
 }
 
+func _RingServiceValidateManifestCommand(cfg *client.Config) *cobra.Command {
+	req := &ValidateManifestRequest{}
+
+	cmd := &cobra.Command{
+		Use:   cfg.CommandNamer("ValidateManifest"),
+		Short: "ValidateManifest RPC client",
+		Long:  "ValidateManifest checks a ring manifest can be joined by this node,\n without creating the ring.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if cfg.UseEnvVars {
+				if err := flag.SetFlagsFromEnv(cmd.Parent().PersistentFlags(), true, cfg.EnvVarNamer, cfg.EnvVarPrefix, "RingService"); err != nil {
+					return err
+				}
+				if err := flag.SetFlagsFromEnv(cmd.PersistentFlags(), false, cfg.EnvVarNamer, cfg.EnvVarPrefix, "RingService", "ValidateManifest"); err != nil {
+					return err
+				}
+			}
+			return client.RoundTrip(cmd.Context(), cfg, func(cc grpc.ClientConnInterface, in iocodec.Decoder, out iocodec.Encoder) error {
+				cli := NewRingServiceClient(cc)
+				v := &ValidateManifestRequest{}
+
+				if err := in(v); err != nil {
+					return err
+				}
+				proto.Merge(v, req)
+
+				res, err := cli.ValidateManifest(cmd.Context(), v)
+
+				if err != nil {
+					return err
+				}
+
+				return out(res)
+
+			})
+		},
+	}
+
+	_Manifest := &Manifest{}
+	cmd.PersistentFlags().Int32Var(&_Manifest.N, cfg.FlagNamer("Manifest N"), 0, "")
+	flag.WithPostSetHook(cmd.PersistentFlags(), cfg.FlagNamer("Manifest N"), func() { req.Manifest = _Manifest })
+	cmd.PersistentFlags().Int32Var(&_Manifest.T, cfg.FlagNamer("Manifest T"), 0, "")
+	flag.WithPostSetHook(cmd.PersistentFlags(), cfg.FlagNamer("Manifest T"), func() { req.Manifest = _Manifest })
+	cmd.PersistentFlags().StringVar(&_Manifest.Dkg, cfg.FlagNamer("Manifest Dkg"), "", "")
+	flag.WithPostSetHook(cmd.PersistentFlags(), cfg.FlagNamer("Manifest Dkg"), func() { req.Manifest = _Manifest })
+	cmd.PersistentFlags().StringVar(&_Manifest.Pss, cfg.FlagNamer("Manifest Pss"), "", "")
+	flag.WithPostSetHook(cmd.PersistentFlags(), cfg.FlagNamer("Manifest Pss"), func() { req.Manifest = _Manifest })
+	cmd.PersistentFlags().StringVar(&_Manifest.Pre, cfg.FlagNamer("Manifest Pre"), "", "")
+	flag.WithPostSetHook(cmd.PersistentFlags(), cfg.FlagNamer("Manifest Pre"), func() { req.Manifest = _Manifest })
+	cmd.PersistentFlags().StringVar(&_Manifest.Bulletin, cfg.FlagNamer("Manifest Bulletin"), "", "")
+	flag.WithPostSetHook(cmd.PersistentFlags(), cfg.FlagNamer("Manifest Bulletin"), func() { req.Manifest = _Manifest })
+	cmd.PersistentFlags().StringVar(&_Manifest.Transport, cfg.FlagNamer("Manifest Transport"), "", "")
+	flag.WithPostSetHook(cmd.PersistentFlags(), cfg.FlagNamer("Manifest Transport"), func() { req.Manifest = _Manifest })
+	flag.SliceVar(cmd.PersistentFlags(), flag.ParseMessageE[*Node], &_Manifest.Nodes, cfg.FlagNamer("Manifest Nodes"), "")
+	flag.WithPostSetHook(cmd.PersistentFlags(), cfg.FlagNamer("Manifest Nodes"), func() { req.Manifest = _Manifest })
+	cmd.PersistentFlags().StringVar(&_Manifest.Authorization, cfg.FlagNamer("Manifest Authorization"), "", "")
+	flag.WithPostSetHook(cmd.PersistentFlags(), cfg.FlagNamer("Manifest Authorization"), func() { req.Manifest = _Manifest })
+	cmd.PersistentFlags().StringVar(&_Manifest.Authentication, cfg.FlagNamer("Manifest Authentication"), "", "")
+	flag.WithPostSetHook(cmd.PersistentFlags(), cfg.FlagNamer("Manifest Authentication"), func() { req.Manifest = _Manifest })
+
+	return cmd
+}
+
+func _RingServiceProposeRingCommand(cfg *client.Config) *cobra.Command {
+	req := &ProposeRingRequest{}
+
+	cmd := &cobra.Command{
+		Use:   cfg.CommandNamer("ProposeRing"),
+		Short: "ProposeRing RPC client",
+		Long:  "ProposeRing posts a ring proposal to all the manifest nodes. The\n ring DKG starts once every node has acknowledged the proposal.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if cfg.UseEnvVars {
+				if err := flag.SetFlagsFromEnv(cmd.Parent().PersistentFlags(), true, cfg.EnvVarNamer, cfg.EnvVarPrefix, "RingService"); err != nil {
+					return err
+				}
+				if err := flag.SetFlagsFromEnv(cmd.PersistentFlags(), false, cfg.EnvVarNamer, cfg.EnvVarPrefix, "RingService", "ProposeRing"); err != nil {
+					return err
+				}
+			}
+			return client.RoundTrip(cmd.Context(), cfg, func(cc grpc.ClientConnInterface, in iocodec.Decoder, out iocodec.Encoder) error {
+				cli := NewRingServiceClient(cc)
+				v := &ProposeRingRequest{}
+
+				if err := in(v); err != nil {
+					return err
+				}
+				proto.Merge(v, req)
+
+				res, err := cli.ProposeRing(cmd.Context(), v)
+
+				if err != nil {
+					return err
+				}
+
+				return out(res)
+
+			})
+		},
+	}
+
+	_Manifest := &Manifest{}
+	cmd.PersistentFlags().Int32Var(&_Manifest.N, cfg.FlagNamer("Manifest N"), 0, "")
+	flag.WithPostSetHook(cmd.PersistentFlags(), cfg.FlagNamer("Manifest N"), func() { req.Manifest = _Manifest })
+	cmd.PersistentFlags().Int32Var(&_Manifest.T, cfg.FlagNamer("Manifest T"), 0, "")
+	flag.WithPostSetHook(cmd.PersistentFlags(), cfg.FlagNamer("Manifest T"), func() { req.Manifest = _Manifest })
+	cmd.PersistentFlags().StringVar(&_Manifest.Dkg, cfg.FlagNamer("Manifest Dkg"), "", "")
+	flag.WithPostSetHook(cmd.PersistentFlags(), cfg.FlagNamer("Manifest Dkg"), func() { req.Manifest = _Manifest })
+	cmd.PersistentFlags().StringVar(&_Manifest.Pss, cfg.FlagNamer("Manifest Pss"), "", "")
+	flag.WithPostSetHook(cmd.PersistentFlags(), cfg.FlagNamer("Manifest Pss"), func() { req.Manifest = _Manifest })
+	cmd.PersistentFlags().StringVar(&_Manifest.Pre, cfg.FlagNamer("Manifest Pre"), "", "")
+	flag.WithPostSetHook(cmd.PersistentFlags(), cfg.FlagNamer("Manifest Pre"), func() { req.Manifest = _Manifest })
+	cmd.PersistentFlags().StringVar(&_Manifest.Bulletin, cfg.FlagNamer("Manifest Bulletin"), "", "")
+	flag.WithPostSetHook(cmd.PersistentFlags(), cfg.FlagNamer("Manifest Bulletin"), func() { req.Manifest = _Manifest })
+	cmd.PersistentFlags().StringVar(&_Manifest.Transport, cfg.FlagNamer("Manifest Transport"), "", "")
+	flag.WithPostSetHook(cmd.PersistentFlags(), cfg.FlagNamer("Manifest Transport"), func() { req.Manifest = _Manifest })
+	flag.SliceVar(cmd.PersistentFlags(), flag.ParseMessageE[*Node], &_Manifest.Nodes, cfg.FlagNamer("Manifest Nodes"), "")
+	flag.WithPostSetHook(cmd.PersistentFlags(), cfg.FlagNamer("Manifest Nodes"), func() { req.Manifest = _Manifest })
+	cmd.PersistentFlags().StringVar(&_Manifest.Authorization, cfg.FlagNamer("Manifest Authorization"), "", "")
+	flag.WithPostSetHook(cmd.PersistentFlags(), cfg.FlagNamer("Manifest Authorization"), func() { req.Manifest = _Manifest })
+	cmd.PersistentFlags().StringVar(&_Manifest.Authentication, cfg.FlagNamer("Manifest Authentication"), "", "")
+	flag.WithPostSetHook(cmd.PersistentFlags(), cfg.FlagNamer("Manifest Authentication"), func() { req.Manifest = _Manifest })
+
+	return cmd
+}
+
+func _RingServiceListProposalsCommand(cfg *client.Config) *cobra.Command {
+	req := &ListProposalsRequest{}
+
+	cmd := &cobra.Command{
+		Use:   cfg.CommandNamer("ListProposals"),
+		Short: "ListProposals RPC client",
+		Long:  "",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if cfg.UseEnvVars {
+				if err := flag.SetFlagsFromEnv(cmd.Parent().PersistentFlags(), true, cfg.EnvVarNamer, cfg.EnvVarPrefix, "RingService"); err != nil {
+					return err
+				}
+				if err := flag.SetFlagsFromEnv(cmd.PersistentFlags(), false, cfg.EnvVarNamer, cfg.EnvVarPrefix, "RingService", "ListProposals"); err != nil {
+					return err
+				}
+			}
+			return client.RoundTrip(cmd.Context(), cfg, func(cc grpc.ClientConnInterface, in iocodec.Decoder, out iocodec.Encoder) error {
+				cli := NewRingServiceClient(cc)
+				v := &ListProposalsRequest{}
+
+				if err := in(v); err != nil {
+					return err
+				}
+				proto.Merge(v, req)
+
+				res, err := cli.ListProposals(cmd.Context(), v)
+
+				if err != nil {
+					return err
+				}
+
+				return out(res)
+
+			})
+		},
+	}
+
+	return cmd
+}
+
+func _RingServiceApproveProposalCommand(cfg *client.Config) *cobra.Command {
+	req := &ApproveProposalRequest{}
+
+	cmd := &cobra.Command{
+		Use:   cfg.CommandNamer("ApproveProposal"),
+		Short: "ApproveProposal RPC client",
+		Long:  "ApproveProposal accepts a pending ring proposal that wasn't\n automatically accepted by the local proposal policy.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if cfg.UseEnvVars {
+				if err := flag.SetFlagsFromEnv(cmd.Parent().PersistentFlags(), true, cfg.EnvVarNamer, cfg.EnvVarPrefix, "RingService"); err != nil {
+					return err
+				}
+				if err := flag.SetFlagsFromEnv(cmd.PersistentFlags(), false, cfg.EnvVarNamer, cfg.EnvVarPrefix, "RingService", "ApproveProposal"); err != nil {
+					return err
+				}
+			}
+			return client.RoundTrip(cmd.Context(), cfg, func(cc grpc.ClientConnInterface, in iocodec.Decoder, out iocodec.Encoder) error {
+				cli := NewRingServiceClient(cc)
+				v := &ApproveProposalRequest{}
+
+				if err := in(v); err != nil {
+					return err
+				}
+				proto.Merge(v, req)
+
+				res, err := cli.ApproveProposal(cmd.Context(), v)
+
+				if err != nil {
+					return err
+				}
+
+				return out(res)
+
+			})
+		},
+	}
+
+	cmd.PersistentFlags().StringVar(&req.RingId, cfg.FlagNamer("RingId"), "", "")
+
+	return cmd
+}
+
 func _RingServiceDeleteRingCommand(cfg *client.Config) *cobra.Command {
 	req := &DeleteRingRequest{}
 
@@ -435,6 +652,14 @@ func _RingServiceStoreSecretCommand(cfg *client.Config) *cobra.Command {
 	flag.WithPostSetHook(cmd.PersistentFlags(), cfg.FlagNamer("Secret EncScrt"), func() { req.Secret = _Secret })
 	cmd.PersistentFlags().StringVar(&_Secret.AuthzCtx, cfg.FlagNamer("Secret AuthzCtx"), "", "")
 	flag.WithPostSetHook(cmd.PersistentFlags(), cfg.FlagNamer("Secret AuthzCtx"), func() { req.Secret = _Secret })
+	cmd.PersistentFlags().StringVar(&_Secret.Dem, cfg.FlagNamer("Secret Dem"), "", "")
+	flag.WithPostSetHook(cmd.PersistentFlags(), cfg.FlagNamer("Secret Dem"), func() { req.Secret = _Secret })
+	cmd.PersistentFlags().StringVar(&_Secret.EncDataCid, cfg.FlagNamer("Secret EncDataCid"), "", "")
+	flag.WithPostSetHook(cmd.PersistentFlags(), cfg.FlagNamer("Secret EncDataCid"), func() { req.Secret = _Secret })
+	flag.BytesBase64Var(cmd.PersistentFlags(), &_Secret.EncData, cfg.FlagNamer("Secret EncData"), "")
+	flag.WithPostSetHook(cmd.PersistentFlags(), cfg.FlagNamer("Secret EncData"), func() { req.Secret = _Secret })
+	cmd.PersistentFlags().StringVar(&_Secret.Owner, cfg.FlagNamer("Secret Owner"), "", "")
+	flag.WithPostSetHook(cmd.PersistentFlags(), cfg.FlagNamer("Secret Owner"), func() { req.Secret = _Secret })
 
 	return cmd
 }
@@ -484,6 +709,291 @@ func _RingServiceReencryptSecretCommand(cfg *client.Config) *cobra.Command {
 	flag.BytesBase64Var(cmd.PersistentFlags(), &_RdrPk.Data, cfg.FlagNamer("RdrPk Data"), "")
 	flag.WithPostSetHook(cmd.PersistentFlags(), cfg.FlagNamer("RdrPk Data"), func() { req.RdrPk = _RdrPk })
 	flag.BytesBase64Var(cmd.PersistentFlags(), &req.AcpProof, cfg.FlagNamer("AcpProof"), "")
+	cmd.PersistentFlags().StringVar(&req.Subject, cfg.FlagNamer("Subject"), "", "")
+
+	return cmd
+}
+
+func _RingServiceDecryptSecretCommand(cfg *client.Config) *cobra.Command {
+	req := &DecryptSecretRequest{}
+
+	cmd := &cobra.Command{
+		Use:   cfg.CommandNamer("DecryptSecret"),
+		Short: "DecryptSecret RPC client",
+		Long:  "DecryptSecret decrypts the secret with threshold decryption, and\n returns its plaintext to the caller, which must hold the decrypt\n permission on the secret authorization context object. It's meant\n for trusted aggregators, as the node serving the request sees the\n plaintext, and is disabled unless enabled in the node configuration.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if cfg.UseEnvVars {
+				if err := flag.SetFlagsFromEnv(cmd.Parent().PersistentFlags(), true, cfg.EnvVarNamer, cfg.EnvVarPrefix, "RingService"); err != nil {
+					return err
+				}
+				if err := flag.SetFlagsFromEnv(cmd.PersistentFlags(), false, cfg.EnvVarNamer, cfg.EnvVarPrefix, "RingService", "DecryptSecret"); err != nil {
+					return err
+				}
+			}
+			return client.RoundTrip(cmd.Context(), cfg, func(cc grpc.ClientConnInterface, in iocodec.Decoder, out iocodec.Encoder) error {
+				cli := NewRingServiceClient(cc)
+				v := &DecryptSecretRequest{}
+
+				if err := in(v); err != nil {
+					return err
+				}
+				proto.Merge(v, req)
+
+				res, err := cli.DecryptSecret(cmd.Context(), v)
+
+				if err != nil {
+					return err
+				}
+
+				return out(res)
+
+			})
+		},
+	}
+
+	cmd.PersistentFlags().StringVar(&req.RingId, cfg.FlagNamer("RingId"), "", "")
+	cmd.PersistentFlags().StringVar(&req.SecretId, cfg.FlagNamer("SecretId"), "", "")
+	flag.BytesBase64Var(cmd.PersistentFlags(), &req.AcpProof, cfg.FlagNamer("AcpProof"), "")
+	cmd.PersistentFlags().StringVar(&req.Subject, cfg.FlagNamer("Subject"), "", "")
+	flag.BytesBase64Var(cmd.PersistentFlags(), &req.Credential, cfg.FlagNamer("Credential"), "")
+
+	return cmd
+}
+
+func _RingServiceAuditLogCommand(cfg *client.Config) *cobra.Command {
+	req := &AuditLogRequest{}
+
+	cmd := &cobra.Command{
+		Use:   cfg.CommandNamer("AuditLog"),
+		Short: "AuditLog RPC client",
+		Long:  "AuditLog returns the secret access audit records of a ring\n kept by this node, optionally filtered by subject or secret.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if cfg.UseEnvVars {
+				if err := flag.SetFlagsFromEnv(cmd.Parent().PersistentFlags(), true, cfg.EnvVarNamer, cfg.EnvVarPrefix, "RingService"); err != nil {
+					return err
+				}
+				if err := flag.SetFlagsFromEnv(cmd.PersistentFlags(), false, cfg.EnvVarNamer, cfg.EnvVarPrefix, "RingService", "AuditLog"); err != nil {
+					return err
+				}
+			}
+			return client.RoundTrip(cmd.Context(), cfg, func(cc grpc.ClientConnInterface, in iocodec.Decoder, out iocodec.Encoder) error {
+				cli := NewRingServiceClient(cc)
+				v := &AuditLogRequest{}
+
+				if err := in(v); err != nil {
+					return err
+				}
+				proto.Merge(v, req)
+
+				res, err := cli.AuditLog(cmd.Context(), v)
+
+				if err != nil {
+					return err
+				}
+
+				return out(res)
+
+			})
+		},
+	}
+
+	cmd.PersistentFlags().StringVar(&req.RingId, cfg.FlagNamer("RingId"), "", "")
+	cmd.PersistentFlags().StringVar(&req.Subject, cfg.FlagNamer("Subject"), "", "")
+	cmd.PersistentFlags().StringVar(&req.SecretId, cfg.FlagNamer("SecretId"), "", "")
+
+	return cmd
+}
+
+func _RingServiceWriteRelationshipCommand(cfg *client.Config) *cobra.Command {
+	req := &WriteRelationshipRequest{}
+
+	cmd := &cobra.Command{
+		Use:   cfg.CommandNamer("WriteRelationship"),
+		Short: "WriteRelationship RPC client",
+		Long:  "WriteRelationship adds a relationship to the ring authorizer,\n if it manages its own relationships.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if cfg.UseEnvVars {
+				if err := flag.SetFlagsFromEnv(cmd.Parent().PersistentFlags(), true, cfg.EnvVarNamer, cfg.EnvVarPrefix, "RingService"); err != nil {
+					return err
+				}
+				if err := flag.SetFlagsFromEnv(cmd.PersistentFlags(), false, cfg.EnvVarNamer, cfg.EnvVarPrefix, "RingService", "WriteRelationship"); err != nil {
+					return err
+				}
+			}
+			return client.RoundTrip(cmd.Context(), cfg, func(cc grpc.ClientConnInterface, in iocodec.Decoder, out iocodec.Encoder) error {
+				cli := NewRingServiceClient(cc)
+				v := &WriteRelationshipRequest{}
+
+				if err := in(v); err != nil {
+					return err
+				}
+				proto.Merge(v, req)
+
+				res, err := cli.WriteRelationship(cmd.Context(), v)
+
+				if err != nil {
+					return err
+				}
+
+				return out(res)
+
+			})
+		},
+	}
+
+	cmd.PersistentFlags().StringVar(&req.RingId, cfg.FlagNamer("RingId"), "", "")
+	_Relationship := &v1alpha1.Relationship{}
+	cmd.PersistentFlags().StringVar(&_Relationship.PolicyId, cfg.FlagNamer("Relationship PolicyId"), "", "")
+	flag.WithPostSetHook(cmd.PersistentFlags(), cfg.FlagNamer("Relationship PolicyId"), func() { req.Relationship = _Relationship })
+	cmd.PersistentFlags().StringVar(&_Relationship.Resource, cfg.FlagNamer("Relationship Resource"), "", "")
+	flag.WithPostSetHook(cmd.PersistentFlags(), cfg.FlagNamer("Relationship Resource"), func() { req.Relationship = _Relationship })
+	cmd.PersistentFlags().StringVar(&_Relationship.ResourceId, cfg.FlagNamer("Relationship ResourceId"), "", "")
+	flag.WithPostSetHook(cmd.PersistentFlags(), cfg.FlagNamer("Relationship ResourceId"), func() { req.Relationship = _Relationship })
+	cmd.PersistentFlags().StringVar(&_Relationship.Relation, cfg.FlagNamer("Relationship Relation"), "", "")
+	flag.WithPostSetHook(cmd.PersistentFlags(), cfg.FlagNamer("Relationship Relation"), func() { req.Relationship = _Relationship })
+	cmd.PersistentFlags().StringVar(&_Relationship.Subject, cfg.FlagNamer("Relationship Subject"), "", "")
+	flag.WithPostSetHook(cmd.PersistentFlags(), cfg.FlagNamer("Relationship Subject"), func() { req.Relationship = _Relationship })
+
+	return cmd
+}
+
+func _RingServiceDeleteRelationshipCommand(cfg *client.Config) *cobra.Command {
+	req := &DeleteRelationshipRequest{}
+
+	cmd := &cobra.Command{
+		Use:   cfg.CommandNamer("DeleteRelationship"),
+		Short: "DeleteRelationship RPC client",
+		Long:  "DeleteRelationship removes a relationship from the ring authorizer,\n if it manages its own relationships.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if cfg.UseEnvVars {
+				if err := flag.SetFlagsFromEnv(cmd.Parent().PersistentFlags(), true, cfg.EnvVarNamer, cfg.EnvVarPrefix, "RingService"); err != nil {
+					return err
+				}
+				if err := flag.SetFlagsFromEnv(cmd.PersistentFlags(), false, cfg.EnvVarNamer, cfg.EnvVarPrefix, "RingService", "DeleteRelationship"); err != nil {
+					return err
+				}
+			}
+			return client.RoundTrip(cmd.Context(), cfg, func(cc grpc.ClientConnInterface, in iocodec.Decoder, out iocodec.Encoder) error {
+				cli := NewRingServiceClient(cc)
+				v := &DeleteRelationshipRequest{}
+
+				if err := in(v); err != nil {
+					return err
+				}
+				proto.Merge(v, req)
+
+				res, err := cli.DeleteRelationship(cmd.Context(), v)
+
+				if err != nil {
+					return err
+				}
+
+				return out(res)
+
+			})
+		},
+	}
+
+	cmd.PersistentFlags().StringVar(&req.RingId, cfg.FlagNamer("RingId"), "", "")
+	_Relationship := &v1alpha1.Relationship{}
+	cmd.PersistentFlags().StringVar(&_Relationship.PolicyId, cfg.FlagNamer("Relationship PolicyId"), "", "")
+	flag.WithPostSetHook(cmd.PersistentFlags(), cfg.FlagNamer("Relationship PolicyId"), func() { req.Relationship = _Relationship })
+	cmd.PersistentFlags().StringVar(&_Relationship.Resource, cfg.FlagNamer("Relationship Resource"), "", "")
+	flag.WithPostSetHook(cmd.PersistentFlags(), cfg.FlagNamer("Relationship Resource"), func() { req.Relationship = _Relationship })
+	cmd.PersistentFlags().StringVar(&_Relationship.ResourceId, cfg.FlagNamer("Relationship ResourceId"), "", "")
+	flag.WithPostSetHook(cmd.PersistentFlags(), cfg.FlagNamer("Relationship ResourceId"), func() { req.Relationship = _Relationship })
+	cmd.PersistentFlags().StringVar(&_Relationship.Relation, cfg.FlagNamer("Relationship Relation"), "", "")
+	flag.WithPostSetHook(cmd.PersistentFlags(), cfg.FlagNamer("Relationship Relation"), func() { req.Relationship = _Relationship })
+	cmd.PersistentFlags().StringVar(&_Relationship.Subject, cfg.FlagNamer("Relationship Subject"), "", "")
+	flag.WithPostSetHook(cmd.PersistentFlags(), cfg.FlagNamer("Relationship Subject"), func() { req.Relationship = _Relationship })
+
+	return cmd
+}
+
+func _RingServiceSignCommand(cfg *client.Config) *cobra.Command {
+	req := &SignRequest{}
+
+	cmd := &cobra.Command{
+		Use:   cfg.CommandNamer("Sign"),
+		Short: "Sign RPC client",
+		Long:  "Sign signs the message with the ring key. Each ring node signs with\n its DKG share, and a threshold of partial signatures is recovered into\n a single signature, verifiable with the ring public key. Rings with\n pairing keys sign with threshold BLS, other rings with FROST Schnorr\n signatures, whose nonce commitments go through the ring bulletin.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if cfg.UseEnvVars {
+				if err := flag.SetFlagsFromEnv(cmd.Parent().PersistentFlags(), true, cfg.EnvVarNamer, cfg.EnvVarPrefix, "RingService"); err != nil {
+					return err
+				}
+				if err := flag.SetFlagsFromEnv(cmd.PersistentFlags(), false, cfg.EnvVarNamer, cfg.EnvVarPrefix, "RingService", "Sign"); err != nil {
+					return err
+				}
+			}
+			return client.RoundTrip(cmd.Context(), cfg, func(cc grpc.ClientConnInterface, in iocodec.Decoder, out iocodec.Encoder) error {
+				cli := NewRingServiceClient(cc)
+				v := &SignRequest{}
+
+				if err := in(v); err != nil {
+					return err
+				}
+				proto.Merge(v, req)
+
+				res, err := cli.Sign(cmd.Context(), v)
+
+				if err != nil {
+					return err
+				}
+
+				return out(res)
+
+			})
+		},
+	}
+
+	cmd.PersistentFlags().StringVar(&req.RingId, cfg.FlagNamer("RingId"), "", "")
+	flag.BytesBase64Var(cmd.PersistentFlags(), &req.Message, cfg.FlagNamer("Message"), "")
+	flag.BytesBase64Var(cmd.PersistentFlags(), &req.Credential, cfg.FlagNamer("Credential"), "")
+
+	return cmd
+}
+
+func _RingServiceVerifyRingSignatureCommand(cfg *client.Config) *cobra.Command {
+	req := &VerifyRingSignatureRequest{}
+
+	cmd := &cobra.Command{
+		Use:   cfg.CommandNamer("VerifyRingSignature"),
+		Short: "VerifyRingSignature RPC client",
+		Long:  "VerifyRingSignature checks a signature of the message by the ring key.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if cfg.UseEnvVars {
+				if err := flag.SetFlagsFromEnv(cmd.Parent().PersistentFlags(), true, cfg.EnvVarNamer, cfg.EnvVarPrefix, "RingService"); err != nil {
+					return err
+				}
+				if err := flag.SetFlagsFromEnv(cmd.PersistentFlags(), false, cfg.EnvVarNamer, cfg.EnvVarPrefix, "RingService", "VerifyRingSignature"); err != nil {
+					return err
+				}
+			}
+			return client.RoundTrip(cmd.Context(), cfg, func(cc grpc.ClientConnInterface, in iocodec.Decoder, out iocodec.Encoder) error {
+				cli := NewRingServiceClient(cc)
+				v := &VerifyRingSignatureRequest{}
+
+				if err := in(v); err != nil {
+					return err
+				}
+				proto.Merge(v, req)
+
+				res, err := cli.VerifyRingSignature(cmd.Context(), v)
+
+				if err != nil {
+					return err
+				}
+
+				return out(res)
+
+			})
+		},
+	}
+
+	cmd.PersistentFlags().StringVar(&req.RingId, cfg.FlagNamer("RingId"), "", "")
+	flag.BytesBase64Var(cmd.PersistentFlags(), &req.Message, cfg.FlagNamer("Message"), "")
+	flag.BytesBase64Var(cmd.PersistentFlags(), &req.Signature, cfg.FlagNamer("Signature"), "")
 
 	return cmd
 }
@@ -8,6 +8,8 @@ package ringv1alpha1
 
 import (
 	pb "github.com/libp2p/go-libp2p/core/crypto/pb"
+	v1alpha1 "github.com/sourcenetwork/orbis-go/gen/proto/orbis/audit/v1alpha1"
+	v1alpha11 "github.com/sourcenetwork/orbis-go/gen/proto/orbis/authz/v1alpha1"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
@@ -202,16 +204,16 @@ func (x *CreateRingResponse) GetId() string {
 	return ""
 }
 
-type GetRingRequest struct {
+type ValidateManifestRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Manifest *Manifest `protobuf:"bytes,1,opt,name=manifest,proto3" json:"manifest,omitempty"`
 }
 
-func (x *GetRingRequest) Reset() {
-	*x = GetRingRequest{}
+func (x *ValidateManifestRequest) Reset() {
+	*x = ValidateManifestRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_orbis_ring_v1alpha1_ring_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -219,13 +221,13 @@ func (x *GetRingRequest) Reset() {
 	}
 }
 
-func (x *GetRingRequest) String() string {
+func (x *ValidateManifestRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRingRequest) ProtoMessage() {}
+func (*ValidateManifestRequest) ProtoMessage() {}
 
-func (x *GetRingRequest) ProtoReflect() protoreflect.Message {
+func (x *ValidateManifestRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_orbis_ring_v1alpha1_ring_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -237,28 +239,30 @@ func (x *GetRingRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRingRequest.ProtoReflect.Descriptor instead.
-func (*GetRingRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ValidateManifestRequest.ProtoReflect.Descriptor instead.
+func (*ValidateManifestRequest) Descriptor() ([]byte, []int) {
 	return file_orbis_ring_v1alpha1_ring_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *GetRingRequest) GetId() string {
+func (x *ValidateManifestRequest) GetManifest() *Manifest {
 	if x != nil {
-		return x.Id
+		return x.Manifest
 	}
-	return ""
+	return nil
 }
 
-type GetRingResponse struct {
+type ValidateManifestResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Ring *Ring `protobuf:"bytes,1,opt,name=ring,proto3" json:"ring,omitempty"`
+	Valid      bool                 `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	RingId     string               `protobuf:"bytes,2,opt,name=ring_id,json=ringId,proto3" json:"ring_id,omitempty"`
+	Violations []*ManifestViolation `protobuf:"bytes,3,rep,name=violations,proto3" json:"violations,omitempty"`
 }
 
-func (x *GetRingResponse) Reset() {
-	*x = GetRingResponse{}
+func (x *ValidateManifestResponse) Reset() {
+	*x = ValidateManifestResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_orbis_ring_v1alpha1_ring_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -266,13 +270,13 @@ func (x *GetRingResponse) Reset() {
 	}
 }
 
-func (x *GetRingResponse) String() string {
+func (x *ValidateManifestResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRingResponse) ProtoMessage() {}
+func (*ValidateManifestResponse) ProtoMessage() {}
 
-func (x *GetRingResponse) ProtoReflect() protoreflect.Message {
+func (x *ValidateManifestResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_orbis_ring_v1alpha1_ring_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -284,28 +288,43 @@ func (x *GetRingResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRingResponse.ProtoReflect.Descriptor instead.
-func (*GetRingResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ValidateManifestResponse.ProtoReflect.Descriptor instead.
+func (*ValidateManifestResponse) Descriptor() ([]byte, []int) {
 	return file_orbis_ring_v1alpha1_ring_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *GetRingResponse) GetRing() *Ring {
+func (x *ValidateManifestResponse) GetValid() bool {
 	if x != nil {
-		return x.Ring
+		return x.Valid
+	}
+	return false
+}
+
+func (x *ValidateManifestResponse) GetRingId() string {
+	if x != nil {
+		return x.RingId
+	}
+	return ""
+}
+
+func (x *ValidateManifestResponse) GetViolations() []*ManifestViolation {
+	if x != nil {
+		return x.Violations
 	}
 	return nil
 }
 
-type DeleteRingRequest struct {
+type ManifestViolation struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Field       string `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
 }
 
-func (x *DeleteRingRequest) Reset() {
-	*x = DeleteRingRequest{}
+func (x *ManifestViolation) Reset() {
+	*x = ManifestViolation{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_orbis_ring_v1alpha1_ring_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -313,13 +332,13 @@ func (x *DeleteRingRequest) Reset() {
 	}
 }
 
-func (x *DeleteRingRequest) String() string {
+func (x *ManifestViolation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteRingRequest) ProtoMessage() {}
+func (*ManifestViolation) ProtoMessage() {}
 
-func (x *DeleteRingRequest) ProtoReflect() protoreflect.Message {
+func (x *ManifestViolation) ProtoReflect() protoreflect.Message {
 	mi := &file_orbis_ring_v1alpha1_ring_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -331,28 +350,35 @@ func (x *DeleteRingRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteRingRequest.ProtoReflect.Descriptor instead.
-func (*DeleteRingRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ManifestViolation.ProtoReflect.Descriptor instead.
+func (*ManifestViolation) Descriptor() ([]byte, []int) {
 	return file_orbis_ring_v1alpha1_ring_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *DeleteRingRequest) GetId() string {
+func (x *ManifestViolation) GetField() string {
 	if x != nil {
-		return x.Id
+		return x.Field
 	}
 	return ""
 }
 
-type RefreshRequest struct {
+func (x *ManifestViolation) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type ProposeRingRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Manifest *Manifest `protobuf:"bytes,1,opt,name=manifest,proto3" json:"manifest,omitempty"`
 }
 
-func (x *RefreshRequest) Reset() {
-	*x = RefreshRequest{}
+func (x *ProposeRingRequest) Reset() {
+	*x = ProposeRingRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_orbis_ring_v1alpha1_ring_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -360,13 +386,13 @@ func (x *RefreshRequest) Reset() {
 	}
 }
 
-func (x *RefreshRequest) String() string {
+func (x *ProposeRingRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RefreshRequest) ProtoMessage() {}
+func (*ProposeRingRequest) ProtoMessage() {}
 
-func (x *RefreshRequest) ProtoReflect() protoreflect.Message {
+func (x *ProposeRingRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_orbis_ring_v1alpha1_ring_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -378,28 +404,28 @@ func (x *RefreshRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RefreshRequest.ProtoReflect.Descriptor instead.
-func (*RefreshRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ProposeRingRequest.ProtoReflect.Descriptor instead.
+func (*ProposeRingRequest) Descriptor() ([]byte, []int) {
 	return file_orbis_ring_v1alpha1_ring_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *RefreshRequest) GetId() string {
+func (x *ProposeRingRequest) GetManifest() *Manifest {
 	if x != nil {
-		return x.Id
+		return x.Manifest
 	}
-	return ""
+	return nil
 }
 
-type PublicKeyRequest struct {
+type ProposeRingResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	RingId string `protobuf:"bytes,1,opt,name=ring_id,json=ringId,proto3" json:"ring_id,omitempty"`
 }
 
-func (x *PublicKeyRequest) Reset() {
-	*x = PublicKeyRequest{}
+func (x *ProposeRingResponse) Reset() {
+	*x = ProposeRingResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_orbis_ring_v1alpha1_ring_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -407,13 +433,13 @@ func (x *PublicKeyRequest) Reset() {
 	}
 }
 
-func (x *PublicKeyRequest) String() string {
+func (x *ProposeRingResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PublicKeyRequest) ProtoMessage() {}
+func (*ProposeRingResponse) ProtoMessage() {}
 
-func (x *PublicKeyRequest) ProtoReflect() protoreflect.Message {
+func (x *ProposeRingResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_orbis_ring_v1alpha1_ring_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -425,28 +451,26 @@ func (x *PublicKeyRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PublicKeyRequest.ProtoReflect.Descriptor instead.
-func (*PublicKeyRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ProposeRingResponse.ProtoReflect.Descriptor instead.
+func (*ProposeRingResponse) Descriptor() ([]byte, []int) {
 	return file_orbis_ring_v1alpha1_ring_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *PublicKeyRequest) GetId() string {
+func (x *ProposeRingResponse) GetRingId() string {
 	if x != nil {
-		return x.Id
+		return x.RingId
 	}
 	return ""
 }
 
-type PublicKeyResponse struct {
+type ListProposalsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	PublicKey *pb.PublicKey `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
 }
 
-func (x *PublicKeyResponse) Reset() {
-	*x = PublicKeyResponse{}
+func (x *ListProposalsRequest) Reset() {
+	*x = ListProposalsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_orbis_ring_v1alpha1_ring_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -454,13 +478,13 @@ func (x *PublicKeyResponse) Reset() {
 	}
 }
 
-func (x *PublicKeyResponse) String() string {
+func (x *ListProposalsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PublicKeyResponse) ProtoMessage() {}
+func (*ListProposalsRequest) ProtoMessage() {}
 
-func (x *PublicKeyResponse) ProtoReflect() protoreflect.Message {
+func (x *ListProposalsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_orbis_ring_v1alpha1_ring_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -472,26 +496,21 @@ func (x *PublicKeyResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PublicKeyResponse.ProtoReflect.Descriptor instead.
-func (*PublicKeyResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListProposalsRequest.ProtoReflect.Descriptor instead.
+func (*ListProposalsRequest) Descriptor() ([]byte, []int) {
 	return file_orbis_ring_v1alpha1_ring_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *PublicKeyResponse) GetPublicKey() *pb.PublicKey {
-	if x != nil {
-		return x.PublicKey
-	}
-	return nil
-}
-
-type RefreshResponse struct {
+type ListProposalsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Proposals []*RingProposal `protobuf:"bytes,1,rep,name=proposals,proto3" json:"proposals,omitempty"`
 }
 
-func (x *RefreshResponse) Reset() {
-	*x = RefreshResponse{}
+func (x *ListProposalsResponse) Reset() {
+	*x = ListProposalsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_orbis_ring_v1alpha1_ring_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -499,13 +518,13 @@ func (x *RefreshResponse) Reset() {
 	}
 }
 
-func (x *RefreshResponse) String() string {
+func (x *ListProposalsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RefreshResponse) ProtoMessage() {}
+func (*ListProposalsResponse) ProtoMessage() {}
 
-func (x *RefreshResponse) ProtoReflect() protoreflect.Message {
+func (x *ListProposalsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_orbis_ring_v1alpha1_ring_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -517,21 +536,28 @@ func (x *RefreshResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RefreshResponse.ProtoReflect.Descriptor instead.
-func (*RefreshResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListProposalsResponse.ProtoReflect.Descriptor instead.
+func (*ListProposalsResponse) Descriptor() ([]byte, []int) {
 	return file_orbis_ring_v1alpha1_ring_proto_rawDescGZIP(), []int{10}
 }
 
-type StateRequest struct {
+func (x *ListProposalsResponse) GetProposals() []*RingProposal {
+	if x != nil {
+		return x.Proposals
+	}
+	return nil
+}
+
+type ApproveProposalRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	RingId string `protobuf:"bytes,1,opt,name=ring_id,json=ringId,proto3" json:"ring_id,omitempty"`
 }
 
-func (x *StateRequest) Reset() {
-	*x = StateRequest{}
+func (x *ApproveProposalRequest) Reset() {
+	*x = ApproveProposalRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_orbis_ring_v1alpha1_ring_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -539,13 +565,13 @@ func (x *StateRequest) Reset() {
 	}
 }
 
-func (x *StateRequest) String() string {
+func (x *ApproveProposalRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StateRequest) ProtoMessage() {}
+func (*ApproveProposalRequest) ProtoMessage() {}
 
-func (x *StateRequest) ProtoReflect() protoreflect.Message {
+func (x *ApproveProposalRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_orbis_ring_v1alpha1_ring_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -557,28 +583,28 @@ func (x *StateRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StateRequest.ProtoReflect.Descriptor instead.
-func (*StateRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ApproveProposalRequest.ProtoReflect.Descriptor instead.
+func (*ApproveProposalRequest) Descriptor() ([]byte, []int) {
 	return file_orbis_ring_v1alpha1_ring_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *StateRequest) GetId() string {
+func (x *ApproveProposalRequest) GetRingId() string {
 	if x != nil {
-		return x.Id
+		return x.RingId
 	}
 	return ""
 }
 
-type StateResponse struct {
+type ApproveProposalResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Services []*ServiceState `protobuf:"bytes,1,rep,name=services,proto3" json:"services,omitempty"`
+	Proposal *RingProposal `protobuf:"bytes,1,opt,name=proposal,proto3" json:"proposal,omitempty"`
 }
 
-func (x *StateResponse) Reset() {
-	*x = StateResponse{}
+func (x *ApproveProposalResponse) Reset() {
+	*x = ApproveProposalResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_orbis_ring_v1alpha1_ring_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -586,13 +612,13 @@ func (x *StateResponse) Reset() {
 	}
 }
 
-func (x *StateResponse) String() string {
+func (x *ApproveProposalResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StateResponse) ProtoMessage() {}
+func (*ApproveProposalResponse) ProtoMessage() {}
 
-func (x *StateResponse) ProtoReflect() protoreflect.Message {
+func (x *ApproveProposalResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_orbis_ring_v1alpha1_ring_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -604,29 +630,35 @@ func (x *StateResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StateResponse.ProtoReflect.Descriptor instead.
-func (*StateResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ApproveProposalResponse.ProtoReflect.Descriptor instead.
+func (*ApproveProposalResponse) Descriptor() ([]byte, []int) {
 	return file_orbis_ring_v1alpha1_ring_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *StateResponse) GetServices() []*ServiceState {
+func (x *ApproveProposalResponse) GetProposal() *RingProposal {
 	if x != nil {
-		return x.Services
+		return x.Proposal
 	}
 	return nil
 }
 
-type ServiceState struct {
+// RingProposal is posted on the proposal bulletin namespace by the
+// proposing node. The acks and status are only set by this node
+// when listing proposals.
+type RingProposal struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	State string `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	RingId   string    `protobuf:"bytes,1,opt,name=ring_id,json=ringId,proto3" json:"ring_id,omitempty"`
+	Manifest *Manifest `protobuf:"bytes,2,opt,name=manifest,proto3" json:"manifest,omitempty"`
+	Proposer string    `protobuf:"bytes,3,opt,name=proposer,proto3" json:"proposer,omitempty"` // node id of the proposer
+	Acks     []string  `protobuf:"bytes,4,rep,name=acks,proto3" json:"acks,omitempty"`         // node ids that acknowledged the proposal
+	Status   string    `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
 }
 
-func (x *ServiceState) Reset() {
-	*x = ServiceState{}
+func (x *RingProposal) Reset() {
+	*x = RingProposal{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_orbis_ring_v1alpha1_ring_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -634,13 +666,13 @@ func (x *ServiceState) Reset() {
 	}
 }
 
-func (x *ServiceState) String() string {
+func (x *RingProposal) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ServiceState) ProtoMessage() {}
+func (*RingProposal) ProtoMessage() {}
 
-func (x *ServiceState) ProtoReflect() protoreflect.Message {
+func (x *RingProposal) ProtoReflect() protoreflect.Message {
 	mi := &file_orbis_ring_v1alpha1_ring_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -652,35 +684,59 @@ func (x *ServiceState) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ServiceState.ProtoReflect.Descriptor instead.
-func (*ServiceState) Descriptor() ([]byte, []int) {
+// Deprecated: Use RingProposal.ProtoReflect.Descriptor instead.
+func (*RingProposal) Descriptor() ([]byte, []int) {
 	return file_orbis_ring_v1alpha1_ring_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *ServiceState) GetName() string {
+func (x *RingProposal) GetRingId() string {
 	if x != nil {
-		return x.Name
+		return x.RingId
 	}
 	return ""
 }
 
-func (x *ServiceState) GetState() string {
+func (x *RingProposal) GetManifest() *Manifest {
 	if x != nil {
-		return x.State
+		return x.Manifest
+	}
+	return nil
+}
+
+func (x *RingProposal) GetProposer() string {
+	if x != nil {
+		return x.Proposer
 	}
 	return ""
 }
 
-type ListSecretsRequest struct {
+func (x *RingProposal) GetAcks() []string {
+	if x != nil {
+		return x.Acks
+	}
+	return nil
+}
+
+func (x *RingProposal) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+// RingProposalAck is posted on the proposal bulletin namespace by
+// each node that accepted and joined a proposed ring.
+type RingProposalAck struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	RingId string `protobuf:"bytes,1,opt,name=ring_id,json=ringId,proto3" json:"ring_id,omitempty"`
+	NodeId string `protobuf:"bytes,2,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
 }
 
-func (x *ListSecretsRequest) Reset() {
-	*x = ListSecretsRequest{}
+func (x *RingProposalAck) Reset() {
+	*x = RingProposalAck{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_orbis_ring_v1alpha1_ring_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -688,13 +744,13 @@ func (x *ListSecretsRequest) Reset() {
 	}
 }
 
-func (x *ListSecretsRequest) String() string {
+func (x *RingProposalAck) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListSecretsRequest) ProtoMessage() {}
+func (*RingProposalAck) ProtoMessage() {}
 
-func (x *ListSecretsRequest) ProtoReflect() protoreflect.Message {
+func (x *RingProposalAck) ProtoReflect() protoreflect.Message {
 	mi := &file_orbis_ring_v1alpha1_ring_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -706,28 +762,35 @@ func (x *ListSecretsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListSecretsRequest.ProtoReflect.Descriptor instead.
-func (*ListSecretsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RingProposalAck.ProtoReflect.Descriptor instead.
+func (*RingProposalAck) Descriptor() ([]byte, []int) {
 	return file_orbis_ring_v1alpha1_ring_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *ListSecretsRequest) GetRingId() string {
+func (x *RingProposalAck) GetRingId() string {
 	if x != nil {
 		return x.RingId
 	}
 	return ""
 }
 
-type ListSecretsResponse struct {
+func (x *RingProposalAck) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+type GetRingRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Secrets []*Secret `protobuf:"bytes,1,rep,name=secrets,proto3" json:"secrets,omitempty"`
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *ListSecretsResponse) Reset() {
-	*x = ListSecretsResponse{}
+func (x *GetRingRequest) Reset() {
+	*x = GetRingRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_orbis_ring_v1alpha1_ring_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -735,13 +798,13 @@ func (x *ListSecretsResponse) Reset() {
 	}
 }
 
-func (x *ListSecretsResponse) String() string {
+func (x *GetRingRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListSecretsResponse) ProtoMessage() {}
+func (*GetRingRequest) ProtoMessage() {}
 
-func (x *ListSecretsResponse) ProtoReflect() protoreflect.Message {
+func (x *GetRingRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_orbis_ring_v1alpha1_ring_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -753,29 +816,28 @@ func (x *ListSecretsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListSecretsResponse.ProtoReflect.Descriptor instead.
-func (*ListSecretsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetRingRequest.ProtoReflect.Descriptor instead.
+func (*GetRingRequest) Descriptor() ([]byte, []int) {
 	return file_orbis_ring_v1alpha1_ring_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *ListSecretsResponse) GetSecrets() []*Secret {
+func (x *GetRingRequest) GetId() string {
 	if x != nil {
-		return x.Secrets
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-type StoreSecretRequest struct {
+type GetRingResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RingId string  `protobuf:"bytes,1,opt,name=ring_id,json=ringId,proto3" json:"ring_id,omitempty"`
-	Secret *Secret `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"`
+	Ring *Ring `protobuf:"bytes,1,opt,name=ring,proto3" json:"ring,omitempty"`
 }
 
-func (x *StoreSecretRequest) Reset() {
-	*x = StoreSecretRequest{}
+func (x *GetRingResponse) Reset() {
+	*x = GetRingResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_orbis_ring_v1alpha1_ring_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -783,13 +845,13 @@ func (x *StoreSecretRequest) Reset() {
 	}
 }
 
-func (x *StoreSecretRequest) String() string {
+func (x *GetRingResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StoreSecretRequest) ProtoMessage() {}
+func (*GetRingResponse) ProtoMessage() {}
 
-func (x *StoreSecretRequest) ProtoReflect() protoreflect.Message {
+func (x *GetRingResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_orbis_ring_v1alpha1_ring_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -801,35 +863,28 @@ func (x *StoreSecretRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StoreSecretRequest.ProtoReflect.Descriptor instead.
-func (*StoreSecretRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetRingResponse.ProtoReflect.Descriptor instead.
+func (*GetRingResponse) Descriptor() ([]byte, []int) {
 	return file_orbis_ring_v1alpha1_ring_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *StoreSecretRequest) GetRingId() string {
-	if x != nil {
-		return x.RingId
-	}
-	return ""
-}
-
-func (x *StoreSecretRequest) GetSecret() *Secret {
+func (x *GetRingResponse) GetRing() *Ring {
 	if x != nil {
-		return x.Secret
+		return x.Ring
 	}
 	return nil
 }
 
-type StoreSecretResponse struct {
+type DeleteRingRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SecretId string `protobuf:"bytes,1,opt,name=secret_id,json=secretId,proto3" json:"secret_id,omitempty"`
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *StoreSecretResponse) Reset() {
-	*x = StoreSecretResponse{}
+func (x *DeleteRingRequest) Reset() {
+	*x = DeleteRingRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_orbis_ring_v1alpha1_ring_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -837,13 +892,13 @@ func (x *StoreSecretResponse) Reset() {
 	}
 }
 
-func (x *StoreSecretResponse) String() string {
+func (x *DeleteRingRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StoreSecretResponse) ProtoMessage() {}
+func (*DeleteRingRequest) ProtoMessage() {}
 
-func (x *StoreSecretResponse) ProtoReflect() protoreflect.Message {
+func (x *DeleteRingRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_orbis_ring_v1alpha1_ring_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -855,30 +910,28 @@ func (x *StoreSecretResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StoreSecretResponse.ProtoReflect.Descriptor instead.
-func (*StoreSecretResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteRingRequest.ProtoReflect.Descriptor instead.
+func (*DeleteRingRequest) Descriptor() ([]byte, []int) {
 	return file_orbis_ring_v1alpha1_ring_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *StoreSecretResponse) GetSecretId() string {
+func (x *DeleteRingRequest) GetId() string {
 	if x != nil {
-		return x.SecretId
+		return x.Id
 	}
 	return ""
 }
 
-type DeleteSecretRequest struct {
+type RefreshRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RingId   string `protobuf:"bytes,1,opt,name=ring_id,json=ringId,proto3" json:"ring_id,omitempty"`
-	SecretId string `protobuf:"bytes,2,opt,name=secret_id,json=secretId,proto3" json:"secret_id,omitempty"`
-	AcpProof []byte `protobuf:"bytes,3,opt,name=acp_proof,json=acpProof,proto3" json:"acp_proof,omitempty"`
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *DeleteSecretRequest) Reset() {
-	*x = DeleteSecretRequest{}
+func (x *RefreshRequest) Reset() {
+	*x = RefreshRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_orbis_ring_v1alpha1_ring_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -886,13 +939,13 @@ func (x *DeleteSecretRequest) Reset() {
 	}
 }
 
-func (x *DeleteSecretRequest) String() string {
+func (x *RefreshRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteSecretRequest) ProtoMessage() {}
+func (*RefreshRequest) ProtoMessage() {}
 
-func (x *DeleteSecretRequest) ProtoReflect() protoreflect.Message {
+func (x *RefreshRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_orbis_ring_v1alpha1_ring_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -904,60 +957,1495 @@ func (x *DeleteSecretRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteSecretRequest.ProtoReflect.Descriptor instead.
-func (*DeleteSecretRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RefreshRequest.ProtoReflect.Descriptor instead.
+func (*RefreshRequest) Descriptor() ([]byte, []int) {
 	return file_orbis_ring_v1alpha1_ring_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *DeleteSecretRequest) GetRingId() string {
+func (x *RefreshRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type PublicKeyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *PublicKeyRequest) Reset() {
+	*x = PublicKeyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_orbis_ring_v1alpha1_ring_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PublicKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublicKeyRequest) ProtoMessage() {}
+
+func (x *PublicKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orbis_ring_v1alpha1_ring_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublicKeyRequest.ProtoReflect.Descriptor instead.
+func (*PublicKeyRequest) Descriptor() ([]byte, []int) {
+	return file_orbis_ring_v1alpha1_ring_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *PublicKeyRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type PublicKeyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PublicKey *pb.PublicKey `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+}
+
+func (x *PublicKeyResponse) Reset() {
+	*x = PublicKeyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_orbis_ring_v1alpha1_ring_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PublicKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublicKeyResponse) ProtoMessage() {}
+
+func (x *PublicKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_orbis_ring_v1alpha1_ring_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublicKeyResponse.ProtoReflect.Descriptor instead.
+func (*PublicKeyResponse) Descriptor() ([]byte, []int) {
+	return file_orbis_ring_v1alpha1_ring_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *PublicKeyResponse) GetPublicKey() *pb.PublicKey {
+	if x != nil {
+		return x.PublicKey
+	}
+	return nil
+}
+
+type RefreshResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RefreshResponse) Reset() {
+	*x = RefreshResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_orbis_ring_v1alpha1_ring_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RefreshResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshResponse) ProtoMessage() {}
+
+func (x *RefreshResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_orbis_ring_v1alpha1_ring_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshResponse.ProtoReflect.Descriptor instead.
+func (*RefreshResponse) Descriptor() ([]byte, []int) {
+	return file_orbis_ring_v1alpha1_ring_proto_rawDescGZIP(), []int{21}
+}
+
+type StateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *StateRequest) Reset() {
+	*x = StateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_orbis_ring_v1alpha1_ring_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StateRequest) ProtoMessage() {}
+
+func (x *StateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orbis_ring_v1alpha1_ring_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StateRequest.ProtoReflect.Descriptor instead.
+func (*StateRequest) Descriptor() ([]byte, []int) {
+	return file_orbis_ring_v1alpha1_ring_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *StateRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type StateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Services []*ServiceState `protobuf:"bytes,1,rep,name=services,proto3" json:"services,omitempty"`
+}
+
+func (x *StateResponse) Reset() {
+	*x = StateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_orbis_ring_v1alpha1_ring_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StateResponse) ProtoMessage() {}
+
+func (x *StateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_orbis_ring_v1alpha1_ring_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StateResponse.ProtoReflect.Descriptor instead.
+func (*StateResponse) Descriptor() ([]byte, []int) {
+	return file_orbis_ring_v1alpha1_ring_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *StateResponse) GetServices() []*ServiceState {
+	if x != nil {
+		return x.Services
+	}
+	return nil
+}
+
+type ServiceState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	State string `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+}
+
+func (x *ServiceState) Reset() {
+	*x = ServiceState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_orbis_ring_v1alpha1_ring_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServiceState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceState) ProtoMessage() {}
+
+func (x *ServiceState) ProtoReflect() protoreflect.Message {
+	mi := &file_orbis_ring_v1alpha1_ring_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceState.ProtoReflect.Descriptor instead.
+func (*ServiceState) Descriptor() ([]byte, []int) {
+	return file_orbis_ring_v1alpha1_ring_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ServiceState) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ServiceState) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+type ListSecretsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RingId string `protobuf:"bytes,1,opt,name=ring_id,json=ringId,proto3" json:"ring_id,omitempty"`
+}
+
+func (x *ListSecretsRequest) Reset() {
+	*x = ListSecretsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_orbis_ring_v1alpha1_ring_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSecretsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSecretsRequest) ProtoMessage() {}
+
+func (x *ListSecretsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orbis_ring_v1alpha1_ring_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSecretsRequest.ProtoReflect.Descriptor instead.
+func (*ListSecretsRequest) Descriptor() ([]byte, []int) {
+	return file_orbis_ring_v1alpha1_ring_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListSecretsRequest) GetRingId() string {
+	if x != nil {
+		return x.RingId
+	}
+	return ""
+}
+
+type ListSecretsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Secrets   []*Secret `protobuf:"bytes,1,rep,name=secrets,proto3" json:"secrets,omitempty"`
+	SecretIds []string  `protobuf:"bytes,2,rep,name=secret_ids,json=secretIds,proto3" json:"secret_ids,omitempty"` // ids of the secrets, in the same order
+}
+
+func (x *ListSecretsResponse) Reset() {
+	*x = ListSecretsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_orbis_ring_v1alpha1_ring_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSecretsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSecretsResponse) ProtoMessage() {}
+
+func (x *ListSecretsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_orbis_ring_v1alpha1_ring_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSecretsResponse.ProtoReflect.Descriptor instead.
+func (*ListSecretsResponse) Descriptor() ([]byte, []int) {
+	return file_orbis_ring_v1alpha1_ring_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ListSecretsResponse) GetSecrets() []*Secret {
+	if x != nil {
+		return x.Secrets
+	}
+	return nil
+}
+
+func (x *ListSecretsResponse) GetSecretIds() []string {
+	if x != nil {
+		return x.SecretIds
+	}
+	return nil
+}
+
+type StoreSecretRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RingId string  `protobuf:"bytes,1,opt,name=ring_id,json=ringId,proto3" json:"ring_id,omitempty"`
+	Secret *Secret `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"`
+}
+
+func (x *StoreSecretRequest) Reset() {
+	*x = StoreSecretRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_orbis_ring_v1alpha1_ring_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StoreSecretRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StoreSecretRequest) ProtoMessage() {}
+
+func (x *StoreSecretRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orbis_ring_v1alpha1_ring_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StoreSecretRequest.ProtoReflect.Descriptor instead.
+func (*StoreSecretRequest) Descriptor() ([]byte, []int) {
+	return file_orbis_ring_v1alpha1_ring_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *StoreSecretRequest) GetRingId() string {
+	if x != nil {
+		return x.RingId
+	}
+	return ""
+}
+
+func (x *StoreSecretRequest) GetSecret() *Secret {
+	if x != nil {
+		return x.Secret
+	}
+	return nil
+}
+
+type StoreSecretResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SecretId string `protobuf:"bytes,1,opt,name=secret_id,json=secretId,proto3" json:"secret_id,omitempty"`
+}
+
+func (x *StoreSecretResponse) Reset() {
+	*x = StoreSecretResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_orbis_ring_v1alpha1_ring_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StoreSecretResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StoreSecretResponse) ProtoMessage() {}
+
+func (x *StoreSecretResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_orbis_ring_v1alpha1_ring_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StoreSecretResponse.ProtoReflect.Descriptor instead.
+func (*StoreSecretResponse) Descriptor() ([]byte, []int) {
+	return file_orbis_ring_v1alpha1_ring_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *StoreSecretResponse) GetSecretId() string {
+	if x != nil {
+		return x.SecretId
+	}
+	return ""
+}
+
+type DeleteSecretRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RingId   string `protobuf:"bytes,1,opt,name=ring_id,json=ringId,proto3" json:"ring_id,omitempty"`
+	SecretId string `protobuf:"bytes,2,opt,name=secret_id,json=secretId,proto3" json:"secret_id,omitempty"`
+	AcpProof []byte `protobuf:"bytes,3,opt,name=acp_proof,json=acpProof,proto3" json:"acp_proof,omitempty"`
+}
+
+func (x *DeleteSecretRequest) Reset() {
+	*x = DeleteSecretRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_orbis_ring_v1alpha1_ring_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteSecretRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSecretRequest) ProtoMessage() {}
+
+func (x *DeleteSecretRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orbis_ring_v1alpha1_ring_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSecretRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSecretRequest) Descriptor() ([]byte, []int) {
+	return file_orbis_ring_v1alpha1_ring_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *DeleteSecretRequest) GetRingId() string {
+	if x != nil {
+		return x.RingId
+	}
+	return ""
+}
+
+func (x *DeleteSecretRequest) GetSecretId() string {
+	if x != nil {
+		return x.SecretId
+	}
+	return ""
+}
+
+func (x *DeleteSecretRequest) GetAcpProof() []byte {
+	if x != nil {
+		return x.AcpProof
+	}
+	return nil
+}
+
+type ReencryptSecretRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RingId   string        `protobuf:"bytes,1,opt,name=ring_id,json=ringId,proto3" json:"ring_id,omitempty"`
+	SecretId string        `protobuf:"bytes,2,opt,name=secret_id,json=secretId,proto3" json:"secret_id,omitempty"`
+	RdrPk    *pb.PublicKey `protobuf:"bytes,3,opt,name=rdr_pk,json=rdrPk,proto3" json:"rdr_pk,omitempty"`
+	AcpProof []byte        `protobuf:"bytes,4,opt,name=acp_proof,json=acpProof,proto3" json:"acp_proof,omitempty"`
+	Subject  string        `protobuf:"bytes,5,opt,name=subject,proto3" json:"subject,omitempty"` // requesting subject, forwarded between ring nodes and ignored by the API
+}
+
+func (x *ReencryptSecretRequest) Reset() {
+	*x = ReencryptSecretRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_orbis_ring_v1alpha1_ring_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReencryptSecretRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReencryptSecretRequest) ProtoMessage() {}
+
+func (x *ReencryptSecretRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orbis_ring_v1alpha1_ring_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReencryptSecretRequest.ProtoReflect.Descriptor instead.
+func (*ReencryptSecretRequest) Descriptor() ([]byte, []int) {
+	return file_orbis_ring_v1alpha1_ring_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ReencryptSecretRequest) GetRingId() string {
+	if x != nil {
+		return x.RingId
+	}
+	return ""
+}
+
+func (x *ReencryptSecretRequest) GetSecretId() string {
+	if x != nil {
+		return x.SecretId
+	}
+	return ""
+}
+
+func (x *ReencryptSecretRequest) GetRdrPk() *pb.PublicKey {
+	if x != nil {
+		return x.RdrPk
+	}
+	return nil
+}
+
+func (x *ReencryptSecretRequest) GetAcpProof() []byte {
+	if x != nil {
+		return x.AcpProof
+	}
+	return nil
+}
+
+func (x *ReencryptSecretRequest) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+// Reencryption commitment recovered from verified secret shares, and encrypted secret
+type ReencryptSecretResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	XncCmt  []byte   `protobuf:"bytes,1,opt,name=xnc_cmt,json=xncCmt,proto3" json:"xnc_cmt,omitempty"`    // reencryption commitment
+	EncScrt [][]byte `protobuf:"bytes,2,rep,name=enc_scrt,json=encScrt,proto3" json:"enc_scrt,omitempty"` // enncrypted secret
+	EncData []byte   `protobuf:"bytes,3,opt,name=enc_data,json=encData,proto3" json:"enc_data,omitempty"` // DEM ciphertext, for hybrid secrets
+	Dem     string   `protobuf:"bytes,4,opt,name=dem,proto3" json:"dem,omitempty"`                        // data encapsulation mechanism, for hybrid secrets
+	EncCmt  []byte   `protobuf:"bytes,5,opt,name=enc_cmt,json=encCmt,proto3" json:"enc_cmt,omitempty"`    // encryption commitment, authenticated by the DEM
+}
+
+func (x *ReencryptSecretResponse) Reset() {
+	*x = ReencryptSecretResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_orbis_ring_v1alpha1_ring_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReencryptSecretResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReencryptSecretResponse) ProtoMessage() {}
+
+func (x *ReencryptSecretResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_orbis_ring_v1alpha1_ring_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReencryptSecretResponse.ProtoReflect.Descriptor instead.
+func (*ReencryptSecretResponse) Descriptor() ([]byte, []int) {
+	return file_orbis_ring_v1alpha1_ring_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ReencryptSecretResponse) GetXncCmt() []byte {
+	if x != nil {
+		return x.XncCmt
+	}
+	return nil
+}
+
+func (x *ReencryptSecretResponse) GetEncScrt() [][]byte {
+	if x != nil {
+		return x.EncScrt
+	}
+	return nil
+}
+
+func (x *ReencryptSecretResponse) GetEncData() []byte {
+	if x != nil {
+		return x.EncData
+	}
+	return nil
+}
+
+func (x *ReencryptSecretResponse) GetDem() string {
+	if x != nil {
+		return x.Dem
+	}
+	return ""
+}
+
+func (x *ReencryptSecretResponse) GetEncCmt() []byte {
+	if x != nil {
+		return x.EncCmt
+	}
+	return nil
+}
+
+type DecryptSecretRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RingId     string `protobuf:"bytes,1,opt,name=ring_id,json=ringId,proto3" json:"ring_id,omitempty"`
+	SecretId   string `protobuf:"bytes,2,opt,name=secret_id,json=secretId,proto3" json:"secret_id,omitempty"`
+	AcpProof   []byte `protobuf:"bytes,3,opt,name=acp_proof,json=acpProof,proto3" json:"acp_proof,omitempty"`
+	Subject    string `protobuf:"bytes,4,opt,name=subject,proto3" json:"subject,omitempty"`       // requesting subject, forwarded between ring nodes and ignored by the API
+	Credential []byte `protobuf:"bytes,5,opt,name=credential,proto3" json:"credential,omitempty"` // requesting subject credential, forwarded between ring nodes and ignored by the API
+}
+
+func (x *DecryptSecretRequest) Reset() {
+	*x = DecryptSecretRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_orbis_ring_v1alpha1_ring_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DecryptSecretRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecryptSecretRequest) ProtoMessage() {}
+
+func (x *DecryptSecretRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orbis_ring_v1alpha1_ring_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DecryptSecretRequest.ProtoReflect.Descriptor instead.
+func (*DecryptSecretRequest) Descriptor() ([]byte, []int) {
+	return file_orbis_ring_v1alpha1_ring_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *DecryptSecretRequest) GetRingId() string {
+	if x != nil {
+		return x.RingId
+	}
+	return ""
+}
+
+func (x *DecryptSecretRequest) GetSecretId() string {
+	if x != nil {
+		return x.SecretId
+	}
+	return ""
+}
+
+func (x *DecryptSecretRequest) GetAcpProof() []byte {
+	if x != nil {
+		return x.AcpProof
+	}
+	return nil
+}
+
+func (x *DecryptSecretRequest) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *DecryptSecretRequest) GetCredential() []byte {
+	if x != nil {
+		return x.Credential
+	}
+	return nil
+}
+
+type DecryptSecretResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Secret []byte `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"` // plaintext secret
+}
+
+func (x *DecryptSecretResponse) Reset() {
+	*x = DecryptSecretResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_orbis_ring_v1alpha1_ring_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DecryptSecretResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecryptSecretResponse) ProtoMessage() {}
+
+func (x *DecryptSecretResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_orbis_ring_v1alpha1_ring_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DecryptSecretResponse.ProtoReflect.Descriptor instead.
+func (*DecryptSecretResponse) Descriptor() ([]byte, []int) {
+	return file_orbis_ring_v1alpha1_ring_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *DecryptSecretResponse) GetSecret() []byte {
+	if x != nil {
+		return x.Secret
+	}
+	return nil
+}
+
+type AuditLogRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RingId   string `protobuf:"bytes,1,opt,name=ring_id,json=ringId,proto3" json:"ring_id,omitempty"`
+	Subject  string `protobuf:"bytes,2,opt,name=subject,proto3" json:"subject,omitempty"`
+	SecretId string `protobuf:"bytes,3,opt,name=secret_id,json=secretId,proto3" json:"secret_id,omitempty"`
+}
+
+func (x *AuditLogRequest) Reset() {
+	*x = AuditLogRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_orbis_ring_v1alpha1_ring_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuditLogRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditLogRequest) ProtoMessage() {}
+
+func (x *AuditLogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orbis_ring_v1alpha1_ring_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditLogRequest.ProtoReflect.Descriptor instead.
+func (*AuditLogRequest) Descriptor() ([]byte, []int) {
+	return file_orbis_ring_v1alpha1_ring_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *AuditLogRequest) GetRingId() string {
+	if x != nil {
+		return x.RingId
+	}
+	return ""
+}
+
+func (x *AuditLogRequest) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *AuditLogRequest) GetSecretId() string {
+	if x != nil {
+		return x.SecretId
+	}
+	return ""
+}
+
+type AuditLogResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Records    []*v1alpha1.Record `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`
+	ChainValid bool               `protobuf:"varint,2,opt,name=chain_valid,json=chainValid,proto3" json:"chain_valid,omitempty"` // whether the full ring chain verified
+}
+
+func (x *AuditLogResponse) Reset() {
+	*x = AuditLogResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_orbis_ring_v1alpha1_ring_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuditLogResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditLogResponse) ProtoMessage() {}
+
+func (x *AuditLogResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_orbis_ring_v1alpha1_ring_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditLogResponse.ProtoReflect.Descriptor instead.
+func (*AuditLogResponse) Descriptor() ([]byte, []int) {
+	return file_orbis_ring_v1alpha1_ring_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *AuditLogResponse) GetRecords() []*v1alpha1.Record {
+	if x != nil {
+		return x.Records
+	}
+	return nil
+}
+
+func (x *AuditLogResponse) GetChainValid() bool {
+	if x != nil {
+		return x.ChainValid
+	}
+	return false
+}
+
+type WriteRelationshipRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RingId       string                  `protobuf:"bytes,1,opt,name=ring_id,json=ringId,proto3" json:"ring_id,omitempty"`
+	Relationship *v1alpha11.Relationship `protobuf:"bytes,2,opt,name=relationship,proto3" json:"relationship,omitempty"`
+}
+
+func (x *WriteRelationshipRequest) Reset() {
+	*x = WriteRelationshipRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_orbis_ring_v1alpha1_ring_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WriteRelationshipRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WriteRelationshipRequest) ProtoMessage() {}
+
+func (x *WriteRelationshipRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orbis_ring_v1alpha1_ring_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WriteRelationshipRequest.ProtoReflect.Descriptor instead.
+func (*WriteRelationshipRequest) Descriptor() ([]byte, []int) {
+	return file_orbis_ring_v1alpha1_ring_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *WriteRelationshipRequest) GetRingId() string {
+	if x != nil {
+		return x.RingId
+	}
+	return ""
+}
+
+func (x *WriteRelationshipRequest) GetRelationship() *v1alpha11.Relationship {
+	if x != nil {
+		return x.Relationship
+	}
+	return nil
+}
+
+type WriteRelationshipResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *WriteRelationshipResponse) Reset() {
+	*x = WriteRelationshipResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_orbis_ring_v1alpha1_ring_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WriteRelationshipResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WriteRelationshipResponse) ProtoMessage() {}
+
+func (x *WriteRelationshipResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_orbis_ring_v1alpha1_ring_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WriteRelationshipResponse.ProtoReflect.Descriptor instead.
+func (*WriteRelationshipResponse) Descriptor() ([]byte, []int) {
+	return file_orbis_ring_v1alpha1_ring_proto_rawDescGZIP(), []int{37}
+}
+
+type DeleteRelationshipRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RingId       string                  `protobuf:"bytes,1,opt,name=ring_id,json=ringId,proto3" json:"ring_id,omitempty"`
+	Relationship *v1alpha11.Relationship `protobuf:"bytes,2,opt,name=relationship,proto3" json:"relationship,omitempty"`
+}
+
+func (x *DeleteRelationshipRequest) Reset() {
+	*x = DeleteRelationshipRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_orbis_ring_v1alpha1_ring_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteRelationshipRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRelationshipRequest) ProtoMessage() {}
+
+func (x *DeleteRelationshipRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orbis_ring_v1alpha1_ring_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRelationshipRequest.ProtoReflect.Descriptor instead.
+func (*DeleteRelationshipRequest) Descriptor() ([]byte, []int) {
+	return file_orbis_ring_v1alpha1_ring_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *DeleteRelationshipRequest) GetRingId() string {
+	if x != nil {
+		return x.RingId
+	}
+	return ""
+}
+
+func (x *DeleteRelationshipRequest) GetRelationship() *v1alpha11.Relationship {
+	if x != nil {
+		return x.Relationship
+	}
+	return nil
+}
+
+type DeleteRelationshipResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteRelationshipResponse) Reset() {
+	*x = DeleteRelationshipResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_orbis_ring_v1alpha1_ring_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteRelationshipResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRelationshipResponse) ProtoMessage() {}
+
+func (x *DeleteRelationshipResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_orbis_ring_v1alpha1_ring_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRelationshipResponse.ProtoReflect.Descriptor instead.
+func (*DeleteRelationshipResponse) Descriptor() ([]byte, []int) {
+	return file_orbis_ring_v1alpha1_ring_proto_rawDescGZIP(), []int{39}
+}
+
+type SignRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RingId     string `protobuf:"bytes,1,opt,name=ring_id,json=ringId,proto3" json:"ring_id,omitempty"`
+	Message    []byte `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Credential []byte `protobuf:"bytes,3,opt,name=credential,proto3" json:"credential,omitempty"` // requesting operator credential, forwarded between ring nodes and ignored by the API
+}
+
+func (x *SignRequest) Reset() {
+	*x = SignRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_orbis_ring_v1alpha1_ring_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SignRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignRequest) ProtoMessage() {}
+
+func (x *SignRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orbis_ring_v1alpha1_ring_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignRequest.ProtoReflect.Descriptor instead.
+func (*SignRequest) Descriptor() ([]byte, []int) {
+	return file_orbis_ring_v1alpha1_ring_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *SignRequest) GetRingId() string {
+	if x != nil {
+		return x.RingId
+	}
+	return ""
+}
+
+func (x *SignRequest) GetMessage() []byte {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+func (x *SignRequest) GetCredential() []byte {
+	if x != nil {
+		return x.Credential
+	}
+	return nil
+}
+
+type SignResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Signature []byte `protobuf:"bytes,1,opt,name=signature,proto3" json:"signature,omitempty"`
+	Scheme    string `protobuf:"bytes,2,opt,name=scheme,proto3" json:"scheme,omitempty"` // threshold signature scheme
+}
+
+func (x *SignResponse) Reset() {
+	*x = SignResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_orbis_ring_v1alpha1_ring_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SignResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignResponse) ProtoMessage() {}
+
+func (x *SignResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_orbis_ring_v1alpha1_ring_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignResponse.ProtoReflect.Descriptor instead.
+func (*SignResponse) Descriptor() ([]byte, []int) {
+	return file_orbis_ring_v1alpha1_ring_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *SignResponse) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+func (x *SignResponse) GetScheme() string {
+	if x != nil {
+		return x.Scheme
+	}
+	return ""
+}
+
+// PartialSignature is sent by each ring node to the node
+// requesting a signature, signed with the node DKG share.
+type PartialSignature struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RingId    string `protobuf:"bytes,1,opt,name=ring_id,json=ringId,proto3" json:"ring_id,omitempty"`
+	Signature []byte `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (x *PartialSignature) Reset() {
+	*x = PartialSignature{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_orbis_ring_v1alpha1_ring_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PartialSignature) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PartialSignature) ProtoMessage() {}
+
+func (x *PartialSignature) ProtoReflect() protoreflect.Message {
+	mi := &file_orbis_ring_v1alpha1_ring_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PartialSignature.ProtoReflect.Descriptor instead.
+func (*PartialSignature) Descriptor() ([]byte, []int) {
+	return file_orbis_ring_v1alpha1_ring_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *PartialSignature) GetRingId() string {
+	if x != nil {
+		return x.RingId
+	}
+	return ""
+}
+
+func (x *PartialSignature) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+// NonceCommitment is posted to the ring bulletin by each ring
+// node, committing to its nonces for an interactive signature.
+type NonceCommitment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RingId     string `protobuf:"bytes,1,opt,name=ring_id,json=ringId,proto3" json:"ring_id,omitempty"`
+	SessionId  string `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Index      int32  `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"` // share index of the node
+	Commitment []byte `protobuf:"bytes,4,opt,name=commitment,proto3" json:"commitment,omitempty"`
+}
+
+func (x *NonceCommitment) Reset() {
+	*x = NonceCommitment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_orbis_ring_v1alpha1_ring_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NonceCommitment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NonceCommitment) ProtoMessage() {}
+
+func (x *NonceCommitment) ProtoReflect() protoreflect.Message {
+	mi := &file_orbis_ring_v1alpha1_ring_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NonceCommitment.ProtoReflect.Descriptor instead.
+func (*NonceCommitment) Descriptor() ([]byte, []int) {
+	return file_orbis_ring_v1alpha1_ring_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *NonceCommitment) GetRingId() string {
+	if x != nil {
+		return x.RingId
+	}
+	return ""
+}
+
+func (x *NonceCommitment) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *NonceCommitment) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *NonceCommitment) GetCommitment() []byte {
+	if x != nil {
+		return x.Commitment
+	}
+	return nil
+}
+
+// SigningPackage is sent by the node requesting an interactive
+// signature to the signers, once a threshold of them committed.
+type SigningPackage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RingId      string             `protobuf:"bytes,1,opt,name=ring_id,json=ringId,proto3" json:"ring_id,omitempty"`
+	Message     []byte             `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Commitments []*NonceCommitment `protobuf:"bytes,3,rep,name=commitments,proto3" json:"commitments,omitempty"`
+}
+
+func (x *SigningPackage) Reset() {
+	*x = SigningPackage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_orbis_ring_v1alpha1_ring_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SigningPackage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SigningPackage) ProtoMessage() {}
+
+func (x *SigningPackage) ProtoReflect() protoreflect.Message {
+	mi := &file_orbis_ring_v1alpha1_ring_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SigningPackage.ProtoReflect.Descriptor instead.
+func (*SigningPackage) Descriptor() ([]byte, []int) {
+	return file_orbis_ring_v1alpha1_ring_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *SigningPackage) GetRingId() string {
 	if x != nil {
 		return x.RingId
 	}
 	return ""
 }
 
-func (x *DeleteSecretRequest) GetSecretId() string {
+func (x *SigningPackage) GetMessage() []byte {
 	if x != nil {
-		return x.SecretId
+		return x.Message
 	}
-	return ""
+	return nil
 }
 
-func (x *DeleteSecretRequest) GetAcpProof() []byte {
+func (x *SigningPackage) GetCommitments() []*NonceCommitment {
 	if x != nil {
-		return x.AcpProof
+		return x.Commitments
 	}
 	return nil
 }
 
-type ReencryptSecretRequest struct {
+type VerifyRingSignatureRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RingId   string        `protobuf:"bytes,1,opt,name=ring_id,json=ringId,proto3" json:"ring_id,omitempty"`
-	SecretId string        `protobuf:"bytes,2,opt,name=secret_id,json=secretId,proto3" json:"secret_id,omitempty"`
-	RdrPk    *pb.PublicKey `protobuf:"bytes,3,opt,name=rdr_pk,json=rdrPk,proto3" json:"rdr_pk,omitempty"`
-	AcpProof []byte        `protobuf:"bytes,4,opt,name=acp_proof,json=acpProof,proto3" json:"acp_proof,omitempty"`
+	RingId    string `protobuf:"bytes,1,opt,name=ring_id,json=ringId,proto3" json:"ring_id,omitempty"`
+	Message   []byte `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Signature []byte `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
 }
 
-func (x *ReencryptSecretRequest) Reset() {
-	*x = ReencryptSecretRequest{}
+func (x *VerifyRingSignatureRequest) Reset() {
+	*x = VerifyRingSignatureRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_orbis_ring_v1alpha1_ring_proto_msgTypes[19]
+		mi := &file_orbis_ring_v1alpha1_ring_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ReencryptSecretRequest) String() string {
+func (x *VerifyRingSignatureRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReencryptSecretRequest) ProtoMessage() {}
+func (*VerifyRingSignatureRequest) ProtoMessage() {}
 
-func (x *ReencryptSecretRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_orbis_ring_v1alpha1_ring_proto_msgTypes[19]
+func (x *VerifyRingSignatureRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orbis_ring_v1alpha1_ring_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -968,66 +2456,58 @@ func (x *ReencryptSecretRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReencryptSecretRequest.ProtoReflect.Descriptor instead.
-func (*ReencryptSecretRequest) Descriptor() ([]byte, []int) {
-	return file_orbis_ring_v1alpha1_ring_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use VerifyRingSignatureRequest.ProtoReflect.Descriptor instead.
+func (*VerifyRingSignatureRequest) Descriptor() ([]byte, []int) {
+	return file_orbis_ring_v1alpha1_ring_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *ReencryptSecretRequest) GetRingId() string {
+func (x *VerifyRingSignatureRequest) GetRingId() string {
 	if x != nil {
 		return x.RingId
 	}
 	return ""
 }
 
-func (x *ReencryptSecretRequest) GetSecretId() string {
-	if x != nil {
-		return x.SecretId
-	}
-	return ""
-}
-
-func (x *ReencryptSecretRequest) GetRdrPk() *pb.PublicKey {
+func (x *VerifyRingSignatureRequest) GetMessage() []byte {
 	if x != nil {
-		return x.RdrPk
+		return x.Message
 	}
 	return nil
 }
 
-func (x *ReencryptSecretRequest) GetAcpProof() []byte {
+func (x *VerifyRingSignatureRequest) GetSignature() []byte {
 	if x != nil {
-		return x.AcpProof
+		return x.Signature
 	}
 	return nil
 }
 
-// Reencryption commitment recovered from verified secret shares, and encrypted secret
-type ReencryptSecretResponse struct {
+type VerifyRingSignatureResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	XncCmt  []byte   `protobuf:"bytes,1,opt,name=xnc_cmt,json=xncCmt,proto3" json:"xnc_cmt,omitempty"`    // reencryption commitment
-	EncScrt [][]byte `protobuf:"bytes,2,rep,name=enc_scrt,json=encScrt,proto3" json:"enc_scrt,omitempty"` // enncrypted secret
+	Valid  bool   `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	Scheme string `protobuf:"bytes,2,opt,name=scheme,proto3" json:"scheme,omitempty"` // threshold signature scheme
 }
 
-func (x *ReencryptSecretResponse) Reset() {
-	*x = ReencryptSecretResponse{}
+func (x *VerifyRingSignatureResponse) Reset() {
+	*x = VerifyRingSignatureResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_orbis_ring_v1alpha1_ring_proto_msgTypes[20]
+		mi := &file_orbis_ring_v1alpha1_ring_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ReencryptSecretResponse) String() string {
+func (x *VerifyRingSignatureResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReencryptSecretResponse) ProtoMessage() {}
+func (*VerifyRingSignatureResponse) ProtoMessage() {}
 
-func (x *ReencryptSecretResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_orbis_ring_v1alpha1_ring_proto_msgTypes[20]
+func (x *VerifyRingSignatureResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_orbis_ring_v1alpha1_ring_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1038,23 +2518,23 @@ func (x *ReencryptSecretResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReencryptSecretResponse.ProtoReflect.Descriptor instead.
-func (*ReencryptSecretResponse) Descriptor() ([]byte, []int) {
-	return file_orbis_ring_v1alpha1_ring_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use VerifyRingSignatureResponse.ProtoReflect.Descriptor instead.
+func (*VerifyRingSignatureResponse) Descriptor() ([]byte, []int) {
+	return file_orbis_ring_v1alpha1_ring_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *ReencryptSecretResponse) GetXncCmt() []byte {
+func (x *VerifyRingSignatureResponse) GetValid() bool {
 	if x != nil {
-		return x.XncCmt
+		return x.Valid
 	}
-	return nil
+	return false
 }
 
-func (x *ReencryptSecretResponse) GetEncScrt() [][]byte {
+func (x *VerifyRingSignatureResponse) GetScheme() string {
 	if x != nil {
-		return x.EncScrt
+		return x.Scheme
 	}
-	return nil
+	return ""
 }
 
 type Secret struct {
@@ -1062,15 +2542,19 @@ type Secret struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	EncCmt   []byte   `protobuf:"bytes,1,opt,name=enc_cmt,json=encCmt,proto3" json:"enc_cmt,omitempty"`       // encryption commitment
-	EncScrt  [][]byte `protobuf:"bytes,2,rep,name=enc_scrt,json=encScrt,proto3" json:"enc_scrt,omitempty"`    // enncrypted secret
-	AuthzCtx string   `protobuf:"bytes,3,opt,name=authz_ctx,json=authzCtx,proto3" json:"authz_ctx,omitempty"` // authorization context
+	EncCmt     []byte   `protobuf:"bytes,1,opt,name=enc_cmt,json=encCmt,proto3" json:"enc_cmt,omitempty"`               // encryption commitment
+	EncScrt    [][]byte `protobuf:"bytes,2,rep,name=enc_scrt,json=encScrt,proto3" json:"enc_scrt,omitempty"`            // enncrypted secret
+	AuthzCtx   string   `protobuf:"bytes,3,opt,name=authz_ctx,json=authzCtx,proto3" json:"authz_ctx,omitempty"`         // authorization context
+	Dem        string   `protobuf:"bytes,4,opt,name=dem,proto3" json:"dem,omitempty"`                                   // data encapsulation mechanism, empty if enc_scrt is the secret itself
+	EncDataCid string   `protobuf:"bytes,5,opt,name=enc_data_cid,json=encDataCid,proto3" json:"enc_data_cid,omitempty"` // content id of the DEM ciphertext
+	EncData    []byte   `protobuf:"bytes,6,opt,name=enc_data,json=encData,proto3" json:"enc_data,omitempty"`            // DEM ciphertext, moved to the content store when stored
+	Owner      string   `protobuf:"bytes,7,opt,name=owner,proto3" json:"owner,omitempty"`                               // DID of the subject which stored the secret, set by the ring
 }
 
 func (x *Secret) Reset() {
 	*x = Secret{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_orbis_ring_v1alpha1_ring_proto_msgTypes[21]
+		mi := &file_orbis_ring_v1alpha1_ring_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1083,7 +2567,7 @@ func (x *Secret) String() string {
 func (*Secret) ProtoMessage() {}
 
 func (x *Secret) ProtoReflect() protoreflect.Message {
-	mi := &file_orbis_ring_v1alpha1_ring_proto_msgTypes[21]
+	mi := &file_orbis_ring_v1alpha1_ring_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1096,7 +2580,7 @@ func (x *Secret) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Secret.ProtoReflect.Descriptor instead.
 func (*Secret) Descriptor() ([]byte, []int) {
-	return file_orbis_ring_v1alpha1_ring_proto_rawDescGZIP(), []int{21}
+	return file_orbis_ring_v1alpha1_ring_proto_rawDescGZIP(), []int{47}
 }
 
 func (x *Secret) GetEncCmt() []byte {
@@ -1120,6 +2604,34 @@ func (x *Secret) GetAuthzCtx() string {
 	return ""
 }
 
+func (x *Secret) GetDem() string {
+	if x != nil {
+		return x.Dem
+	}
+	return ""
+}
+
+func (x *Secret) GetEncDataCid() string {
+	if x != nil {
+		return x.EncDataCid
+	}
+	return ""
+}
+
+func (x *Secret) GetEncData() []byte {
+	if x != nil {
+		return x.EncData
+	}
+	return nil
+}
+
+func (x *Secret) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
 type ReencryptedSecretShare struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1137,7 +2649,7 @@ type ReencryptedSecretShare struct {
 func (x *ReencryptedSecretShare) Reset() {
 	*x = ReencryptedSecretShare{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_orbis_ring_v1alpha1_ring_proto_msgTypes[22]
+		mi := &file_orbis_ring_v1alpha1_ring_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1150,7 +2662,7 @@ func (x *ReencryptedSecretShare) String() string {
 func (*ReencryptedSecretShare) ProtoMessage() {}
 
 func (x *ReencryptedSecretShare) ProtoReflect() protoreflect.Message {
-	mi := &file_orbis_ring_v1alpha1_ring_proto_msgTypes[22]
+	mi := &file_orbis_ring_v1alpha1_ring_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1163,7 +2675,7 @@ func (x *ReencryptedSecretShare) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReencryptedSecretShare.ProtoReflect.Descriptor instead.
 func (*ReencryptedSecretShare) Descriptor() ([]byte, []int) {
-	return file_orbis_ring_v1alpha1_ring_proto_rawDescGZIP(), []int{22}
+	return file_orbis_ring_v1alpha1_ring_proto_rawDescGZIP(), []int{48}
 }
 
 func (x *ReencryptedSecretShare) GetRingId() string {
@@ -1215,6 +2727,95 @@ func (x *ReencryptedSecretShare) GetProofi() []byte {
 	return nil
 }
 
+// DecryptedSecretShare is sent by each ring node to the node
+// requesting a threshold decryption.
+type DecryptedSecretShare struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RingId   string `protobuf:"bytes,1,opt,name=ring_id,json=ringId,proto3" json:"ring_id,omitempty"`
+	SecretId string `protobuf:"bytes,2,opt,name=secret_id,json=secretId,proto3" json:"secret_id,omitempty"`
+	Index    int32  `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`
+	DecSki   []byte `protobuf:"bytes,4,opt,name=dec_ski,json=decSki,proto3" json:"dec_ski,omitempty"` // partial decryption
+	Chlgi    []byte `protobuf:"bytes,5,opt,name=chlgi,proto3" json:"chlgi,omitempty"`                 // challenge
+	Proofi   []byte `protobuf:"bytes,6,opt,name=proofi,proto3" json:"proofi,omitempty"`               // proof
+}
+
+func (x *DecryptedSecretShare) Reset() {
+	*x = DecryptedSecretShare{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_orbis_ring_v1alpha1_ring_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DecryptedSecretShare) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecryptedSecretShare) ProtoMessage() {}
+
+func (x *DecryptedSecretShare) ProtoReflect() protoreflect.Message {
+	mi := &file_orbis_ring_v1alpha1_ring_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DecryptedSecretShare.ProtoReflect.Descriptor instead.
+func (*DecryptedSecretShare) Descriptor() ([]byte, []int) {
+	return file_orbis_ring_v1alpha1_ring_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *DecryptedSecretShare) GetRingId() string {
+	if x != nil {
+		return x.RingId
+	}
+	return ""
+}
+
+func (x *DecryptedSecretShare) GetSecretId() string {
+	if x != nil {
+		return x.SecretId
+	}
+	return ""
+}
+
+func (x *DecryptedSecretShare) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *DecryptedSecretShare) GetDecSki() []byte {
+	if x != nil {
+		return x.DecSki
+	}
+	return nil
+}
+
+func (x *DecryptedSecretShare) GetChlgi() []byte {
+	if x != nil {
+		return x.Chlgi
+	}
+	return nil
+}
+
+func (x *DecryptedSecretShare) GetProofi() []byte {
+	if x != nil {
+		return x.Proofi
+	}
+	return nil
+}
+
 type Ring struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1227,7 +2828,7 @@ type Ring struct {
 func (x *Ring) Reset() {
 	*x = Ring{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_orbis_ring_v1alpha1_ring_proto_msgTypes[23]
+		mi := &file_orbis_ring_v1alpha1_ring_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1240,7 +2841,7 @@ func (x *Ring) String() string {
 func (*Ring) ProtoMessage() {}
 
 func (x *Ring) ProtoReflect() protoreflect.Message {
-	mi := &file_orbis_ring_v1alpha1_ring_proto_msgTypes[23]
+	mi := &file_orbis_ring_v1alpha1_ring_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1253,7 +2854,7 @@ func (x *Ring) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Ring.ProtoReflect.Descriptor instead.
 func (*Ring) Descriptor() ([]byte, []int) {
-	return file_orbis_ring_v1alpha1_ring_proto_rawDescGZIP(), []int{23}
+	return file_orbis_ring_v1alpha1_ring_proto_rawDescGZIP(), []int{50}
 }
 
 func (x *Ring) GetId() string {
@@ -1290,7 +2891,7 @@ type Manifest struct {
 func (x *Manifest) Reset() {
 	*x = Manifest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_orbis_ring_v1alpha1_ring_proto_msgTypes[24]
+		mi := &file_orbis_ring_v1alpha1_ring_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1303,7 +2904,7 @@ func (x *Manifest) String() string {
 func (*Manifest) ProtoMessage() {}
 
 func (x *Manifest) ProtoReflect() protoreflect.Message {
-	mi := &file_orbis_ring_v1alpha1_ring_proto_msgTypes[24]
+	mi := &file_orbis_ring_v1alpha1_ring_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1316,7 +2917,7 @@ func (x *Manifest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Manifest.ProtoReflect.Descriptor instead.
 func (*Manifest) Descriptor() ([]byte, []int) {
-	return file_orbis_ring_v1alpha1_ring_proto_rawDescGZIP(), []int{24}
+	return file_orbis_ring_v1alpha1_ring_proto_rawDescGZIP(), []int{51}
 }
 
 func (x *Manifest) GetN() int32 {
@@ -1394,15 +2995,17 @@ type Node struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id        string        `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Address   string        `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"` // multiaddress
-	PublicKey *pb.PublicKey `protobuf:"bytes,3,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	Id                  string        `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Address             string        `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"` // multiaddress
+	PublicKey           *pb.PublicKey `protobuf:"bytes,3,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	PairingKey          *pb.PublicKey `protobuf:"bytes,4,opt,name=pairing_key,json=pairingKey,proto3" json:"pairing_key,omitempty"`                              // long term key of the node in pairing rings
+	PairingKeySignature []byte        `protobuf:"bytes,5,opt,name=pairing_key_signature,json=pairingKeySignature,proto3" json:"pairing_key_signature,omitempty"` // signature of the pairing key by the node identity key
 }
 
 func (x *Node) Reset() {
 	*x = Node{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_orbis_ring_v1alpha1_ring_proto_msgTypes[25]
+		mi := &file_orbis_ring_v1alpha1_ring_proto_msgTypes[52]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1415,7 +3018,7 @@ func (x *Node) String() string {
 func (*Node) ProtoMessage() {}
 
 func (x *Node) ProtoReflect() protoreflect.Message {
-	mi := &file_orbis_ring_v1alpha1_ring_proto_msgTypes[25]
+	mi := &file_orbis_ring_v1alpha1_ring_proto_msgTypes[52]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1428,7 +3031,7 @@ func (x *Node) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Node.ProtoReflect.Descriptor instead.
 func (*Node) Descriptor() ([]byte, []int) {
-	return file_orbis_ring_v1alpha1_ring_proto_rawDescGZIP(), []int{25}
+	return file_orbis_ring_v1alpha1_ring_proto_rawDescGZIP(), []int{52}
 }
 
 func (x *Node) GetId() string {
@@ -1452,6 +3055,20 @@ func (x *Node) GetPublicKey() *pb.PublicKey {
 	return nil
 }
 
+func (x *Node) GetPairingKey() *pb.PublicKey {
+	if x != nil {
+		return x.PairingKey
+	}
+	return nil
+}
+
+func (x *Node) GetPairingKeySignature() []byte {
+	if x != nil {
+		return x.PairingKeySignature
+	}
+	return nil
+}
+
 var File_orbis_ring_v1alpha1_ring_proto protoreflect.FileDescriptor
 
 var file_orbis_ring_v1alpha1_ring_proto_rawDesc = []byte{
@@ -1463,245 +3080,525 @@ var file_orbis_ring_v1alpha1_ring_proto_rawDesc = []byte{
 	0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x1a, 0x1d, 0x6c, 0x69, 0x62, 0x70, 0x32, 0x70, 0x2f, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2f,
-	0x76, 0x31, 0x2f, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
-	0x12, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x22, 0x44, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x69, 0x6e, 0x67, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x05, 0x72, 0x69, 0x6e, 0x67,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6f, 0x72, 0x62, 0x69, 0x73, 0x2e,
-	0x72, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x69,
-	0x6e, 0x67, 0x52, 0x05, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x4e, 0x0a, 0x11, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x52, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x39,
+	0x76, 0x31, 0x2f, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x20, 0x6f, 0x72, 0x62, 0x69, 0x73, 0x2f, 0x61, 0x75, 0x64, 0x69, 0x74, 0x2f, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x61, 0x75, 0x64, 0x69, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x20, 0x6f, 0x72, 0x62, 0x69, 0x73, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x7a, 0x2f, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x7a, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x22, 0x12, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x69, 0x6e, 0x67, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x44, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x05,
+	0x72, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6f, 0x72,
+	0x62, 0x69, 0x73, 0x2e, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x52, 0x69, 0x6e, 0x67, 0x52, 0x05, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x4e, 0x0a,
+	0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x39, 0x0a, 0x08, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6f, 0x72, 0x62, 0x69, 0x73, 0x2e, 0x72, 0x69, 0x6e,
+	0x67, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x61, 0x6e, 0x69, 0x66,
+	0x65, 0x73, 0x74, 0x52, 0x08, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x22, 0x24, 0x0a,
+	0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x22, 0x54, 0x0a, 0x17, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x4d,
+	0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x39,
 	0x0a, 0x08, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
 	0x32, 0x1d, 0x2e, 0x6f, 0x72, 0x62, 0x69, 0x73, 0x2e, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31,
 	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x52,
-	0x08, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x22, 0x24, 0x0a, 0x12, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x52, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x08, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x22, 0x91, 0x01, 0x0a, 0x18, 0x56, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07,
+	0x72, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72,
+	0x69, 0x6e, 0x67, 0x49, 0x64, 0x12, 0x46, 0x0a, 0x0a, 0x76, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6f, 0x72, 0x62, 0x69,
+	0x73, 0x2e, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x56, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x0a, 0x76, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x4b, 0x0a,
+	0x11, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x56, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x4f, 0x0a, 0x12, 0x50, 0x72,
+	0x6f, 0x70, 0x6f, 0x73, 0x65, 0x52, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x39, 0x0a, 0x08, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6f, 0x72, 0x62, 0x69, 0x73, 0x2e, 0x72, 0x69, 0x6e, 0x67, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73,
+	0x74, 0x52, 0x08, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x22, 0x2e, 0x0a, 0x13, 0x50,
+	0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x52, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x22, 0x16, 0x0a, 0x14, 0x4c,
+	0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x22, 0x58, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x6f,
+	0x73, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x09,
+	0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x21, 0x2e, 0x6f, 0x72, 0x62, 0x69, 0x73, 0x2e, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73,
+	0x61, 0x6c, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x22, 0x31, 0x0a,
+	0x16, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x69, 0x6e, 0x67, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x69, 0x6e, 0x67, 0x49, 0x64,
+	0x22, 0x58, 0x0a, 0x17, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x50, 0x72, 0x6f, 0x70, 0x6f,
+	0x73, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x08, 0x70,
+	0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e,
+	0x6f, 0x72, 0x62, 0x69, 0x73, 0x2e, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x52, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c,
+	0x52, 0x08, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x22, 0xaa, 0x01, 0x0a, 0x0c, 0x52,
+	0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x12, 0x17, 0x0a, 0x07, 0x72,
+	0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x69,
+	0x6e, 0x67, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x08, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6f, 0x72, 0x62, 0x69, 0x73, 0x2e, 0x72,
+	0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x61, 0x6e,
+	0x69, 0x66, 0x65, 0x73, 0x74, 0x52, 0x08, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x12,
+	0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x61,
+	0x63, 0x6b, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x61, 0x63, 0x6b, 0x73, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x43, 0x0a, 0x0f, 0x52, 0x69, 0x6e, 0x67, 0x50,
+	0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x41, 0x63, 0x6b, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x69,
+	0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x69, 0x6e,
+	0x67, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x22, 0x20, 0x0a, 0x0e,
+	0x47, 0x65, 0x74, 0x52, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x40,
+	0x0a, 0x0f, 0x47, 0x65, 0x74, 0x52, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x2d, 0x0a, 0x04, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x6f, 0x72, 0x62, 0x69, 0x73, 0x2e, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x69, 0x6e, 0x67, 0x52, 0x04, 0x72, 0x69, 0x6e, 0x67,
+	0x22, 0x23, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x69, 0x6e, 0x67, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x20, 0x0a, 0x0e, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x22, 0x0a, 0x10, 0x50, 0x75, 0x62, 0x6c, 0x69,
+	0x63, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x4f, 0x0a, 0x11, 0x50,
+	0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x3a, 0x0a, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6c, 0x69, 0x62, 0x70, 0x32, 0x70, 0x2e, 0x63, 0x72,
+	0x79, 0x70, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65,
+	0x79, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x22, 0x11, 0x0a, 0x0f,
+	0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x1e, 0x0a, 0x0c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
 	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22,
-	0x20, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x52, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
-	0x64, 0x22, 0x40, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x52, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x04, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6f, 0x72, 0x62, 0x69, 0x73, 0x2e, 0x72, 0x69, 0x6e, 0x67, 0x2e,
-	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x69, 0x6e, 0x67, 0x52, 0x04, 0x72,
-	0x69, 0x6e, 0x67, 0x22, 0x23, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x69, 0x6e,
-	0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x20, 0x0a, 0x0e, 0x52, 0x65, 0x66, 0x72,
-	0x65, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x22, 0x0a, 0x10, 0x50, 0x75,
-	0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x4f,
-	0x0a, 0x11, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65,
-	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6c, 0x69, 0x62, 0x70, 0x32, 0x70,
-	0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69,
-	0x63, 0x4b, 0x65, 0x79, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x22,
-	0x11, 0x0a, 0x0f, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x1e, 0x0a, 0x0c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
-	0x69, 0x64, 0x22, 0x4e, 0x0a, 0x0d, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x08, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6f, 0x72, 0x62, 0x69, 0x73, 0x2e, 0x72, 0x69,
-	0x6e, 0x67, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x08, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x73, 0x22, 0x38, 0x0a, 0x0c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61,
-	0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x22, 0x2d, 0x0a, 0x12,
-	0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x22, 0x4c, 0x0a, 0x13, 0x4c,
-	0x69, 0x73, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x35, 0x0a, 0x07, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6f, 0x72, 0x62, 0x69, 0x73, 0x2e, 0x72, 0x69, 0x6e, 0x67,
-	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74,
-	0x52, 0x07, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x22, 0x62, 0x0a, 0x12, 0x53, 0x74, 0x6f,
-	0x72, 0x65, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x4e, 0x0a, 0x0d, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x3d, 0x0a, 0x08, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6f, 0x72, 0x62, 0x69, 0x73, 0x2e, 0x72, 0x69, 0x6e, 0x67, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x08, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x22,
+	0x38, 0x0a, 0x0c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x22, 0x2d, 0x0a, 0x12, 0x4c, 0x69, 0x73,
+	0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
 	0x17, 0x0a, 0x07, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x72, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x12, 0x33, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72,
-	0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6f, 0x72, 0x62, 0x69, 0x73,
-	0x2e, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53,
-	0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x22, 0x32, 0x0a,
-	0x13, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x49,
-	0x64, 0x22, 0x68, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x63, 0x72, 0x65,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x69, 0x6e, 0x67,
-	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x69, 0x6e, 0x67, 0x49,
-	0x64, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x49, 0x64, 0x12, 0x1b,
-	0x0a, 0x09, 0x61, 0x63, 0x70, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x08, 0x61, 0x63, 0x70, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x22, 0x9f, 0x01, 0x0a, 0x16,
-	0x52, 0x65, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x12,
-	0x1b, 0x0a, 0x09, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x49, 0x64, 0x12, 0x32, 0x0a, 0x06,
-	0x72, 0x64, 0x72, 0x5f, 0x70, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6c,
-	0x69, 0x62, 0x70, 0x32, 0x70, 0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e,
-	0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x52, 0x05, 0x72, 0x64, 0x72, 0x50, 0x6b,
-	0x12, 0x1b, 0x0a, 0x09, 0x61, 0x63, 0x70, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x08, 0x61, 0x63, 0x70, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x22, 0x4d, 0x0a,
-	0x17, 0x52, 0x65, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x78, 0x6e, 0x63, 0x5f,
-	0x63, 0x6d, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x78, 0x6e, 0x63, 0x43, 0x6d,
-	0x74, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x63, 0x5f, 0x73, 0x63, 0x72, 0x74, 0x18, 0x02, 0x20,
-	0x03, 0x28, 0x0c, 0x52, 0x07, 0x65, 0x6e, 0x63, 0x53, 0x63, 0x72, 0x74, 0x22, 0x59, 0x0a, 0x06,
-	0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x65, 0x6e, 0x63, 0x5f, 0x63, 0x6d,
-	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x65, 0x6e, 0x63, 0x43, 0x6d, 0x74, 0x12,
-	0x19, 0x0a, 0x08, 0x65, 0x6e, 0x63, 0x5f, 0x73, 0x63, 0x72, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28,
-	0x0c, 0x52, 0x07, 0x65, 0x6e, 0x63, 0x53, 0x63, 0x72, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x75,
-	0x74, 0x68, 0x7a, 0x5f, 0x63, 0x74, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61,
-	0x75, 0x74, 0x68, 0x7a, 0x43, 0x74, 0x78, 0x22, 0xdf, 0x01, 0x0a, 0x16, 0x52, 0x65, 0x65, 0x6e,
-	0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x53, 0x68, 0x61,
-	0x72, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x52, 0x06, 0x72, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x22, 0x6b, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74,
+	0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x35, 0x0a, 0x07, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x6f, 0x72, 0x62, 0x69, 0x73, 0x2e, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x07, 0x73,
+	0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74,
+	0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x63, 0x72,
+	0x65, 0x74, 0x49, 0x64, 0x73, 0x22, 0x62, 0x0a, 0x12, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x53, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x72,
+	0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x69,
+	0x6e, 0x67, 0x49, 0x64, 0x12, 0x33, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6f, 0x72, 0x62, 0x69, 0x73, 0x2e, 0x72, 0x69, 0x6e,
+	0x67, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65,
+	0x74, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x22, 0x32, 0x0a, 0x13, 0x53, 0x74, 0x6f,
+	0x72, 0x65, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x1b, 0x0a, 0x09, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x49, 0x64, 0x22, 0x68, 0x0a,
+	0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x12, 0x1b, 0x0a,
+	0x09, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x63,
+	0x70, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x61,
+	0x63, 0x70, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x22, 0xb9, 0x01, 0x0a, 0x16, 0x52, 0x65, 0x65, 0x6e,
+	0x63, 0x72, 0x79, 0x70, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x73,
 	0x65, 0x63, 0x72, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
 	0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x49, 0x64, 0x12, 0x32, 0x0a, 0x06, 0x72, 0x64, 0x72, 0x5f,
 	0x70, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6c, 0x69, 0x62, 0x70, 0x32,
 	0x70, 0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x62, 0x6c,
-	0x69, 0x63, 0x4b, 0x65, 0x79, 0x52, 0x05, 0x72, 0x64, 0x72, 0x50, 0x6b, 0x12, 0x14, 0x0a, 0x05,
-	0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x69, 0x6e, 0x64,
-	0x65, 0x78, 0x12, 0x17, 0x0a, 0x07, 0x78, 0x6e, 0x63, 0x5f, 0x73, 0x6b, 0x69, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x06, 0x78, 0x6e, 0x63, 0x53, 0x6b, 0x69, 0x12, 0x14, 0x0a, 0x05, 0x63,
-	0x68, 0x6c, 0x67, 0x69, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x63, 0x68, 0x6c, 0x67,
-	0x69, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x69, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x06, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x69, 0x22, 0x51, 0x0a, 0x04, 0x52, 0x69, 0x6e,
-	0x67, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
-	0x64, 0x12, 0x39, 0x0a, 0x08, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6f, 0x72, 0x62, 0x69, 0x73, 0x2e, 0x72, 0x69, 0x6e, 0x67,
-	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65,
-	0x73, 0x74, 0x52, 0x08, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x22, 0x95, 0x02, 0x0a,
-	0x08, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x12, 0x0c, 0x0a, 0x01, 0x6e, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x6e, 0x12, 0x0c, 0x0a, 0x01, 0x74, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x01, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x6b, 0x67, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x03, 0x64, 0x6b, 0x67, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x73, 0x73, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x70, 0x73, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x72, 0x65,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x70, 0x72, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x62,
-	0x75, 0x6c, 0x6c, 0x65, 0x74, 0x69, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x62,
-	0x75, 0x6c, 0x6c, 0x65, 0x74, 0x69, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73,
-	0x70, 0x6f, 0x72, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x72, 0x61, 0x6e,
-	0x73, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x2f, 0x0a, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x08,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6f, 0x72, 0x62, 0x69, 0x73, 0x2e, 0x72, 0x69, 0x6e,
-	0x67, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52,
-	0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
-	0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x61,
-	0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x0e,
-	0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x22, 0x6c, 0x0a, 0x04, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x0e, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07,
-	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x3a, 0x0a, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63,
-	0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6c, 0x69, 0x62,
-	0x70, 0x32, 0x70, 0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75,
-	0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b,
-	0x65, 0x79, 0x32, 0xb4, 0x0b, 0x0a, 0x0b, 0x52, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x12, 0x73, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x69, 0x6e, 0x67, 0x73, 0x12,
-	0x25, 0x2e, 0x6f, 0x72, 0x62, 0x69, 0x73, 0x2e, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x61,
-	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x69, 0x6e, 0x67, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x6f, 0x72, 0x62, 0x69, 0x73, 0x2e, 0x72,
-	0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73,
-	0x74, 0x52, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x17,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11, 0x12, 0x0f, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
-	0x31, 0x2f, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x72, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x52, 0x69,
-	0x6e, 0x67, 0x12, 0x23, 0x2e, 0x6f, 0x72, 0x62, 0x69, 0x73, 0x2e, 0x72, 0x69, 0x6e, 0x67, 0x2e,
-	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x69, 0x6e, 0x67,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x6f, 0x72, 0x62, 0x69, 0x73, 0x2e,
-	0x72, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65,
-	0x74, 0x52, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1c, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x16, 0x12, 0x14, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
-	0x2f, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x79, 0x0a, 0x0a, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x69, 0x6e, 0x67, 0x12, 0x26, 0x2e, 0x6f, 0x72, 0x62, 0x69,
-	0x73, 0x2e, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x27, 0x2e, 0x6f, 0x72, 0x62, 0x69, 0x73, 0x2e, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x76,
-	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x69,
-	0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x14, 0x3a, 0x01, 0x2a, 0x22, 0x0f, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
-	0x2f, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x6a, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x52, 0x69, 0x6e, 0x67, 0x12, 0x26, 0x2e, 0x6f, 0x72, 0x62, 0x69, 0x73, 0x2e, 0x72, 0x69, 0x6e,
-	0x67, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x52, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
-	0x6d, 0x70, 0x74, 0x79, 0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x2a, 0x14, 0x2f, 0x76,
-	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x2f, 0x7b, 0x69,
-	0x64, 0x7d, 0x12, 0x83, 0x01, 0x0a, 0x09, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79,
-	0x12, 0x25, 0x2e, 0x6f, 0x72, 0x62, 0x69, 0x73, 0x2e, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31,
-	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x6f, 0x72, 0x62, 0x69, 0x73, 0x2e,
-	0x72, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x75,
-	0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x12, 0x1f, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
-	0x61, 0x31, 0x2f, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x70, 0x75,
-	0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x12, 0x7a, 0x0a, 0x07, 0x52, 0x65, 0x66, 0x72,
-	0x65, 0x73, 0x68, 0x12, 0x23, 0x2e, 0x6f, 0x72, 0x62, 0x69, 0x73, 0x2e, 0x72, 0x69, 0x6e, 0x67,
+	0x69, 0x63, 0x4b, 0x65, 0x79, 0x52, 0x05, 0x72, 0x64, 0x72, 0x50, 0x6b, 0x12, 0x1b, 0x0a, 0x09,
+	0x61, 0x63, 0x70, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x08, 0x61, 0x63, 0x70, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x75, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x22, 0x93, 0x01, 0x0a, 0x17, 0x52, 0x65, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70,
+	0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x17, 0x0a, 0x07, 0x78, 0x6e, 0x63, 0x5f, 0x63, 0x6d, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x06, 0x78, 0x6e, 0x63, 0x43, 0x6d, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x63, 0x5f,
+	0x73, 0x63, 0x72, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x07, 0x65, 0x6e, 0x63, 0x53,
+	0x63, 0x72, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x63, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x65, 0x6e, 0x63, 0x44, 0x61, 0x74, 0x61, 0x12, 0x10,
+	0x0a, 0x03, 0x64, 0x65, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x64, 0x65, 0x6d,
+	0x12, 0x17, 0x0a, 0x07, 0x65, 0x6e, 0x63, 0x5f, 0x63, 0x6d, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x06, 0x65, 0x6e, 0x63, 0x43, 0x6d, 0x74, 0x22, 0xa3, 0x01, 0x0a, 0x14, 0x44, 0x65,
+	0x63, 0x72, 0x79, 0x70, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x73,
+	0x65, 0x63, 0x72, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x63, 0x70, 0x5f,
+	0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x61, 0x63, 0x70,
+	0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12,
+	0x1e, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x22,
+	0x2f, 0x0a, 0x15, 0x44, 0x65, 0x63, 0x72, 0x79, 0x70, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72,
+	0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74,
+	0x22, 0x61, 0x0a, 0x0f, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07,
+	0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73,
+	0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x63, 0x72, 0x65,
+	0x74, 0x49, 0x64, 0x22, 0x6b, 0x0a, 0x10, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6f, 0x72, 0x62, 0x69, 0x73,
+	0x2e, 0x61, 0x75, 0x64, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x12,
+	0x1f, 0x0a, 0x0b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x22, 0x7b, 0x0a, 0x18, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x68, 0x69, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07,
+	0x72, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72,
+	0x69, 0x6e, 0x67, 0x49, 0x64, 0x12, 0x46, 0x0a, 0x0c, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x68, 0x69, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6f, 0x72,
+	0x62, 0x69, 0x73, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x7a, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2e, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x52,
+	0x0c, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x22, 0x1b, 0x0a,
+	0x19, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68,
+	0x69, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x7c, 0x0a, 0x19, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x69, 0x6e, 0x67, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x69, 0x6e, 0x67, 0x49, 0x64,
+	0x12, 0x46, 0x0a, 0x0c, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6f, 0x72, 0x62, 0x69, 0x73, 0x2e, 0x61,
+	0x75, 0x74, 0x68, 0x7a, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x65,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x52, 0x0c, 0x72, 0x65, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x22, 0x1c, 0x0a, 0x1a, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x60, 0x0a, 0x0b, 0x53, 0x69, 0x67, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x12, 0x18,
+	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x63, 0x72,
+	0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x22, 0x44, 0x0a, 0x0c, 0x53, 0x69, 0x67, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67,
+	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x22, 0x49,
+	0x0a, 0x10, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73,
+	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09,
+	0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x7f, 0x0a, 0x0f, 0x4e, 0x6f, 0x6e,
+	0x63, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x17, 0x0a, 0x07,
+	0x72, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72,
+	0x69, 0x6e, 0x67, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a,
+	0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x8b, 0x01, 0x0a, 0x0e, 0x53,
+	0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x12, 0x17, 0x0a,
+	0x07, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x72, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x46, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6f, 0x72, 0x62, 0x69, 0x73, 0x2e, 0x72, 0x69,
+	0x6e, 0x67, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4e, 0x6f, 0x6e, 0x63,
+	0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x63, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x6d, 0x0a, 0x1a, 0x56, 0x65, 0x72, 0x69,
+	0x66, 0x79, 0x52, 0x69, 0x6e, 0x67, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x12,
+	0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67,
+	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69,
+	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x4b, 0x0a, 0x1b, 0x56, 0x65, 0x72, 0x69, 0x66,
+	0x79, 0x52, 0x69, 0x6e, 0x67, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x63,
+	0x68, 0x65, 0x6d, 0x65, 0x22, 0xbe, 0x01, 0x0a, 0x06, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12,
+	0x17, 0x0a, 0x07, 0x65, 0x6e, 0x63, 0x5f, 0x63, 0x6d, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x06, 0x65, 0x6e, 0x63, 0x43, 0x6d, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x63, 0x5f,
+	0x73, 0x63, 0x72, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x07, 0x65, 0x6e, 0x63, 0x53,
+	0x63, 0x72, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x7a, 0x5f, 0x63, 0x74, 0x78,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x75, 0x74, 0x68, 0x7a, 0x43, 0x74, 0x78,
+	0x12, 0x10, 0x0a, 0x03, 0x64, 0x65, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x64,
+	0x65, 0x6d, 0x12, 0x20, 0x0a, 0x0c, 0x65, 0x6e, 0x63, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x63,
+	0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x6e, 0x63, 0x44, 0x61, 0x74,
+	0x61, 0x43, 0x69, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x63, 0x5f, 0x64, 0x61, 0x74, 0x61,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x65, 0x6e, 0x63, 0x44, 0x61, 0x74, 0x61, 0x12,
+	0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x6f, 0x77, 0x6e, 0x65, 0x72, 0x22, 0xdf, 0x01, 0x0a, 0x16, 0x52, 0x65, 0x65, 0x6e, 0x63, 0x72,
+	0x79, 0x70, 0x74, 0x65, 0x64, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x53, 0x68, 0x61, 0x72, 0x65,
+	0x12, 0x17, 0x0a, 0x07, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x72, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x65, 0x63,
+	0x72, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x49, 0x64, 0x12, 0x32, 0x0a, 0x06, 0x72, 0x64, 0x72, 0x5f, 0x70, 0x6b,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6c, 0x69, 0x62, 0x70, 0x32, 0x70, 0x2e,
+	0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63,
+	0x4b, 0x65, 0x79, 0x52, 0x05, 0x72, 0x64, 0x72, 0x50, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x12, 0x17, 0x0a, 0x07, 0x78, 0x6e, 0x63, 0x5f, 0x73, 0x6b, 0x69, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x06, 0x78, 0x6e, 0x63, 0x53, 0x6b, 0x69, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x68, 0x6c,
+	0x67, 0x69, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x63, 0x68, 0x6c, 0x67, 0x69, 0x12,
+	0x16, 0x0a, 0x06, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x69, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x06, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x69, 0x22, 0xa9, 0x01, 0x0a, 0x14, 0x44, 0x65, 0x63, 0x72,
+	0x79, 0x70, 0x74, 0x65, 0x64, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x53, 0x68, 0x61, 0x72, 0x65,
+	0x12, 0x17, 0x0a, 0x07, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x72, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x65, 0x63,
+	0x72, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x17, 0x0a, 0x07,
+	0x64, 0x65, 0x63, 0x5f, 0x73, 0x6b, 0x69, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x64,
+	0x65, 0x63, 0x53, 0x6b, 0x69, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x68, 0x6c, 0x67, 0x69, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x63, 0x68, 0x6c, 0x67, 0x69, 0x12, 0x16, 0x0a, 0x06, 0x70,
+	0x72, 0x6f, 0x6f, 0x66, 0x69, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x70, 0x72, 0x6f,
+	0x6f, 0x66, 0x69, 0x22, 0x51, 0x0a, 0x04, 0x52, 0x69, 0x6e, 0x67, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x39, 0x0a, 0x08, 0x6d,
+	0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
+	0x6f, 0x72, 0x62, 0x69, 0x73, 0x2e, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x52, 0x08, 0x6d, 0x61,
+	0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x22, 0x95, 0x02, 0x0a, 0x08, 0x4d, 0x61, 0x6e, 0x69, 0x66,
+	0x65, 0x73, 0x74, 0x12, 0x0c, 0x0a, 0x01, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01,
+	0x6e, 0x12, 0x0c, 0x0a, 0x01, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x74, 0x12,
+	0x10, 0x0a, 0x03, 0x64, 0x6b, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x64, 0x6b,
+	0x67, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x70, 0x73, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x70, 0x72, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x75, 0x6c, 0x6c, 0x65, 0x74, 0x69,
+	0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x62, 0x75, 0x6c, 0x6c, 0x65, 0x74, 0x69,
+	0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x12,
+	0x2f, 0x0a, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x6f, 0x72, 0x62, 0x69, 0x73, 0x2e, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73,
+	0x12, 0x24, 0x0a, 0x0d, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69,
+	0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x0e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e,
+	0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
+	0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xde,
+	0x01, 0x0a, 0x04, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x3a, 0x0a, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6c, 0x69, 0x62, 0x70, 0x32, 0x70, 0x2e, 0x63,
+	0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b,
+	0x65, 0x79, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x3c, 0x0a,
+	0x0b, 0x70, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6c, 0x69, 0x62, 0x70, 0x32, 0x70, 0x2e, 0x63, 0x72, 0x79, 0x70,
+	0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x52,
+	0x0a, 0x70, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x4b, 0x65, 0x79, 0x12, 0x32, 0x0a, 0x15, 0x70,
+	0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x13, 0x70, 0x61, 0x69, 0x72,
+	0x69, 0x6e, 0x67, 0x4b, 0x65, 0x79, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x32,
+	0xb2, 0x17, 0x0a, 0x0b, 0x52, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
+	0x73, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x25, 0x2e, 0x6f,
+	0x72, 0x62, 0x69, 0x73, 0x2e, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x6f, 0x72, 0x62, 0x69, 0x73, 0x2e, 0x72, 0x69, 0x6e, 0x67,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x69,
+	0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x17, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x11, 0x12, 0x0f, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x72,
+	0x69, 0x6e, 0x67, 0x73, 0x12, 0x72, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x52, 0x69, 0x6e, 0x67, 0x12,
+	0x23, 0x2e, 0x6f, 0x72, 0x62, 0x69, 0x73, 0x2e, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x6f, 0x72, 0x62, 0x69, 0x73, 0x2e, 0x72, 0x69, 0x6e,
+	0x67, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x69,
+	0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x16, 0x12, 0x14, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x72, 0x69,
+	0x6e, 0x67, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x79, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x52, 0x69, 0x6e, 0x67, 0x12, 0x26, 0x2e, 0x6f, 0x72, 0x62, 0x69, 0x73, 0x2e, 0x72,
+	0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x52, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27,
+	0x2e, 0x6f, 0x72, 0x62, 0x69, 0x73, 0x2e, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x69, 0x6e, 0x67, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x3a,
+	0x01, 0x2a, 0x22, 0x0f, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x72, 0x69,
+	0x6e, 0x67, 0x73, 0x12, 0x94, 0x01, 0x0a, 0x10, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65,
+	0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x2e, 0x6f, 0x72, 0x62, 0x69, 0x73,
+	0x2e, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x56,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x6f, 0x72, 0x62, 0x69, 0x73, 0x2e, 0x72,
+	0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x3a, 0x01, 0x2a,
+	0x22, 0x18, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x72, 0x69, 0x6e, 0x67,
+	0x73, 0x3a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x12, 0x80, 0x01, 0x0a, 0x0b, 0x50,
+	0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x52, 0x69, 0x6e, 0x67, 0x12, 0x27, 0x2e, 0x6f, 0x72, 0x62,
+	0x69, 0x73, 0x2e, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x52, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x6f, 0x72, 0x62, 0x69, 0x73, 0x2e, 0x72, 0x69, 0x6e, 0x67,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73,
+	0x65, 0x52, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1e, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x18, 0x3a, 0x01, 0x2a, 0x22, 0x13, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x12, 0x83, 0x01,
+	0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x12,
+	0x29, 0x2e, 0x6f, 0x72, 0x62, 0x69, 0x73, 0x2e, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73,
+	0x61, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x6f, 0x72, 0x62,
+	0x69, 0x73, 0x2e, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x12, 0x13,
+	0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73,
+	0x61, 0x6c, 0x73, 0x12, 0x9b, 0x01, 0x0a, 0x0f, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x50,
+	0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x12, 0x2b, 0x2e, 0x6f, 0x72, 0x62, 0x69, 0x73, 0x2e,
+	0x72, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x41, 0x70,
+	0x70, 0x72, 0x6f, 0x76, 0x65, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x6f, 0x72, 0x62, 0x69, 0x73, 0x2e, 0x72, 0x69, 0x6e,
+	0x67, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f,
+	0x76, 0x65, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x2d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x27, 0x22, 0x25, 0x2f, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x2f,
+	0x7b, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x7d, 0x3a, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76,
+	0x65, 0x12, 0x6a, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x69, 0x6e, 0x67, 0x12,
+	0x26, 0x2e, 0x6f, 0x72, 0x62, 0x69, 0x73, 0x2e, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x69, 0x6e, 0x67,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22,
+	0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x2a, 0x14, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2f, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x83, 0x01,
+	0x0a, 0x09, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x25, 0x2e, 0x6f, 0x72,
+	0x62, 0x69, 0x73, 0x2e, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x26, 0x2e, 0x6f, 0x72, 0x62, 0x69, 0x73, 0x2e, 0x72, 0x69, 0x6e, 0x67, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b,
+	0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x21, 0x12, 0x1f, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x72, 0x69,
+	0x6e, 0x67, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f,
+	0x6b, 0x65, 0x79, 0x12, 0x7a, 0x0a, 0x07, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x12, 0x23,
+	0x2e, 0x6f, 0x72, 0x62, 0x69, 0x73, 0x2e, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x6f, 0x72, 0x62, 0x69, 0x73, 0x2e, 0x72, 0x69, 0x6e, 0x67,
 	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73,
-	0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x6f, 0x72, 0x62, 0x69, 0x73,
+	0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x1e, 0x22, 0x1c, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x72, 0x69, 0x6e,
+	0x67, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x3a, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x12,
+	0x72, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x21, 0x2e, 0x6f, 0x72, 0x62, 0x69, 0x73,
+	0x2e, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x6f, 0x72,
+	0x62, 0x69, 0x73, 0x2e, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x12, 0x1a, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2f, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x3a, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x12, 0x8b, 0x01, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x63, 0x72,
+	0x65, 0x74, 0x73, 0x12, 0x27, 0x2e, 0x6f, 0x72, 0x62, 0x69, 0x73, 0x2e, 0x72, 0x69, 0x6e, 0x67,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x6f,
+	0x72, 0x62, 0x69, 0x73, 0x2e, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x29, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23, 0x12, 0x21,
+	0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x2f,
+	0x7b, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74,
+	0x73, 0x12, 0x93, 0x01, 0x0a, 0x0b, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x53, 0x65, 0x63, 0x72, 0x65,
+	0x74, 0x12, 0x27, 0x2e, 0x6f, 0x72, 0x62, 0x69, 0x73, 0x2e, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x53, 0x65, 0x63,
+	0x72, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x6f, 0x72, 0x62,
+	0x69, 0x73, 0x2e, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x31, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2b, 0x3a, 0x06, 0x73, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x22, 0x21, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f,
+	0x72, 0x69, 0x6e, 0x67, 0x73, 0x2f, 0x7b, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x7d, 0x2f,
+	0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x12, 0xb0, 0x01, 0x0a, 0x0f, 0x52, 0x65, 0x65, 0x6e,
+	0x63, 0x72, 0x79, 0x70, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x2b, 0x2e, 0x6f, 0x72,
+	0x62, 0x69, 0x73, 0x2e, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x52, 0x65, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x6f, 0x72, 0x62, 0x69, 0x73,
 	0x2e, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52,
-	0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x24,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x22, 0x1c, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
-	0x31, 0x2f, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x3a, 0x72, 0x65, 0x66,
-	0x72, 0x65, 0x73, 0x68, 0x12, 0x72, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x21, 0x2e,
-	0x6f, 0x72, 0x62, 0x69, 0x73, 0x2e, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
-	0x68, 0x61, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x22, 0x2e, 0x6f, 0x72, 0x62, 0x69, 0x73, 0x2e, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31,
-	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x12, 0x1a, 0x2f, 0x76,
-	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x2f, 0x7b, 0x69,
-	0x64, 0x7d, 0x3a, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x8b, 0x01, 0x0a, 0x0b, 0x4c, 0x69, 0x73,
-	0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x12, 0x27, 0x2e, 0x6f, 0x72, 0x62, 0x69, 0x73,
-	0x2e, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4c,
-	0x69, 0x73, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x28, 0x2e, 0x6f, 0x72, 0x62, 0x69, 0x73, 0x2e, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x76,
-	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x63, 0x72,
-	0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x29, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x23, 0x12, 0x21, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x72,
-	0x69, 0x6e, 0x67, 0x73, 0x2f, 0x7b, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x73,
-	0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x12, 0x93, 0x01, 0x0a, 0x0b, 0x53, 0x74, 0x6f, 0x72, 0x65,
-	0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x27, 0x2e, 0x6f, 0x72, 0x62, 0x69, 0x73, 0x2e, 0x72,
-	0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x74, 0x6f,
-	0x72, 0x65, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x28, 0x2e, 0x6f, 0x72, 0x62, 0x69, 0x73, 0x2e, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x61,
-	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x53, 0x65, 0x63, 0x72, 0x65,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x31, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x2b, 0x3a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x22, 0x21, 0x2f, 0x76, 0x31, 0x61, 0x6c,
-	0x70, 0x68, 0x61, 0x31, 0x2f, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x2f, 0x7b, 0x72, 0x69, 0x6e, 0x67,
-	0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x12, 0xb0, 0x01, 0x0a,
-	0x0f, 0x52, 0x65, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74,
-	0x12, 0x2b, 0x2e, 0x6f, 0x72, 0x62, 0x69, 0x73, 0x2e, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31,
-	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74,
-	0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e,
+	0x65, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x42, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3c, 0x3a, 0x01,
+	0x2a, 0x22, 0x37, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x72, 0x69, 0x6e,
+	0x67, 0x73, 0x2f, 0x7b, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x73, 0x65, 0x63,
+	0x72, 0x65, 0x74, 0x73, 0x2f, 0x7b, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x7d,
+	0x3a, 0x72, 0x65, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x12, 0xa8, 0x01, 0x0a, 0x0d, 0x44,
+	0x65, 0x63, 0x72, 0x79, 0x70, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x29, 0x2e, 0x6f,
+	0x72, 0x62, 0x69, 0x73, 0x2e, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2e, 0x44, 0x65, 0x63, 0x72, 0x79, 0x70, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x6f, 0x72, 0x62, 0x69, 0x73, 0x2e,
+	0x72, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x44, 0x65,
+	0x63, 0x72, 0x79, 0x70, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x40, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3a, 0x3a, 0x01, 0x2a, 0x22, 0x35,
+	0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x2f,
+	0x7b, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74,
+	0x73, 0x2f, 0x7b, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x3a, 0x64, 0x65,
+	0x63, 0x72, 0x79, 0x70, 0x74, 0x12, 0x80, 0x01, 0x0a, 0x08, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c,
+	0x6f, 0x67, 0x12, 0x24, 0x2e, 0x6f, 0x72, 0x62, 0x69, 0x73, 0x2e, 0x72, 0x69, 0x6e, 0x67, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f,
+	0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x6f, 0x72, 0x62, 0x69, 0x73,
+	0x2e, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x41,
+	0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x12, 0x1f, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2f, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x2f, 0x7b, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x69,
+	0x64, 0x7d, 0x2f, 0x61, 0x75, 0x64, 0x69, 0x74, 0x12, 0xb1, 0x01, 0x0a, 0x11, 0x57, 0x72, 0x69,
+	0x74, 0x65, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x12, 0x2d,
+	0x2e, 0x6f, 0x72, 0x62, 0x69, 0x73, 0x2e, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e,
 	0x6f, 0x72, 0x62, 0x69, 0x73, 0x2e, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
-	0x68, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x53, 0x65, 0x63,
-	0x72, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x42, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x3c, 0x3a, 0x01, 0x2a, 0x22, 0x37, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
-	0x31, 0x2f, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x2f, 0x7b, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64,
-	0x7d, 0x2f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x2f, 0x7b, 0x73, 0x65, 0x63, 0x72, 0x65,
-	0x74, 0x5f, 0x69, 0x64, 0x7d, 0x3a, 0x72, 0x65, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x12,
-	0x87, 0x01, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74,
-	0x12, 0x28, 0x2e, 0x6f, 0x72, 0x62, 0x69, 0x73, 0x2e, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31,
-	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x63,
-	0x72, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
-	0x74, 0x79, 0x22, 0x35, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2f, 0x2a, 0x2d, 0x2f, 0x76, 0x31, 0x61,
+	0x68, 0x61, 0x31, 0x2e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x68, 0x69, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3d, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x37, 0x3a, 0x0c, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x68, 0x69, 0x70, 0x22, 0x27, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x72,
+	0x69, 0x6e, 0x67, 0x73, 0x2f, 0x7b, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x72,
+	0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x73, 0x12, 0xbb, 0x01, 0x0a,
+	0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x68, 0x69, 0x70, 0x12, 0x2e, 0x2e, 0x6f, 0x72, 0x62, 0x69, 0x73, 0x2e, 0x72, 0x69, 0x6e, 0x67,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x6f, 0x72, 0x62, 0x69, 0x73, 0x2e, 0x72, 0x69, 0x6e, 0x67,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x44, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3e, 0x3a, 0x0c, 0x72, 0x65,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x22, 0x2e, 0x2f, 0x76, 0x31, 0x61,
 	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x2f, 0x7b, 0x72, 0x69, 0x6e,
-	0x67, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x2f, 0x7b, 0x73,
-	0x65, 0x63, 0x72, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x42, 0xe0, 0x01, 0x0a, 0x17, 0x63, 0x6f,
-	0x6d, 0x2e, 0x6f, 0x72, 0x62, 0x69, 0x73, 0x2e, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x61,
-	0x6c, 0x70, 0x68, 0x61, 0x31, 0x42, 0x09, 0x52, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x74, 0x6f,
-	0x50, 0x01, 0x5a, 0x4c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x6f, 0x72, 0x62,
-	0x69, 0x73, 0x2d, 0x67, 0x6f, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f,
-	0x6f, 0x72, 0x62, 0x69, 0x73, 0x2f, 0x72, 0x69, 0x6e, 0x67, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70,
-	0x68, 0x61, 0x31, 0x3b, 0x72, 0x69, 0x6e, 0x67, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
-	0xa2, 0x02, 0x03, 0x4f, 0x52, 0x58, 0xaa, 0x02, 0x13, 0x4f, 0x72, 0x62, 0x69, 0x73, 0x2e, 0x52,
-	0x69, 0x6e, 0x67, 0x2e, 0x56, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0xca, 0x02, 0x13, 0x4f,
-	0x72, 0x62, 0x69, 0x73, 0x5c, 0x52, 0x69, 0x6e, 0x67, 0x5c, 0x56, 0x31, 0x61, 0x6c, 0x70, 0x68,
-	0x61, 0x31, 0xe2, 0x02, 0x1f, 0x4f, 0x72, 0x62, 0x69, 0x73, 0x5c, 0x52, 0x69, 0x6e, 0x67, 0x5c,
-	0x56, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61,
-	0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x15, 0x4f, 0x72, 0x62, 0x69, 0x73, 0x3a, 0x3a, 0x52, 0x69,
-	0x6e, 0x67, 0x3a, 0x3a, 0x56, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
+	0x67, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68,
+	0x69, 0x70, 0x73, 0x3a, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x76, 0x0a, 0x04, 0x53, 0x69,
+	0x67, 0x6e, 0x12, 0x20, 0x2e, 0x6f, 0x72, 0x62, 0x69, 0x73, 0x2e, 0x72, 0x69, 0x6e, 0x67, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6f, 0x72, 0x62, 0x69, 0x73, 0x2e, 0x72, 0x69, 0x6e,
+	0x67, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x29, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23, 0x3a,
+	0x01, 0x2a, 0x22, 0x1e, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x72, 0x69,
+	0x6e, 0x67, 0x73, 0x2f, 0x7b, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x7d, 0x3a, 0x73, 0x69,
+	0x67, 0x6e, 0x12, 0xa5, 0x01, 0x0a, 0x13, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x52, 0x69, 0x6e,
+	0x67, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x2f, 0x2e, 0x6f, 0x72, 0x62,
+	0x69, 0x73, 0x2e, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x52, 0x69, 0x6e, 0x67, 0x53, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x6f, 0x72,
+	0x62, 0x69, 0x73, 0x2e, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x52, 0x69, 0x6e, 0x67, 0x53, 0x69, 0x67, 0x6e,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2b, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x25, 0x3a, 0x01, 0x2a, 0x22, 0x20, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2f, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x2f, 0x7b, 0x72, 0x69, 0x6e, 0x67, 0x5f,
+	0x69, 0x64, 0x7d, 0x3a, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x12, 0x87, 0x01, 0x0a, 0x0c, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x28, 0x2e, 0x6f, 0x72,
+	0x62, 0x69, 0x73, 0x2e, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x35, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x2f, 0x2a, 0x2d, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2f, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x2f, 0x7b, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x7d,
+	0x2f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x2f, 0x7b, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74,
+	0x5f, 0x69, 0x64, 0x7d, 0x42, 0xe0, 0x01, 0x0a, 0x17, 0x63, 0x6f, 0x6d, 0x2e, 0x6f, 0x72, 0x62,
+	0x69, 0x73, 0x2e, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x42, 0x09, 0x52, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x4c, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x6f, 0x72, 0x62, 0x69, 0x73, 0x2d, 0x67, 0x6f,
+	0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6f, 0x72, 0x62, 0x69, 0x73,
+	0x2f, 0x72, 0x69, 0x6e, 0x67, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x3b, 0x72,
+	0x69, 0x6e, 0x67, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0xa2, 0x02, 0x03, 0x4f, 0x52,
+	0x58, 0xaa, 0x02, 0x13, 0x4f, 0x72, 0x62, 0x69, 0x73, 0x2e, 0x52, 0x69, 0x6e, 0x67, 0x2e, 0x56,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0xca, 0x02, 0x13, 0x4f, 0x72, 0x62, 0x69, 0x73, 0x5c,
+	0x52, 0x69, 0x6e, 0x67, 0x5c, 0x56, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0xe2, 0x02, 0x1f,
+	0x4f, 0x72, 0x62, 0x69, 0x73, 0x5c, 0x52, 0x69, 0x6e, 0x67, 0x5c, 0x56, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea,
+	0x02, 0x15, 0x4f, 0x72, 0x62, 0x69, 0x73, 0x3a, 0x3a, 0x52, 0x69, 0x6e, 0x67, 0x3a, 0x3a, 0x56,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1716,77 +3613,137 @@ func file_orbis_ring_v1alpha1_ring_proto_rawDescGZIP() []byte {
 	return file_orbis_ring_v1alpha1_ring_proto_rawDescData
 }
 
-var file_orbis_ring_v1alpha1_ring_proto_msgTypes = make([]protoimpl.MessageInfo, 26)
+var file_orbis_ring_v1alpha1_ring_proto_msgTypes = make([]protoimpl.MessageInfo, 53)
 var file_orbis_ring_v1alpha1_ring_proto_goTypes = []interface{}{
-	(*ListRingsRequest)(nil),        // 0: orbis.ring.v1alpha1.ListRingsRequest
-	(*ListRingsResponse)(nil),       // 1: orbis.ring.v1alpha1.ListRingsResponse
-	(*CreateRingRequest)(nil),       // 2: orbis.ring.v1alpha1.CreateRingRequest
-	(*CreateRingResponse)(nil),      // 3: orbis.ring.v1alpha1.CreateRingResponse
-	(*GetRingRequest)(nil),          // 4: orbis.ring.v1alpha1.GetRingRequest
-	(*GetRingResponse)(nil),         // 5: orbis.ring.v1alpha1.GetRingResponse
-	(*DeleteRingRequest)(nil),       // 6: orbis.ring.v1alpha1.DeleteRingRequest
-	(*RefreshRequest)(nil),          // 7: orbis.ring.v1alpha1.RefreshRequest
-	(*PublicKeyRequest)(nil),        // 8: orbis.ring.v1alpha1.PublicKeyRequest
-	(*PublicKeyResponse)(nil),       // 9: orbis.ring.v1alpha1.PublicKeyResponse
-	(*RefreshResponse)(nil),         // 10: orbis.ring.v1alpha1.RefreshResponse
-	(*StateRequest)(nil),            // 11: orbis.ring.v1alpha1.StateRequest
-	(*StateResponse)(nil),           // 12: orbis.ring.v1alpha1.StateResponse
-	(*ServiceState)(nil),            // 13: orbis.ring.v1alpha1.ServiceState
-	(*ListSecretsRequest)(nil),      // 14: orbis.ring.v1alpha1.ListSecretsRequest
-	(*ListSecretsResponse)(nil),     // 15: orbis.ring.v1alpha1.ListSecretsResponse
-	(*StoreSecretRequest)(nil),      // 16: orbis.ring.v1alpha1.StoreSecretRequest
-	(*StoreSecretResponse)(nil),     // 17: orbis.ring.v1alpha1.StoreSecretResponse
-	(*DeleteSecretRequest)(nil),     // 18: orbis.ring.v1alpha1.DeleteSecretRequest
-	(*ReencryptSecretRequest)(nil),  // 19: orbis.ring.v1alpha1.ReencryptSecretRequest
-	(*ReencryptSecretResponse)(nil), // 20: orbis.ring.v1alpha1.ReencryptSecretResponse
-	(*Secret)(nil),                  // 21: orbis.ring.v1alpha1.Secret
-	(*ReencryptedSecretShare)(nil),  // 22: orbis.ring.v1alpha1.ReencryptedSecretShare
-	(*Ring)(nil),                    // 23: orbis.ring.v1alpha1.Ring
-	(*Manifest)(nil),                // 24: orbis.ring.v1alpha1.Manifest
-	(*Node)(nil),                    // 25: orbis.ring.v1alpha1.Node
-	(*pb.PublicKey)(nil),            // 26: libp2p.crypto.v1.PublicKey
-	(*emptypb.Empty)(nil),           // 27: google.protobuf.Empty
+	(*ListRingsRequest)(nil),            // 0: orbis.ring.v1alpha1.ListRingsRequest
+	(*ListRingsResponse)(nil),           // 1: orbis.ring.v1alpha1.ListRingsResponse
+	(*CreateRingRequest)(nil),           // 2: orbis.ring.v1alpha1.CreateRingRequest
+	(*CreateRingResponse)(nil),          // 3: orbis.ring.v1alpha1.CreateRingResponse
+	(*ValidateManifestRequest)(nil),     // 4: orbis.ring.v1alpha1.ValidateManifestRequest
+	(*ValidateManifestResponse)(nil),    // 5: orbis.ring.v1alpha1.ValidateManifestResponse
+	(*ManifestViolation)(nil),           // 6: orbis.ring.v1alpha1.ManifestViolation
+	(*ProposeRingRequest)(nil),          // 7: orbis.ring.v1alpha1.ProposeRingRequest
+	(*ProposeRingResponse)(nil),         // 8: orbis.ring.v1alpha1.ProposeRingResponse
+	(*ListProposalsRequest)(nil),        // 9: orbis.ring.v1alpha1.ListProposalsRequest
+	(*ListProposalsResponse)(nil),       // 10: orbis.ring.v1alpha1.ListProposalsResponse
+	(*ApproveProposalRequest)(nil),      // 11: orbis.ring.v1alpha1.ApproveProposalRequest
+	(*ApproveProposalResponse)(nil),     // 12: orbis.ring.v1alpha1.ApproveProposalResponse
+	(*RingProposal)(nil),                // 13: orbis.ring.v1alpha1.RingProposal
+	(*RingProposalAck)(nil),             // 14: orbis.ring.v1alpha1.RingProposalAck
+	(*GetRingRequest)(nil),              // 15: orbis.ring.v1alpha1.GetRingRequest
+	(*GetRingResponse)(nil),             // 16: orbis.ring.v1alpha1.GetRingResponse
+	(*DeleteRingRequest)(nil),           // 17: orbis.ring.v1alpha1.DeleteRingRequest
+	(*RefreshRequest)(nil),              // 18: orbis.ring.v1alpha1.RefreshRequest
+	(*PublicKeyRequest)(nil),            // 19: orbis.ring.v1alpha1.PublicKeyRequest
+	(*PublicKeyResponse)(nil),           // 20: orbis.ring.v1alpha1.PublicKeyResponse
+	(*RefreshResponse)(nil),             // 21: orbis.ring.v1alpha1.RefreshResponse
+	(*StateRequest)(nil),                // 22: orbis.ring.v1alpha1.StateRequest
+	(*StateResponse)(nil),               // 23: orbis.ring.v1alpha1.StateResponse
+	(*ServiceState)(nil),                // 24: orbis.ring.v1alpha1.ServiceState
+	(*ListSecretsRequest)(nil),          // 25: orbis.ring.v1alpha1.ListSecretsRequest
+	(*ListSecretsResponse)(nil),         // 26: orbis.ring.v1alpha1.ListSecretsResponse
+	(*StoreSecretRequest)(nil),          // 27: orbis.ring.v1alpha1.StoreSecretRequest
+	(*StoreSecretResponse)(nil),         // 28: orbis.ring.v1alpha1.StoreSecretResponse
+	(*DeleteSecretRequest)(nil),         // 29: orbis.ring.v1alpha1.DeleteSecretRequest
+	(*ReencryptSecretRequest)(nil),      // 30: orbis.ring.v1alpha1.ReencryptSecretRequest
+	(*ReencryptSecretResponse)(nil),     // 31: orbis.ring.v1alpha1.ReencryptSecretResponse
+	(*DecryptSecretRequest)(nil),        // 32: orbis.ring.v1alpha1.DecryptSecretRequest
+	(*DecryptSecretResponse)(nil),       // 33: orbis.ring.v1alpha1.DecryptSecretResponse
+	(*AuditLogRequest)(nil),             // 34: orbis.ring.v1alpha1.AuditLogRequest
+	(*AuditLogResponse)(nil),            // 35: orbis.ring.v1alpha1.AuditLogResponse
+	(*WriteRelationshipRequest)(nil),    // 36: orbis.ring.v1alpha1.WriteRelationshipRequest
+	(*WriteRelationshipResponse)(nil),   // 37: orbis.ring.v1alpha1.WriteRelationshipResponse
+	(*DeleteRelationshipRequest)(nil),   // 38: orbis.ring.v1alpha1.DeleteRelationshipRequest
+	(*DeleteRelationshipResponse)(nil),  // 39: orbis.ring.v1alpha1.DeleteRelationshipResponse
+	(*SignRequest)(nil),                 // 40: orbis.ring.v1alpha1.SignRequest
+	(*SignResponse)(nil),                // 41: orbis.ring.v1alpha1.SignResponse
+	(*PartialSignature)(nil),            // 42: orbis.ring.v1alpha1.PartialSignature
+	(*NonceCommitment)(nil),             // 43: orbis.ring.v1alpha1.NonceCommitment
+	(*SigningPackage)(nil),              // 44: orbis.ring.v1alpha1.SigningPackage
+	(*VerifyRingSignatureRequest)(nil),  // 45: orbis.ring.v1alpha1.VerifyRingSignatureRequest
+	(*VerifyRingSignatureResponse)(nil), // 46: orbis.ring.v1alpha1.VerifyRingSignatureResponse
+	(*Secret)(nil),                      // 47: orbis.ring.v1alpha1.Secret
+	(*ReencryptedSecretShare)(nil),      // 48: orbis.ring.v1alpha1.ReencryptedSecretShare
+	(*DecryptedSecretShare)(nil),        // 49: orbis.ring.v1alpha1.DecryptedSecretShare
+	(*Ring)(nil),                        // 50: orbis.ring.v1alpha1.Ring
+	(*Manifest)(nil),                    // 51: orbis.ring.v1alpha1.Manifest
+	(*Node)(nil),                        // 52: orbis.ring.v1alpha1.Node
+	(*pb.PublicKey)(nil),                // 53: libp2p.crypto.v1.PublicKey
+	(*v1alpha1.Record)(nil),             // 54: orbis.audit.v1alpha1.Record
+	(*v1alpha11.Relationship)(nil),      // 55: orbis.authz.v1alpha1.Relationship
+	(*emptypb.Empty)(nil),               // 56: google.protobuf.Empty
 }
 var file_orbis_ring_v1alpha1_ring_proto_depIdxs = []int32{
-	23, // 0: orbis.ring.v1alpha1.ListRingsResponse.rings:type_name -> orbis.ring.v1alpha1.Ring
-	24, // 1: orbis.ring.v1alpha1.CreateRingRequest.manifest:type_name -> orbis.ring.v1alpha1.Manifest
-	23, // 2: orbis.ring.v1alpha1.GetRingResponse.ring:type_name -> orbis.ring.v1alpha1.Ring
-	26, // 3: orbis.ring.v1alpha1.PublicKeyResponse.public_key:type_name -> libp2p.crypto.v1.PublicKey
-	13, // 4: orbis.ring.v1alpha1.StateResponse.services:type_name -> orbis.ring.v1alpha1.ServiceState
-	21, // 5: orbis.ring.v1alpha1.ListSecretsResponse.secrets:type_name -> orbis.ring.v1alpha1.Secret
-	21, // 6: orbis.ring.v1alpha1.StoreSecretRequest.secret:type_name -> orbis.ring.v1alpha1.Secret
-	26, // 7: orbis.ring.v1alpha1.ReencryptSecretRequest.rdr_pk:type_name -> libp2p.crypto.v1.PublicKey
-	26, // 8: orbis.ring.v1alpha1.ReencryptedSecretShare.rdr_pk:type_name -> libp2p.crypto.v1.PublicKey
-	24, // 9: orbis.ring.v1alpha1.Ring.manifest:type_name -> orbis.ring.v1alpha1.Manifest
-	25, // 10: orbis.ring.v1alpha1.Manifest.nodes:type_name -> orbis.ring.v1alpha1.Node
-	26, // 11: orbis.ring.v1alpha1.Node.public_key:type_name -> libp2p.crypto.v1.PublicKey
-	0,  // 12: orbis.ring.v1alpha1.RingService.ListRings:input_type -> orbis.ring.v1alpha1.ListRingsRequest
-	4,  // 13: orbis.ring.v1alpha1.RingService.GetRing:input_type -> orbis.ring.v1alpha1.GetRingRequest
-	2,  // 14: orbis.ring.v1alpha1.RingService.CreateRing:input_type -> orbis.ring.v1alpha1.CreateRingRequest
-	6,  // 15: orbis.ring.v1alpha1.RingService.DeleteRing:input_type -> orbis.ring.v1alpha1.DeleteRingRequest
-	8,  // 16: orbis.ring.v1alpha1.RingService.PublicKey:input_type -> orbis.ring.v1alpha1.PublicKeyRequest
-	7,  // 17: orbis.ring.v1alpha1.RingService.Refresh:input_type -> orbis.ring.v1alpha1.RefreshRequest
-	11, // 18: orbis.ring.v1alpha1.RingService.State:input_type -> orbis.ring.v1alpha1.StateRequest
-	14, // 19: orbis.ring.v1alpha1.RingService.ListSecrets:input_type -> orbis.ring.v1alpha1.ListSecretsRequest
-	16, // 20: orbis.ring.v1alpha1.RingService.StoreSecret:input_type -> orbis.ring.v1alpha1.StoreSecretRequest
-	19, // 21: orbis.ring.v1alpha1.RingService.ReencryptSecret:input_type -> orbis.ring.v1alpha1.ReencryptSecretRequest
-	18, // 22: orbis.ring.v1alpha1.RingService.DeleteSecret:input_type -> orbis.ring.v1alpha1.DeleteSecretRequest
-	1,  // 23: orbis.ring.v1alpha1.RingService.ListRings:output_type -> orbis.ring.v1alpha1.ListRingsResponse
-	5,  // 24: orbis.ring.v1alpha1.RingService.GetRing:output_type -> orbis.ring.v1alpha1.GetRingResponse
-	3,  // 25: orbis.ring.v1alpha1.RingService.CreateRing:output_type -> orbis.ring.v1alpha1.CreateRingResponse
-	27, // 26: orbis.ring.v1alpha1.RingService.DeleteRing:output_type -> google.protobuf.Empty
-	9,  // 27: orbis.ring.v1alpha1.RingService.PublicKey:output_type -> orbis.ring.v1alpha1.PublicKeyResponse
-	10, // 28: orbis.ring.v1alpha1.RingService.Refresh:output_type -> orbis.ring.v1alpha1.RefreshResponse
-	12, // 29: orbis.ring.v1alpha1.RingService.State:output_type -> orbis.ring.v1alpha1.StateResponse
-	15, // 30: orbis.ring.v1alpha1.RingService.ListSecrets:output_type -> orbis.ring.v1alpha1.ListSecretsResponse
-	17, // 31: orbis.ring.v1alpha1.RingService.StoreSecret:output_type -> orbis.ring.v1alpha1.StoreSecretResponse
-	20, // 32: orbis.ring.v1alpha1.RingService.ReencryptSecret:output_type -> orbis.ring.v1alpha1.ReencryptSecretResponse
-	27, // 33: orbis.ring.v1alpha1.RingService.DeleteSecret:output_type -> google.protobuf.Empty
-	23, // [23:34] is the sub-list for method output_type
-	12, // [12:23] is the sub-list for method input_type
-	12, // [12:12] is the sub-list for extension type_name
-	12, // [12:12] is the sub-list for extension extendee
-	0,  // [0:12] is the sub-list for field type_name
+	50, // 0: orbis.ring.v1alpha1.ListRingsResponse.rings:type_name -> orbis.ring.v1alpha1.Ring
+	51, // 1: orbis.ring.v1alpha1.CreateRingRequest.manifest:type_name -> orbis.ring.v1alpha1.Manifest
+	51, // 2: orbis.ring.v1alpha1.ValidateManifestRequest.manifest:type_name -> orbis.ring.v1alpha1.Manifest
+	6,  // 3: orbis.ring.v1alpha1.ValidateManifestResponse.violations:type_name -> orbis.ring.v1alpha1.ManifestViolation
+	51, // 4: orbis.ring.v1alpha1.ProposeRingRequest.manifest:type_name -> orbis.ring.v1alpha1.Manifest
+	13, // 5: orbis.ring.v1alpha1.ListProposalsResponse.proposals:type_name -> orbis.ring.v1alpha1.RingProposal
+	13, // 6: orbis.ring.v1alpha1.ApproveProposalResponse.proposal:type_name -> orbis.ring.v1alpha1.RingProposal
+	51, // 7: orbis.ring.v1alpha1.RingProposal.manifest:type_name -> orbis.ring.v1alpha1.Manifest
+	50, // 8: orbis.ring.v1alpha1.GetRingResponse.ring:type_name -> orbis.ring.v1alpha1.Ring
+	53, // 9: orbis.ring.v1alpha1.PublicKeyResponse.public_key:type_name -> libp2p.crypto.v1.PublicKey
+	24, // 10: orbis.ring.v1alpha1.StateResponse.services:type_name -> orbis.ring.v1alpha1.ServiceState
+	47, // 11: orbis.ring.v1alpha1.ListSecretsResponse.secrets:type_name -> orbis.ring.v1alpha1.Secret
+	47, // 12: orbis.ring.v1alpha1.StoreSecretRequest.secret:type_name -> orbis.ring.v1alpha1.Secret
+	53, // 13: orbis.ring.v1alpha1.ReencryptSecretRequest.rdr_pk:type_name -> libp2p.crypto.v1.PublicKey
+	54, // 14: orbis.ring.v1alpha1.AuditLogResponse.records:type_name -> orbis.audit.v1alpha1.Record
+	55, // 15: orbis.ring.v1alpha1.WriteRelationshipRequest.relationship:type_name -> orbis.authz.v1alpha1.Relationship
+	55, // 16: orbis.ring.v1alpha1.DeleteRelationshipRequest.relationship:type_name -> orbis.authz.v1alpha1.Relationship
+	43, // 17: orbis.ring.v1alpha1.SigningPackage.commitments:type_name -> orbis.ring.v1alpha1.NonceCommitment
+	53, // 18: orbis.ring.v1alpha1.ReencryptedSecretShare.rdr_pk:type_name -> libp2p.crypto.v1.PublicKey
+	51, // 19: orbis.ring.v1alpha1.Ring.manifest:type_name -> orbis.ring.v1alpha1.Manifest
+	52, // 20: orbis.ring.v1alpha1.Manifest.nodes:type_name -> orbis.ring.v1alpha1.Node
+	53, // 21: orbis.ring.v1alpha1.Node.public_key:type_name -> libp2p.crypto.v1.PublicKey
+	53, // 22: orbis.ring.v1alpha1.Node.pairing_key:type_name -> libp2p.crypto.v1.PublicKey
+	0,  // 23: orbis.ring.v1alpha1.RingService.ListRings:input_type -> orbis.ring.v1alpha1.ListRingsRequest
+	15, // 24: orbis.ring.v1alpha1.RingService.GetRing:input_type -> orbis.ring.v1alpha1.GetRingRequest
+	2,  // 25: orbis.ring.v1alpha1.RingService.CreateRing:input_type -> orbis.ring.v1alpha1.CreateRingRequest
+	4,  // 26: orbis.ring.v1alpha1.RingService.ValidateManifest:input_type -> orbis.ring.v1alpha1.ValidateManifestRequest
+	7,  // 27: orbis.ring.v1alpha1.RingService.ProposeRing:input_type -> orbis.ring.v1alpha1.ProposeRingRequest
+	9,  // 28: orbis.ring.v1alpha1.RingService.ListProposals:input_type -> orbis.ring.v1alpha1.ListProposalsRequest
+	11, // 29: orbis.ring.v1alpha1.RingService.ApproveProposal:input_type -> orbis.ring.v1alpha1.ApproveProposalRequest
+	17, // 30: orbis.ring.v1alpha1.RingService.DeleteRing:input_type -> orbis.ring.v1alpha1.DeleteRingRequest
+	19, // 31: orbis.ring.v1alpha1.RingService.PublicKey:input_type -> orbis.ring.v1alpha1.PublicKeyRequest
+	18, // 32: orbis.ring.v1alpha1.RingService.Refresh:input_type -> orbis.ring.v1alpha1.RefreshRequest
+	22, // 33: orbis.ring.v1alpha1.RingService.State:input_type -> orbis.ring.v1alpha1.StateRequest
+	25, // 34: orbis.ring.v1alpha1.RingService.ListSecrets:input_type -> orbis.ring.v1alpha1.ListSecretsRequest
+	27, // 35: orbis.ring.v1alpha1.RingService.StoreSecret:input_type -> orbis.ring.v1alpha1.StoreSecretRequest
+	30, // 36: orbis.ring.v1alpha1.RingService.ReencryptSecret:input_type -> orbis.ring.v1alpha1.ReencryptSecretRequest
+	32, // 37: orbis.ring.v1alpha1.RingService.DecryptSecret:input_type -> orbis.ring.v1alpha1.DecryptSecretRequest
+	34, // 38: orbis.ring.v1alpha1.RingService.AuditLog:input_type -> orbis.ring.v1alpha1.AuditLogRequest
+	36, // 39: orbis.ring.v1alpha1.RingService.WriteRelationship:input_type -> orbis.ring.v1alpha1.WriteRelationshipRequest
+	38, // 40: orbis.ring.v1alpha1.RingService.DeleteRelationship:input_type -> orbis.ring.v1alpha1.DeleteRelationshipRequest
+	40, // 41: orbis.ring.v1alpha1.RingService.Sign:input_type -> orbis.ring.v1alpha1.SignRequest
+	45, // 42: orbis.ring.v1alpha1.RingService.VerifyRingSignature:input_type -> orbis.ring.v1alpha1.VerifyRingSignatureRequest
+	29, // 43: orbis.ring.v1alpha1.RingService.DeleteSecret:input_type -> orbis.ring.v1alpha1.DeleteSecretRequest
+	1,  // 44: orbis.ring.v1alpha1.RingService.ListRings:output_type -> orbis.ring.v1alpha1.ListRingsResponse
+	16, // 45: orbis.ring.v1alpha1.RingService.GetRing:output_type -> orbis.ring.v1alpha1.GetRingResponse
+	3,  // 46: orbis.ring.v1alpha1.RingService.CreateRing:output_type -> orbis.ring.v1alpha1.CreateRingResponse
+	5,  // 47: orbis.ring.v1alpha1.RingService.ValidateManifest:output_type -> orbis.ring.v1alpha1.ValidateManifestResponse
+	8,  // 48: orbis.ring.v1alpha1.RingService.ProposeRing:output_type -> orbis.ring.v1alpha1.ProposeRingResponse
+	10, // 49: orbis.ring.v1alpha1.RingService.ListProposals:output_type -> orbis.ring.v1alpha1.ListProposalsResponse
+	12, // 50: orbis.ring.v1alpha1.RingService.ApproveProposal:output_type -> orbis.ring.v1alpha1.ApproveProposalResponse
+	56, // 51: orbis.ring.v1alpha1.RingService.DeleteRing:output_type -> google.protobuf.Empty
+	20, // 52: orbis.ring.v1alpha1.RingService.PublicKey:output_type -> orbis.ring.v1alpha1.PublicKeyResponse
+	21, // 53: orbis.ring.v1alpha1.RingService.Refresh:output_type -> orbis.ring.v1alpha1.RefreshResponse
+	23, // 54: orbis.ring.v1alpha1.RingService.State:output_type -> orbis.ring.v1alpha1.StateResponse
+	26, // 55: orbis.ring.v1alpha1.RingService.ListSecrets:output_type -> orbis.ring.v1alpha1.ListSecretsResponse
+	28, // 56: orbis.ring.v1alpha1.RingService.StoreSecret:output_type -> orbis.ring.v1alpha1.StoreSecretResponse
+	31, // 57: orbis.ring.v1alpha1.RingService.ReencryptSecret:output_type -> orbis.ring.v1alpha1.ReencryptSecretResponse
+	33, // 58: orbis.ring.v1alpha1.RingService.DecryptSecret:output_type -> orbis.ring.v1alpha1.DecryptSecretResponse
+	35, // 59: orbis.ring.v1alpha1.RingService.AuditLog:output_type -> orbis.ring.v1alpha1.AuditLogResponse
+	37, // 60: orbis.ring.v1alpha1.RingService.WriteRelationship:output_type -> orbis.ring.v1alpha1.WriteRelationshipResponse
+	39, // 61: orbis.ring.v1alpha1.RingService.DeleteRelationship:output_type -> orbis.ring.v1alpha1.DeleteRelationshipResponse
+	41, // 62: orbis.ring.v1alpha1.RingService.Sign:output_type -> orbis.ring.v1alpha1.SignResponse
+	46, // 63: orbis.ring.v1alpha1.RingService.VerifyRingSignature:output_type -> orbis.ring.v1alpha1.VerifyRingSignatureResponse
+	56, // 64: orbis.ring.v1alpha1.RingService.DeleteSecret:output_type -> google.protobuf.Empty
+	44, // [44:65] is the sub-list for method output_type
+	23, // [23:44] is the sub-list for method input_type
+	23, // [23:23] is the sub-list for extension type_name
+	23, // [23:23] is the sub-list for extension extendee
+	0,  // [0:23] is the sub-list for field type_name
 }
 
 func init() { file_orbis_ring_v1alpha1_ring_proto_init() }
@@ -1807,8 +3764,92 @@ func file_orbis_ring_v1alpha1_ring_proto_init() {
 				return nil
 			}
 		}
-		file_orbis_ring_v1alpha1_ring_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListRingsResponse); i {
+		file_orbis_ring_v1alpha1_ring_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListRingsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_orbis_ring_v1alpha1_ring_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateRingRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_orbis_ring_v1alpha1_ring_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateRingResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_orbis_ring_v1alpha1_ring_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidateManifestRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_orbis_ring_v1alpha1_ring_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidateManifestResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_orbis_ring_v1alpha1_ring_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ManifestViolation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_orbis_ring_v1alpha1_ring_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProposeRingRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_orbis_ring_v1alpha1_ring_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProposeRingResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1819,8 +3860,8 @@ func file_orbis_ring_v1alpha1_ring_proto_init() {
 				return nil
 			}
 		}
-		file_orbis_ring_v1alpha1_ring_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateRingRequest); i {
+		file_orbis_ring_v1alpha1_ring_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListProposalsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1831,8 +3872,8 @@ func file_orbis_ring_v1alpha1_ring_proto_init() {
 				return nil
 			}
 		}
-		file_orbis_ring_v1alpha1_ring_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateRingResponse); i {
+		file_orbis_ring_v1alpha1_ring_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListProposalsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1843,7 +3884,55 @@ func file_orbis_ring_v1alpha1_ring_proto_init() {
 				return nil
 			}
 		}
-		file_orbis_ring_v1alpha1_ring_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+		file_orbis_ring_v1alpha1_ring_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApproveProposalRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_orbis_ring_v1alpha1_ring_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApproveProposalResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_orbis_ring_v1alpha1_ring_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RingProposal); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_orbis_ring_v1alpha1_ring_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RingProposalAck); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_orbis_ring_v1alpha1_ring_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetRingRequest); i {
 			case 0:
 				return &v.state
@@ -1855,7 +3944,7 @@ func file_orbis_ring_v1alpha1_ring_proto_init() {
 				return nil
 			}
 		}
-		file_orbis_ring_v1alpha1_ring_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+		file_orbis_ring_v1alpha1_ring_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetRingResponse); i {
 			case 0:
 				return &v.state
@@ -1867,7 +3956,7 @@ func file_orbis_ring_v1alpha1_ring_proto_init() {
 				return nil
 			}
 		}
-		file_orbis_ring_v1alpha1_ring_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+		file_orbis_ring_v1alpha1_ring_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DeleteRingRequest); i {
 			case 0:
 				return &v.state
@@ -1879,7 +3968,7 @@ func file_orbis_ring_v1alpha1_ring_proto_init() {
 				return nil
 			}
 		}
-		file_orbis_ring_v1alpha1_ring_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+		file_orbis_ring_v1alpha1_ring_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RefreshRequest); i {
 			case 0:
 				return &v.state
@@ -1891,7 +3980,7 @@ func file_orbis_ring_v1alpha1_ring_proto_init() {
 				return nil
 			}
 		}
-		file_orbis_ring_v1alpha1_ring_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+		file_orbis_ring_v1alpha1_ring_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PublicKeyRequest); i {
 			case 0:
 				return &v.state
@@ -1903,7 +3992,7 @@ func file_orbis_ring_v1alpha1_ring_proto_init() {
 				return nil
 			}
 		}
-		file_orbis_ring_v1alpha1_ring_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+		file_orbis_ring_v1alpha1_ring_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PublicKeyResponse); i {
 			case 0:
 				return &v.state
@@ -1915,7 +4004,7 @@ func file_orbis_ring_v1alpha1_ring_proto_init() {
 				return nil
 			}
 		}
-		file_orbis_ring_v1alpha1_ring_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+		file_orbis_ring_v1alpha1_ring_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RefreshResponse); i {
 			case 0:
 				return &v.state
@@ -1927,7 +4016,7 @@ func file_orbis_ring_v1alpha1_ring_proto_init() {
 				return nil
 			}
 		}
-		file_orbis_ring_v1alpha1_ring_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+		file_orbis_ring_v1alpha1_ring_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*StateRequest); i {
 			case 0:
 				return &v.state
@@ -1939,7 +4028,7 @@ func file_orbis_ring_v1alpha1_ring_proto_init() {
 				return nil
 			}
 		}
-		file_orbis_ring_v1alpha1_ring_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+		file_orbis_ring_v1alpha1_ring_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*StateResponse); i {
 			case 0:
 				return &v.state
@@ -1951,7 +4040,7 @@ func file_orbis_ring_v1alpha1_ring_proto_init() {
 				return nil
 			}
 		}
-		file_orbis_ring_v1alpha1_ring_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+		file_orbis_ring_v1alpha1_ring_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ServiceState); i {
 			case 0:
 				return &v.state
@@ -1963,7 +4052,7 @@ func file_orbis_ring_v1alpha1_ring_proto_init() {
 				return nil
 			}
 		}
-		file_orbis_ring_v1alpha1_ring_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+		file_orbis_ring_v1alpha1_ring_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListSecretsRequest); i {
 			case 0:
 				return &v.state
@@ -1975,7 +4064,7 @@ func file_orbis_ring_v1alpha1_ring_proto_init() {
 				return nil
 			}
 		}
-		file_orbis_ring_v1alpha1_ring_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+		file_orbis_ring_v1alpha1_ring_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListSecretsResponse); i {
 			case 0:
 				return &v.state
@@ -1987,7 +4076,7 @@ func file_orbis_ring_v1alpha1_ring_proto_init() {
 				return nil
 			}
 		}
-		file_orbis_ring_v1alpha1_ring_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+		file_orbis_ring_v1alpha1_ring_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*StoreSecretRequest); i {
 			case 0:
 				return &v.state
@@ -1999,7 +4088,7 @@ func file_orbis_ring_v1alpha1_ring_proto_init() {
 				return nil
 			}
 		}
-		file_orbis_ring_v1alpha1_ring_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+		file_orbis_ring_v1alpha1_ring_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*StoreSecretResponse); i {
 			case 0:
 				return &v.state
@@ -2011,7 +4100,7 @@ func file_orbis_ring_v1alpha1_ring_proto_init() {
 				return nil
 			}
 		}
-		file_orbis_ring_v1alpha1_ring_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+		file_orbis_ring_v1alpha1_ring_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DeleteSecretRequest); i {
 			case 0:
 				return &v.state
@@ -2023,7 +4112,7 @@ func file_orbis_ring_v1alpha1_ring_proto_init() {
 				return nil
 			}
 		}
-		file_orbis_ring_v1alpha1_ring_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+		file_orbis_ring_v1alpha1_ring_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ReencryptSecretRequest); i {
 			case 0:
 				return &v.state
@@ -2035,7 +4124,7 @@ func file_orbis_ring_v1alpha1_ring_proto_init() {
 				return nil
 			}
 		}
-		file_orbis_ring_v1alpha1_ring_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+		file_orbis_ring_v1alpha1_ring_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ReencryptSecretResponse); i {
 			case 0:
 				return &v.state
@@ -2047,7 +4136,187 @@ func file_orbis_ring_v1alpha1_ring_proto_init() {
 				return nil
 			}
 		}
-		file_orbis_ring_v1alpha1_ring_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+		file_orbis_ring_v1alpha1_ring_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DecryptSecretRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_orbis_ring_v1alpha1_ring_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DecryptSecretResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_orbis_ring_v1alpha1_ring_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuditLogRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_orbis_ring_v1alpha1_ring_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuditLogResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_orbis_ring_v1alpha1_ring_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WriteRelationshipRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_orbis_ring_v1alpha1_ring_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WriteRelationshipResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_orbis_ring_v1alpha1_ring_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteRelationshipRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_orbis_ring_v1alpha1_ring_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteRelationshipResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_orbis_ring_v1alpha1_ring_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SignRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_orbis_ring_v1alpha1_ring_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SignResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_orbis_ring_v1alpha1_ring_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PartialSignature); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_orbis_ring_v1alpha1_ring_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NonceCommitment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_orbis_ring_v1alpha1_ring_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SigningPackage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_orbis_ring_v1alpha1_ring_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerifyRingSignatureRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_orbis_ring_v1alpha1_ring_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerifyRingSignatureResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_orbis_ring_v1alpha1_ring_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Secret); i {
 			case 0:
 				return &v.state
@@ -2059,7 +4328,7 @@ func file_orbis_ring_v1alpha1_ring_proto_init() {
 				return nil
 			}
 		}
-		file_orbis_ring_v1alpha1_ring_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+		file_orbis_ring_v1alpha1_ring_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ReencryptedSecretShare); i {
 			case 0:
 				return &v.state
@@ -2071,7 +4340,19 @@ func file_orbis_ring_v1alpha1_ring_proto_init() {
 				return nil
 			}
 		}
-		file_orbis_ring_v1alpha1_ring_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+		file_orbis_ring_v1alpha1_ring_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DecryptedSecretShare); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_orbis_ring_v1alpha1_ring_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Ring); i {
 			case 0:
 				return &v.state
@@ -2083,7 +4364,7 @@ func file_orbis_ring_v1alpha1_ring_proto_init() {
 				return nil
 			}
 		}
-		file_orbis_ring_v1alpha1_ring_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+		file_orbis_ring_v1alpha1_ring_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Manifest); i {
 			case 0:
 				return &v.state
@@ -2095,7 +4376,7 @@ func file_orbis_ring_v1alpha1_ring_proto_init() {
 				return nil
 			}
 		}
-		file_orbis_ring_v1alpha1_ring_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+		file_orbis_ring_v1alpha1_ring_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Node); i {
 			case 0:
 				return &v.state
@@ -2114,7 +4395,7 @@ func file_orbis_ring_v1alpha1_ring_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_orbis_ring_v1alpha1_ring_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   26,
+			NumMessages:   53,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -20,17 +20,27 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	RingService_ListRings_FullMethodName       = "/orbis.ring.v1alpha1.RingService/ListRings"
-	RingService_GetRing_FullMethodName         = "/orbis.ring.v1alpha1.RingService/GetRing"
-	RingService_CreateRing_FullMethodName      = "/orbis.ring.v1alpha1.RingService/CreateRing"
-	RingService_DeleteRing_FullMethodName      = "/orbis.ring.v1alpha1.RingService/DeleteRing"
-	RingService_PublicKey_FullMethodName       = "/orbis.ring.v1alpha1.RingService/PublicKey"
-	RingService_Refresh_FullMethodName         = "/orbis.ring.v1alpha1.RingService/Refresh"
-	RingService_State_FullMethodName           = "/orbis.ring.v1alpha1.RingService/State"
-	RingService_ListSecrets_FullMethodName     = "/orbis.ring.v1alpha1.RingService/ListSecrets"
-	RingService_StoreSecret_FullMethodName     = "/orbis.ring.v1alpha1.RingService/StoreSecret"
-	RingService_ReencryptSecret_FullMethodName = "/orbis.ring.v1alpha1.RingService/ReencryptSecret"
-	RingService_DeleteSecret_FullMethodName    = "/orbis.ring.v1alpha1.RingService/DeleteSecret"
+	RingService_ListRings_FullMethodName           = "/orbis.ring.v1alpha1.RingService/ListRings"
+	RingService_GetRing_FullMethodName             = "/orbis.ring.v1alpha1.RingService/GetRing"
+	RingService_CreateRing_FullMethodName          = "/orbis.ring.v1alpha1.RingService/CreateRing"
+	RingService_ValidateManifest_FullMethodName    = "/orbis.ring.v1alpha1.RingService/ValidateManifest"
+	RingService_ProposeRing_FullMethodName         = "/orbis.ring.v1alpha1.RingService/ProposeRing"
+	RingService_ListProposals_FullMethodName       = "/orbis.ring.v1alpha1.RingService/ListProposals"
+	RingService_ApproveProposal_FullMethodName     = "/orbis.ring.v1alpha1.RingService/ApproveProposal"
+	RingService_DeleteRing_FullMethodName          = "/orbis.ring.v1alpha1.RingService/DeleteRing"
+	RingService_PublicKey_FullMethodName           = "/orbis.ring.v1alpha1.RingService/PublicKey"
+	RingService_Refresh_FullMethodName             = "/orbis.ring.v1alpha1.RingService/Refresh"
+	RingService_State_FullMethodName               = "/orbis.ring.v1alpha1.RingService/State"
+	RingService_ListSecrets_FullMethodName         = "/orbis.ring.v1alpha1.RingService/ListSecrets"
+	RingService_StoreSecret_FullMethodName         = "/orbis.ring.v1alpha1.RingService/StoreSecret"
+	RingService_ReencryptSecret_FullMethodName     = "/orbis.ring.v1alpha1.RingService/ReencryptSecret"
+	RingService_DecryptSecret_FullMethodName       = "/orbis.ring.v1alpha1.RingService/DecryptSecret"
+	RingService_AuditLog_FullMethodName            = "/orbis.ring.v1alpha1.RingService/AuditLog"
+	RingService_WriteRelationship_FullMethodName   = "/orbis.ring.v1alpha1.RingService/WriteRelationship"
+	RingService_DeleteRelationship_FullMethodName  = "/orbis.ring.v1alpha1.RingService/DeleteRelationship"
+	RingService_Sign_FullMethodName                = "/orbis.ring.v1alpha1.RingService/Sign"
+	RingService_VerifyRingSignature_FullMethodName = "/orbis.ring.v1alpha1.RingService/VerifyRingSignature"
+	RingService_DeleteSecret_FullMethodName        = "/orbis.ring.v1alpha1.RingService/DeleteSecret"
 )
 
 // RingServiceClient is the client API for RingService service.
@@ -40,6 +50,16 @@ type RingServiceClient interface {
 	ListRings(ctx context.Context, in *ListRingsRequest, opts ...grpc.CallOption) (*ListRingsResponse, error)
 	GetRing(ctx context.Context, in *GetRingRequest, opts ...grpc.CallOption) (*GetRingResponse, error)
 	CreateRing(ctx context.Context, in *CreateRingRequest, opts ...grpc.CallOption) (*CreateRingResponse, error)
+	// ValidateManifest checks a ring manifest can be joined by this node,
+	// without creating the ring.
+	ValidateManifest(ctx context.Context, in *ValidateManifestRequest, opts ...grpc.CallOption) (*ValidateManifestResponse, error)
+	// ProposeRing posts a ring proposal to all the manifest nodes. The
+	// ring DKG starts once every node has acknowledged the proposal.
+	ProposeRing(ctx context.Context, in *ProposeRingRequest, opts ...grpc.CallOption) (*ProposeRingResponse, error)
+	ListProposals(ctx context.Context, in *ListProposalsRequest, opts ...grpc.CallOption) (*ListProposalsResponse, error)
+	// ApproveProposal accepts a pending ring proposal that wasn't
+	// automatically accepted by the local proposal policy.
+	ApproveProposal(ctx context.Context, in *ApproveProposalRequest, opts ...grpc.CallOption) (*ApproveProposalResponse, error)
 	DeleteRing(ctx context.Context, in *DeleteRingRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	PublicKey(ctx context.Context, in *PublicKeyRequest, opts ...grpc.CallOption) (*PublicKeyResponse, error)
 	Refresh(ctx context.Context, in *RefreshRequest, opts ...grpc.CallOption) (*RefreshResponse, error)
@@ -47,6 +67,29 @@ type RingServiceClient interface {
 	ListSecrets(ctx context.Context, in *ListSecretsRequest, opts ...grpc.CallOption) (*ListSecretsResponse, error)
 	StoreSecret(ctx context.Context, in *StoreSecretRequest, opts ...grpc.CallOption) (*StoreSecretResponse, error)
 	ReencryptSecret(ctx context.Context, in *ReencryptSecretRequest, opts ...grpc.CallOption) (*ReencryptSecretResponse, error)
+	// DecryptSecret decrypts the secret with threshold decryption, and
+	// returns its plaintext to the caller, which must hold the decrypt
+	// permission on the secret authorization context object. It's meant
+	// for trusted aggregators, as the node serving the request sees the
+	// plaintext, and is disabled unless enabled in the node configuration.
+	DecryptSecret(ctx context.Context, in *DecryptSecretRequest, opts ...grpc.CallOption) (*DecryptSecretResponse, error)
+	// AuditLog returns the secret access audit records of a ring
+	// kept by this node, optionally filtered by subject or secret.
+	AuditLog(ctx context.Context, in *AuditLogRequest, opts ...grpc.CallOption) (*AuditLogResponse, error)
+	// WriteRelationship adds a relationship to the ring authorizer,
+	// if it manages its own relationships.
+	WriteRelationship(ctx context.Context, in *WriteRelationshipRequest, opts ...grpc.CallOption) (*WriteRelationshipResponse, error)
+	// DeleteRelationship removes a relationship from the ring authorizer,
+	// if it manages its own relationships.
+	DeleteRelationship(ctx context.Context, in *DeleteRelationshipRequest, opts ...grpc.CallOption) (*DeleteRelationshipResponse, error)
+	// Sign signs the message with the ring key. Each ring node signs with
+	// its DKG share, and a threshold of partial signatures is recovered into
+	// a single signature, verifiable with the ring public key. Rings with
+	// pairing keys sign with threshold BLS, other rings with FROST Schnorr
+	// signatures, whose nonce commitments go through the ring bulletin.
+	Sign(ctx context.Context, in *SignRequest, opts ...grpc.CallOption) (*SignResponse, error)
+	// VerifyRingSignature checks a signature of the message by the ring key.
+	VerifyRingSignature(ctx context.Context, in *VerifyRingSignatureRequest, opts ...grpc.CallOption) (*VerifyRingSignatureResponse, error)
 	DeleteSecret(ctx context.Context, in *DeleteSecretRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
@@ -85,6 +128,42 @@ func (c *ringServiceClient) CreateRing(ctx context.Context, in *CreateRingReques
 	return out, nil
 }
 
+func (c *ringServiceClient) ValidateManifest(ctx context.Context, in *ValidateManifestRequest, opts ...grpc.CallOption) (*ValidateManifestResponse, error) {
+	out := new(ValidateManifestResponse)
+	err := c.cc.Invoke(ctx, RingService_ValidateManifest_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ringServiceClient) ProposeRing(ctx context.Context, in *ProposeRingRequest, opts ...grpc.CallOption) (*ProposeRingResponse, error) {
+	out := new(ProposeRingResponse)
+	err := c.cc.Invoke(ctx, RingService_ProposeRing_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ringServiceClient) ListProposals(ctx context.Context, in *ListProposalsRequest, opts ...grpc.CallOption) (*ListProposalsResponse, error) {
+	out := new(ListProposalsResponse)
+	err := c.cc.Invoke(ctx, RingService_ListProposals_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ringServiceClient) ApproveProposal(ctx context.Context, in *ApproveProposalRequest, opts ...grpc.CallOption) (*ApproveProposalResponse, error) {
+	out := new(ApproveProposalResponse)
+	err := c.cc.Invoke(ctx, RingService_ApproveProposal_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *ringServiceClient) DeleteRing(ctx context.Context, in *DeleteRingRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, RingService_DeleteRing_FullMethodName, in, out, opts...)
@@ -148,6 +227,60 @@ func (c *ringServiceClient) ReencryptSecret(ctx context.Context, in *ReencryptSe
 	return out, nil
 }
 
+func (c *ringServiceClient) DecryptSecret(ctx context.Context, in *DecryptSecretRequest, opts ...grpc.CallOption) (*DecryptSecretResponse, error) {
+	out := new(DecryptSecretResponse)
+	err := c.cc.Invoke(ctx, RingService_DecryptSecret_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ringServiceClient) AuditLog(ctx context.Context, in *AuditLogRequest, opts ...grpc.CallOption) (*AuditLogResponse, error) {
+	out := new(AuditLogResponse)
+	err := c.cc.Invoke(ctx, RingService_AuditLog_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ringServiceClient) WriteRelationship(ctx context.Context, in *WriteRelationshipRequest, opts ...grpc.CallOption) (*WriteRelationshipResponse, error) {
+	out := new(WriteRelationshipResponse)
+	err := c.cc.Invoke(ctx, RingService_WriteRelationship_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ringServiceClient) DeleteRelationship(ctx context.Context, in *DeleteRelationshipRequest, opts ...grpc.CallOption) (*DeleteRelationshipResponse, error) {
+	out := new(DeleteRelationshipResponse)
+	err := c.cc.Invoke(ctx, RingService_DeleteRelationship_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ringServiceClient) Sign(ctx context.Context, in *SignRequest, opts ...grpc.CallOption) (*SignResponse, error) {
+	out := new(SignResponse)
+	err := c.cc.Invoke(ctx, RingService_Sign_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ringServiceClient) VerifyRingSignature(ctx context.Context, in *VerifyRingSignatureRequest, opts ...grpc.CallOption) (*VerifyRingSignatureResponse, error) {
+	out := new(VerifyRingSignatureResponse)
+	err := c.cc.Invoke(ctx, RingService_VerifyRingSignature_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *ringServiceClient) DeleteSecret(ctx context.Context, in *DeleteSecretRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, RingService_DeleteSecret_FullMethodName, in, out, opts...)
@@ -164,6 +297,16 @@ type RingServiceServer interface {
 	ListRings(context.Context, *ListRingsRequest) (*ListRingsResponse, error)
 	GetRing(context.Context, *GetRingRequest) (*GetRingResponse, error)
 	CreateRing(context.Context, *CreateRingRequest) (*CreateRingResponse, error)
+	// ValidateManifest checks a ring manifest can be joined by this node,
+	// without creating the ring.
+	ValidateManifest(context.Context, *ValidateManifestRequest) (*ValidateManifestResponse, error)
+	// ProposeRing posts a ring proposal to all the manifest nodes. The
+	// ring DKG starts once every node has acknowledged the proposal.
+	ProposeRing(context.Context, *ProposeRingRequest) (*ProposeRingResponse, error)
+	ListProposals(context.Context, *ListProposalsRequest) (*ListProposalsResponse, error)
+	// ApproveProposal accepts a pending ring proposal that wasn't
+	// automatically accepted by the local proposal policy.
+	ApproveProposal(context.Context, *ApproveProposalRequest) (*ApproveProposalResponse, error)
 	DeleteRing(context.Context, *DeleteRingRequest) (*emptypb.Empty, error)
 	PublicKey(context.Context, *PublicKeyRequest) (*PublicKeyResponse, error)
 	Refresh(context.Context, *RefreshRequest) (*RefreshResponse, error)
@@ -171,6 +314,29 @@ type RingServiceServer interface {
 	ListSecrets(context.Context, *ListSecretsRequest) (*ListSecretsResponse, error)
 	StoreSecret(context.Context, *StoreSecretRequest) (*StoreSecretResponse, error)
 	ReencryptSecret(context.Context, *ReencryptSecretRequest) (*ReencryptSecretResponse, error)
+	// DecryptSecret decrypts the secret with threshold decryption, and
+	// returns its plaintext to the caller, which must hold the decrypt
+	// permission on the secret authorization context object. It's meant
+	// for trusted aggregators, as the node serving the request sees the
+	// plaintext, and is disabled unless enabled in the node configuration.
+	DecryptSecret(context.Context, *DecryptSecretRequest) (*DecryptSecretResponse, error)
+	// AuditLog returns the secret access audit records of a ring
+	// kept by this node, optionally filtered by subject or secret.
+	AuditLog(context.Context, *AuditLogRequest) (*AuditLogResponse, error)
+	// WriteRelationship adds a relationship to the ring authorizer,
+	// if it manages its own relationships.
+	WriteRelationship(context.Context, *WriteRelationshipRequest) (*WriteRelationshipResponse, error)
+	// DeleteRelationship removes a relationship from the ring authorizer,
+	// if it manages its own relationships.
+	DeleteRelationship(context.Context, *DeleteRelationshipRequest) (*DeleteRelationshipResponse, error)
+	// Sign signs the message with the ring key. Each ring node signs with
+	// its DKG share, and a threshold of partial signatures is recovered into
+	// a single signature, verifiable with the ring public key. Rings with
+	// pairing keys sign with threshold BLS, other rings with FROST Schnorr
+	// signatures, whose nonce commitments go through the ring bulletin.
+	Sign(context.Context, *SignRequest) (*SignResponse, error)
+	// VerifyRingSignature checks a signature of the message by the ring key.
+	VerifyRingSignature(context.Context, *VerifyRingSignatureRequest) (*VerifyRingSignatureResponse, error)
 	DeleteSecret(context.Context, *DeleteSecretRequest) (*emptypb.Empty, error)
 	mustEmbedUnimplementedRingServiceServer()
 }
@@ -188,6 +354,18 @@ func (UnimplementedRingServiceServer) GetRing(context.Context, *GetRingRequest)
 func (UnimplementedRingServiceServer) CreateRing(context.Context, *CreateRingRequest) (*CreateRingResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateRing not implemented")
 }
+func (UnimplementedRingServiceServer) ValidateManifest(context.Context, *ValidateManifestRequest) (*ValidateManifestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateManifest not implemented")
+}
+func (UnimplementedRingServiceServer) ProposeRing(context.Context, *ProposeRingRequest) (*ProposeRingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposeRing not implemented")
+}
+func (UnimplementedRingServiceServer) ListProposals(context.Context, *ListProposalsRequest) (*ListProposalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProposals not implemented")
+}
+func (UnimplementedRingServiceServer) ApproveProposal(context.Context, *ApproveProposalRequest) (*ApproveProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveProposal not implemented")
+}
 func (UnimplementedRingServiceServer) DeleteRing(context.Context, *DeleteRingRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteRing not implemented")
 }
@@ -209,6 +387,24 @@ func (UnimplementedRingServiceServer) StoreSecret(context.Context, *StoreSecretR
 func (UnimplementedRingServiceServer) ReencryptSecret(context.Context, *ReencryptSecretRequest) (*ReencryptSecretResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReencryptSecret not implemented")
 }
+func (UnimplementedRingServiceServer) DecryptSecret(context.Context, *DecryptSecretRequest) (*DecryptSecretResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DecryptSecret not implemented")
+}
+func (UnimplementedRingServiceServer) AuditLog(context.Context, *AuditLogRequest) (*AuditLogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuditLog not implemented")
+}
+func (UnimplementedRingServiceServer) WriteRelationship(context.Context, *WriteRelationshipRequest) (*WriteRelationshipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WriteRelationship not implemented")
+}
+func (UnimplementedRingServiceServer) DeleteRelationship(context.Context, *DeleteRelationshipRequest) (*DeleteRelationshipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRelationship not implemented")
+}
+func (UnimplementedRingServiceServer) Sign(context.Context, *SignRequest) (*SignResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Sign not implemented")
+}
+func (UnimplementedRingServiceServer) VerifyRingSignature(context.Context, *VerifyRingSignatureRequest) (*VerifyRingSignatureResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyRingSignature not implemented")
+}
 func (UnimplementedRingServiceServer) DeleteSecret(context.Context, *DeleteSecretRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteSecret not implemented")
 }
@@ -279,6 +475,78 @@ func _RingService_CreateRing_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RingService_ValidateManifest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateManifestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RingServiceServer).ValidateManifest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RingService_ValidateManifest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RingServiceServer).ValidateManifest(ctx, req.(*ValidateManifestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RingService_ProposeRing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProposeRingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RingServiceServer).ProposeRing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RingService_ProposeRing_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RingServiceServer).ProposeRing(ctx, req.(*ProposeRingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RingService_ListProposals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProposalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RingServiceServer).ListProposals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RingService_ListProposals_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RingServiceServer).ListProposals(ctx, req.(*ListProposalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RingService_ApproveProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveProposalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RingServiceServer).ApproveProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RingService_ApproveProposal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RingServiceServer).ApproveProposal(ctx, req.(*ApproveProposalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _RingService_DeleteRing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteRingRequest)
 	if err := dec(in); err != nil {
@@ -405,6 +673,114 @@ func _RingService_ReencryptSecret_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RingService_DecryptSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DecryptSecretRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RingServiceServer).DecryptSecret(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RingService_DecryptSecret_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RingServiceServer).DecryptSecret(ctx, req.(*DecryptSecretRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RingService_AuditLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuditLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RingServiceServer).AuditLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RingService_AuditLog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RingServiceServer).AuditLog(ctx, req.(*AuditLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RingService_WriteRelationship_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WriteRelationshipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RingServiceServer).WriteRelationship(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RingService_WriteRelationship_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RingServiceServer).WriteRelationship(ctx, req.(*WriteRelationshipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RingService_DeleteRelationship_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRelationshipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RingServiceServer).DeleteRelationship(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RingService_DeleteRelationship_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RingServiceServer).DeleteRelationship(ctx, req.(*DeleteRelationshipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RingService_Sign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RingServiceServer).Sign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RingService_Sign_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RingServiceServer).Sign(ctx, req.(*SignRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RingService_VerifyRingSignature_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyRingSignatureRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RingServiceServer).VerifyRingSignature(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RingService_VerifyRingSignature_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RingServiceServer).VerifyRingSignature(ctx, req.(*VerifyRingSignatureRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _RingService_DeleteSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteSecretRequest)
 	if err := dec(in); err != nil {
@@ -442,6 +818,22 @@ var RingService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateRing",
 			Handler:    _RingService_CreateRing_Handler,
 		},
+		{
+			MethodName: "ValidateManifest",
+			Handler:    _RingService_ValidateManifest_Handler,
+		},
+		{
+			MethodName: "ProposeRing",
+			Handler:    _RingService_ProposeRing_Handler,
+		},
+		{
+			MethodName: "ListProposals",
+			Handler:    _RingService_ListProposals_Handler,
+		},
+		{
+			MethodName: "ApproveProposal",
+			Handler:    _RingService_ApproveProposal_Handler,
+		},
 		{
 			MethodName: "DeleteRing",
 			Handler:    _RingService_DeleteRing_Handler,
@@ -470,6 +862,30 @@ var RingService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ReencryptSecret",
 			Handler:    _RingService_ReencryptSecret_Handler,
 		},
+		{
+			MethodName: "DecryptSecret",
+			Handler:    _RingService_DecryptSecret_Handler,
+		},
+		{
+			MethodName: "AuditLog",
+			Handler:    _RingService_AuditLog_Handler,
+		},
+		{
+			MethodName: "WriteRelationship",
+			Handler:    _RingService_WriteRelationship_Handler,
+		},
+		{
+			MethodName: "DeleteRelationship",
+			Handler:    _RingService_DeleteRelationship_Handler,
+		},
+		{
+			MethodName: "Sign",
+			Handler:    _RingService_Sign_Handler,
+		},
+		{
+			MethodName: "VerifyRingSignature",
+			Handler:    _RingService_VerifyRingSignature_Handler,
+		},
 		{
 			MethodName: "DeleteSecret",
 			Handler:    _RingService_DeleteSecret_Handler,
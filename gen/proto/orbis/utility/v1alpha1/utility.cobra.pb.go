@@ -200,6 +200,7 @@ func _UtilityServiceEncryptSecretCommand(cfg *client.Config) *cobra.Command {
 	cmd.PersistentFlags().StringVar(&req.KeyType, cfg.FlagNamer("KeyType"), "", "")
 	flag.BytesBase64Var(cmd.PersistentFlags(), &req.DkgPk, cfg.FlagNamer("DkgPk"), "")
 	flag.BytesBase64Var(cmd.PersistentFlags(), &req.Scrt, cfg.FlagNamer("Scrt"), "")
+	cmd.PersistentFlags().StringVar(&req.Dem, cfg.FlagNamer("Dem"), "", "")
 
 	return cmd
 }
@@ -246,6 +247,9 @@ func _UtilityServiceDecryptSecretCommand(cfg *client.Config) *cobra.Command {
 	flag.BytesBase64Var(cmd.PersistentFlags(), &req.XncCmt, cfg.FlagNamer("XncCmt"), "")
 	flag.BytesBase64Var(cmd.PersistentFlags(), &req.RdrSk, cfg.FlagNamer("RdrSk"), "")
 	flag.BytesBase64Var(cmd.PersistentFlags(), &req.DkgPk, cfg.FlagNamer("DkgPk"), "")
+	cmd.PersistentFlags().StringVar(&req.Dem, cfg.FlagNamer("Dem"), "", "")
+	flag.BytesBase64Var(cmd.PersistentFlags(), &req.EncData, cfg.FlagNamer("EncData"), "")
+	flag.BytesBase64Var(cmd.PersistentFlags(), &req.EncCmt, cfg.FlagNamer("EncCmt"), "")
 
 	return cmd
 }
@@ -30,6 +30,13 @@ type SubjectInfo struct {
 	Type    string
 	Subject string
 	PubKey  crypto.PublicKey
+	// Binding is the request binding of the credential, if
+	// any, restricting it to a single request. See ReencryptBinding.
+	Binding string
+	// Capabilities restrict a delegated credential, whose
+	// subject is the delegation root. It's nil for credentials
+	// issued by the subject itself, which are unrestricted.
+	Capabilities []Capability
 }
 
 // Metadata represents the generic version of parsed metadata
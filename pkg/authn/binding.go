@@ -0,0 +1,70 @@
+package authn
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+
+	ic "github.com/libp2p/go-libp2p/core/crypto"
+
+	"github.com/sourcenetwork/orbis-go/pkg/crypto"
+)
+
+// reencryptBindingDomain separates re-encryption bindings
+// from any other use of the hash.
+const reencryptBindingDomain = "orbis/reencrypt/v1"
+
+// ReencryptBinding returns the binding of the re-encryption of the secret
+// to the reader key, which is the base64url encoded SHA-256 digest of the
+// secret id and the marshaled reader key. A credential carrying the
+// binding only authorizes that re-encryption.
+func ReencryptBinding(secretID string, rdrPk crypto.PublicKey) (string, error) {
+	buf, err := ic.MarshalPublicKey(rdrPk)
+	if err != nil {
+		return "", fmt.Errorf("marshal reader public key: %w", err)
+	}
+
+	h := sha256.New()
+	h.Write([]byte(reencryptBindingDomain))
+	h.Write([]byte{0})
+	h.Write([]byte(secretID))
+	h.Write([]byte{0})
+	h.Write(buf)
+	return base64.RawURLEncoding.EncodeToString(h.Sum(nil)), nil
+}
+
+// signBindingDomain separates ring signature bindings
+// from any other use of the hash.
+const signBindingDomain = "orbis/sign/v1"
+
+// SignBinding returns the binding of the signature of the message by
+// the ring, which is the base64url encoded SHA-256 digest of the ring
+// id and the message. A credential carrying the binding only authorizes
+// that signature.
+func SignBinding(ringID string, msg []byte) string {
+	h := sha256.New()
+	h.Write([]byte(signBindingDomain))
+	h.Write([]byte{0})
+	h.Write([]byte(ringID))
+	h.Write([]byte{0})
+	h.Write(msg)
+	return base64.RawURLEncoding.EncodeToString(h.Sum(nil))
+}
+
+// decryptBindingDomain separates threshold decryption
+// bindings from any other use of the hash.
+const decryptBindingDomain = "orbis/decrypt/v1"
+
+// DecryptBinding returns the binding of the threshold decryption of the
+// secret by the ring, which is the base64url encoded SHA-256 digest of
+// the ring id and the secret id. A credential carrying the binding only
+// authorizes that decryption.
+func DecryptBinding(ringID string, secretID string) string {
+	h := sha256.New()
+	h.Write([]byte(decryptBindingDomain))
+	h.Write([]byte{0})
+	h.Write([]byte(ringID))
+	h.Write([]byte{0})
+	h.Write([]byte(secretID))
+	return base64.RawURLEncoding.EncodeToString(h.Sum(nil))
+}
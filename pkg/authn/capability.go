@@ -0,0 +1,58 @@
+package authn
+
+import (
+	"strings"
+)
+
+// wildcard matches any ability, or any resource suffix.
+const wildcard = "*"
+
+// Capability is an ability on a resource, delegated to a subject.
+type Capability struct {
+	// Resource is an authorization object formatted as
+	// PolicyID/Resource:ResourceID, where a trailing "*"
+	// matches any suffix, such as "PolicyID/secret:*".
+	Resource string `json:"with"`
+	// Ability is a relation of the resource, or "*" for any.
+	Ability string `json:"can"`
+}
+
+// Covers returns whether the capability includes the other one,
+// so the other one attenuates it.
+func (c Capability) Covers(o Capability) bool {
+	if c.Ability != wildcard && c.Ability != o.Ability {
+		return false
+	}
+	if prefix, ok := strings.CutSuffix(c.Resource, wildcard); ok {
+		return strings.HasPrefix(o.Resource, prefix)
+	}
+	return c.Resource == o.Resource
+}
+
+// Delegated returns whether the subject is acting through
+// a delegated credential, restricted by its capabilities.
+func (s SubjectInfo) Delegated() bool {
+	return s.Capabilities != nil
+}
+
+// Allows returns whether the credential grants the permission, formatted
+// as PolicyID/Resource:ResourceID#relation. Only the capabilities of
+// delegated credentials are checked, the subject permissions are left
+// to the authorizer.
+func (s SubjectInfo) Allows(perm string) bool {
+	if !s.Delegated() {
+		return true
+	}
+
+	i := strings.LastIndex(perm, "#")
+	if i < 0 {
+		return false
+	}
+	want := Capability{Resource: perm[:i], Ability: perm[i+1:]}
+	for _, c := range s.Capabilities {
+		if c.Covers(want) {
+			return true
+		}
+	}
+	return false
+}
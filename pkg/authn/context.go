@@ -0,0 +1,18 @@
+package authn
+
+import "context"
+
+type subjectCtxKey struct{}
+
+// ContextWithSubject returns a copy of ctx carrying the
+// authenticated subject of the request.
+func ContextWithSubject(ctx context.Context, subject SubjectInfo) context.Context {
+	return context.WithValue(ctx, subjectCtxKey{}, subject)
+}
+
+// SubjectFromContext returns the authenticated subject of the
+// request, if it was authenticated.
+func SubjectFromContext(ctx context.Context) (SubjectInfo, bool) {
+	subject, ok := ctx.Value(subjectCtxKey{}).(SubjectInfo)
+	return subject, ok
+}
@@ -0,0 +1,223 @@
+package jws
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/go-jose/go-jose/v3/jwt"
+
+	"github.com/sourcenetwork/orbis-go/pkg/authn"
+	"github.com/sourcenetwork/orbis-go/pkg/crypto"
+)
+
+// maxDelegationDepth bounds the number of proofs in a delegation chain.
+const maxDelegationDepth = 8
+
+var _ authn.CredentialService = (*delegationSrv)(nil)
+
+// delegationSrv verifies UCAN style delegation chains. Each token of a
+// chain is a JWS issued by a DID to an audience DID, delegating it the
+// capabilities in the `att` claim, proven by the parent token in the
+// `prf` claim. The request token is the one issued to orbis by the
+// invoker, and the chain root, without proof, is issued by the subject
+// whose permissions are delegated.
+//
+// Every token must be signed by its issuer, unexpired, issued to the
+// issuer of its child, and attenuate its parent: its capabilities and
+// validity must be covered by the parent ones. The authenticated subject
+// is the root issuer, restricted to the invoked capabilities, with the
+// invoker public key.
+type delegationSrv struct {
+	credentialSrv
+}
+
+// NewDelegationCredentialService returns a credential service accepting
+// delegation chains. The options apply to the request token.
+func NewDelegationCredentialService(resolver authn.KeyResolver, metadataParser authn.RequestMetadataParser, opts ...Option) authn.CredentialService {
+	c := credentialSrv{
+		resolver:       resolver,
+		metadataParser: metadataParser,
+	}
+	for _, opt := range opts {
+		opt(&c)
+	}
+	return delegationSrv{credentialSrv: c}
+}
+
+func (d delegationSrv) VerifyRequestSubject(ctx context.Context, token []byte) (authn.SubjectInfo, error) {
+	now := time.Now()
+	invoker, leaf, err := d.verifyLink(ctx, token, OrbisJWSAudience, now)
+	if err != nil {
+		return authn.SubjectInfo{}, err
+	}
+	err = d.validateLifetime(leaf.Claims)
+	if err != nil {
+		return authn.SubjectInfo{}, err
+	}
+
+	root := invoker.Subject
+	link := leaf
+	for depth := 0; len(link.Proofs) > 0; depth++ {
+		if depth == maxDelegationDepth {
+			return authn.SubjectInfo{}, fmt.Errorf("delegation chain longer than %d", maxDelegationDepth)
+		}
+		if len(link.Proofs) != 1 {
+			return authn.SubjectInfo{}, fmt.Errorf("delegation of %s has %d proofs, expected 1", link.Issuer, len(link.Proofs))
+		}
+
+		issuer, parent, err := d.verifyLink(ctx, []byte(link.Proofs[0]), link.Issuer, now)
+		if err != nil {
+			return authn.SubjectInfo{}, fmt.Errorf("delegation to %s: %w", link.Issuer, err)
+		}
+		err = attenuates(link, parent)
+		if err != nil {
+			return authn.SubjectInfo{}, fmt.Errorf("delegation to %s: %w", link.Issuer, err)
+		}
+
+		root = issuer.Subject
+		link = parent
+	}
+
+	// only the request token is single use,
+	// delegations can be invoked many times.
+	err = d.checkReplay(leaf.Claims)
+	if err != nil {
+		return authn.SubjectInfo{}, err
+	}
+
+	caps := leaf.Capabilities
+	if caps == nil {
+		// a nil slice would make the credential unrestricted.
+		caps = []authn.Capability{}
+	}
+
+	return authn.SubjectInfo{
+		Type:         "UCAN",
+		Subject:      root,
+		PubKey:       invoker.PubKey,
+		Binding:      leaf.Binding,
+		Capabilities: caps,
+	}, nil
+}
+
+// verifyLink verifies a token of the chain is signed by its
+// issuer, issued to the audience, and valid at the time.
+func (d delegationSrv) verifyLink(ctx context.Context, token []byte, audience string, now time.Time) (authn.SubjectInfo, delegationClaims, error) {
+	info, payload, err := verifySigned(ctx, d.resolver, token)
+	if err != nil {
+		return authn.SubjectInfo{}, delegationClaims{}, err
+	}
+
+	var cl delegationClaims
+	err = json.Unmarshal(payload, &cl)
+	if err != nil {
+		return authn.SubjectInfo{}, delegationClaims{}, fmt.Errorf("unmarshaling JWS payload: %w", err)
+	}
+
+	expected := jwt.Expected{
+		Audience: jwt.Audience{audience},
+		Issuer:   info.Subject,
+		Time:     now,
+	}
+	err = cl.ValidateWithLeeway(expected, verifyLeewayTime)
+	if err != nil {
+		return authn.SubjectInfo{}, delegationClaims{}, fmt.Errorf("JWS claim failed validation: %w", err)
+	}
+	if cl.Expiry == nil {
+		return authn.SubjectInfo{}, delegationClaims{}, fmt.Errorf("JWS claim failed validation: missing exp")
+	}
+
+	return info, cl, nil
+}
+
+// attenuates checks the child capabilities and validity
+// are covered by the parent ones.
+func attenuates(child, parent delegationClaims) error {
+	if child.Expiry.Time().After(parent.Expiry.Time()) {
+		return fmt.Errorf("expires after its proof")
+	}
+	if parent.NotBefore != nil && (child.NotBefore == nil || child.NotBefore.Time().Before(parent.NotBefore.Time())) {
+		return fmt.Errorf("valid before its proof")
+	}
+
+	for _, c := range child.Capabilities {
+		if !covered(parent.Capabilities, c) {
+			return fmt.Errorf("capability %s#%s not delegated", c.Resource, c.Ability)
+		}
+	}
+
+	return nil
+}
+
+func covered(caps []authn.Capability, c authn.Capability) bool {
+	for _, p := range caps {
+		if p.Covers(c) {
+			return true
+		}
+	}
+	return false
+}
+
+// Delegation describes a token of a delegation chain.
+type Delegation struct {
+	// Issuer is the DID delegating the capabilities,
+	// which must be resolvable from the key id.
+	Issuer string
+	// Audience is the DID the capabilities are delegated
+	// to, or OrbisJWSAudience to invoke them.
+	Audience     string
+	Capabilities []authn.Capability
+	// Proof is the token delegating the capabilities to the
+	// issuer, empty if the issuer is the chain root.
+	Proof string
+	// Binding restricts an invocation to a single
+	// request, see authn.ReencryptBinding.
+	Binding string
+}
+
+// NewDelegationToken creates a compact JWS delegation token, signed
+// by the issuer private key, and valid for ttl. The token of a chain
+// can't outlive its proof, so its expiry is capped to the proof one.
+func NewDelegationToken(sk crypto.PrivateKey, kid string, d Delegation, ttl time.Duration) (string, error) {
+	cl, err := newClaims(d.Issuer, ttl)
+	if err != nil {
+		return "", err
+	}
+	cl.Audience = jwt.Audience{d.Audience}
+
+	if d.Proof != "" {
+		proof, err := jwt.ParseSigned(d.Proof)
+		if err != nil {
+			return "", fmt.Errorf("parse proof: %w", err)
+		}
+		var pcl jwt.Claims
+		err = proof.UnsafeClaimsWithoutVerification(&pcl)
+		if err != nil {
+			return "", fmt.Errorf("parse proof claims: %w", err)
+		}
+		if pcl.Expiry != nil && pcl.Expiry.Time().Before(cl.Expiry.Time()) {
+			cl.Expiry = pcl.Expiry
+		}
+	}
+
+	dcl := delegationClaims{
+		Claims:       cl,
+		Capabilities: d.Capabilities,
+		Binding:      d.Binding,
+	}
+	if d.Proof != "" {
+		dcl.Proofs = []string{d.Proof}
+	}
+
+	return signToken(sk, kid, dcl)
+}
+
+type delegationClaims struct {
+	jwt.Claims
+	Capabilities []authn.Capability `json:"att"`
+	// Proofs are the parent tokens of the chain.
+	Proofs  []string `json:"prf,omitempty"`
+	Binding string   `json:"req,omitempty"`
+}
@@ -0,0 +1,195 @@
+package jws
+
+import (
+	"context"
+	"crypto/rand"
+	"testing"
+	"time"
+
+	"github.com/TBD54566975/ssi-sdk/did/key"
+	ic "github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/stretchr/testify/require"
+	"go.dedis.ch/kyber/v3/suites"
+
+	"github.com/sourcenetwork/orbis-go/pkg/authn"
+	"github.com/sourcenetwork/orbis-go/pkg/crypto"
+	"github.com/sourcenetwork/orbis-go/pkg/did"
+)
+
+type testIdentity struct {
+	sk  crypto.PrivateKey
+	pk  crypto.PublicKey
+	did string
+	kid string
+}
+
+func newTestIdentity(t *testing.T) testIdentity {
+	sk, pk, err := crypto.GenerateKeyPair(suites.MustFind("ed25519"), rand.Reader)
+	require.NoError(t, err)
+	subject, kid, err := did.KeyDID(pk)
+	require.NoError(t, err)
+	return testIdentity{sk: sk, pk: pk, did: subject, kid: kid}
+}
+
+func (id testIdentity) delegate(t *testing.T, aud string, caps []authn.Capability, proof string, ttl time.Duration) string {
+	token, err := NewDelegationToken(id.sk, id.kid, Delegation{
+		Issuer:       id.did,
+		Audience:     aud,
+		Capabilities: caps,
+		Proof:        proof,
+	}, ttl)
+	require.NoError(t, err)
+	return token
+}
+
+func verifyDelegation(token string, opts ...Option) (authn.SubjectInfo, error) {
+	creds := NewDelegationCredentialService(did.NewResolver(key.Resolver{}), nil, opts...)
+	return creds.VerifyRequestSubject(context.Background(), []byte(token))
+}
+
+var (
+	readAll = authn.Capability{Resource: "abc123/secret:*", Ability: "read"}
+	readS1  = authn.Capability{Resource: "abc123/secret:s1", Ability: "read"}
+	writeS1 = authn.Capability{Resource: "abc123/secret:s1", Ability: "write"}
+)
+
+func TestDelegationChain(t *testing.T) {
+	alice, backend, worker := newTestIdentity(t), newTestIdentity(t), newTestIdentity(t)
+
+	// alice => backend => worker => orbis
+	root := alice.delegate(t, backend.did, []authn.Capability{readAll}, "", time.Hour)
+	link := backend.delegate(t, worker.did, []authn.Capability{readS1}, root, time.Hour)
+	token := worker.delegate(t, OrbisJWSAudience, []authn.Capability{readS1}, link, time.Minute)
+
+	info, err := verifyDelegation(token)
+	require.NoError(t, err)
+	require.Equal(t, alice.did, info.Subject)
+	require.True(t, worker.pk.Equals(info.PubKey))
+	require.True(t, info.Delegated())
+	require.True(t, info.Allows("abc123/secret:s1#read"))
+	require.False(t, info.Allows("abc123/secret:s2#read"))
+	require.False(t, info.Allows("abc123/secret:s1#write"))
+
+	// an invocation without proof is restricted to its own capabilities
+	info, err = verifyDelegation(alice.delegate(t, OrbisJWSAudience, nil, "", time.Minute))
+	require.NoError(t, err)
+	require.Equal(t, alice.did, info.Subject)
+	require.True(t, info.Delegated())
+	require.False(t, info.Allows("abc123/secret:s1#read"))
+}
+
+func TestDelegationChainSecp256k1(t *testing.T) {
+	alice := newTestIdentity(t)
+
+	sk, pk, err := ic.GenerateSecp256k1Key(rand.Reader)
+	require.NoError(t, err)
+	bobSk, err := crypto.PrivateKeyFromLibP2P(sk)
+	require.NoError(t, err)
+	bobPk, err := crypto.PublicKeyFromLibP2P(pk)
+	require.NoError(t, err)
+	bobDID, bobKID, err := did.KeyDID(bobPk)
+	require.NoError(t, err)
+	bob := testIdentity{sk: bobSk, pk: bobPk, did: bobDID, kid: bobKID}
+
+	root := alice.delegate(t, bob.did, []authn.Capability{readS1}, "", time.Hour)
+	info, err := verifyDelegation(bob.delegate(t, OrbisJWSAudience, []authn.Capability{readS1}, root, time.Minute))
+	require.NoError(t, err)
+	require.Equal(t, alice.did, info.Subject)
+	require.True(t, bob.pk.Equals(info.PubKey))
+}
+
+func TestDelegationChainInvalid(t *testing.T) {
+	alice, bob, mallory := newTestIdentity(t), newTestIdentity(t), newTestIdentity(t)
+	root := alice.delegate(t, bob.did, []authn.Capability{readS1}, "", time.Hour)
+
+	tests := []struct {
+		name  string
+		token string
+	}{
+		{"escalated capability", bob.delegate(t, OrbisJWSAudience, []authn.Capability{writeS1}, root, time.Minute)},
+		{"widened capability", bob.delegate(t, OrbisJWSAudience, []authn.Capability{readAll}, root, time.Minute)},
+		{"proof issued to another audience", mallory.delegate(t, OrbisJWSAudience, []authn.Capability{readS1}, root, time.Minute)},
+		{"invocation issued to another audience", bob.delegate(t, mallory.did, []authn.Capability{readS1}, root, time.Minute)},
+		{"expired proof", bob.delegate(t, OrbisJWSAudience, []authn.Capability{readS1}, alice.delegate(t, bob.did, []authn.Capability{readS1}, "", -time.Minute), time.Minute)},
+		{"expired invocation", bob.delegate(t, OrbisJWSAudience, []authn.Capability{readS1}, root, -time.Minute)},
+		{"tampered proof", bob.delegate(t, OrbisJWSAudience, []authn.Capability{readS1}, root[:len(root)-4]+"AAAA", time.Minute)},
+		{"malformed proof", bob.delegate(t, OrbisJWSAudience, []authn.Capability{readS1}, "", time.Minute)[:10]},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := verifyDelegation(tt.token)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestDelegationChainDepth(t *testing.T) {
+	ids := make([]testIdentity, maxDelegationDepth+2)
+	for i := range ids {
+		ids[i] = newTestIdentity(t)
+	}
+
+	// chains of depth proofs
+	chain := func(depth int) string {
+		token := ""
+		for i := 0; i < depth; i++ {
+			token = ids[i].delegate(t, ids[i+1].did, []authn.Capability{readS1}, token, time.Hour)
+		}
+		return ids[depth].delegate(t, OrbisJWSAudience, []authn.Capability{readS1}, token, time.Minute)
+	}
+
+	info, err := verifyDelegation(chain(maxDelegationDepth))
+	require.NoError(t, err)
+	require.Equal(t, ids[0].did, info.Subject)
+
+	_, err = verifyDelegation(chain(maxDelegationDepth + 1))
+	require.ErrorContains(t, err, "delegation chain longer")
+}
+
+func TestDelegationReplayProtection(t *testing.T) {
+	alice, bob := newTestIdentity(t), newTestIdentity(t)
+	root := alice.delegate(t, bob.did, []authn.Capability{readS1}, "", time.Hour)
+	creds := NewDelegationCredentialService(did.NewResolver(key.Resolver{}), nil, WithReplayProtection(10, 10))
+	ctx := context.Background()
+
+	// the delegation can be invoked many times, but each invocation once
+	token := bob.delegate(t, OrbisJWSAudience, []authn.Capability{readS1}, root, time.Minute)
+	_, err := creds.VerifyRequestSubject(ctx, []byte(token))
+	require.NoError(t, err)
+	_, err = creds.VerifyRequestSubject(ctx, []byte(token))
+	require.ErrorIs(t, err, ErrTokenReplayed)
+
+	token = bob.delegate(t, OrbisJWSAudience, []authn.Capability{readS1}, root, time.Minute)
+	_, err = creds.VerifyRequestSubject(ctx, []byte(token))
+	require.NoError(t, err)
+}
+
+func TestDelegationTokenExpiryCapped(t *testing.T) {
+	alice, bob := newTestIdentity(t), newTestIdentity(t)
+	root := alice.delegate(t, bob.did, []authn.Capability{readS1}, "", time.Minute)
+
+	// outliving the proof would make the chain invalid
+	_, err := verifyDelegation(bob.delegate(t, OrbisJWSAudience, []authn.Capability{readS1}, root, time.Hour))
+	require.NoError(t, err)
+}
+
+func TestCapabilityCovers(t *testing.T) {
+	tests := []struct {
+		parent, child authn.Capability
+		covers        bool
+	}{
+		{readS1, readS1, true},
+		{readAll, readS1, true},
+		{readS1, readAll, false},
+		{readS1, writeS1, false},
+		{authn.Capability{Resource: "abc123/secret:s1", Ability: "*"}, writeS1, true},
+		{authn.Capability{Resource: "abc123/*", Ability: "read"}, readAll, true},
+		{authn.Capability{Resource: "other/secret:*", Ability: "read"}, readS1, false},
+	}
+	for _, tt := range tests {
+		require.Equal(t, tt.covers, tt.parent.Covers(tt.child), "%v covers %v", tt.parent, tt.child)
+	}
+
+	require.True(t, authn.SubjectInfo{}.Allows("abc123/secret:s1#write"))
+	require.False(t, authn.SubjectInfo{Capabilities: []authn.Capability{readS1}}.Allows("abc123/secret:s1"))
+}
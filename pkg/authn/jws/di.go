@@ -2,6 +2,7 @@ package jws
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/samber/do"
 	"github.com/sourcenetwork/orbis-go/config"
@@ -12,21 +13,20 @@ import (
 
 var (
 	_                 types.Factory[authn.CredentialService] = (*selfSignedFactory)(nil)
+	_                 types.Factory[authn.CredentialService] = (*delegationFactory)(nil)
 	SelfSignedFactory                                        = selfSignedFactory{}
+	DelegationFactory                                        = delegationFactory{}
 )
 
 type selfSignedFactory struct{}
 
-func (selfSignedFactory) New(inj *do.Injector, rkeys []db.RepoKey, _ config.Config) (authn.CredentialService, error) {
-	resolver, err := do.Invoke[authn.KeyResolver](inj)
-	if err != nil {
-		return nil, fmt.Errorf("invoke key resolver: %w", err)
-	}
-	metadataFn, err := do.Invoke[authn.RequestMetadataParser](inj)
+func (selfSignedFactory) New(inj *do.Injector, rkeys []db.RepoKey, cfg config.Config) (authn.CredentialService, error) {
+	resolver, metadataFn, opts, err := serviceDeps(inj, cfg)
 	if err != nil {
-		return nil, fmt.Errorf("invoke metadata parser: %w", err)
+		return nil, err
 	}
-	return NewSelfSignedCredentialService(resolver, metadataFn), nil
+
+	return NewSelfSignedCredentialService(resolver, metadataFn, opts...), nil
 }
 
 func (selfSignedFactory) Name() string {
@@ -38,3 +38,46 @@ func (selfSignedFactory) Name() string {
 func (selfSignedFactory) Repos() []string {
 	return []string{}
 }
+
+type delegationFactory struct{}
+
+func (delegationFactory) New(inj *do.Injector, rkeys []db.RepoKey, cfg config.Config) (authn.CredentialService, error) {
+	resolver, metadataFn, opts, err := serviceDeps(inj, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewDelegationCredentialService(resolver, metadataFn, opts...), nil
+}
+
+func (delegationFactory) Name() string {
+	return "jws-ucan"
+}
+
+// Repos returns empty string to indicate no dependent repos needed
+// which means an empty array will be passed to `New` for `rkeys`
+func (delegationFactory) Repos() []string {
+	return []string{}
+}
+
+// serviceDeps invokes the credential service dependencies, and
+// returns the options configured by cfg.
+func serviceDeps(inj *do.Injector, cfg config.Config) (authn.KeyResolver, authn.RequestMetadataParser, []Option, error) {
+	resolver, err := do.Invoke[authn.KeyResolver](inj)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("invoke key resolver: %w", err)
+	}
+	metadataFn, err := do.Invoke[authn.RequestMetadataParser](inj)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("invoke metadata parser: %w", err)
+	}
+
+	opts := []Option{
+		WithMaxLifetime(time.Duration(cfg.GRPC.Authn.MaxTokenLifetime) * time.Second),
+	}
+	if cfg.GRPC.Authn.ReplayProtection {
+		opts = append(opts, WithReplayProtection(cfg.GRPC.Authn.ReplayCacheSize, cfg.GRPC.Authn.ReplayIssuerSize))
+	}
+
+	return resolver, metadataFn, opts, nil
+}
@@ -2,11 +2,14 @@ package jws
 
 import (
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
 
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/ecdsa"
 	"github.com/go-jose/go-jose/v3"
 	"github.com/go-jose/go-jose/v3/jwt"
 	logging "github.com/ipfs/go-log"
@@ -21,6 +24,12 @@ const (
 
 	OrbisJWSAudience = "orbis"
 	TokenMetadataKey = "authorization"
+
+	// ES256K is the secp256k1 ECDSA signature algorithm,
+	// which go-jose doesn't support.
+	ES256K = jose.SignatureAlgorithm("ES256K")
+
+	es256kSignatureSize = 64
 )
 
 var (
@@ -34,13 +43,45 @@ var _ authn.CredentialService = (*credentialSrv)(nil)
 type credentialSrv struct {
 	resolver       authn.KeyResolver
 	metadataParser authn.RequestMetadataParser
+
+	// maxLifetime bounds the token lifetime, from
+	// issuance to expiry, if positive.
+	maxLifetime time.Duration
+	// replay tracks the token ids, if enabled.
+	replay *replayCache
 }
 
-func NewSelfSignedCredentialService(resolver authn.KeyResolver, metadataParser authn.RequestMetadataParser) authn.CredentialService {
-	return credentialSrv{
+// Option configures the credential service.
+type Option func(*credentialSrv)
+
+// WithMaxLifetime rejects tokens valid for longer than d, from their
+// issuance to their expiry, which both must be set.
+func WithMaxLifetime(d time.Duration) Option {
+	return func(c *credentialSrv) {
+		c.maxLifetime = d
+	}
+}
+
+// WithReplayProtection requires tokens to have a unique `jti` claim, and
+// rejects tokens whose id was already seen until they expire. At most
+// issuerSize unexpired ids are tracked for each issuer, whose further
+// tokens are rejected, and size ids overall, beyond which the ids
+// closest to their expiry are forgotten.
+func WithReplayProtection(size, issuerSize int) Option {
+	return func(c *credentialSrv) {
+		c.replay = newReplayCache(size, issuerSize)
+	}
+}
+
+func NewSelfSignedCredentialService(resolver authn.KeyResolver, metadataParser authn.RequestMetadataParser, opts ...Option) authn.CredentialService {
+	c := credentialSrv{
 		resolver:       resolver,
 		metadataParser: metadataParser,
 	}
+	for _, opt := range opts {
+		opt(&c)
+	}
+	return c
 }
 
 func (c credentialSrv) GetRequestToken(ctx context.Context) ([]byte, error) {
@@ -63,63 +104,162 @@ func (c credentialSrv) GetRequestToken(ctx context.Context) ([]byte, error) {
 }
 
 func (c credentialSrv) VerifyRequestSubject(ctx context.Context, token []byte) (authn.SubjectInfo, error) {
+	userInfo, payload, err := verifySigned(ctx, c.resolver, token)
+	if err != nil {
+		return authn.SubjectInfo{}, err
+	}
+
+	claims := claims{}
+	err = json.Unmarshal(payload, &claims)
+	if err != nil {
+		return authn.SubjectInfo{}, fmt.Errorf("unmarshaling JWS payload: %w", err)
+	}
+
+	// verify claims
+	expected := jwt.Expected{
+		Audience: jwt.Audience{OrbisJWSAudience},
+		Issuer:   userInfo.Subject,
+		Subject:  userInfo.Subject,
+		Time:     time.Now(),
+	}
+
+	err = claims.ValidateWithLeeway(expected, verifyLeewayTime)
+	if err != nil {
+		return authn.SubjectInfo{}, fmt.Errorf("JWS claim failed validation: %w", err)
+	}
+
+	err = c.validateLifetime(claims.Claims)
+	if err != nil {
+		return authn.SubjectInfo{}, err
+	}
+
+	// tokens are only recorded once fully verified, so
+	// invalid tokens can't fill the replay cache.
+	err = c.checkReplay(claims.Claims)
+	if err != nil {
+		return authn.SubjectInfo{}, err
+	}
+
+	return authn.SubjectInfo{
+		Type:    "JWS",
+		Subject: userInfo.Subject,
+		PubKey:  userInfo.PubKey,
+		Binding: claims.Binding,
+	}, nil
+}
+
+// verifySigned verifies the JWS token signature with the key resolved
+// from its key id, returning the signer info and the token payload.
+func verifySigned(ctx context.Context, resolver authn.KeyResolver, token []byte) (authn.SubjectInfo, []byte, error) {
 	jws, err := jose.ParseSigned(string(token))
 	if err != nil {
-		return authn.SubjectInfo{}, fmt.Errorf("parsing jws token: %w", err)
+		return authn.SubjectInfo{}, nil, fmt.Errorf("parsing jws token: %w", err)
 	}
 
 	// this is likely impossible because ParseSigned above
 	// will catch it, but just for sanity
 	if len(jws.Signatures) == 0 {
-		return authn.SubjectInfo{}, fmt.Errorf("missing jws signatures")
+		return authn.SubjectInfo{}, nil, fmt.Errorf("missing jws signatures")
 	}
 
 	sig := jws.Signatures[0]
 	if sig.Protected.KeyID == "" {
-		return authn.SubjectInfo{}, fmt.Errorf("missing either JWK or KeyID")
+		return authn.SubjectInfo{}, nil, fmt.Errorf("missing either JWK or KeyID")
 	}
 
 	// otherwise resolve the JWK from the KeyID
 	kid := sig.Protected.KeyID
-	userInfo, err := c.resolver.Resolve(ctx, kid)
+	userInfo, err := resolver.Resolve(ctx, kid)
 	if err != nil {
-		return authn.SubjectInfo{}, fmt.Errorf("resolving kid: %w", err)
+		return authn.SubjectInfo{}, nil, fmt.Errorf("resolving kid: %w", err)
 	}
-	key, err := userInfo.PubKey.Std()
+	payload, err := verify(jws, token, userInfo.PubKey)
 	if err != nil {
-		return authn.SubjectInfo{}, fmt.Errorf("extracting JWK from resolved public key: %w", err)
+		return authn.SubjectInfo{}, nil, fmt.Errorf("verifying JWS: %w", err)
 	}
 
-	// verify jws
-	payload, err := jws.Verify(key)
-	if err != nil {
-		return authn.SubjectInfo{}, fmt.Errorf("verifying JWS: %w", err)
+	return userInfo, payload, nil
+}
+
+// validateLifetime checks the token isn't valid
+// for longer than the maximum lifetime.
+func (c credentialSrv) validateLifetime(cl jwt.Claims) error {
+	if c.maxLifetime <= 0 {
+		return nil
 	}
+	if cl.IssuedAt == nil || cl.Expiry == nil {
+		return fmt.Errorf("JWS claim failed validation: missing iat or exp")
+	}
+	if lifetime := cl.Expiry.Time().Sub(cl.IssuedAt.Time()); lifetime > c.maxLifetime {
+		return fmt.Errorf("JWS claim failed validation: lifetime %s exceeds %s", lifetime, c.maxLifetime)
+	}
+	return nil
+}
 
-	claims := claims{}
-	err = json.Unmarshal(payload, &claims)
+// checkReplay records the token id, if replay protection is
+// enabled, failing if the token was already used.
+func (c credentialSrv) checkReplay(cl jwt.Claims) error {
+	if c.replay == nil {
+		return nil
+	}
+	if cl.ID == "" || cl.Expiry == nil {
+		return fmt.Errorf("JWS claim failed validation: missing jti or exp")
+	}
+	err := c.replay.add(cl.Issuer, cl.ID, cl.Expiry.Time().Add(verifyLeewayTime))
 	if err != nil {
-		return authn.SubjectInfo{}, fmt.Errorf("unmarshaling JWS payload: %w", err)
+		return fmt.Errorf("JWS claim failed validation: %w", err)
 	}
+	return nil
+}
 
-	// verify claims
-	expected := jwt.Expected{
-		Audience: jwt.Audience{OrbisJWSAudience},
-		Issuer:   userInfo.Subject,
-		Subject:  userInfo.Subject,
-		Time:     claims.Expiry.Time().Add(verifyLeewayTime),
+// verify checks the token signature with the public key, returning
+// the payload. The algorithm must match the key type, where ES256K
+// is verified separately as go-jose doesn't support secp256k1.
+func verify(jws *jose.JSONWebSignature, token []byte, pk crypto.PublicKey) ([]byte, error) {
+	alg := jose.SignatureAlgorithm(jws.Signatures[0].Protected.Algorithm)
+	switch {
+	case alg == jose.EdDSA && pk.Type() == crypto.Ed25519,
+		alg == jose.ES256 && pk.Type() == crypto.ECDSA:
+		key, err := pk.Std()
+		if err != nil {
+			return nil, fmt.Errorf("extracting key from resolved public key: %w", err)
+		}
+		return jws.Verify(key)
+
+	case alg == ES256K && pk.Type() == crypto.Secp256k1:
+		return verifyES256K(token, pk)
+
+	default:
+		return nil, fmt.Errorf("unsupported algorithm %s for %s key", alg, pk.Type())
 	}
+}
 
-	err = claims.ValidateWithLeeway(expected, verifyLeewayTime)
-	if err != nil {
-		return authn.SubjectInfo{}, fmt.Errorf("JWS claim failed validation: %w", err)
+// verifyES256K verifies a compact ES256K token, whose signature is the
+// R || S encoded secp256k1 ECDSA signature of the SHA-256 digest of the
+// signing input.
+func verifyES256K(token []byte, pk crypto.PublicKey) ([]byte, error) {
+	parts := strings.Split(string(token), ".")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("ES256K token isn't compact")
 	}
 
-	return authn.SubjectInfo{
-		Type:    "JWS",
-		Subject: userInfo.Subject,
-		PubKey:  userInfo.PubKey,
-	}, nil
+	sig, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil || len(sig) != es256kSignatureSize {
+		return nil, fmt.Errorf("malformed ES256K signature")
+	}
+	var r, s btcec.ModNScalar
+	if r.SetByteSlice(sig[:32]) || s.SetByteSlice(sig[32:]) {
+		return nil, fmt.Errorf("malformed ES256K signature")
+	}
+
+	// libp2p secp256k1 keys hash the data with SHA-256,
+	// and expect DER signatures.
+	ok, err := pk.Verify([]byte(parts[0]+"."+parts[1]), ecdsa.NewSignature(&r, &s).Serialize())
+	if err != nil || !ok {
+		return nil, fmt.Errorf("invalid ES256K signature")
+	}
+
+	return base64.RawURLEncoding.DecodeString(parts[1])
 }
 
 // Converts a Public Key to a JWK
@@ -136,7 +276,14 @@ func JWKFromPublicKey(pk crypto.PublicKey) (*jose.JSONWebKey, error) {
 			return nil, fmt.Errorf("extrating pubkey bytes: %w", err)
 		}
 		key = ed25519.PublicKey(buf)
+	case crypto.ECDSA:
+		var err error
+		key, err = pk.Std()
+		if err != nil {
+			return nil, fmt.Errorf("extracting ecdsa pubkey: %w", err)
+		}
 	default:
+		// go-jose doesn't support secp256k1 JWKs.
 		// invalid
 		return nil, fmt.Errorf("invalid key type %s", pk.Type())
 	}
@@ -148,4 +295,7 @@ func JWKFromPublicKey(pk crypto.PublicKey) (*jose.JSONWebKey, error) {
 
 type claims struct {
 	jwt.Claims
+	// Binding restricts the token to a single request,
+	// see authn.ReencryptBinding.
+	Binding string `json:"req,omitempty"`
 }
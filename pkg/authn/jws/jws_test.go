@@ -3,8 +3,12 @@ package jws
 import (
 	"context"
 	gocrypto "crypto"
+	"crypto/ecdsa"
 	"crypto/ed25519"
+	"crypto/elliptic"
 	"crypto/rand"
+	"encoding/base64"
+	"strings"
 	"testing"
 	"time"
 
@@ -12,6 +16,7 @@ import (
 	"github.com/TBD54566975/ssi-sdk/did/key"
 	"github.com/go-jose/go-jose/v3"
 	"github.com/go-jose/go-jose/v3/jwt"
+	ic "github.com/libp2p/go-libp2p/core/crypto"
 
 	"github.com/sourcenetwork/orbis-go/pkg/authn"
 	"github.com/sourcenetwork/orbis-go/pkg/authn/mocks"
@@ -19,6 +24,7 @@ import (
 	"github.com/sourcenetwork/orbis-go/pkg/did"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"go.dedis.ch/kyber/v3/suites"
 )
 
 var (
@@ -174,3 +180,228 @@ func TestDIDKeyJWSCredentialService(t *testing.T) {
 	}, info)
 
 }
+
+func TestSelfSignedToken(t *testing.T) {
+	sk, pk, err := crypto.GenerateKeyPair(suites.MustFind("ed25519"), rand.Reader)
+	require.NoError(t, err)
+
+	subject, kid, err := did.KeyDID(pk)
+	require.NoError(t, err)
+
+	signedJWT, err := NewSelfSignedToken(sk, kid, subject, time.Minute)
+	require.NoError(t, err)
+
+	mockMD := mocks.NewMetadata(t)
+	mockMD.EXPECT().Get(TokenMetadataKey).Return([]string{tokenPrefix + signedJWT})
+	mockReqParser := mocks.NewRequestMetadataParser(t)
+	mockReqParser.EXPECT().Parse(mock.Anything).Return(mockMD, true)
+
+	ctx := context.Background()
+	credService := NewSelfSignedCredentialService(did.NewResolver(key.Resolver{}), mockReqParser)
+	token, err := credService.GetRequestToken(ctx)
+	require.NoError(t, err)
+	info, err := credService.VerifyRequestSubject(ctx, token)
+	require.NoError(t, err)
+	require.Equal(t, subject, info.Subject)
+	require.True(t, pk.Equals(info.PubKey))
+}
+
+func verifyToken(t *testing.T, resolver authn.KeyResolver, token string) (authn.SubjectInfo, error) {
+	mockMD := mocks.NewMetadata(t)
+	mockMD.EXPECT().Get(TokenMetadataKey).Return([]string{tokenPrefix + token})
+	mockReqParser := mocks.NewRequestMetadataParser(t)
+	mockReqParser.EXPECT().Parse(mock.Anything).Return(mockMD, true)
+
+	ctx := context.Background()
+	credService := NewSelfSignedCredentialService(resolver, mockReqParser)
+	raw, err := credService.GetRequestToken(ctx)
+	require.NoError(t, err)
+	return credService.VerifyRequestSubject(ctx, raw)
+}
+
+func TestSelfSignedTokenES256K(t *testing.T) {
+	icsk, _, err := ic.GenerateSecp256k1Key(rand.Reader)
+	require.NoError(t, err)
+	sk, err := crypto.PrivateKeyFromLibP2P(icsk)
+	require.NoError(t, err)
+	pk := sk.GetPublic()
+
+	subject, kid, err := did.KeyDID(pk)
+	require.NoError(t, err)
+
+	token, err := NewSelfSignedToken(sk, kid, subject, time.Minute)
+	require.NoError(t, err)
+
+	resolver := did.NewResolver(key.Resolver{})
+	info, err := verifyToken(t, resolver, token)
+	require.NoError(t, err)
+	require.Equal(t, subject, info.Subject)
+	require.True(t, pk.Equals(info.PubKey))
+
+	// tampered claims
+	parts := strings.Split(token, ".")
+	parts[1] = base64.RawURLEncoding.EncodeToString([]byte(`{"iss":"` + subject + `","sub":"` + subject + `","aud":["orbis"]}`))
+	_, err = verifyToken(t, resolver, strings.Join(parts, "."))
+	require.ErrorContains(t, err, "invalid ES256K signature")
+}
+
+func TestES256Token(t *testing.T) {
+	sk, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	pk, err := crypto.PublicKeyFromStdPublicKey(&sk.PublicKey)
+	require.NoError(t, err)
+
+	subject, kid, err := did.KeyDID(pk)
+	require.NoError(t, err)
+
+	claims := claims{
+		Claims: jwt.Claims{
+			Subject:  subject,
+			Issuer:   subject,
+			Expiry:   jwt.NewNumericDate(time.Now().Add(time.Minute)),
+			Audience: jwt.Audience{OrbisJWSAudience},
+		},
+	}
+	token, err := jwt.Signed(mustMakeSigner(jose.ES256, sk, kid)).Claims(claims).CompactSerialize()
+	require.NoError(t, err)
+
+	info, err := verifyToken(t, did.NewResolver(key.Resolver{}), token)
+	require.NoError(t, err)
+	require.Equal(t, subject, info.Subject)
+	require.True(t, pk.Equals(info.PubKey))
+
+	// the algorithm must match the key type
+	_, edSk, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	token, err = jwt.Signed(mustMakeSigner(jose.EdDSA, edSk, kid)).Claims(claims).CompactSerialize()
+	require.NoError(t, err)
+	_, err = verifyToken(t, did.NewResolver(key.Resolver{}), token)
+	require.ErrorContains(t, err, "unsupported algorithm")
+}
+
+func newTestCredentialService(t *testing.T, token string, opts ...Option) authn.CredentialService {
+	mockMD := mocks.NewMetadata(t)
+	mockMD.EXPECT().Get(TokenMetadataKey).Return([]string{tokenPrefix + token}).Maybe()
+	mockReqParser := mocks.NewRequestMetadataParser(t)
+	mockReqParser.EXPECT().Parse(mock.Anything).Return(mockMD, true).Maybe()
+	return NewSelfSignedCredentialService(did.NewResolver(key.Resolver{}), mockReqParser, opts...)
+}
+
+func newTestToken(t *testing.T, cl jwt.Claims) (string, string) {
+	_, pk, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	cpk := mustGetPublicKey(pk.Public())
+	subject, kid, err := did.KeyDID(cpk)
+	require.NoError(t, err)
+
+	cl.Issuer = subject
+	cl.Subject = subject
+	cl.Audience = jwt.Audience{OrbisJWSAudience}
+	token, err := jwt.Signed(mustMakeSigner(jose.EdDSA, pk, kid)).Claims(cl).CompactSerialize()
+	require.NoError(t, err)
+	return token, subject
+}
+
+func TestExpiredToken(t *testing.T) {
+	token, _ := newTestToken(t, jwt.Claims{
+		Expiry: jwt.NewNumericDate(time.Now().Add(-time.Minute)),
+	})
+	creds := newTestCredentialService(t, token)
+	_, err := creds.VerifyRequestSubject(context.Background(), []byte(token))
+	require.ErrorIs(t, err, jwt.ErrExpired)
+}
+
+func TestMaxLifetime(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+
+	token, _ := newTestToken(t, jwt.Claims{
+		IssuedAt: jwt.NewNumericDate(now),
+		Expiry:   jwt.NewNumericDate(now.Add(time.Minute)),
+	})
+	creds := newTestCredentialService(t, token, WithMaxLifetime(time.Minute))
+	_, err := creds.VerifyRequestSubject(ctx, []byte(token))
+	require.NoError(t, err)
+
+	token, _ = newTestToken(t, jwt.Claims{
+		IssuedAt: jwt.NewNumericDate(now),
+		Expiry:   jwt.NewNumericDate(now.Add(time.Hour)),
+	})
+	_, err = creds.VerifyRequestSubject(ctx, []byte(token))
+	require.ErrorContains(t, err, "exceeds")
+
+	token, _ = newTestToken(t, jwt.Claims{
+		Expiry: jwt.NewNumericDate(now.Add(time.Minute)),
+	})
+	_, err = creds.VerifyRequestSubject(ctx, []byte(token))
+	require.ErrorContains(t, err, "missing iat")
+}
+
+func TestReplayProtection(t *testing.T) {
+	ctx := context.Background()
+	sk, pk, err := crypto.GenerateKeyPair(suites.MustFind("ed25519"), rand.Reader)
+	require.NoError(t, err)
+	subject, kid, err := did.KeyDID(pk)
+	require.NoError(t, err)
+
+	token, err := NewSelfSignedToken(sk, kid, subject, time.Minute)
+	require.NoError(t, err)
+	creds := newTestCredentialService(t, token, WithReplayProtection(10, 10))
+
+	_, err = creds.VerifyRequestSubject(ctx, []byte(token))
+	require.NoError(t, err)
+	_, err = creds.VerifyRequestSubject(ctx, []byte(token))
+	require.ErrorIs(t, err, ErrTokenReplayed)
+
+	// fresh tokens get a new id
+	token, err = NewSelfSignedToken(sk, kid, subject, time.Minute)
+	require.NoError(t, err)
+	_, err = creds.VerifyRequestSubject(ctx, []byte(token))
+	require.NoError(t, err)
+
+	token, _ = newTestToken(t, jwt.Claims{
+		Expiry: jwt.NewNumericDate(time.Now().Add(time.Minute)),
+	})
+	_, err = creds.VerifyRequestSubject(ctx, []byte(token))
+	require.ErrorContains(t, err, "missing jti")
+}
+
+func TestBoundToken(t *testing.T) {
+	sk, pk, err := crypto.GenerateKeyPair(suites.MustFind("ed25519"), rand.Reader)
+	require.NoError(t, err)
+	subject, kid, err := did.KeyDID(pk)
+	require.NoError(t, err)
+
+	binding, err := authn.ReencryptBinding("secret", pk)
+	require.NoError(t, err)
+	token, err := NewSelfSignedBoundToken(sk, kid, subject, time.Minute, binding)
+	require.NoError(t, err)
+
+	info, err := newTestCredentialService(t, token).VerifyRequestSubject(context.Background(), []byte(token))
+	require.NoError(t, err)
+	require.Equal(t, binding, info.Binding)
+}
+
+func TestReplayCache(t *testing.T) {
+	now := time.Now()
+	c := newReplayCache(3, 2)
+	c.now = func() time.Time { return now }
+
+	require.NoError(t, c.add("alice", "a", now.Add(time.Second)))
+	require.ErrorIs(t, c.add("alice", "a", now.Add(time.Second)), ErrTokenReplayed)
+	require.NoError(t, c.add("alice", "b", now.Add(time.Minute)))
+	require.ErrorIs(t, c.add("alice", "c", now.Add(time.Minute)), ErrReplayCacheFull)
+
+	// a full issuer doesn't deny the others
+	require.NoError(t, c.add("bob", "a", now.Add(time.Hour)))
+
+	// a full cache forgets the ids closest to their expiry
+	require.NoError(t, c.add("carol", "a", now.Add(time.Hour)))
+	require.NoError(t, c.add("alice", "a", now.Add(time.Second)))
+	require.ErrorIs(t, c.add("bob", "a", now.Add(time.Hour)), ErrTokenReplayed)
+
+	// expired ids are forgotten
+	now = now.Add(time.Minute)
+	require.NoError(t, c.add("alice", "c", now.Add(time.Minute)))
+	require.ErrorIs(t, c.add("alice", "c", now.Add(time.Minute)), ErrTokenReplayed)
+}
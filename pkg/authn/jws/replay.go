@@ -0,0 +1,111 @@
+package jws
+
+import (
+	"container/heap"
+	"fmt"
+	"sync"
+	"time"
+)
+
+var (
+	ErrTokenReplayed   = fmt.Errorf("token replayed")
+	ErrReplayCacheFull = fmt.Errorf("token replay cache full for the issuer")
+)
+
+// replayCache tracks the ids of the seen tokens until they expire.
+//
+// Each issuer is bounded to issuerSize unexpired ids, and its further
+// tokens are rejected rather than forgetting ids which could then be
+// replayed. Since issuers are free to mint, the whole cache is bounded
+// too, but once full it forgets the ids closest to their expiry, so a
+// flood of tokens never denies the other issuers.
+type replayCache struct {
+	mu       sync.Mutex
+	seen     map[string]time.Time // issuer/token id => expiry
+	issuers  map[string]int       // issuer => unexpired ids
+	expiries expiryHeap
+
+	size       int
+	issuerSize int
+	now        func() time.Time
+}
+
+func newReplayCache(size, issuerSize int) *replayCache {
+	return &replayCache{
+		seen:       make(map[string]time.Time),
+		issuers:    make(map[string]int),
+		size:       size,
+		issuerSize: issuerSize,
+		now:        time.Now,
+	}
+}
+
+// add records the token id of the issuer until its expiry, failing if
+// the id was already seen and hasn't expired yet, or if the issuer has
+// too many unexpired ids.
+func (c *replayCache) add(issuer, id string, expiry time.Time) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.now()
+	c.prune(now)
+
+	key := issuer + "/" + id
+	if _, ok := c.seen[key]; ok {
+		return ErrTokenReplayed
+	}
+	if c.issuers[issuer] >= c.issuerSize {
+		return ErrReplayCacheFull
+	}
+
+	if len(c.seen) >= c.size {
+		e := heap.Pop(&c.expiries).(replayEntry)
+		c.forget(e)
+		log.Warnf("Token replay cache full, forgot token %s expiring in %s", e.key, e.expiry.Sub(now))
+	}
+
+	c.seen[key] = expiry
+	c.issuers[issuer]++
+	heap.Push(&c.expiries, replayEntry{key: key, issuer: issuer, expiry: expiry})
+
+	return nil
+}
+
+// prune forgets the expired token ids.
+func (c *replayCache) prune(now time.Time) {
+	for len(c.expiries) > 0 && !now.Before(c.expiries[0].expiry) {
+		c.forget(heap.Pop(&c.expiries).(replayEntry))
+	}
+}
+
+func (c *replayCache) forget(e replayEntry) {
+	delete(c.seen, e.key)
+	c.issuers[e.issuer]--
+	if c.issuers[e.issuer] <= 0 {
+		delete(c.issuers, e.issuer)
+	}
+}
+
+type replayEntry struct {
+	key    string
+	issuer string
+	expiry time.Time
+}
+
+// expiryHeap orders the token ids by expiry, the soonest first.
+type expiryHeap []replayEntry
+
+func (h expiryHeap) Len() int           { return len(h) }
+func (h expiryHeap) Less(i, j int) bool { return h[i].expiry.Before(h[j].expiry) }
+func (h expiryHeap) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+
+func (h *expiryHeap) Push(x any) {
+	*h = append(*h, x.(replayEntry))
+}
+
+func (h *expiryHeap) Pop() any {
+	old := *h
+	e := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return e
+}
@@ -0,0 +1,138 @@
+package jws
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/ecdsa"
+	"github.com/go-jose/go-jose/v3"
+	"github.com/go-jose/go-jose/v3/jwt"
+
+	"github.com/sourcenetwork/orbis-go/pkg/crypto"
+)
+
+// NewSelfSignedToken creates a compact JWS token for the subject,
+// signed by the private key, which can be verified by the self signed
+// credential service. The subject is used as the issuer, and the key
+// id must be resolvable to the public key, such as a `did:key`.
+//
+// Ed25519 keys sign EdDSA tokens, and secp256k1 keys ES256K tokens.
+// Every token has a random `jti`, so it can only be used once by
+// services with replay protection.
+func NewSelfSignedToken(sk crypto.PrivateKey, kid string, subject string, ttl time.Duration) (string, error) {
+	return newSelfSignedToken(sk, kid, subject, ttl, "")
+}
+
+// NewSelfSignedBoundToken creates a self signed token like
+// NewSelfSignedToken, bound to a single request by the binding,
+// such as the one returned by authn.ReencryptBinding.
+func NewSelfSignedBoundToken(sk crypto.PrivateKey, kid string, subject string, ttl time.Duration, binding string) (string, error) {
+	return newSelfSignedToken(sk, kid, subject, ttl, binding)
+}
+
+func newSelfSignedToken(sk crypto.PrivateKey, kid string, subject string, ttl time.Duration, binding string) (string, error) {
+	jcl, err := newClaims(subject, ttl)
+	if err != nil {
+		return "", err
+	}
+	jcl.Subject = subject
+	jcl.Audience = jwt.Audience{OrbisJWSAudience}
+
+	cl := claims{
+		Claims:  jcl,
+		Binding: binding,
+	}
+
+	return signToken(sk, kid, cl)
+}
+
+// newClaims returns the claims of a token issued now by
+// the issuer, valid for ttl, with a random `jti`.
+func newClaims(issuer string, ttl time.Duration) (jwt.Claims, error) {
+	var nonce [16]byte
+	_, err := rand.Read(nonce[:])
+	if err != nil {
+		return jwt.Claims{}, fmt.Errorf("generate jti: %w", err)
+	}
+
+	now := time.Now()
+	return jwt.Claims{
+		ID:       hex.EncodeToString(nonce[:]),
+		Issuer:   issuer,
+		IssuedAt: jwt.NewNumericDate(now),
+		Expiry:   jwt.NewNumericDate(now.Add(ttl)),
+	}, nil
+}
+
+// signToken signs the claims with the private key, as an EdDSA
+// token for Ed25519 keys, and an ES256K one for secp256k1 keys.
+func signToken(sk crypto.PrivateKey, kid string, cl any) (string, error) {
+	raw, err := sk.Raw()
+	if err != nil {
+		return "", fmt.Errorf("raw private key: %w", err)
+	}
+
+	switch sk.Type() {
+	case crypto.Ed25519:
+		return signEdDSA(ed25519.PrivateKey(raw), kid, cl)
+	case crypto.Secp256k1:
+		priv, _ := btcec.PrivKeyFromBytes(raw)
+		return signES256K(priv, kid, cl)
+	default:
+		return "", fmt.Errorf("unsupported signing key type %s", sk.Type())
+	}
+}
+
+func signEdDSA(sk ed25519.PrivateKey, kid string, cl any) (string, error) {
+	opts := new(jose.SignerOptions)
+	opts.WithHeader(jose.HeaderKey("kid"), kid)
+	signer, err := jose.NewSigner(
+		jose.SigningKey{
+			Algorithm: jose.EdDSA,
+			Key:       sk,
+		},
+		opts,
+	)
+	if err != nil {
+		return "", fmt.Errorf("create signer: %w", err)
+	}
+
+	token, err := jwt.Signed(signer).Claims(cl).CompactSerialize()
+	if err != nil {
+		return "", fmt.Errorf("sign jwt: %w", err)
+	}
+
+	return token, nil
+}
+
+// signES256K creates a compact ES256K token, which go-jose can't sign.
+func signES256K(sk *btcec.PrivateKey, kid string, cl any) (string, error) {
+	header, err := json.Marshal(map[string]string{
+		"alg": string(ES256K),
+		"kid": kid,
+	})
+	if err != nil {
+		return "", fmt.Errorf("marshal header: %w", err)
+	}
+	payload, err := json.Marshal(cl)
+	if err != nil {
+		return "", fmt.Errorf("marshal claims: %w", err)
+	}
+
+	input := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+	digest := sha256.Sum256([]byte(input))
+	sig, err := ecdsa.SignCompact(sk, digest[:], true)
+	if err != nil {
+		return "", fmt.Errorf("sign jwt: %w", err)
+	}
+
+	// drop the recovery byte, keeping R || S
+	return input + "." + base64.RawURLEncoding.EncodeToString(sig[1:]), nil
+}
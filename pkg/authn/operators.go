@@ -0,0 +1,27 @@
+package authn
+
+import "strings"
+
+// Operators are the node operators, the subjects allowed to
+// administer the node and its rings.
+type Operators map[string]struct{}
+
+// NewOperators returns the operators of the configured DIDs,
+// ignoring the blank ones.
+func NewOperators(dids []string) Operators {
+	ops := make(Operators)
+	for _, did := range dids {
+		did = strings.TrimSpace(did)
+		if did != "" {
+			ops[did] = struct{}{}
+		}
+	}
+	return ops
+}
+
+// Has reports whether the subject is an operator.
+// Without operators, no subject is one.
+func (o Operators) Has(subject string) bool {
+	_, ok := o[subject]
+	return ok
+}
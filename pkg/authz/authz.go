@@ -1,12 +1,25 @@
 package authz
 
-import "context"
+import (
+	"context"
+	"fmt"
+	"regexp"
+)
 
 const (
 	READ  = "read"
 	WRITE = "write"
 )
 
+var (
+	ErrInvalidPermission = fmt.Errorf("invalid permission")
+	ErrObjectOwned       = fmt.Errorf("object owned by another subject")
+)
+
+// permission is formatted as:
+// PolicyID/Resource:ResourceID#relation
+var permRegex = regexp.MustCompile(`^([^/]+)/(\w+):([^#]+)#(\w+)$`)
+
 type Authz interface {
 	Name() string
 
@@ -20,3 +33,61 @@ type Authz interface {
 	// so a generic byte-array was the most appropriate.
 	Check(ctx context.Context, permission, subject string) (bool, error)
 }
+
+// Relationship relates a subject to a resource object of
+// a policy, through one of the resource relations.
+type Relationship struct {
+	PolicyID   string
+	Resource   string
+	ResourceID string
+	Relation   string
+	// Subject is either an entity "type:id", or a
+	// subject set "type:id#relation".
+	Subject string
+}
+
+// RelationshipWriter is implemented by authorizers that
+// manage their own relationships, rather than delegating
+// them to an external system.
+type RelationshipWriter interface {
+	WriteRelationship(ctx context.Context, rel Relationship) error
+	DeleteRelationship(ctx context.Context, rel Relationship) error
+}
+
+// ObjectRegistrar is implemented by authorizers that can
+// register the owner of objects.
+type ObjectRegistrar interface {
+	// RegisterObject writes the relationship if the object has no
+	// subjects related through the relation yet, atomically. It
+	// returns whether the relationship was written, which is false
+	// if the subject already has it, and ErrObjectOwned if another
+	// subject does.
+	RegisterObject(ctx context.Context, rel Relationship) (bool, error)
+
+	// UnregisterObject rolls back a relationship written
+	// by RegisterObject.
+	UnregisterObject(ctx context.Context, rel Relationship) error
+}
+
+// ParsePermission parses a permission formatted as
+// PolicyID/Resource:ResourceID#relation, into a
+// relationship without a subject.
+func ParsePermission(perm string) (Relationship, error) {
+	m := permRegex.FindStringSubmatch(perm)
+	if m == nil {
+		return Relationship{}, fmt.Errorf("%w: %s", ErrInvalidPermission, perm)
+	}
+
+	return Relationship{
+		PolicyID:   m[1],
+		Resource:   m[2],
+		ResourceID: m[3],
+		Relation:   m[4],
+	}, nil
+}
+
+// Permission formats the relationship object and
+// relation as PolicyID/Resource:ResourceID#relation.
+func (r Relationship) Permission() string {
+	return r.PolicyID + "/" + r.Resource + ":" + r.ResourceID + "#" + r.Relation
+}
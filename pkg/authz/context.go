@@ -0,0 +1,18 @@
+package authz
+
+import "context"
+
+type proofCtxKey struct{}
+
+// ContextWithProof returns a copy of ctx carrying an authorization
+// proof sent with the request, such as an ACP access ticket.
+func ContextWithProof(ctx context.Context, proof []byte) context.Context {
+	return context.WithValue(ctx, proofCtxKey{}, proof)
+}
+
+// ProofFromContext returns the authorization proof sent
+// with the request, if any.
+func ProofFromContext(ctx context.Context) ([]byte, bool) {
+	proof, ok := ctx.Value(proofCtxKey{}).([]byte)
+	return proof, ok && len(proof) > 0
+}
@@ -0,0 +1,53 @@
+package local
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/samber/do"
+
+	"github.com/sourcenetwork/orbis-go/config"
+	"github.com/sourcenetwork/orbis-go/pkg/authz"
+	"github.com/sourcenetwork/orbis-go/pkg/db"
+	"github.com/sourcenetwork/orbis-go/pkg/types"
+)
+
+var (
+	_       types.Factory[authz.Authz] = (*factory)(nil)
+	Factory                            = factory{}
+)
+
+type factory struct{}
+
+func (factory) New(inj *do.Injector, rkeys []db.RepoKey, cfg config.Config) (authz.Authz, error) {
+	d, err := do.Invoke[*db.DB](inj)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(rkeys) != 1 {
+		return nil, fmt.Errorf("invalid repo keys, expected 1 got %d", len(rkeys))
+	}
+
+	var policies [][]byte
+	for _, path := range cfg.Authz.Policies {
+		if path == "" {
+			continue
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("read policy: %w", err)
+		}
+		policies = append(policies, data)
+	}
+
+	return New(d, rkeys[0], policies...)
+}
+
+func (factory) Name() string {
+	return name
+}
+
+func (factory) Repos() []string {
+	return []string{"relationships"}
+}
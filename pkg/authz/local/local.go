@@ -0,0 +1,387 @@
+package local
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	logging "github.com/ipfs/go-log"
+
+	authzv1alpha1 "github.com/sourcenetwork/orbis-go/gen/proto/orbis/authz/v1alpha1"
+	"github.com/sourcenetwork/orbis-go/pkg/authz"
+	"github.com/sourcenetwork/orbis-go/pkg/db"
+)
+
+var (
+	_ authz.Authz              = (*Local)(nil)
+	_ authz.RelationshipWriter = (*Local)(nil)
+	_ authz.ObjectRegistrar    = (*Local)(nil)
+)
+
+var log = logging.Logger("orbis/authz/local")
+
+const (
+	name = "local"
+
+	// maxDepth bounds the relation graph traversal,
+	// guarding against cyclic relationships.
+	maxDepth = 32
+)
+
+var (
+	ErrUnknownPolicy     = fmt.Errorf("unknown policy")
+	ErrUnknownRelation   = fmt.Errorf("unknown relation")
+	ErrInvalidSubject    = fmt.Errorf("invalid subject")
+	ErrInvalidPermission = fmt.Errorf("invalid permission")
+	ErrIndirectRelation  = fmt.Errorf("relation doesn't accept direct relationships")
+	ErrSubjectType       = fmt.Errorf("subject type not allowed by relation")
+	ErrMaxDepth          = fmt.Errorf("relation graph too deep")
+)
+
+// writeMu serializes relationship writes. Every ring gets its own
+// authorizer, but they all share the node relationship table.
+var writeMu sync.Mutex
+
+// Local is an embedded Zanzibar style authorizer. Policies use the zanzi
+// policy definition YAML format, and relationships are stored in the
+// node db. Relationships are local to the node, so in multi node rings
+// they have to be written to every node which checks permissions.
+type Local struct {
+	policies map[string]*policy
+	repo     db.Repository[*authzv1alpha1.RelationSubjects]
+}
+
+// New returns a local authorizer storing its relationships in the
+// given db, using the given policy definitions.
+func New(d *db.DB, rkey db.RepoKey, policies ...[]byte) (*Local, error) {
+	repo, err := db.GetRepo(d, rkey, relationSubjectsPkFunc)
+	if err != nil {
+		return nil, fmt.Errorf("get relationships repo: %w", err)
+	}
+
+	l := &Local{
+		policies: make(map[string]*policy, len(policies)),
+		repo:     repo,
+	}
+	for _, data := range policies {
+		p, err := parsePolicy(data)
+		if err != nil {
+			return nil, fmt.Errorf("parse policy: %w", err)
+		}
+		if _, exists := l.policies[p.id]; exists {
+			return nil, fmt.Errorf("duplicate policy %s", p.id)
+		}
+		l.policies[p.id] = p
+	}
+
+	return l, nil
+}
+
+func (l *Local) Name() string {
+	return name
+}
+
+func (l *Local) Init(_ context.Context) error {
+	return nil
+}
+
+// Check reports whether the subject, formatted as "type:id", has the
+// permission formatted as PolicyID/ResourceGroup:ResourceID#relation.
+func (l *Local) Check(ctx context.Context, perm, subject string) (bool, error) {
+	m := permRegex.FindStringSubmatch(perm)
+	if m == nil {
+		return false, fmt.Errorf("%w: %s", ErrInvalidPermission, perm)
+	}
+	policyID, res, resID, rel := m[1], m[2], m[3], m[4]
+
+	p, ok := l.policies[policyID]
+	if !ok {
+		return false, fmt.Errorf("%w: %s", ErrUnknownPolicy, policyID)
+	}
+
+	subj, err := parseSubject(subject)
+	if err != nil {
+		return false, err
+	}
+
+	c := checker{l: l, p: p, subject: subj}
+	return c.check(ctx, res, resID, rel, 0)
+}
+
+// WriteRelationship adds the relationship, after validating it against
+// its policy. Writing an existing relationship is a no-op.
+func (l *Local) WriteRelationship(ctx context.Context, rel authz.Relationship) error {
+	subj, err := l.validate(rel)
+	if err != nil {
+		return err
+	}
+
+	writeMu.Lock()
+	defer writeMu.Unlock()
+
+	rs, err := l.relationSubjects(ctx, rel.PolicyID, rel.Resource, rel.ResourceID, rel.Relation)
+	if err != nil {
+		return err
+	}
+	for _, s := range rs.Subjects {
+		if s == subj.String() {
+			return nil
+		}
+	}
+	rs.Subjects = append(rs.Subjects, subj.String())
+
+	err = l.repo.Save(ctx, rs)
+	if err != nil {
+		return fmt.Errorf("save relationship: %w", err)
+	}
+	log.Debugf("wrote relationship %s/%s:%s#%s@%s", rel.PolicyID, rel.Resource, rel.ResourceID, rel.Relation, subj)
+
+	return nil
+}
+
+// RegisterObject adds the relationship if the object has no subjects
+// related through the relation yet.
+func (l *Local) RegisterObject(ctx context.Context, rel authz.Relationship) (bool, error) {
+	subj, err := l.validate(rel)
+	if err != nil {
+		return false, err
+	}
+
+	writeMu.Lock()
+	defer writeMu.Unlock()
+
+	rs, err := l.relationSubjects(ctx, rel.PolicyID, rel.Resource, rel.ResourceID, rel.Relation)
+	if err != nil {
+		return false, err
+	}
+	switch {
+	case len(rs.Subjects) == 1 && rs.Subjects[0] == subj.String():
+		return false, nil
+	case len(rs.Subjects) > 0:
+		return false, fmt.Errorf("%w: %s/%s:%s", authz.ErrObjectOwned, rel.PolicyID, rel.Resource, rel.ResourceID)
+	}
+	rs.Subjects = []string{subj.String()}
+
+	err = l.repo.Save(ctx, rs)
+	if err != nil {
+		return false, fmt.Errorf("save relationship: %w", err)
+	}
+	log.Debugf("registered object %s/%s:%s#%s@%s", rel.PolicyID, rel.Resource, rel.ResourceID, rel.Relation, subj)
+
+	return true, nil
+}
+
+// UnregisterObject removes a relationship added by RegisterObject.
+func (l *Local) UnregisterObject(ctx context.Context, rel authz.Relationship) error {
+	return l.DeleteRelationship(ctx, rel)
+}
+
+// DeleteRelationship removes the relationship. Deleting
+// a missing relationship is a no-op.
+func (l *Local) DeleteRelationship(ctx context.Context, rel authz.Relationship) error {
+	subj, err := parseSubject(rel.Subject)
+	if err != nil {
+		return err
+	}
+
+	writeMu.Lock()
+	defer writeMu.Unlock()
+
+	rs, err := l.relationSubjects(ctx, rel.PolicyID, rel.Resource, rel.ResourceID, rel.Relation)
+	if err != nil {
+		return err
+	}
+
+	subjects := rs.Subjects[:0]
+	for _, s := range rs.Subjects {
+		if s != subj.String() {
+			subjects = append(subjects, s)
+		}
+	}
+	if len(subjects) == len(rs.Subjects) {
+		return nil
+	}
+	rs.Subjects = subjects
+
+	if len(rs.Subjects) == 0 {
+		err = l.repo.Delete(ctx, rs)
+	} else {
+		err = l.repo.Save(ctx, rs)
+	}
+	if err != nil {
+		return fmt.Errorf("delete relationship: %w", err)
+	}
+	log.Debugf("deleted relationship %s/%s:%s#%s@%s", rel.PolicyID, rel.Resource, rel.ResourceID, rel.Relation, subj)
+
+	return nil
+}
+
+// validate checks the relationship refers to a direct relation
+// of a known policy resource, which accepts the subject type.
+func (l *Local) validate(rel authz.Relationship) (subject, error) {
+	p, ok := l.policies[rel.PolicyID]
+	if !ok {
+		return subject{}, fmt.Errorf("%w: %s", ErrUnknownPolicy, rel.PolicyID)
+	}
+	if rel.ResourceID == "" {
+		return subject{}, fmt.Errorf("relationship missing resource id")
+	}
+
+	r, err := p.relation(rel.Resource, rel.Relation)
+	if err != nil {
+		return subject{}, err
+	}
+	if !r.direct {
+		return subject{}, fmt.Errorf("%w: %s#%s", ErrIndirectRelation, rel.Resource, rel.Relation)
+	}
+
+	subj, err := parseSubject(rel.Subject)
+	if err != nil {
+		return subject{}, err
+	}
+	if !r.allows(subj) {
+		return subject{}, fmt.Errorf("%w: %s in %s#%s", ErrSubjectType, subj, rel.Resource, rel.Relation)
+	}
+	if subj.relation != "" {
+		if _, err := p.relation(subj.typ, subj.relation); err != nil {
+			return subject{}, err
+		}
+	}
+
+	return subj, nil
+}
+
+// relationSubjects returns the subjects related to the object, which
+// is a new empty set if there are none.
+func (l *Local) relationSubjects(ctx context.Context, policyID, res, resID, rel string) (*authzv1alpha1.RelationSubjects, error) {
+	rs := &authzv1alpha1.RelationSubjects{
+		PolicyId:   policyID,
+		Resource:   res,
+		ResourceId: resID,
+		Relation:   rel,
+	}
+	if !l.repo.Exists(ctx, rs) {
+		return rs, nil
+	}
+
+	rs, err := l.repo.Get(ctx, rs)
+	if err != nil {
+		return nil, fmt.Errorf("get relationships: %w", err)
+	}
+	return rs, nil
+}
+
+// checker evaluates relation expressions for a subject.
+type checker struct {
+	l       *Local
+	p       *policy
+	subject subject
+}
+
+func (c *checker) check(ctx context.Context, res, resID, rel string, depth int) (bool, error) {
+	if depth > maxDepth {
+		return false, ErrMaxDepth
+	}
+
+	r, err := c.p.relation(res, rel)
+	if err != nil {
+		return false, err
+	}
+
+	return c.eval(ctx, r.expr, res, resID, rel, depth)
+}
+
+func (c *checker) eval(ctx context.Context, e expr, res, resID, rel string, depth int) (bool, error) {
+	switch e := e.(type) {
+	case thisExpr:
+		return c.direct(ctx, res, resID, rel, depth)
+
+	case computedExpr:
+		return c.check(ctx, res, resID, e.relation, depth+1)
+
+	case tupleToUsersetExpr:
+		rs, err := c.l.relationSubjects(ctx, c.p.id, res, resID, e.tupleset)
+		if err != nil {
+			return false, err
+		}
+		for _, s := range rs.Subjects {
+			obj, err := parseSubject(s)
+			if err != nil {
+				return false, err
+			}
+			// related objects without the computed relation don't match.
+			if _, err := c.p.relation(obj.typ, e.computed); err != nil {
+				continue
+			}
+			ok, err := c.check(ctx, obj.typ, obj.id, e.computed, depth+1)
+			if err != nil || ok {
+				return ok, err
+			}
+		}
+		return false, nil
+
+	case opExpr:
+		left, err := c.eval(ctx, e.left, res, resID, rel, depth)
+		if err != nil {
+			return false, err
+		}
+		switch {
+		case e.op == '+' && left:
+			return true, nil
+		case (e.op == '&' || e.op == '-') && !left:
+			return false, nil
+		}
+
+		right, err := c.eval(ctx, e.right, res, resID, rel, depth)
+		if err != nil {
+			return false, err
+		}
+		if e.op == '-' {
+			return !right, nil
+		}
+		return right, nil
+	}
+
+	return false, fmt.Errorf("unknown expression %T", e)
+}
+
+// direct matches the subject against the relationships written to
+// the relation, following the subject sets.
+func (c *checker) direct(ctx context.Context, res, resID, rel string, depth int) (bool, error) {
+	rs, err := c.l.relationSubjects(ctx, c.p.id, res, resID, rel)
+	if err != nil {
+		return false, err
+	}
+
+	want := c.subject.String()
+	for _, s := range rs.Subjects {
+		if s == want {
+			return true, nil
+		}
+	}
+
+	for _, s := range rs.Subjects {
+		set, err := parseSubject(s)
+		if err != nil {
+			return false, err
+		}
+		if set.relation == "" {
+			continue
+		}
+		ok, err := c.check(ctx, set.typ, set.id, set.relation, depth+1)
+		if err != nil || ok {
+			return ok, err
+		}
+	}
+
+	return false, nil
+}
+
+func relationSubjectsPkFunc(kb db.KeyBuilder, rs *authzv1alpha1.RelationSubjects) []byte {
+	return kb.
+		AddStringField(rs.PolicyId).
+		AddStringField(rs.Resource).
+		AddStringField(rs.ResourceId).
+		AddStringField(rs.Relation).
+		Bytes()
+}
@@ -0,0 +1,288 @@
+package local
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcenetwork/orbis-go/pkg/authz"
+	"github.com/sourcenetwork/orbis-go/pkg/db"
+)
+
+var testPolicy = []byte(`
+id: docs
+name: test
+doc: test policy
+
+resources:
+  folder:
+    relations:
+      owner:
+        types:
+          - user
+      viewer:
+        expr: _this + owner
+        types:
+          - user
+          - group:member
+  file:
+    relations:
+      parent:
+        types:
+          - folder
+      owner:
+        expr: _this
+        types:
+          - '*'
+      collaborator:
+        expr: _this
+        types:
+          - user
+          - group:member
+      banned:
+        types:
+          - user
+      read:
+        expr: (owner + collaborator + parent->viewer) - banned
+        types: []
+      edit:
+        expr: owner & collaborator
+  group:
+    relations:
+      member:
+        types:
+          - user
+          - group:member
+  user:
+`)
+
+func newTestLocal(t *testing.T) *Local {
+	d, err := db.New(t.TempDir())
+	require.NoError(t, err)
+
+	l, err := New(d, db.NewRepoKey("relationships"), testPolicy)
+	require.NoError(t, err)
+	return l
+}
+
+func write(t *testing.T, l *Local, res, id, rel, subject string) {
+	t.Helper()
+	err := l.WriteRelationship(context.Background(), authz.Relationship{
+		PolicyID:   "docs",
+		Resource:   res,
+		ResourceID: id,
+		Relation:   rel,
+		Subject:    subject,
+	})
+	require.NoError(t, err)
+}
+
+func TestLocalCheck(t *testing.T) {
+	ctx := context.Background()
+	l := newTestLocal(t)
+
+	write(t, l, "file", "readme", "owner", "user:did:key:alice")
+	write(t, l, "file", "readme", "collaborator", "group:eng#member")
+	write(t, l, "group", "eng", "member", "group:leads#member")
+	write(t, l, "group", "leads", "member", "user:did:key:bob")
+	write(t, l, "file", "readme", "parent", "folder:docs")
+	write(t, l, "folder", "docs", "viewer", "user:did:key:carol")
+	write(t, l, "folder", "docs", "owner", "user:did:key:dave")
+	write(t, l, "file", "readme", "banned", "user:did:key:dave")
+
+	tests := []struct {
+		perm    string
+		subject string
+		want    bool
+	}{
+		{"docs/file:readme#read", "user:did:key:alice", true},  // owner
+		{"docs/file:readme#read", "user:did:key:bob", true},    // nested group member
+		{"docs/file:readme#read", "user:did:key:carol", true},  // parent folder viewer
+		{"docs/file:readme#read", "user:did:key:dave", false},  // banned folder owner
+		{"docs/file:readme#read", "user:did:key:eve", false},   // unrelated
+		{"docs/file:other#read", "user:did:key:alice", false},  // other object
+		{"docs/file:readme#edit", "user:did:key:alice", false}, // owner only
+		{"docs/file:readme#owner", "user:did:key:alice", true},
+	}
+	for _, tt := range tests {
+		ok, err := l.Check(ctx, tt.perm, tt.subject)
+		require.NoError(t, err)
+		require.Equal(t, tt.want, ok, "%s %s", tt.perm, tt.subject)
+	}
+
+	// edit requires both owner and collaborator
+	write(t, l, "file", "readme", "collaborator", "user:did:key:alice")
+	ok, err := l.Check(ctx, "docs/file:readme#edit", "user:did:key:alice")
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	// deleting revokes access
+	err = l.DeleteRelationship(ctx, authz.Relationship{
+		PolicyID:   "docs",
+		Resource:   "group",
+		ResourceID: "leads",
+		Relation:   "member",
+		Subject:    "user:did:key:bob",
+	})
+	require.NoError(t, err)
+	ok, err = l.Check(ctx, "docs/file:readme#read", "user:did:key:bob")
+	require.NoError(t, err)
+	require.False(t, ok)
+}
+
+func TestLocalCheckErrors(t *testing.T) {
+	ctx := context.Background()
+	l := newTestLocal(t)
+
+	_, err := l.Check(ctx, "not a permission", "user:alice")
+	require.ErrorIs(t, err, ErrInvalidPermission)
+
+	_, err = l.Check(ctx, "other/file:readme#read", "user:alice")
+	require.ErrorIs(t, err, ErrUnknownPolicy)
+
+	_, err = l.Check(ctx, "docs/file:readme#delete", "user:alice")
+	require.ErrorIs(t, err, ErrUnknownRelation)
+
+	_, err = l.Check(ctx, "docs/file:readme#read", "alice")
+	require.ErrorIs(t, err, ErrInvalidSubject)
+}
+
+func TestLocalCyclicRelationships(t *testing.T) {
+	l := newTestLocal(t)
+
+	write(t, l, "group", "a", "member", "group:b#member")
+	write(t, l, "group", "b", "member", "group:a#member")
+
+	_, err := l.Check(context.Background(), "docs/group:a#member", "user:alice")
+	require.ErrorIs(t, err, ErrMaxDepth)
+}
+
+func TestLocalWriteValidation(t *testing.T) {
+	ctx := context.Background()
+	l := newTestLocal(t)
+
+	rel := func(res, relation, subject string) authz.Relationship {
+		return authz.Relationship{PolicyID: "docs", Resource: res, ResourceID: "x", Relation: relation, Subject: subject}
+	}
+
+	err := l.WriteRelationship(ctx, rel("file", "read", "user:alice"))
+	require.ErrorIs(t, err, ErrIndirectRelation)
+
+	err = l.WriteRelationship(ctx, rel("file", "collaborator", "folder:docs"))
+	require.ErrorIs(t, err, ErrSubjectType)
+
+	err = l.WriteRelationship(ctx, rel("file", "owner", "folder:docs")) // '*' type
+	require.NoError(t, err)
+
+	err = l.WriteRelationship(ctx, rel("file", "unknown", "user:alice"))
+	require.ErrorIs(t, err, ErrUnknownRelation)
+
+	err = l.WriteRelationship(ctx, authz.Relationship{PolicyID: "other", Resource: "file", ResourceID: "x", Relation: "owner", Subject: "user:alice"})
+	require.ErrorIs(t, err, ErrUnknownPolicy)
+}
+
+func TestLocalRegisterObject(t *testing.T) {
+	ctx := context.Background()
+	l := newTestLocal(t)
+
+	owner := func(subject string) authz.Relationship {
+		return authz.Relationship{PolicyID: "docs", Resource: "file", ResourceID: "x", Relation: "owner", Subject: subject}
+	}
+
+	created, err := l.RegisterObject(ctx, owner("user:alice"))
+	require.NoError(t, err)
+	require.True(t, created)
+
+	created, err = l.RegisterObject(ctx, owner("user:alice"))
+	require.NoError(t, err)
+	require.False(t, created)
+
+	_, err = l.RegisterObject(ctx, owner("user:bob"))
+	require.ErrorIs(t, err, authz.ErrObjectOwned)
+
+	ok, err := l.Check(ctx, "docs/file:x#read", "user:alice")
+	require.NoError(t, err)
+	require.True(t, ok)
+	ok, err = l.Check(ctx, "docs/file:x#read", "user:bob")
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	// rolled back registrations free the object
+	require.NoError(t, l.UnregisterObject(ctx, owner("user:alice")))
+	created, err = l.RegisterObject(ctx, owner("user:bob"))
+	require.NoError(t, err)
+	require.True(t, created)
+}
+
+func TestDemoPolicy(t *testing.T) {
+	ctx := context.Background()
+	policy, err := os.ReadFile("../../../demo/secret-policy.yaml")
+	require.NoError(t, err)
+
+	d, err := db.New(t.TempDir())
+	require.NoError(t, err)
+	l, err := New(d, db.NewRepoKey("relationships"), policy)
+	require.NoError(t, err)
+
+	for _, rel := range []authz.Relationship{
+		{PolicyID: "1", Resource: "secret", ResourceID: "x", Relation: "owner", Subject: "user:alice"},
+		{PolicyID: "1", Resource: "secret", ResourceID: "x", Relation: "collaborator", Subject: "user:bob"},
+	} {
+		require.NoError(t, l.WriteRelationship(ctx, rel))
+	}
+
+	// the owner stores secrets, collaborators only read them
+	for _, tt := range []struct {
+		perm    string
+		subject string
+		allowed bool
+	}{
+		{"1/secret:x#" + authz.WRITE, "user:alice", true},
+		{"1/secret:x#" + authz.WRITE, "user:bob", false},
+		{"1/secret:x#" + authz.READ, "user:alice", true},
+		{"1/secret:x#" + authz.READ, "user:bob", true},
+		{"1/secret:x#" + authz.READ, "user:carol", false},
+	} {
+		ok, err := l.Check(ctx, tt.perm, tt.subject)
+		require.NoError(t, err)
+		require.Equal(t, tt.allowed, ok, "%s@%s", tt.perm, tt.subject)
+	}
+}
+
+func TestParsePolicy(t *testing.T) {
+	_, err := parsePolicy([]byte(`
+id: p
+resources:
+  file:
+    relations:
+      read:
+        expr: owner + viewer
+      owner:
+`))
+	require.ErrorContains(t, err, "unknown relation viewer")
+
+	_, err = parsePolicy([]byte(`
+id: p
+resources:
+  file:
+    relations:
+      read:
+        expr: (owner +
+      owner:
+`))
+	require.Error(t, err)
+
+	e, err := parseExpr("a + b->c - (d & _this)")
+	require.NoError(t, err)
+	require.Equal(t, opExpr{
+		op: '-',
+		left: opExpr{
+			op:    '+',
+			left:  computedExpr{relation: "a"},
+			right: tupleToUsersetExpr{tupleset: "b", computed: "c"},
+		},
+		right: opExpr{op: '&', left: computedExpr{relation: "d"}, right: thisExpr{}},
+	}, e)
+}
@@ -0,0 +1,304 @@
+package local
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
+
+	"github.com/sourcenetwork/zanzi/pkg/policy_definition"
+)
+
+const (
+	// thisRelation is the expression term for the
+	// relationships written directly to a relation.
+	thisRelation = "_this"
+
+	// anyType allows subjects of any type in a relation.
+	anyType = policy_definition.UniversalSetType
+)
+
+// permission is formatted as:
+// PolicyID/ResourceGroup:ResourceID#relation
+var permRegex = regexp.MustCompile(`^(\w+)/(\w+):([^#]+)#(\w+)$`)
+
+type policy struct {
+	id        string
+	resources map[string]*resource
+}
+
+type resource struct {
+	name      string
+	relations map[string]*relation
+}
+
+type relation struct {
+	name  string
+	expr  expr
+	types []string
+	// direct is whether relationships can be
+	// written to the relation, ie. its expression
+	// refers to _this.
+	direct bool
+}
+
+// parsePolicy parses a policy in the zanzi policy definition
+// YAML format, and validates the relation expressions.
+func parsePolicy(data []byte) (*policy, error) {
+	def, err := policy_definition.UnmarshalPolicyDefinition(string(data))
+	if err != nil {
+		return nil, err
+	}
+	if def.Id == "" {
+		return nil, fmt.Errorf("policy missing id")
+	}
+
+	p := &policy{
+		id:        def.Id,
+		resources: make(map[string]*resource, len(def.Resources)),
+	}
+	for resName, resDef := range def.Resources {
+		res := &resource{
+			name:      resName,
+			relations: make(map[string]*relation, len(resDef.Relations)),
+		}
+		for relName, relDef := range resDef.Relations {
+			src := relDef.Expr
+			if strings.TrimSpace(src) == "" {
+				src = thisRelation
+			}
+			e, err := parseExpr(src)
+			if err != nil {
+				return nil, fmt.Errorf("relation %s#%s: %w", resName, relName, err)
+			}
+			res.relations[relName] = &relation{
+				name:   relName,
+				expr:   e,
+				types:  relDef.Types,
+				direct: refersThis(e),
+			}
+		}
+		p.resources[resName] = res
+	}
+
+	// computed relations must exist on the resource, tuple
+	// to userset relations are resolved on the related
+	// objects at check time.
+	for _, res := range p.resources {
+		for _, rel := range res.relations {
+			for _, name := range referencedRelations(rel.expr) {
+				if _, ok := res.relations[name]; !ok {
+					return nil, fmt.Errorf("relation %s#%s: unknown relation %s", res.name, rel.name, name)
+				}
+			}
+		}
+	}
+
+	return p, nil
+}
+
+func (p *policy) relation(res, rel string) (*relation, error) {
+	r, ok := p.resources[res]
+	if !ok {
+		return nil, fmt.Errorf("%w: resource %s", ErrUnknownRelation, res)
+	}
+	relation, ok := r.relations[rel]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s#%s", ErrUnknownRelation, res, rel)
+	}
+	return relation, nil
+}
+
+// allows reports whether the subject type is allowed by the
+// relation types, where "type:relation" entries allow subject sets.
+func (r *relation) allows(s subject) bool {
+	want := s.typ
+	if s.relation != "" {
+		want = s.typ + policy_definition.TypeRelationSeparator + s.relation
+	}
+	for _, t := range r.types {
+		if t == anyType || t == want {
+			return true
+		}
+	}
+	return false
+}
+
+// subject is an entity "type:id", or a subject set "type:id#relation".
+type subject struct {
+	typ      string
+	id       string
+	relation string
+}
+
+func parseSubject(s string) (subject, error) {
+	var subj subject
+	entity := s
+	if i := strings.LastIndex(s, "#"); i >= 0 {
+		entity, subj.relation = s[:i], s[i+1:]
+	}
+
+	typ, id, ok := strings.Cut(entity, ":")
+	if !ok || typ == "" || id == "" {
+		return subject{}, fmt.Errorf("%w: %q", ErrInvalidSubject, s)
+	}
+	subj.typ, subj.id = typ, id
+
+	return subj, nil
+}
+
+func (s subject) String() string {
+	if s.relation == "" {
+		return s.typ + ":" + s.id
+	}
+	return s.typ + ":" + s.id + "#" + s.relation
+}
+
+// expr is a parsed relation expression, following the zanzi grammar:
+//
+//	expr := term | term (op term)+
+//	op := "+" (union) | "&" (intersection) | "-" (difference)
+//	term := "_this" | relation | relation "->" relation | "(" expr ")"
+//
+// Operators have the same precedence and are left associative.
+type expr interface{}
+
+type (
+	// thisExpr matches the relationships written to the relation.
+	thisExpr struct{}
+	// computedExpr matches the subjects of another
+	// relation of the same object.
+	computedExpr struct {
+		relation string
+	}
+	// tupleToUsersetExpr matches the subjects of the computed
+	// relation on the objects related through the tupleset relation.
+	tupleToUsersetExpr struct {
+		tupleset string
+		computed string
+	}
+	opExpr struct {
+		op          byte
+		left, right expr
+	}
+)
+
+func parseExpr(src string) (expr, error) {
+	p := &exprParser{src: src}
+	e, err := p.expr()
+	if err != nil {
+		return nil, err
+	}
+	if p.skipSpace(); p.pos != len(p.src) {
+		return nil, fmt.Errorf("unexpected %q at %d in expression %q", p.src[p.pos], p.pos, src)
+	}
+	return e, nil
+}
+
+type exprParser struct {
+	src string
+	pos int
+}
+
+func (p *exprParser) expr() (expr, error) {
+	left, err := p.term()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		p.skipSpace()
+		if p.pos == len(p.src) {
+			return left, nil
+		}
+		op := p.src[p.pos]
+		if op != '+' && op != '&' && op != '-' {
+			return left, nil
+		}
+		p.pos++
+		right, err := p.term()
+		if err != nil {
+			return nil, err
+		}
+		left = opExpr{op: op, left: left, right: right}
+	}
+}
+
+func (p *exprParser) term() (expr, error) {
+	p.skipSpace()
+	if p.pos < len(p.src) && p.src[p.pos] == '(' {
+		p.pos++
+		e, err := p.expr()
+		if err != nil {
+			return nil, err
+		}
+		p.skipSpace()
+		if p.pos == len(p.src) || p.src[p.pos] != ')' {
+			return nil, fmt.Errorf("missing ')' in expression %q", p.src)
+		}
+		p.pos++
+		return e, nil
+	}
+
+	name := p.identifier()
+	if name == "" {
+		return nil, fmt.Errorf("expected relation at %d in expression %q", p.pos, p.src)
+	}
+	if name == thisRelation {
+		return thisExpr{}, nil
+	}
+
+	p.skipSpace()
+	if strings.HasPrefix(p.src[p.pos:], "->") {
+		p.pos += 2
+		p.skipSpace()
+		computed := p.identifier()
+		if computed == "" {
+			return nil, fmt.Errorf("expected relation after '->' in expression %q", p.src)
+		}
+		return tupleToUsersetExpr{tupleset: name, computed: computed}, nil
+	}
+
+	return computedExpr{relation: name}, nil
+}
+
+func (p *exprParser) identifier() string {
+	start := p.pos
+	for p.pos < len(p.src) {
+		c := rune(p.src[p.pos])
+		if !unicode.IsLetter(c) && !unicode.IsDigit(c) && c != '_' {
+			break
+		}
+		p.pos++
+	}
+	return p.src[start:p.pos]
+}
+
+func (p *exprParser) skipSpace() {
+	for p.pos < len(p.src) && unicode.IsSpace(rune(p.src[p.pos])) {
+		p.pos++
+	}
+}
+
+func refersThis(e expr) bool {
+	switch e := e.(type) {
+	case thisExpr:
+		return true
+	case opExpr:
+		return refersThis(e.left) || refersThis(e.right)
+	}
+	return false
+}
+
+// referencedRelations returns the relations of the same
+// resource referred to by the expression.
+func referencedRelations(e expr) []string {
+	switch e := e.(type) {
+	case computedExpr:
+		return []string{e.relation}
+	case tupleToUsersetExpr:
+		return []string{e.tupleset}
+	case opExpr:
+		return append(referencedRelations(e.left), referencedRelations(e.right)...)
+	}
+	return nil
+}
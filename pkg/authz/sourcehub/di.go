@@ -0,0 +1,55 @@
+package sourcehub
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/samber/do"
+	acptypes "github.com/sourcenetwork/sourcehub/x/acp/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/sourcenetwork/orbis-go/config"
+	"github.com/sourcenetwork/orbis-go/pkg/authn"
+	"github.com/sourcenetwork/orbis-go/pkg/authz"
+	"github.com/sourcenetwork/orbis-go/pkg/db"
+	"github.com/sourcenetwork/orbis-go/pkg/types"
+)
+
+var (
+	_       types.Factory[authz.Authz] = (*factory)(nil)
+	Factory                            = factory{}
+)
+
+type factory struct{}
+
+func (factory) New(inj *do.Injector, _ []db.RepoKey, cfg config.Config) (authz.Authz, error) {
+	conn, err := grpc.Dial(cfg.Authz.SourceHub.Address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, fmt.Errorf("dial sourcehub: %w", err)
+	}
+
+	var tickets *TicketVerifier
+	if cfg.Authz.SourceHub.Proofs {
+		resolver, err := do.Invoke[authn.KeyResolver](inj)
+		if err != nil {
+			return nil, fmt.Errorf("invoke key resolver: %w", err)
+		}
+		chain, err := NewCometChain(cfg.Authz.SourceHub.RPCAddress)
+		if err != nil {
+			return nil, err
+		}
+		tickets = NewTicketVerifier(resolver, chain)
+	}
+
+	ttl := time.Duration(cfg.Authz.SourceHub.CacheTTL) * time.Second
+	return New(acptypes.NewQueryClient(conn), ttl, tickets), nil
+}
+
+func (factory) Name() string {
+	return name
+}
+
+func (factory) Repos() []string {
+	return []string{}
+}
@@ -0,0 +1,205 @@
+package sourcehub
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/golang-lru/v2/expirable"
+	logging "github.com/ipfs/go-log"
+	acptypes "github.com/sourcenetwork/sourcehub/x/acp/types"
+
+	"github.com/sourcenetwork/orbis-go/pkg/authz"
+)
+
+var (
+	_ authz.Authz           = (*ACP)(nil)
+	_ authz.ObjectRegistrar = (*ACP)(nil)
+)
+
+var log = logging.Logger("orbis/authz/sourcehub")
+
+const (
+	name = "sourcehub"
+
+	// cacheSize bounds the number of cached access decisions.
+	cacheSize = 10_000
+)
+
+var (
+	ErrInvalidPermission = fmt.Errorf("invalid permission")
+	ErrInvalidSubject    = fmt.Errorf("invalid subject")
+	ErrInvalidProof      = fmt.Errorf("invalid acp proof")
+	ErrUnregistered      = fmt.Errorf("object not registered on SourceHub")
+)
+
+// permission is formatted as:
+// PolicyID/Resource:ObjectID#permission
+var permRegex = regexp.MustCompile(`^([^/]+)/(\w+):([^#]+)#(\w+)$`)
+
+// ACP is an authorizer delegating to the SourceHub ACP module. Access
+// decisions are cached for a short time, so revocations take effect once
+// the cached decision expires.
+//
+// If a ticket verifier is configured, requests carrying an ACP proof,
+// which is an access ticket issued by SourceHub, are authorized by
+// verifying the ticket instead of querying the ACP module.
+type ACP struct {
+	client  acptypes.QueryClient
+	cache   *expirable.LRU[string, bool]
+	tickets *TicketVerifier
+}
+
+// New returns an ACP authorizer using the ACP query client. Decisions are
+// cached for ttl, where a zero ttl disables the cache. The ticket verifier
+// is optional, without it ACP proofs are ignored.
+func New(client acptypes.QueryClient, ttl time.Duration, tickets *TicketVerifier) *ACP {
+	a := &ACP{
+		client:  client,
+		tickets: tickets,
+	}
+	if ttl > 0 {
+		a.cache = expirable.NewLRU[string, bool](cacheSize, nil, ttl)
+	}
+	return a
+}
+
+func (a *ACP) Name() string {
+	return name
+}
+
+func (a *ACP) Init(_ context.Context) error {
+	return nil
+}
+
+// Check reports whether the subject, formatted as "type:did" or as a
+// DID, is the actor of an access request for the permission formatted
+// as PolicyID/Resource:ObjectID#permission, which SourceHub allows.
+func (a *ACP) Check(ctx context.Context, perm, subject string) (bool, error) {
+	policyID, op, err := parsePermission(perm)
+	if err != nil {
+		return false, err
+	}
+
+	actor, err := actorFromSubject(subject)
+	if err != nil {
+		return false, err
+	}
+
+	if proof, ok := authz.ProofFromContext(ctx); ok && a.tickets != nil {
+		err = a.tickets.Verify(ctx, string(proof), policyID, op, actor)
+		if err != nil {
+			return false, fmt.Errorf("%w: %w", ErrInvalidProof, err)
+		}
+		log.Debugf("authorized %s for %s by acp proof", actor, perm)
+		return true, nil
+	}
+
+	key := perm + "@" + actor
+	if a.cache != nil {
+		if allowed, ok := a.cache.Get(key); ok {
+			return allowed, nil
+		}
+	}
+
+	resp, err := a.client.VerifyAccessRequest(ctx, &acptypes.QueryVerifyAccessRequestRequest{
+		PolicyId: policyID,
+		AccessRequest: &acptypes.AccessRequest{
+			Operations: []*acptypes.Operation{op},
+			Actor:      &acptypes.Actor{Id: actor},
+		},
+	})
+	if err != nil {
+		return false, fmt.Errorf("verify access request: %w", err)
+	}
+
+	if a.cache != nil {
+		a.cache.Add(key, resp.Valid)
+	}
+
+	return resp.Valid, nil
+}
+
+// RegisterObject checks the subject is the registered owner of the
+// object. SourceHub objects are registered on chain by a transaction
+// of their owner, which the node can't sign on their behalf, so it
+// never writes the relationship itself.
+func (a *ACP) RegisterObject(ctx context.Context, rel authz.Relationship) (bool, error) {
+	actor, err := actorFromSubject(rel.Subject)
+	if err != nil {
+		return false, err
+	}
+
+	resp, err := a.client.FilterRelationships(ctx, &acptypes.QueryFilterRelationshipsRequest{
+		PolicyId: rel.PolicyID,
+		Selector: &acptypes.RelationshipSelector{
+			ObjectSelector: &acptypes.ObjectSelector{
+				Selector: &acptypes.ObjectSelector_Object{
+					Object: &acptypes.Object{Resource: rel.Resource, Id: rel.ResourceID},
+				},
+			},
+			RelationSelector: &acptypes.RelationSelector{
+				Selector: &acptypes.RelationSelector_Relation{Relation: rel.Relation},
+			},
+			SubjectSelector: &acptypes.SubjectSelector{
+				Selector: &acptypes.SubjectSelector_Wildcard{Wildcard: &acptypes.WildcardSelector{}},
+			},
+		},
+	})
+	if err != nil {
+		return false, fmt.Errorf("filter relationships: %w", err)
+	}
+
+	owned := false
+	for _, rec := range resp.Records {
+		if rec.Archived {
+			continue
+		}
+		if rec.GetRelationship().GetSubject().GetActor().GetId() == actor {
+			return false, nil
+		}
+		owned = true
+	}
+	if owned {
+		return false, fmt.Errorf("%w: %s/%s:%s", authz.ErrObjectOwned, rel.PolicyID, rel.Resource, rel.ResourceID)
+	}
+	return false, fmt.Errorf("%w: %s/%s:%s", ErrUnregistered, rel.PolicyID, rel.Resource, rel.ResourceID)
+}
+
+// UnregisterObject is a no-op, as RegisterObject never
+// writes relationships.
+func (a *ACP) UnregisterObject(context.Context, authz.Relationship) error {
+	return nil
+}
+
+func parsePermission(perm string) (string, *acptypes.Operation, error) {
+	m := permRegex.FindStringSubmatch(perm)
+	if m == nil {
+		return "", nil, fmt.Errorf("%w: %s", ErrInvalidPermission, perm)
+	}
+
+	return m[1], &acptypes.Operation{
+		Object: &acptypes.Object{
+			Resource: m[2],
+			Id:       m[3],
+		},
+		Permission: m[4],
+	}, nil
+}
+
+// actorFromSubject returns the actor DID of a subject, which
+// can be prefixed with its type, such as "user:did:key:z6Mk...".
+func actorFromSubject(subject string) (string, error) {
+	if strings.HasPrefix(subject, "did:") {
+		return subject, nil
+	}
+
+	_, actor, ok := strings.Cut(subject, ":")
+	if !ok || !strings.HasPrefix(actor, "did:") {
+		return "", fmt.Errorf("%w: %s", ErrInvalidSubject, subject)
+	}
+
+	return actor, nil
+}
@@ -0,0 +1,165 @@
+package sourcehub
+
+import (
+	"context"
+	"net"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	acptypes "github.com/sourcenetwork/sourcehub/x/acp/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/sourcenetwork/orbis-go/pkg/authz"
+)
+
+// testACP is a stand-in for the SourceHub ACP query service,
+// allowing the access requests of its allowed actors.
+type testACP struct {
+	acptypes.UnimplementedQueryServer
+
+	allowed map[string]bool
+	owners  map[string]string // object => owner actor
+	calls   atomic.Int32
+}
+
+func (s *testACP) FilterRelationships(ctx context.Context, req *acptypes.QueryFilterRelationshipsRequest) (*acptypes.QueryFilterRelationshipsResponse, error) {
+	obj := req.Selector.ObjectSelector.GetObject()
+	owner, ok := s.owners[req.PolicyId+"/"+obj.Resource+":"+obj.Id]
+	if !ok {
+		return &acptypes.QueryFilterRelationshipsResponse{}, nil
+	}
+	return &acptypes.QueryFilterRelationshipsResponse{
+		Records: []*acptypes.RelationshipRecord{{
+			PolicyId: req.PolicyId,
+			Relationship: &acptypes.Relationship{
+				Object:   obj,
+				Relation: "owner",
+				Subject:  &acptypes.Subject{Subject: &acptypes.Subject_Actor{Actor: &acptypes.Actor{Id: owner}}},
+			},
+		}},
+	}, nil
+}
+
+func (s *testACP) VerifyAccessRequest(ctx context.Context, req *acptypes.QueryVerifyAccessRequestRequest) (*acptypes.QueryVerifyAccessRequestResponse, error) {
+	s.calls.Add(1)
+	op := req.AccessRequest.Operations[0]
+	key := req.PolicyId + "/" + op.Object.Resource + ":" + op.Object.Id + "#" + op.Permission + "@" + req.AccessRequest.Actor.Id
+	return &acptypes.QueryVerifyAccessRequestResponse{Valid: s.allowed[key]}, nil
+}
+
+func newTestClient(t *testing.T, srv acptypes.QueryServer) acptypes.QueryClient {
+	lis := bufconn.Listen(1 << 20)
+	s := grpc.NewServer()
+	acptypes.RegisterQueryServer(s, srv)
+	go s.Serve(lis) // nolint:errcheck
+	t.Cleanup(s.Stop)
+
+	conn, err := grpc.Dial("bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	return acptypes.NewQueryClient(conn)
+}
+
+func TestACPCheck(t *testing.T) {
+	ctx := context.Background()
+	srv := &testACP{allowed: map[string]bool{
+		"abc123/secret:s1#read@did:key:alice": true,
+	}}
+	a := New(newTestClient(t, srv), time.Minute, nil)
+
+	ok, err := a.Check(ctx, "abc123/secret:s1#read", "user:did:key:alice")
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	ok, err = a.Check(ctx, "abc123/secret:s1#read", "did:key:bob")
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	// decisions are cached
+	ok, err = a.Check(ctx, "abc123/secret:s1#read", "user:did:key:alice")
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.EqualValues(t, 2, srv.calls.Load())
+
+	_, err = a.Check(ctx, "abc123/secret:s1", "user:did:key:alice")
+	require.ErrorIs(t, err, ErrInvalidPermission)
+
+	_, err = a.Check(ctx, "abc123/secret:s1#read", "user:alice")
+	require.ErrorIs(t, err, ErrInvalidSubject)
+}
+
+func TestACPRegisterObject(t *testing.T) {
+	ctx := context.Background()
+	srv := &testACP{owners: map[string]string{
+		"abc123/secret:s1": "did:key:alice",
+	}}
+	a := New(newTestClient(t, srv), time.Minute, nil)
+
+	owner := func(id, subject string) authz.Relationship {
+		return authz.Relationship{PolicyID: "abc123", Resource: "secret", ResourceID: id, Relation: "owner", Subject: subject}
+	}
+
+	// the owner is registered on chain, the node never writes it
+	created, err := a.RegisterObject(ctx, owner("s1", "user:did:key:alice"))
+	require.NoError(t, err)
+	require.False(t, created)
+
+	_, err = a.RegisterObject(ctx, owner("s1", "user:did:key:bob"))
+	require.ErrorIs(t, err, authz.ErrObjectOwned)
+
+	_, err = a.RegisterObject(ctx, owner("s2", "user:did:key:alice"))
+	require.ErrorIs(t, err, ErrUnregistered)
+}
+
+func TestACPCheckCacheExpiry(t *testing.T) {
+	ctx := context.Background()
+	srv := &testACP{allowed: map[string]bool{}}
+	a := New(newTestClient(t, srv), 50*time.Millisecond, nil)
+
+	ok, err := a.Check(ctx, "abc123/secret:s1#read", "user:did:key:alice")
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	// granted, but the denial is still cached
+	srv.allowed["abc123/secret:s1#read@did:key:alice"] = true
+	ok, _ = a.Check(ctx, "abc123/secret:s1#read", "user:did:key:alice")
+	require.False(t, ok)
+
+	require.Eventually(t, func() bool {
+		ok, _ := a.Check(ctx, "abc123/secret:s1#read", "user:did:key:alice")
+		return ok
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestACPCheckProof(t *testing.T) {
+	tc := newTestTicket(t)
+	srv := &testACP{allowed: map[string]bool{}}
+	a := New(newTestClient(t, srv), time.Minute, NewTicketVerifier(tc.resolver, tc.chain))
+
+	// a valid ticket is accepted without querying sourcehub
+	ctx := authz.ContextWithProof(context.Background(), []byte(tc.ticket))
+	ok, err := a.Check(ctx, "abc123/secret:s1#read", "user:"+tc.actor)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Zero(t, srv.calls.Load())
+
+	// and rejected for another object
+	_, err = a.Check(ctx, "abc123/secret:s2#read", "user:"+tc.actor)
+	require.ErrorIs(t, err, ErrInvalidProof)
+	require.ErrorIs(t, err, ErrTicketMismatch)
+
+	// without a verifier, proofs are ignored
+	a = New(newTestClient(t, srv), time.Minute, nil)
+	ok, err = a.Check(ctx, "abc123/secret:s1#read", "user:"+tc.actor)
+	require.NoError(t, err)
+	require.False(t, ok)
+	require.EqualValues(t, 1, srv.calls.Load())
+}
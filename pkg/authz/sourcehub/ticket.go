@@ -0,0 +1,278 @@
+package sourcehub
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/ecdsa"
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/crypto/merkle"
+	rpchttp "github.com/cometbft/cometbft/rpc/client/http"
+	lru "github.com/hashicorp/golang-lru/v2"
+	cryptopb "github.com/libp2p/go-libp2p/core/crypto/pb"
+	acptypes "github.com/sourcenetwork/sourcehub/x/acp/types"
+
+	"github.com/sourcenetwork/orbis-go/pkg/authn"
+)
+
+const (
+	// ticketSeparator separates the ticket version
+	// from its base64 encoded content.
+	ticketSeparator = "."
+
+	// headerCacheSize bounds the number of cached app hashes.
+	headerCacheSize = 1024
+)
+
+var (
+	ErrTicketMalformed = fmt.Errorf("malformed access ticket")
+	ErrTicketTampered  = fmt.Errorf("access ticket decision tampered")
+	ErrTicketMismatch  = fmt.Errorf("access ticket doesn't grant the request")
+	ErrTicketSignature = fmt.Errorf("invalid access ticket signature")
+	ErrTicketProof     = fmt.Errorf("invalid access ticket decision proof")
+	ErrTicketExpired   = fmt.Errorf("access ticket expired")
+)
+
+// Chain provides trusted SourceHub block data.
+type Chain interface {
+	// Height returns the latest block height.
+	Height(ctx context.Context) (int64, error)
+	// AppHash returns the app hash of the block at height, which
+	// commits to the application state after the previous block.
+	AppHash(ctx context.Context, height int64) ([]byte, error)
+}
+
+// TicketVerifier verifies SourceHub access tickets. A ticket carries an
+// access decision made by the ACP module, a merkle proof the decision is
+// part of the SourceHub state, and the signature of the decision actor.
+// Verifying a ticket only needs block headers from a trusted node, which
+// are immutable and cached, rather than evaluating the access request.
+type TicketVerifier struct {
+	resolver authn.KeyResolver
+	chain    Chain
+}
+
+// NewTicketVerifier returns a verifier resolving the actor
+// keys with the resolver, and the app hashes with the chain.
+func NewTicketVerifier(resolver authn.KeyResolver, chain Chain) *TicketVerifier {
+	return &TicketVerifier{
+		resolver: resolver,
+		chain:    chain,
+	}
+}
+
+// Verify checks the ticket is authentic, unexpired, and
+// grants the operation on the policy to the actor.
+func (v *TicketVerifier) Verify(ctx context.Context, ticket string, policyID string, op *acptypes.Operation, actor string) error {
+	tkt, err := parseTicket(ticket)
+	if err != nil {
+		return err
+	}
+
+	if tkt.Decision == nil {
+		return fmt.Errorf("%w: missing decision", ErrTicketMalformed)
+	}
+	if tkt.Decision.ProduceId() != tkt.DecisionId {
+		return fmt.Errorf("%w: decision id %s, expected %s", ErrTicketTampered, tkt.DecisionId, tkt.Decision.ProduceId())
+	}
+
+	// The decision id doesn't cover every decision field, notably
+	// SourceHub doesn't hash the operations, so the decision proven
+	// to be in the SourceHub state is used rather than the ticket copy.
+	decision, err := v.verifyProof(ctx, tkt)
+	if err != nil {
+		return err
+	}
+	if decision.Params == nil {
+		return fmt.Errorf("%w: decision missing params", ErrTicketMalformed)
+	}
+
+	if decision.PolicyId != policyID {
+		return fmt.Errorf("%w: policy %s", ErrTicketMismatch, decision.PolicyId)
+	}
+	if decision.ActorDid != actor {
+		return fmt.Errorf("%w: actor %s", ErrTicketMismatch, decision.ActorDid)
+	}
+	if !hasOperation(decision.Operations, op) {
+		return fmt.Errorf("%w: operation %s:%s#%s", ErrTicketMismatch, op.Object.Resource, op.Object.Id, op.Permission)
+	}
+
+	err = v.verifySignature(ctx, tkt, decision.ActorDid)
+	if err != nil {
+		return err
+	}
+
+	height, err := v.chain.Height(ctx)
+	if err != nil {
+		return fmt.Errorf("get sourcehub height: %w", err)
+	}
+	params := decision.Params
+	expiry := decision.IssuedHeight + min(params.DecisionExpirationDelta, params.ProofExpirationDelta, params.TicketExpirationDelta)
+	if uint64(height) > expiry {
+		return fmt.Errorf("%w: at height %d", ErrTicketExpired, expiry)
+	}
+
+	return nil
+}
+
+// verifySignature checks the ticket is signed by the decision actor.
+func (v *TicketVerifier) verifySignature(ctx context.Context, tkt *acptypes.AccessTicket, actor string) error {
+	info, err := v.resolver.Resolve(ctx, actor)
+	if err != nil {
+		return fmt.Errorf("resolve actor key: %w", err)
+	}
+	if info.PubKey == nil {
+		return fmt.Errorf("%w: actor has no public key", ErrTicketSignature)
+	}
+
+	sig := tkt.Signature
+	// SourceHub secp256k1 signatures are in the compact
+	// R || S format, while libp2p keys expect DER.
+	if info.PubKey.Type() == cryptopb.KeyType_Secp256k1 && len(sig) == 64 {
+		var r, s btcec.ModNScalar
+		r.SetByteSlice(sig[:32])
+		s.SetByteSlice(sig[32:])
+		sig = ecdsa.NewSignature(&r, &s).Serialize()
+	}
+
+	ok, err := info.PubKey.Verify(ticketDigest(tkt), sig)
+	if err != nil || !ok {
+		return ErrTicketSignature
+	}
+
+	return nil
+}
+
+// verifyProof checks the decision proof, which is an ABCI query
+// response for the decision with its merkle proof, against the
+// app hash committing to the queried state. It returns the
+// proven decision.
+func (v *TicketVerifier) verifyProof(ctx context.Context, tkt *acptypes.AccessTicket) (*acptypes.AccessDecision, error) {
+	var query abcitypes.ResponseQuery
+	err := query.Unmarshal(tkt.DecisionProof)
+	if err != nil || query.ProofOps == nil {
+		return nil, fmt.Errorf("%w: malformed", ErrTicketProof)
+	}
+
+	var proven acptypes.AccessDecision
+	err = proven.Unmarshal(query.Value)
+	if err != nil || proven.Id != tkt.DecisionId {
+		return nil, fmt.Errorf("%w: proven value isn't the decision", ErrTicketProof)
+	}
+
+	appHash, err := v.chain.AppHash(ctx, query.Height+1)
+	if err != nil {
+		return nil, fmt.Errorf("get sourcehub app hash: %w", err)
+	}
+
+	runtime := merkle.NewProofRuntime()
+	runtime.RegisterOpDecoder(storetypes.ProofOpIAVLCommitment, storetypes.CommitmentOpDecoder)
+	runtime.RegisterOpDecoder(storetypes.ProofOpSimpleMerkleCommitment, storetypes.CommitmentOpDecoder)
+	err = runtime.VerifyValue(query.ProofOps, appHash, decisionKey(tkt.DecisionId), query.Value)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrTicketProof, err)
+	}
+
+	return &proven, nil
+}
+
+// parseTicket decodes a ticket formatted as <version>.<base64url ticket>.
+func parseTicket(ticket string) (*acptypes.AccessTicket, error) {
+	version, encoded, ok := strings.Cut(ticket, ticketSeparator)
+	if !ok || version != acptypes.AccessTicketV1 {
+		return nil, fmt.Errorf("%w: unsupported version", ErrTicketMalformed)
+	}
+
+	buf, err := base64.URLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrTicketMalformed, err)
+	}
+
+	var tkt acptypes.AccessTicket
+	err = tkt.Unmarshal(buf)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrTicketMalformed, err)
+	}
+
+	return &tkt, nil
+}
+
+// ticketDigest is the signed digest of a ticket.
+func ticketDigest(tkt *acptypes.AccessTicket) []byte {
+	h := sha256.New()
+	h.Write([]byte(tkt.VersionDenominator))
+	h.Write([]byte(tkt.DecisionId))
+	h.Write(tkt.DecisionProof)
+	return h.Sum(nil)
+}
+
+// decisionKey is the merkle key path of a decision in the SourceHub
+// state, made of the module store name and the decision store key.
+func decisionKey(decisionID string) string {
+	return "/" + acptypes.ModuleName + "/" + acptypes.AccessDecisionRepositoryKey + decisionID
+}
+
+func hasOperation(ops []*acptypes.Operation, op *acptypes.Operation) bool {
+	for _, o := range ops {
+		if o.GetObject().GetResource() == op.Object.Resource &&
+			o.GetObject().GetId() == op.Object.Id &&
+			o.GetPermission() == op.Permission {
+			return true
+		}
+	}
+	return false
+}
+
+// cometChain reads block data from a CometBFT node RPC.
+type cometChain struct {
+	client *rpchttp.HTTP
+	// height => app hash
+	appHashes *lru.Cache[int64, []byte]
+}
+
+// NewCometChain returns a chain reading block data
+// from the CometBFT RPC at addr, which must be trusted.
+func NewCometChain(addr string) (Chain, error) {
+	client, err := rpchttp.New(addr, "/websocket")
+	if err != nil {
+		return nil, fmt.Errorf("create cometbft rpc client: %w", err)
+	}
+
+	appHashes, _ := lru.New[int64, []byte](headerCacheSize) // only errors on a non positive size
+	return &cometChain{
+		client:    client,
+		appHashes: appHashes,
+	}, nil
+}
+
+func (c *cometChain) Height(ctx context.Context) (int64, error) {
+	resp, err := c.client.ABCIInfo(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return resp.Response.LastBlockHeight, nil
+}
+
+func (c *cometChain) AppHash(ctx context.Context, height int64) ([]byte, error) {
+	if appHash, ok := c.appHashes.Get(height); ok {
+		return appHash, nil
+	}
+
+	resp, err := c.client.Header(ctx, &height)
+	if err != nil {
+		return nil, err
+	}
+	if resp.Header == nil || resp.Header.Height != height {
+		return nil, fmt.Errorf("header at height %d not found", height)
+	}
+
+	appHash := bytes.Clone(resp.Header.AppHash)
+	c.appHashes.Add(height, appHash)
+	return appHash, nil
+}
@@ -0,0 +1,221 @@
+package sourcehub
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"testing"
+
+	cosmoslog "cosmossdk.io/log"
+	"cosmossdk.io/store/metrics"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/ecdsa"
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	dbm "github.com/cosmos/cosmos-db"
+	gogotypes "github.com/cosmos/gogoproto/types"
+	ic "github.com/libp2p/go-libp2p/core/crypto"
+	acptypes "github.com/sourcenetwork/sourcehub/x/acp/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcenetwork/orbis-go/pkg/authn"
+	"github.com/sourcenetwork/orbis-go/pkg/crypto"
+)
+
+type testResolver map[string]crypto.PublicKey
+
+func (r testResolver) Resolve(_ context.Context, did string) (authn.SubjectInfo, error) {
+	pk, ok := r[did]
+	if !ok {
+		return authn.SubjectInfo{}, fmt.Errorf("unknown did %s", did)
+	}
+	return authn.SubjectInfo{Subject: did, PubKey: pk}, nil
+}
+
+type testChain struct {
+	height    int64
+	appHashes map[int64][]byte
+}
+
+func (c *testChain) Height(context.Context) (int64, error) {
+	return c.height, nil
+}
+
+func (c *testChain) AppHash(_ context.Context, height int64) ([]byte, error) {
+	appHash, ok := c.appHashes[height]
+	if !ok {
+		return nil, fmt.Errorf("no block at height %d", height)
+	}
+	return appHash, nil
+}
+
+type testTicket struct {
+	ticket   string
+	tkt      *acptypes.AccessTicket
+	actor    string
+	priv     *btcec.PrivateKey
+	resolver testResolver
+	chain    *testChain
+}
+
+// newTestTicket issues a ticket like SourceHub, with a decision stored
+// in a committed IAVL store, signed by a secp256k1 actor key.
+func newTestTicket(t *testing.T) *testTicket {
+	t.Helper()
+
+	sk, pk, err := ic.GenerateSecp256k1Key(rand.Reader)
+	require.NoError(t, err)
+	rawSk, err := sk.Raw()
+	require.NoError(t, err)
+	priv, _ := btcec.PrivKeyFromBytes(rawSk)
+	actorPk, err := crypto.PublicKeyFromLibP2P(pk)
+	require.NoError(t, err)
+	actor := "did:key:zQ3test"
+
+	decision := &acptypes.AccessDecision{
+		PolicyId: "abc123",
+		Creator:  "source1creator",
+		Operations: []*acptypes.Operation{{
+			Object:     &acptypes.Object{Resource: "secret", Id: "s1"},
+			Permission: "read",
+		}},
+		ActorDid:     actor,
+		Actor:        "source1actor",
+		Params:       &acptypes.DecisionParams{DecisionExpirationDelta: 100, ProofExpirationDelta: 50, TicketExpirationDelta: 100},
+		CreationTime: gogotypes.TimestampNow(),
+		IssuedHeight: 1,
+	}
+	decision.Id = decision.ProduceId()
+	value, err := decision.Marshal()
+	require.NoError(t, err)
+
+	ms := rootmulti.NewStore(dbm.NewMemDB(), cosmoslog.NewNopLogger(), metrics.NewNoOpMetrics())
+	key := storetypes.NewKVStoreKey(acptypes.ModuleName)
+	ms.MountStoreWithDB(key, storetypes.StoreTypeIAVL, nil)
+	require.NoError(t, ms.LoadLatestVersion())
+	ms.GetKVStore(key).Set([]byte(acptypes.AccessDecisionRepositoryKey+decision.Id), value)
+	commit := ms.Commit()
+
+	res, err := ms.Query(&storetypes.RequestQuery{
+		Path:   "/" + acptypes.ModuleName + "/key",
+		Data:   []byte(acptypes.AccessDecisionRepositoryKey + decision.Id),
+		Height: commit.Version,
+		Prove:  true,
+	})
+	require.NoError(t, err)
+	proof, err := (&abcitypes.ResponseQuery{
+		Value:    res.Value,
+		ProofOps: res.ProofOps,
+		Height:   res.Height,
+	}).Marshal()
+	require.NoError(t, err)
+
+	tc := &testTicket{
+		actor:    actor,
+		priv:     priv,
+		resolver: testResolver{actor: actorPk},
+		chain: &testChain{
+			height:    commit.Version + 1,
+			appHashes: map[int64][]byte{commit.Version + 1: commit.Hash},
+		},
+		tkt: &acptypes.AccessTicket{
+			VersionDenominator: acptypes.AccessTicketV1,
+			DecisionId:         decision.Id,
+			Decision:           decision,
+			DecisionProof:      proof,
+		},
+	}
+	tc.sign(t)
+
+	return tc
+}
+
+// sign signs the ticket like a cosmos secp256k1 key,
+// and (re)encodes it.
+func (tc *testTicket) sign(t *testing.T) {
+	digest := ticketDigest(tc.tkt)
+	hash := sha256.Sum256(digest)
+	sig, err := ecdsa.SignCompact(tc.priv, hash[:], true)
+	require.NoError(t, err)
+	tc.tkt.Signature = sig[1:] // drop the recovery byte, keeping R || S
+	tc.encode(t)
+}
+
+func (tc *testTicket) encode(t *testing.T) {
+	buf, err := tc.tkt.Marshal()
+	require.NoError(t, err)
+	tc.ticket = acptypes.AccessTicketV1 + ticketSeparator + base64.URLEncoding.EncodeToString(buf)
+}
+
+func testOp(id, perm string) *acptypes.Operation {
+	return &acptypes.Operation{Object: &acptypes.Object{Resource: "secret", Id: id}, Permission: perm}
+}
+
+func TestTicketVerify(t *testing.T) {
+	ctx := context.Background()
+	tc := newTestTicket(t)
+	v := NewTicketVerifier(tc.resolver, tc.chain)
+
+	require.NoError(t, v.Verify(ctx, tc.ticket, "abc123", testOp("s1", "read"), tc.actor))
+
+	err := v.Verify(ctx, tc.ticket, "other", testOp("s1", "read"), tc.actor)
+	require.ErrorIs(t, err, ErrTicketMismatch)
+	err = v.Verify(ctx, tc.ticket, "abc123", testOp("s1", "write"), tc.actor)
+	require.ErrorIs(t, err, ErrTicketMismatch)
+	err = v.Verify(ctx, tc.ticket, "abc123", testOp("s1", "read"), "did:key:other")
+	require.ErrorIs(t, err, ErrTicketMismatch)
+
+	err = v.Verify(ctx, "v2.abc", "abc123", testOp("s1", "read"), tc.actor)
+	require.ErrorIs(t, err, ErrTicketMalformed)
+
+	// expires with the shortest delta, the proof one
+	tc.chain.height = 52
+	err = v.Verify(ctx, tc.ticket, "abc123", testOp("s1", "read"), tc.actor)
+	require.ErrorIs(t, err, ErrTicketExpired)
+}
+
+func TestTicketVerifyTampered(t *testing.T) {
+	ctx := context.Background()
+
+	// decision changed without its id
+	tc := newTestTicket(t)
+	tc.tkt.Decision.PolicyId = "other"
+	tc.sign(t)
+	err := NewTicketVerifier(tc.resolver, tc.chain).Verify(ctx, tc.ticket, "other", testOp("s1", "read"), tc.actor)
+	require.ErrorIs(t, err, ErrTicketTampered)
+
+	// decision changed with its id, which isn't proven
+	tc = newTestTicket(t)
+	tc.tkt.Decision.PolicyId = "other"
+	tc.tkt.DecisionId = tc.tkt.Decision.ProduceId()
+	tc.tkt.Decision.Id = tc.tkt.DecisionId
+	tc.sign(t)
+	err = NewTicketVerifier(tc.resolver, tc.chain).Verify(ctx, tc.ticket, "other", testOp("s1", "read"), tc.actor)
+	require.ErrorIs(t, err, ErrTicketProof)
+
+	// decision operations aren't part of the id, but
+	// the proven decision is checked, not the ticket copy
+	tc = newTestTicket(t)
+	tc.tkt.Decision.Operations[0].Object.Id = "s2"
+	tc.sign(t)
+	err = NewTicketVerifier(tc.resolver, tc.chain).Verify(ctx, tc.ticket, "abc123", testOp("s2", "read"), tc.actor)
+	require.ErrorIs(t, err, ErrTicketMismatch)
+
+	// signature of another key
+	tc = newTestTicket(t)
+	tc.priv, _ = btcec.NewPrivateKey()
+	tc.sign(t)
+	err = NewTicketVerifier(tc.resolver, tc.chain).Verify(ctx, tc.ticket, "abc123", testOp("s1", "read"), tc.actor)
+	require.ErrorIs(t, err, ErrTicketSignature)
+
+	// proof against another app hash
+	tc = newTestTicket(t)
+	for h := range tc.chain.appHashes {
+		tc.chain.appHashes[h] = make([]byte, 32)
+	}
+	err = NewTicketVerifier(tc.resolver, tc.chain).Verify(ctx, tc.ticket, "abc123", testOp("s1", "read"), tc.actor)
+	require.ErrorIs(t, err, ErrTicketProof)
+}
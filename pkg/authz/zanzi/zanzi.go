@@ -5,9 +5,11 @@ import (
 	"fmt"
 	"regexp"
 	"strings"
+	"sync"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/sourcenetwork/orbis-go/pkg/authz"
 	"github.com/sourcenetwork/zanzi/pkg/api"
@@ -15,7 +17,8 @@ import (
 )
 
 var (
-	_ authz.Authz = (*zanziGRPC)(nil)
+	_ authz.Authz           = (*zanziGRPC)(nil)
+	_ authz.ObjectRegistrar = (*zanziGRPC)(nil)
 )
 
 var (
@@ -27,6 +30,10 @@ type zanziGRPC struct {
 	conn           *grpc.ClientConn
 	policyClient   api.PolicyServiceClient
 	relationClient api.RelationGraphClient
+
+	// registerMu serializes the object registrations of the node,
+	// as zanzi has no conditional relationship writes.
+	registerMu sync.Mutex
 }
 
 func NewGRPC(address string) (authz.Authz, error) {
@@ -77,6 +84,85 @@ func (z *zanziGRPC) Check(ctx context.Context, perm string, subject string) (boo
 	return resp.Result.Authorized, nil
 }
 
+// RegisterObject sets the relationship if the object has no subjects
+// related through the relation yet. Registrations are only serialized
+// within the node, so nodes sharing a zanzi server should not register
+// the same objects concurrently.
+func (z *zanziGRPC) RegisterObject(ctx context.Context, rel authz.Relationship) (bool, error) {
+	r, err := toRelationship(rel)
+	if err != nil {
+		return false, err
+	}
+
+	z.registerMu.Lock()
+	defer z.registerMu.Unlock()
+
+	resp, err := z.policyClient.FindRelationshipRecords(ctx, &api.FindRelationshipRecordsRequest{
+		PolicyId: rel.PolicyID,
+		Selector: &domain.RelationshipSelector{
+			ObjectSelector: &domain.ObjectSelector{
+				Selector: &domain.ObjectSelector_ObjectSpec{ObjectSpec: r.Object},
+			},
+			RelationSelector: &domain.RelationSelector{
+				Selector: &domain.RelationSelector_RelationName{RelationName: rel.Relation},
+			},
+			SubjectSelector: &domain.SubjectSelector{
+				Selector: &domain.SubjectSelector_Wildcard{Wildcard: &domain.WildcardSelector{}},
+			},
+		},
+	})
+	if err != nil {
+		return false, fmt.Errorf("find relationships: %w", err)
+	}
+
+	records := resp.GetResult().GetRecords()
+	switch {
+	case len(records) == 1 && proto.Equal(records[0].GetRelationship().GetSubject(), r.Subject):
+		return false, nil
+	case len(records) > 0:
+		return false, fmt.Errorf("%w: %s/%s:%s", authz.ErrObjectOwned, rel.PolicyID, rel.Resource, rel.ResourceID)
+	}
+
+	_, err = z.policyClient.SetRelationship(ctx, &api.SetRelationshipRequest{
+		PolicyId:     rel.PolicyID,
+		Relationship: r,
+	})
+	if err != nil {
+		return false, fmt.Errorf("set relationship: %w", err)
+	}
+	return true, nil
+}
+
+// UnregisterObject deletes a relationship set by RegisterObject.
+func (z *zanziGRPC) UnregisterObject(ctx context.Context, rel authz.Relationship) error {
+	r, err := toRelationship(rel)
+	if err != nil {
+		return err
+	}
+
+	_, err = z.policyClient.DeleteRelationship(ctx, &api.DeleteRelationshipRequest{
+		PolicyId:     rel.PolicyID,
+		Relationship: r,
+	})
+	if err != nil {
+		return fmt.Errorf("delete relationship: %w", err)
+	}
+	return nil
+}
+
+// toRelationship converts a relationship with an entity subject,
+// formatted as "type:id".
+func toRelationship(rel authz.Relationship) (*domain.Relationship, error) {
+	subj, id, ok := strings.Cut(rel.Subject, ":")
+	if !ok || strings.Contains(id, "#") {
+		return nil, fmt.Errorf("subject validation: %s", rel.Subject)
+	}
+
+	var b domain.RelationshipBuilder
+	r := b.Relationship(rel.Resource, rel.ResourceID, rel.Relation, subj, id)
+	return &r, nil
+}
+
 // permission is formatted as:
 // PolicyID/ObjGroup:ObjID#relation
 // we need to parse out:
@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"golang.org/x/exp/rand"
 
+	"github.com/sourcenetwork/orbis-go/pkg/authz"
 	"github.com/sourcenetwork/zanzi"
 	"github.com/sourcenetwork/zanzi/pkg/api"
 	"github.com/sourcenetwork/zanzi/pkg/domain"
@@ -52,6 +53,43 @@ func TestZanzi(t *testing.T) {
 	require.True(t, check)
 }
 
+func TestZanziRegisterObject(t *testing.T) {
+	port := rand.Int63n(55555) + 9999
+	address := fmt.Sprintf("127.0.0.1:%d", port)
+	require.NoError(t, setupGRPC(address))
+
+	z, err := newGRPC(address)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	require.NoError(t, setup(ctx, z))
+
+	owner := func(subject string) authz.Relationship {
+		return authz.Relationship{PolicyID: "10", Resource: "file", ResourceID: "notes", Relation: "owner", Subject: subject}
+	}
+
+	created, err := z.RegisterObject(ctx, owner("user:alice"))
+	require.NoError(t, err)
+	require.True(t, created)
+
+	created, err = z.RegisterObject(ctx, owner("user:alice"))
+	require.NoError(t, err)
+	require.False(t, created)
+
+	_, err = z.RegisterObject(ctx, owner("user:bob"))
+	require.ErrorIs(t, err, authz.ErrObjectOwned)
+
+	ok, err := z.Check(ctx, "10/file:notes#read", "user:alice")
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	// rolled back registrations free the object
+	require.NoError(t, z.UnregisterObject(ctx, owner("user:alice")))
+	created, err = z.RegisterObject(ctx, owner("user:bob"))
+	require.NoError(t, err)
+	require.True(t, created)
+}
+
 func setup(ctx context.Context, z *zanziGRPC) error {
 
 	// Create Policy
@@ -0,0 +1,237 @@
+// Package backup exports the ring shares and the identity of a
+// node into encrypted backups, and restores them on a new node
+// after a disaster.
+//
+// A backup is sealed with AES-GCM, under a key derived from a
+// password with Argon2id. It can also be split across several
+// custodians, with a Shamir sharing of the backup key, each
+// custodian sealing their share under their own password.
+package backup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	logging "github.com/ipfs/go-log"
+	libp2pcrypto "github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"google.golang.org/protobuf/proto"
+
+	ringv1alpha1 "github.com/sourcenetwork/orbis-go/gen/proto/orbis/ring/v1alpha1"
+	"github.com/sourcenetwork/orbis-go/pkg/crypto"
+	"github.com/sourcenetwork/orbis-go/pkg/db"
+	"github.com/sourcenetwork/orbis-go/pkg/dkg"
+	"github.com/sourcenetwork/orbis-go/pkg/types"
+)
+
+var log = logging.Logger("orbis/backup")
+
+// Version of the backup format.
+const Version = 1
+
+var (
+	ErrUnsupportedVersion = errors.New("unsupported backup version")
+	ErrRingExists         = errors.New("ring already exists")
+	ErrNotRingNode        = errors.New("identity isn't a node of the ring")
+	ErrRingKeyMismatch    = errors.New("ring public key doesn't match the pinned key")
+)
+
+// Backup is the content of a node backup.
+type Backup struct {
+	Version  int       `json:"version"`
+	Created  time.Time `json:"created"`
+	Node     string    `json:"node"`     // peer ID of the identity
+	Identity []byte    `json:"identity"` // libp2p marshalled host private key
+	Rings    []Ring    `json:"rings"`
+}
+
+// Ring is the state a node holds for one of its rings.
+type Ring struct {
+	ID    string `json:"id"`
+	Ring  []byte `json:"ring"` // marshalled ring record
+	DKG   string `json:"dkg"`
+	State []byte `json:"state"` // exported by the DKG archiver
+}
+
+// ringRepoKey mounts the ring repo of the app.
+var ringRepoKey = db.NewRepoKey("ring")
+
+func ringPkFunc(kb db.KeyBuilder, r *ringv1alpha1.Ring) []byte {
+	return kb.AddStringField(r.Id).Bytes()
+}
+
+// Export backs up the identity of the node, and the DKG state of
+// each of its rings. Rings that haven't certified a share yet are
+// left out. The node must be stopped, since it holds the DB.
+func Export(ctx context.Context, d *db.DB, identity libp2pcrypto.PrivKey, archivers ...dkg.Archiver) (*Backup, error) {
+	pid, err := peer.IDFromPrivateKey(identity)
+	if err != nil {
+		return nil, fmt.Errorf("peer id from identity: %w", err)
+	}
+	ibuf, err := libp2pcrypto.MarshalPrivateKey(identity)
+	if err != nil {
+		return nil, fmt.Errorf("marshal identity: %w", err)
+	}
+
+	ringRepo, err := db.GetRepo(d, ringRepoKey, ringPkFunc)
+	if err != nil {
+		return nil, fmt.Errorf("get ring repo: %w", err)
+	}
+	rings, err := ringRepo.GetAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get rings: %w", err)
+	}
+
+	b := &Backup{
+		Version:  Version,
+		Created:  time.Now().UTC(),
+		Node:     pid.String(),
+		Identity: ibuf,
+	}
+	for _, r := range rings {
+		archiver, err := findArchiver(archivers, r.Manifest.GetDkg())
+		if err != nil {
+			return nil, fmt.Errorf("ring %s: %w", r.Id, err)
+		}
+
+		state, err := archiver.Export(ctx, d, types.RingID(r.Id))
+		if errors.Is(err, dkg.ErrNoShare) {
+			log.Warnf("Skipping ring %s, its DKG hasn't certified a share", r.Id)
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("export ring %s dkg: %w", r.Id, err)
+		}
+
+		rbuf, err := proto.Marshal(r)
+		if err != nil {
+			return nil, fmt.Errorf("marshal ring %s: %w", r.Id, err)
+		}
+
+		b.Rings = append(b.Rings, Ring{
+			ID:    r.Id,
+			Ring:  rbuf,
+			DKG:   archiver.Name(),
+			State: state,
+		})
+	}
+
+	return b, nil
+}
+
+// PrivateKey returns the backed up identity of the node.
+func (b *Backup) PrivateKey() (libp2pcrypto.PrivKey, error) {
+	priv, err := libp2pcrypto.UnmarshalPrivateKey(b.Identity)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal identity: %w", err)
+	}
+	pid, err := peer.IDFromPrivateKey(priv)
+	if err != nil {
+		return nil, fmt.Errorf("peer id from identity: %w", err)
+	}
+	if pid.String() != b.Node {
+		return nil, fmt.Errorf("identity of %s, expected %s", pid, b.Node)
+	}
+	return priv, nil
+}
+
+// Restore writes the backed up rings into the DB of a stopped
+// node, which rejoins them on its next start. The DKG archivers
+// verify each share against the public polynomial of its ring
+// before accepting it, and the ring public key is checked against
+// ringKeys, the keys pinned by the operator, for the rings it has.
+// Rings the node already has are refused. Nothing is written
+// unless every ring is restored.
+func Restore(ctx context.Context, d *db.DB, b *Backup, ringKeys map[types.RingID]crypto.PublicKey, archivers ...dkg.Archiver) error {
+	if b.Version != Version {
+		return fmt.Errorf("%w: %d", ErrUnsupportedVersion, b.Version)
+	}
+	if _, err := b.PrivateKey(); err != nil {
+		return err
+	}
+
+	ringRepo, err := db.GetRepo(d, ringRepoKey, ringPkFunc)
+	if err != nil {
+		return fmt.Errorf("get ring repo: %w", err)
+	}
+
+	batch := d.Batch()
+	defer batch.Close()
+
+	for _, br := range b.Rings {
+		r := &ringv1alpha1.Ring{}
+		err := proto.Unmarshal(br.Ring, r)
+		if err != nil {
+			return fmt.Errorf("unmarshal ring %s: %w", br.ID, err)
+		}
+		err = checkRing(b.Node, br, r)
+		if err != nil {
+			return fmt.Errorf("ring %s: %w", br.ID, err)
+		}
+		if ringRepo.Exists(ctx, r, batch) {
+			return fmt.Errorf("%w: %s", ErrRingExists, br.ID)
+		}
+
+		archiver, err := findArchiver(archivers, br.DKG)
+		if err != nil {
+			return fmt.Errorf("ring %s: %w", br.ID, err)
+		}
+		pk, err := archiver.Import(ctx, d, types.RingID(br.ID), br.State, batch)
+		if err != nil {
+			return fmt.Errorf("import ring %s dkg: %w", br.ID, err)
+		}
+		if pinned, ok := ringKeys[types.RingID(br.ID)]; ok {
+			if !pinned.Equals(pk) {
+				return fmt.Errorf("%w: %s", ErrRingKeyMismatch, br.ID)
+			}
+		} else {
+			log.Warnf("The public key of ring %s isn't pinned, it's only checked against the backed up polynomial", br.ID)
+		}
+
+		err = ringRepo.Create(ctx, r, batch)
+		if err != nil {
+			return fmt.Errorf("create ring %s: %w", br.ID, err)
+		}
+	}
+
+	err = batch.Commit(db.Sync)
+	if err != nil {
+		return fmt.Errorf("commit restored rings: %w", err)
+	}
+	for _, br := range b.Rings {
+		log.Infof("Restored ring %s", br.ID)
+	}
+
+	return nil
+}
+
+// checkRing checks the ring record matches its manifest, and the
+// backed up node is one of the ring nodes.
+func checkRing(node string, br Ring, r *ringv1alpha1.Ring) error {
+	if r.Manifest == nil {
+		return fmt.Errorf("missing manifest")
+	}
+	if rid := types.RingIDFromManifest(r.Manifest); r.Id != br.ID || string(rid) != br.ID {
+		return fmt.Errorf("ring id doesn't match the manifest %s", rid)
+	}
+	if r.Manifest.Dkg != br.DKG {
+		return fmt.Errorf("dkg %s doesn't match the manifest %s", br.DKG, r.Manifest.Dkg)
+	}
+	for _, n := range r.Manifest.Nodes {
+		if n.Id == node {
+			return nil
+		}
+	}
+	return ErrNotRingNode
+}
+
+func findArchiver(archivers []dkg.Archiver, name string) (dkg.Archiver, error) {
+	for _, a := range archivers {
+		if a.Name() == name {
+			return a, nil
+		}
+	}
+	return nil, fmt.Errorf("no backup support for dkg %q", name)
+}
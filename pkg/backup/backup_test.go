@@ -0,0 +1,252 @@
+package backup
+
+import (
+	"context"
+	"crypto/rand"
+	"testing"
+
+	libp2pcrypto "github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/require"
+	"go.dedis.ch/kyber/v3/suites"
+
+	ringv1alpha1 "github.com/sourcenetwork/orbis-go/gen/proto/orbis/ring/v1alpha1"
+	"github.com/sourcenetwork/orbis-go/pkg/crypto"
+	"github.com/sourcenetwork/orbis-go/pkg/db"
+	"github.com/sourcenetwork/orbis-go/pkg/dkg"
+	"github.com/sourcenetwork/orbis-go/pkg/types"
+)
+
+// testArchiver keeps the DKG states in memory, and refuses
+// to import the states it's told are invalid. Every ring has
+// the same public key.
+type testArchiver struct {
+	states  map[types.RingID][]byte
+	invalid map[string]bool
+	key     crypto.PublicKey
+}
+
+func (a *testArchiver) Name() string { return "test" }
+
+func (a *testArchiver) Export(_ context.Context, _ *db.DB, rid types.RingID) ([]byte, error) {
+	state, ok := a.states[rid]
+	if !ok {
+		return nil, dkg.ErrNoShare
+	}
+	return state, nil
+}
+
+func (a *testArchiver) Import(_ context.Context, _ *db.DB, rid types.RingID, state []byte, _ db.Batch) (crypto.PublicKey, error) {
+	if a.invalid[string(state)] {
+		return nil, dkg.ErrInvalidShare
+	}
+	a.states[rid] = state
+	return a.key, nil
+}
+
+func newTestRingKey(t *testing.T) crypto.PublicKey {
+	_, pk, err := crypto.GenerateKeyPair(suites.MustFind("ed25519"), rand.Reader)
+	require.NoError(t, err)
+	return pk
+}
+
+func newTestIdentity(t *testing.T) (libp2pcrypto.PrivKey, string) {
+	priv, _, err := libp2pcrypto.GenerateEd25519Key(rand.Reader)
+	require.NoError(t, err)
+	pid, err := peer.IDFromPrivateKey(priv)
+	require.NoError(t, err)
+	return priv, pid.String()
+}
+
+func newTestRing(t *testing.T, d *db.DB, nodes ...string) *ringv1alpha1.Ring {
+	manifest := &ringv1alpha1.Manifest{N: 3, T: 2, Dkg: "test"}
+	for _, n := range nodes {
+		manifest.Nodes = append(manifest.Nodes, &ringv1alpha1.Node{Id: n})
+	}
+	r := &ringv1alpha1.Ring{
+		Id:       string(types.RingIDFromManifest(manifest)),
+		Manifest: manifest,
+	}
+
+	repo, err := db.GetRepo(d, ringRepoKey, ringPkFunc)
+	require.NoError(t, err)
+	require.NoError(t, repo.Create(context.Background(), r))
+	return r
+}
+
+func newTestDB(t *testing.T) *db.DB {
+	d, err := db.New(t.TempDir())
+	require.NoError(t, err)
+	return d
+}
+
+func TestExportRestore(t *testing.T) {
+	ctx := context.Background()
+	priv, node := newTestIdentity(t)
+
+	src := newTestDB(t)
+	certified := newTestRing(t, src, node, "b", "c")
+	newTestRing(t, src, node, "d", "e") // no share yet
+
+	archiver := &testArchiver{states: map[types.RingID][]byte{
+		types.RingID(certified.Id): []byte("state"),
+	}}
+	b, err := Export(ctx, src, priv, archiver)
+	require.NoError(t, err)
+	require.Equal(t, node, b.Node)
+	require.Len(t, b.Rings, 1)
+	require.Equal(t, certified.Id, b.Rings[0].ID)
+
+	restored, err := b.PrivateKey()
+	require.NoError(t, err)
+	require.True(t, priv.Equals(restored))
+
+	dst := newTestDB(t)
+	key := newTestRingKey(t)
+	imported := &testArchiver{states: map[types.RingID][]byte{}, key: key}
+	pinned := map[types.RingID]crypto.PublicKey{types.RingID(certified.Id): key}
+	require.NoError(t, Restore(ctx, dst, b, pinned, imported))
+	require.Equal(t, []byte("state"), imported.states[types.RingID(certified.Id)])
+
+	repo, err := db.GetRepo(dst, ringRepoKey, ringPkFunc)
+	require.NoError(t, err)
+	rings, err := repo.GetAll(ctx)
+	require.NoError(t, err)
+	require.Len(t, rings, 1)
+	require.Equal(t, certified.Manifest.Nodes[0].Id, rings[0].Manifest.Nodes[0].Id)
+
+	// restoring twice would overwrite the ring
+	err = Restore(ctx, dst, b, nil, imported)
+	require.ErrorIs(t, err, ErrRingExists)
+}
+
+func TestRestoreRejects(t *testing.T) {
+	ctx := context.Background()
+	priv, node := newTestIdentity(t)
+
+	src := newTestDB(t)
+	r := newTestRing(t, src, node, "b", "c")
+	archiver := &testArchiver{states: map[types.RingID][]byte{
+		types.RingID(r.Id): []byte("state"),
+	}}
+	b, err := Export(ctx, src, priv, archiver)
+	require.NoError(t, err)
+
+	// the share doesn't match the ring public polynomial
+	invalid := &testArchiver{
+		states:  map[types.RingID][]byte{},
+		invalid: map[string]bool{"state": true},
+	}
+	err = Restore(ctx, newTestDB(t), b, nil, invalid)
+	require.ErrorIs(t, err, dkg.ErrInvalidShare)
+
+	// the ring public key isn't the pinned one
+	archiver.key = newTestRingKey(t)
+	pinned := map[types.RingID]crypto.PublicKey{types.RingID(r.Id): newTestRingKey(t)}
+	err = Restore(ctx, newTestDB(t), b, pinned, archiver)
+	require.ErrorIs(t, err, ErrRingKeyMismatch)
+
+	// the identity isn't one of the ring nodes
+	other, otherNode := newTestIdentity(t)
+	b.Identity, err = libp2pcrypto.MarshalPrivateKey(other)
+	require.NoError(t, err)
+	b.Node = otherNode
+	err = Restore(ctx, newTestDB(t), b, nil, archiver)
+	require.ErrorIs(t, err, ErrNotRingNode)
+
+	// the identity doesn't match the backed up node
+	b.Node = node
+	err = Restore(ctx, newTestDB(t), b, nil, archiver)
+	require.Error(t, err)
+}
+
+func TestRestoreIsAtomic(t *testing.T) {
+	ctx := context.Background()
+	priv, node := newTestIdentity(t)
+
+	src := newTestDB(t)
+	r1 := newTestRing(t, src, node, "b", "c")
+	r2 := newTestRing(t, src, node, "d", "e")
+	archiver := &testArchiver{states: map[types.RingID][]byte{
+		types.RingID(r1.Id): []byte(r1.Id),
+		types.RingID(r2.Id): []byte(r2.Id),
+	}}
+	b, err := Export(ctx, src, priv, archiver)
+	require.NoError(t, err)
+	require.Len(t, b.Rings, 2)
+
+	// the second ring fails, after the first one was restored
+	dst := newTestDB(t)
+	invalid := &testArchiver{
+		states:  map[types.RingID][]byte{},
+		invalid: map[string]bool{string(b.Rings[1].State): true},
+	}
+	err = Restore(ctx, dst, b, nil, invalid)
+	require.ErrorIs(t, err, dkg.ErrInvalidShare)
+	require.Contains(t, invalid.states, types.RingID(b.Rings[0].ID))
+
+	repo, err := db.GetRepo(dst, ringRepoKey, ringPkFunc)
+	require.NoError(t, err)
+	rings, err := repo.GetAll(ctx)
+	require.NoError(t, err)
+	require.Empty(t, rings)
+}
+
+func TestSealOpen(t *testing.T) {
+	b := &Backup{Version: Version, Node: "node", Identity: []byte("identity")}
+
+	f, err := Seal(b, []byte("password"))
+	require.NoError(t, err)
+	require.Nil(t, f.Custodian)
+	require.NotContains(t, string(f.Ciphertext), "identity")
+
+	opened, err := Open([]*File{f}, [][]byte{[]byte("password")})
+	require.NoError(t, err)
+	require.Equal(t, b.Identity, opened.Identity)
+
+	_, err = Open([]*File{f}, [][]byte{[]byte("wrong")})
+	require.ErrorIs(t, err, ErrDecrypt)
+
+	// the header is bound to the ciphertext
+	f.Node = "other"
+	_, err = Open([]*File{f}, [][]byte{[]byte("password")})
+	require.ErrorIs(t, err, ErrDecrypt)
+}
+
+func TestSealShared(t *testing.T) {
+	b := &Backup{Version: Version, Node: "node", Identity: []byte("identity")}
+	passwords := [][]byte{[]byte("alice"), []byte("bob"), []byte("carol")}
+
+	files, err := SealShared(b, passwords, 2)
+	require.NoError(t, err)
+	require.Len(t, files, 3)
+
+	// any two custodians open the backup
+	for _, pair := range [][2]int{{0, 1}, {0, 2}, {2, 1}} {
+		opened, err := Open(
+			[]*File{files[pair[0]], files[pair[1]]},
+			[][]byte{passwords[pair[0]], passwords[pair[1]]},
+		)
+		require.NoError(t, err)
+		require.Equal(t, b.Identity, opened.Identity)
+	}
+
+	_, err = Open(files[:1], passwords[:1])
+	require.ErrorIs(t, err, ErrNotEnoughShares)
+
+	// a share counts once
+	_, err = Open([]*File{files[0], files[0]}, [][]byte{passwords[0], passwords[0]})
+	require.ErrorIs(t, err, ErrNotEnoughShares)
+
+	_, err = Open(files[:2], [][]byte{passwords[0], passwords[2]})
+	require.ErrorIs(t, err, ErrDecrypt)
+
+	// custodian files of different backups don't mix
+	others, err := SealShared(b, passwords, 2)
+	require.NoError(t, err)
+	_, err = Open([]*File{files[0], others[1]}, passwords[:2])
+	require.ErrorIs(t, err, ErrMismatchedFiles)
+
+	_, err = SealShared(b, passwords, 4)
+	require.Error(t, err)
+}
@@ -0,0 +1,298 @@
+package backup
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	"go.dedis.ch/kyber/v3"
+	"go.dedis.ch/kyber/v3/group/edwards25519"
+	"go.dedis.ch/kyber/v3/share"
+
+	"github.com/sourcenetwork/orbis-go/pkg/crypto"
+)
+
+var (
+	ErrDecrypt            = errors.New("wrong password or corrupted backup")
+	ErrNotEnoughShares    = errors.New("not enough custodian shares")
+	ErrMismatchedFiles    = errors.New("backup files aren't from the same backup")
+	ErrMismatchedPassword = errors.New("one password is needed per backup file")
+)
+
+// File is an encrypted backup, or the part of a backup held
+// by one of its custodians.
+type File struct {
+	Version    int                `json:"version"`
+	Node       string             `json:"node"`
+	KDF        crypto.PasswordKDF `json:"kdf"`
+	Ciphertext []byte             `json:"ciphertext"`
+	Custodian  *Custodian         `json:"custodian,omitempty"`
+}
+
+// Custodian is the share of the backup key held by a custodian,
+// sealed under the key derived from their password.
+type Custodian struct {
+	Index     int    `json:"index"`
+	Threshold int    `json:"threshold"`
+	Total     int    `json:"total"`
+	Share     []byte `json:"share"`
+}
+
+// keySuite is the field the backup key is shared over.
+var keySuite = edwards25519.NewBlakeSHA256Ed25519()
+
+// Seal encrypts a backup under a password.
+func Seal(b *Backup, password []byte) (*File, error) {
+	kdf, key, err := deriveKey(password)
+	if err != nil {
+		return nil, err
+	}
+
+	f := &File{
+		Version: b.Version,
+		Node:    b.Node,
+		KDF:     kdf,
+	}
+	err = f.sealPayload(b, key)
+	if err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// SealShared encrypts a backup under a random key, and splits the
+// key across one custodian per password, any threshold of which
+// can open the backup.
+func SealShared(b *Backup, passwords [][]byte, threshold int) ([]*File, error) {
+	total := len(passwords)
+	if threshold < 1 || threshold > total {
+		return nil, fmt.Errorf("invalid threshold %d for %d custodians", threshold, total)
+	}
+
+	secret := keySuite.Scalar().Pick(keySuite.RandomStream())
+	key, err := scalarKey(secret)
+	if err != nil {
+		return nil, err
+	}
+
+	payload := &File{Version: b.Version, Node: b.Node}
+	err = payload.sealPayload(b, key)
+	if err != nil {
+		return nil, err
+	}
+
+	poly := share.NewPriPoly(keySuite, threshold, secret, keySuite.RandomStream())
+	files := make([]*File, total)
+	for i, s := range poly.Shares(total) {
+		kdf, ckey, err := deriveKey(passwords[i])
+		if err != nil {
+			return nil, err
+		}
+		sbuf, err := s.V.MarshalBinary()
+		if err != nil {
+			return nil, fmt.Errorf("marshal key share: %w", err)
+		}
+
+		f := &File{
+			Version:    payload.Version,
+			Node:       payload.Node,
+			KDF:        kdf,
+			Ciphertext: payload.Ciphertext,
+			Custodian: &Custodian{
+				Index:     s.I,
+				Threshold: threshold,
+				Total:     total,
+			},
+		}
+		f.Custodian.Share, err = crypto.Seal(crypto.AES256GCM, ckey, sbuf, f.custodianData())
+		if err != nil {
+			return nil, fmt.Errorf("seal key share: %w", err)
+		}
+		files[i] = f
+	}
+
+	return files, nil
+}
+
+// Open decrypts a backup, from a single sealed file, or from a
+// threshold of custodian files. Each file is opened with the
+// password at the same position.
+func Open(files []*File, passwords [][]byte) (*Backup, error) {
+	if len(files) == 0 {
+		return nil, fmt.Errorf("no backup files")
+	}
+	if len(files) != len(passwords) {
+		return nil, ErrMismatchedPassword
+	}
+
+	first := files[0]
+	if first.Version != Version {
+		return nil, fmt.Errorf("%w: %d", ErrUnsupportedVersion, first.Version)
+	}
+
+	if first.Custodian == nil {
+		if len(files) != 1 {
+			return nil, ErrMismatchedFiles
+		}
+		key, err := first.KDF.Key(passwords[0])
+		if err != nil {
+			return nil, err
+		}
+		return first.openPayload(key)
+	}
+
+	threshold, total := first.Custodian.Threshold, first.Custodian.Total
+	shares := make([]*share.PriShare, 0, len(files))
+	seen := make(map[int]bool)
+	for i, f := range files {
+		if f.Custodian == nil || f.Version != first.Version || f.Node != first.Node ||
+			f.Custodian.Threshold != threshold || f.Custodian.Total != total ||
+			!bytes.Equal(f.Ciphertext, first.Ciphertext) {
+			return nil, ErrMismatchedFiles
+		}
+		if seen[f.Custodian.Index] {
+			continue
+		}
+
+		key, err := f.KDF.Key(passwords[i])
+		if err != nil {
+			return nil, err
+		}
+		sbuf, err := open(key, f.Custodian.Share, f.custodianData())
+		if err != nil {
+			return nil, fmt.Errorf("custodian %d: %w", f.Custodian.Index, err)
+		}
+		v := keySuite.Scalar()
+		err = v.UnmarshalBinary(sbuf)
+		if err != nil {
+			return nil, fmt.Errorf("unmarshal custodian %d share: %w", f.Custodian.Index, err)
+		}
+
+		seen[f.Custodian.Index] = true
+		shares = append(shares, &share.PriShare{I: f.Custodian.Index, V: v})
+	}
+	if len(shares) < threshold {
+		return nil, fmt.Errorf("%w: %d of %d", ErrNotEnoughShares, len(shares), threshold)
+	}
+
+	secret, err := share.RecoverSecret(keySuite, shares, threshold, total)
+	if err != nil {
+		return nil, fmt.Errorf("recover backup key: %w", err)
+	}
+	key, err := scalarKey(secret)
+	if err != nil {
+		return nil, err
+	}
+	return first.openPayload(key)
+}
+
+// ReadFile reads a backup file written by WriteFile.
+func ReadFile(path string) (*File, error) {
+	buf, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read backup file: %w", err)
+	}
+	f := &File{}
+	err = json.Unmarshal(buf, f)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal backup file %s: %w", path, err)
+	}
+	return f, nil
+}
+
+// WriteFile writes a backup file, readable only by the current
+// user. It never overwrites an existing file.
+func WriteFile(path string, f *File) error {
+	buf, err := json.MarshalIndent(f, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshal backup file: %w", err)
+	}
+
+	fd, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+	if err != nil {
+		return fmt.Errorf("create backup file: %w", err)
+	}
+	_, err = fd.Write(buf)
+	if cerr := fd.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return fmt.Errorf("write backup file: %w", err)
+	}
+	return nil
+}
+
+func (f *File) sealPayload(b *Backup, key []byte) error {
+	buf, err := json.Marshal(b)
+	if err != nil {
+		return fmt.Errorf("marshal backup: %w", err)
+	}
+	f.Ciphertext, err = crypto.Seal(crypto.AES256GCM, key, buf, f.payloadData())
+	if err != nil {
+		return fmt.Errorf("seal backup: %w", err)
+	}
+	return nil
+}
+
+func (f *File) openPayload(key []byte) (*Backup, error) {
+	buf, err := open(key, f.Ciphertext, f.payloadData())
+	if err != nil {
+		return nil, err
+	}
+	b := &Backup{}
+	err = json.Unmarshal(buf, b)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal backup: %w", err)
+	}
+	if b.Node != f.Node || b.Version != f.Version {
+		return nil, ErrMismatchedFiles
+	}
+	return b, nil
+}
+
+// payloadData binds the backup ciphertext to its header.
+func (f *File) payloadData() []byte {
+	return []byte(fmt.Sprintf("orbis/backup/v%d/%s", f.Version, f.Node))
+}
+
+// custodianData binds a sealed key share to the ciphertext
+// of the backup, and the custodian index.
+func (f *File) custodianData() []byte {
+	h := sha256.New()
+	h.Write(f.payloadData())
+	h.Write(f.Ciphertext)
+	binary.Write(h, binary.BigEndian, int64(f.Custodian.Index)) // nolint:errcheck
+	return h.Sum(nil)
+}
+
+func deriveKey(password []byte) (crypto.PasswordKDF, []byte, error) {
+	kdf, err := crypto.NewPasswordKDF()
+	if err != nil {
+		return crypto.PasswordKDF{}, nil, err
+	}
+	key, err := kdf.Key(password)
+	return kdf, key, err
+}
+
+func scalarKey(s kyber.Scalar) ([]byte, error) {
+	buf, err := s.MarshalBinary()
+	if err != nil {
+		return nil, fmt.Errorf("marshal backup key: %w", err)
+	}
+	key := sha256.Sum256(buf)
+	return key[:], nil
+}
+
+// open reports any failure to authenticate as ErrDecrypt,
+// since a wrong password is the likely cause.
+func open(key, ciphertext, data []byte) ([]byte, error) {
+	plaintext, err := crypto.Open(crypto.AES256GCM, key, ciphertext, data)
+	if err != nil {
+		return nil, ErrDecrypt
+	}
+	return plaintext, nil
+}
@@ -0,0 +1,235 @@
+// Package client is a Go SDK for talking to orbis nodes.
+//
+// It manages the gRPC connection and the self signed DID/JWS
+// authentication, and performs the secret encryption and decryption
+// locally, so plaintexts and reader keys never leave the client.
+package client
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/tls"
+	"fmt"
+	"sync"
+	"time"
+
+	logging "github.com/ipfs/go-log"
+	"go.dedis.ch/kyber/v3/suites"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+
+	ringv1alpha1 "github.com/sourcenetwork/orbis-go/gen/proto/orbis/ring/v1alpha1"
+	transportv1alpha1 "github.com/sourcenetwork/orbis-go/gen/proto/orbis/transport/v1alpha1"
+	utilityv1alpha1 "github.com/sourcenetwork/orbis-go/gen/proto/orbis/utility/v1alpha1"
+	"github.com/sourcenetwork/orbis-go/pkg/authn/jws"
+	"github.com/sourcenetwork/orbis-go/pkg/crypto"
+	"github.com/sourcenetwork/orbis-go/pkg/did"
+	"github.com/sourcenetwork/orbis-go/pkg/types"
+)
+
+var log = logging.Logger("orbis/client")
+
+var (
+	ErrRingIDMismatch     = fmt.Errorf("ring manifest doesn't match ring id")
+	ErrRingKeyMismatch    = fmt.Errorf("ring public key doesn't match")
+	ErrRingKeyNotPinned   = fmt.Errorf("ring public key isn't pinned, pin it with WithRingKey")
+	ErrUnsupportedKeyType = fmt.Errorf("unsupported key type")
+)
+
+const defaultTokenTTL = 5 * time.Minute
+
+// Client is a connection to an orbis node.
+type Client struct {
+	conn *grpc.ClientConn
+
+	Ring      ringv1alpha1.RingServiceClient
+	Utility   utilityv1alpha1.UtilityServiceClient
+	Transport transportv1alpha1.TransportServiceClient
+
+	identity crypto.PrivateKey
+	did      string
+	kid      string
+
+	tokenTTL time.Duration
+	dem      crypto.DEM
+	insecure bool
+
+	mu       sync.Mutex
+	ringKeys map[types.RingID]crypto.PublicKey
+}
+
+// New dials the orbis node at target.
+//
+// Unless a different identity is given with WithIdentity, a random
+// ed25519 identity is generated for the client. The node is dialed
+// with TLS, verified by the system roots unless WithTLS is given,
+// since the authentication tokens are bearer tokens. WithInsecure
+// dials without TLS.
+func New(ctx context.Context, target string, opts ...Option) (*Client, error) {
+	c := &Client{
+		tokenTTL: defaultTokenTTL,
+		dem:      crypto.AES256GCM,
+		ringKeys: make(map[types.RingID]crypto.PublicKey),
+	}
+
+	o := options{}
+	for _, opt := range opts {
+		opt(c, &o)
+	}
+
+	if c.identity == nil {
+		sk, _, err := crypto.GenerateKeyPair(suites.MustFind("ed25519"), rand.Reader)
+		if err != nil {
+			return nil, fmt.Errorf("generate identity: %w", err)
+		}
+		c.identity = sk
+	}
+
+	var err error
+	c.did, c.kid, err = did.KeyDID(c.identity.GetPublic())
+	if err != nil {
+		return nil, fmt.Errorf("identity did: %w", err)
+	}
+
+	transportCreds := credentials.NewTLS(&tls.Config{MinVersion: tls.VersionTLS12})
+	if c.insecure {
+		transportCreds = insecure.NewCredentials()
+	}
+	dialOpts := []grpc.DialOption{
+		grpc.WithTransportCredentials(transportCreds),
+		grpc.WithPerRPCCredentials(c.credentials(c.identity, c.kid, c.did)),
+	}
+	dialOpts = append(dialOpts, o.dialOpts...)
+
+	c.conn, err = grpc.DialContext(ctx, target, dialOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("dial %s: %w", target, err)
+	}
+
+	c.Ring = ringv1alpha1.NewRingServiceClient(c.conn)
+	c.Utility = utilityv1alpha1.NewUtilityServiceClient(c.conn)
+	c.Transport = transportv1alpha1.NewTransportServiceClient(c.conn)
+
+	return c, nil
+}
+
+// Close closes the underlying connection.
+func (c *Client) Close() error {
+	return c.conn.Close()
+}
+
+// DID returns the `did:key` of the client identity, which is the
+// subject used in authorization policies.
+func (c *Client) DID() string {
+	return c.did
+}
+
+// RingPublicKey returns the verified public key of the ring.
+//
+// The node can't prove the ring key on its own, so it must be pinned
+// with WithRingKey, from a source trusted by the caller, such as the
+// ring operators. The ring manifest is checked against the ring id,
+// so the node can't misrepresent the ring members, and the key the
+// node returns must match the pinned key.
+func (c *Client) RingPublicKey(ctx context.Context, ringID string) (crypto.PublicKey, error) {
+	c.mu.Lock()
+	pinned, ok := c.ringKeys[types.RingID(ringID)]
+	c.mu.Unlock()
+	if !ok {
+		return nil, fmt.Errorf("%w: ring %s", ErrRingKeyNotPinned, ringID)
+	}
+
+	pk, err := c.FetchRingPublicKey(ctx, ringID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !pinned.Equals(pk) {
+		return nil, ErrRingKeyMismatch
+	}
+
+	return pk, nil
+}
+
+// FetchRingPublicKey returns the ring public key reported by the node,
+// after checking the ring manifest against the ring id. The key isn't
+// verified, compare it with the other ring nodes or the ring operators
+// before pinning it with WithRingKey.
+func (c *Client) FetchRingPublicKey(ctx context.Context, ringID string) (crypto.PublicKey, error) {
+	ringResp, err := c.Ring.GetRing(ctx, &ringv1alpha1.GetRingRequest{Id: ringID})
+	if err != nil {
+		return nil, fmt.Errorf("get ring: %w", err)
+	}
+
+	rid := types.RingIDFromManifest(ringResp.Ring.GetManifest())
+	if string(rid) != ringID {
+		return nil, fmt.Errorf("%w: got %s", ErrRingIDMismatch, rid)
+	}
+
+	pkResp, err := c.Ring.PublicKey(ctx, &ringv1alpha1.PublicKeyRequest{Id: ringID})
+	if err != nil {
+		return nil, fmt.Errorf("get ring public key: %w", err)
+	}
+
+	pk, err := crypto.PublicKeyFromProto(pkResp.PublicKey)
+	if err != nil {
+		return nil, fmt.Errorf("ring public key from proto: %w", err)
+	}
+
+	return pk, nil
+}
+
+func (c *Client) credentials(sk crypto.PrivateKey, kid, subject string) tokenCredentials {
+	return tokenCredentials{
+		sk:       sk,
+		kid:      kid,
+		subject:  subject,
+		ttl:      c.tokenTTL,
+		insecure: c.insecure,
+	}
+}
+
+var _ credentials.PerRPCCredentials = tokenCredentials{}
+
+// tokenCredentials signs a fresh self signed JWS for each request.
+type tokenCredentials struct {
+	sk      crypto.PrivateKey
+	kid     string
+	subject string
+	ttl     time.Duration
+	// binding binds the tokens to a request, if set.
+	binding string
+	// insecure allows sending the tokens without TLS.
+	insecure bool
+}
+
+type tokenCredentialsCtxKey struct{}
+
+// withTokenCredentials overrides the client token credentials for
+// requests made with the returned context. A call option can't be
+// used, since gRPC would send both tokens.
+func withTokenCredentials(ctx context.Context, creds tokenCredentials) context.Context {
+	return context.WithValue(ctx, tokenCredentialsCtxKey{}, creds)
+}
+
+func (t tokenCredentials) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	if override, ok := ctx.Value(tokenCredentialsCtxKey{}).(tokenCredentials); ok {
+		t = override
+	}
+
+	token, err := jws.NewSelfSignedBoundToken(t.sk, t.kid, t.subject, t.ttl, t.binding)
+	if err != nil {
+		return nil, fmt.Errorf("create token: %w", err)
+	}
+
+	return map[string]string{
+		jws.TokenMetadataKey: "Bearer " + token,
+	}, nil
+}
+
+// RequireTransportSecurity keeps the bearer tokens from being sent
+// in plaintext, unless the client opted into WithInsecure.
+func (t tokenCredentials) RequireTransportSecurity() bool {
+	return !t.insecure
+}
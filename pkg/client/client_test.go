@@ -0,0 +1,254 @@
+package client
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"fmt"
+	"math/big"
+	"net"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.dedis.ch/kyber/v3/suites"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+
+	ringv1alpha1 "github.com/sourcenetwork/orbis-go/gen/proto/orbis/ring/v1alpha1"
+	"github.com/sourcenetwork/orbis-go/pkg/authn/jws"
+	"github.com/sourcenetwork/orbis-go/pkg/crypto"
+	"github.com/sourcenetwork/orbis-go/pkg/types"
+)
+
+// testRing serves a single ring, holding the ring key itself
+// instead of running a DKG.
+type testRing struct {
+	ringv1alpha1.UnimplementedRingServiceServer
+
+	manifest *ringv1alpha1.Manifest
+	sk       crypto.PrivateKey
+
+	mu      sync.Mutex
+	secrets map[string]*ringv1alpha1.Secret
+	tokens  []string
+}
+
+func newTestRing(t *testing.T) *testRing {
+	sk, _, err := crypto.GenerateKeyPair(suites.MustFind("ed25519"), rand.Reader)
+	require.NoError(t, err)
+
+	return &testRing{
+		manifest: &ringv1alpha1.Manifest{N: 3, T: 2, Dkg: "rabin", Pre: "elgamal"},
+		sk:       sk,
+		secrets:  make(map[string]*ringv1alpha1.Secret),
+	}
+}
+
+func (r *testRing) id() string {
+	return string(types.RingIDFromManifest(r.manifest))
+}
+
+func (r *testRing) GetRing(ctx context.Context, req *ringv1alpha1.GetRingRequest) (*ringv1alpha1.GetRingResponse, error) {
+	return &ringv1alpha1.GetRingResponse{Ring: &ringv1alpha1.Ring{Id: req.Id, Manifest: r.manifest}}, nil
+}
+
+func (r *testRing) PublicKey(context.Context, *ringv1alpha1.PublicKeyRequest) (*ringv1alpha1.PublicKeyResponse, error) {
+	pk, err := crypto.PublicKeyToProto(r.sk.GetPublic())
+	if err != nil {
+		return nil, err
+	}
+	return &ringv1alpha1.PublicKeyResponse{PublicKey: pk}, nil
+}
+
+func (r *testRing) StoreSecret(ctx context.Context, req *ringv1alpha1.StoreSecretRequest) (*ringv1alpha1.StoreSecretResponse, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	sid := fmt.Sprintf("secret-%d", len(r.secrets))
+	r.secrets[sid] = req.Secret
+	return &ringv1alpha1.StoreSecretResponse{SecretId: sid}, nil
+}
+
+// ReencryptSecret re-encrypts with the whole ring key, which
+// is what the ring nodes recover from their shares.
+func (r *testRing) ReencryptSecret(ctx context.Context, req *ringv1alpha1.ReencryptSecretRequest) (*ringv1alpha1.ReencryptSecretResponse, error) {
+	r.mu.Lock()
+	scrt, ok := r.secrets[req.SecretId]
+	r.mu.Unlock()
+	if !ok {
+		return nil, fmt.Errorf("secret %s not found", req.SecretId)
+	}
+
+	rdrPk, err := crypto.PublicKeyFromProto(req.RdrPk)
+	if err != nil {
+		return nil, err
+	}
+
+	ste := suites.MustFind("ed25519")
+	encCmt := ste.Point()
+	err = encCmt.UnmarshalBinary(scrt.EncCmt)
+	if err != nil {
+		return nil, err
+	}
+
+	// rsG + xsG = s(rG + xG)
+	xncCmt := ste.Point().Mul(r.sk.Scalar(), ste.Point().Add(encCmt, rdrPk.Point()))
+	rawXncCmt, err := xncCmt.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+
+	return &ringv1alpha1.ReencryptSecretResponse{
+		XncCmt:  rawXncCmt,
+		EncScrt: scrt.EncScrt,
+		EncData: scrt.EncData,
+		Dem:     scrt.Dem,
+		EncCmt:  scrt.EncCmt,
+	}, nil
+}
+
+func (r *testRing) recordToken(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	r.mu.Lock()
+	r.tokens = append(r.tokens, md.Get(jws.TokenMetadataKey)...)
+	r.mu.Unlock()
+	return handler(ctx, req)
+}
+
+// serve serves the ring in process, with TLS if creds are set, and
+// returns its address.
+func serve(t *testing.T, ring *testRing, creds credentials.TransportCredentials) string {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	opts := []grpc.ServerOption{grpc.UnaryInterceptor(ring.recordToken)}
+	if creds != nil {
+		opts = append(opts, grpc.Creds(creds))
+	}
+	srv := grpc.NewServer(opts...)
+	ringv1alpha1.RegisterRingServiceServer(srv, ring)
+	go srv.Serve(lis) // nolint:errcheck
+	t.Cleanup(srv.Stop)
+
+	return lis.Addr().String()
+}
+
+// selfSignedTLS returns the server TLS config of a self signed
+// certificate, and the client TLS config trusting it.
+func selfSignedTLS(t *testing.T) (*tls.Config, *tls.Config) {
+	sk, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "orbis"},
+		DNSNames:     []string{"localhost"},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+		NotBefore:    time.Now().Add(-time.Minute),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &sk.PublicKey, sk)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	roots := x509.NewCertPool()
+	roots.AddCert(cert)
+
+	server := &tls.Config{Certificates: []tls.Certificate{{Certificate: [][]byte{der}, PrivateKey: sk}}}
+	client := &tls.Config{RootCAs: roots, MinVersion: tls.VersionTLS12}
+	return server, client
+}
+
+func TestStoreAndRetrieveSecret(t *testing.T) {
+	ctx := context.Background()
+	ring := newTestRing(t)
+	serverTLS, clientTLS := selfSignedTLS(t)
+	addr := serve(t, ring, credentials.NewTLS(serverTLS))
+
+	c, err := New(ctx, addr, WithTLS(clientTLS), WithRingKey(ring.id(), ring.sk.GetPublic()))
+	require.NoError(t, err)
+	defer c.Close()
+
+	scrt := []byte(strings.Repeat("secret", 1000))
+	sid, err := c.StoreSecret(ctx, ring.id(), scrt, "secret:1#read")
+	require.NoError(t, err)
+
+	got, err := c.RetrieveSecret(ctx, ring.id(), sid, nil)
+	require.NoError(t, err)
+	require.Equal(t, scrt, got)
+
+	// every request is authenticated
+	ring.mu.Lock()
+	defer ring.mu.Unlock()
+	require.NotEmpty(t, ring.tokens)
+	for _, token := range ring.tokens {
+		require.True(t, strings.HasPrefix(token, "Bearer "))
+	}
+}
+
+func TestRingPublicKeyPinning(t *testing.T) {
+	ctx := context.Background()
+	ring := newTestRing(t)
+	addr := serve(t, ring, nil)
+
+	c, err := New(ctx, addr, WithInsecure())
+	require.NoError(t, err)
+	defer c.Close()
+
+	// the key the node reports isn't trusted
+	_, err = c.RingPublicKey(ctx, ring.id())
+	require.ErrorIs(t, err, ErrRingKeyNotPinned)
+	_, err = c.StoreSecret(ctx, ring.id(), []byte("secret"), "secret:1#read")
+	require.ErrorIs(t, err, ErrRingKeyNotPinned)
+
+	reported, err := c.FetchRingPublicKey(ctx, ring.id())
+	require.NoError(t, err)
+	require.True(t, reported.Equals(ring.sk.GetPublic()))
+
+	_, err = c.FetchRingPublicKey(ctx, "other")
+	require.ErrorIs(t, err, ErrRingIDMismatch)
+
+	// a node reporting another key than the pinned one
+	_, other, err := crypto.GenerateKeyPair(suites.MustFind("ed25519"), rand.Reader)
+	require.NoError(t, err)
+	c, err = New(ctx, addr, WithInsecure(), WithRingKey(ring.id(), other))
+	require.NoError(t, err)
+	defer c.Close()
+	_, err = c.RingPublicKey(ctx, ring.id())
+	require.ErrorIs(t, err, ErrRingKeyMismatch)
+
+	c, err = New(ctx, addr, WithInsecure(), WithRingKey(ring.id(), ring.sk.GetPublic()))
+	require.NoError(t, err)
+	defer c.Close()
+	pk, err := c.RingPublicKey(ctx, ring.id())
+	require.NoError(t, err)
+	require.True(t, pk.Equals(ring.sk.GetPublic()))
+}
+
+func TestTokensRequireTLS(t *testing.T) {
+	ctx := context.Background()
+	ring := newTestRing(t)
+	addr := serve(t, ring, nil)
+
+	// plaintext transport credentials without WithInsecure
+	_, err := New(ctx, addr, WithDialOptions(grpc.WithTransportCredentials(insecure.NewCredentials())))
+	require.ErrorContains(t, err, "transport level security")
+
+	c, err := New(ctx, addr, WithInsecure())
+	require.NoError(t, err)
+	defer c.Close()
+	_, err = c.FetchRingPublicKey(ctx, ring.id())
+	require.NoError(t, err)
+}
@@ -0,0 +1,103 @@
+package client
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	ic "github.com/libp2p/go-libp2p/core/crypto"
+
+	"github.com/sourcenetwork/orbis-go/pkg/crypto"
+	"github.com/sourcenetwork/orbis-go/pkg/did"
+)
+
+// Keyfile is the on-disk format of a client identity key.
+type Keyfile struct {
+	DID        string `json:"did"`
+	KeyType    string `json:"key_type"`
+	PublicKey  string `json:"public_key"`  // base64 raw public key
+	PrivateKey string `json:"private_key"` // base64 raw private key
+}
+
+// SaveKeyfile writes the private key to path, readable only by the
+// current user.
+func SaveKeyfile(path string, sk crypto.PrivateKey) (Keyfile, error) {
+	var kf Keyfile
+
+	subject, _, err := did.KeyDID(sk.GetPublic())
+	if err != nil {
+		return kf, fmt.Errorf("key did: %w", err)
+	}
+
+	rawPk, err := sk.GetPublic().Raw()
+	if err != nil {
+		return kf, fmt.Errorf("raw public key: %w", err)
+	}
+
+	rawSk, err := sk.Raw()
+	if err != nil {
+		return kf, fmt.Errorf("raw private key: %w", err)
+	}
+
+	kf = Keyfile{
+		DID:        subject,
+		KeyType:    sk.Type().String(),
+		PublicKey:  base64.StdEncoding.EncodeToString(rawPk),
+		PrivateKey: base64.StdEncoding.EncodeToString(rawSk),
+	}
+
+	data, err := json.MarshalIndent(kf, "", "  ")
+	if err != nil {
+		return kf, fmt.Errorf("marshal keyfile: %w", err)
+	}
+
+	err = os.MkdirAll(filepath.Dir(path), 0o700)
+	if err != nil {
+		return kf, fmt.Errorf("create keyfile dir: %w", err)
+	}
+
+	err = os.WriteFile(path, data, 0o600)
+	if err != nil {
+		return kf, fmt.Errorf("write keyfile: %w", err)
+	}
+
+	return kf, nil
+}
+
+// LoadKeyfile reads a private key written by SaveKeyfile.
+func LoadKeyfile(path string) (crypto.PrivateKey, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read keyfile: %w", err)
+	}
+
+	var kf Keyfile
+	err = json.Unmarshal(data, &kf)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal keyfile: %w", err)
+	}
+
+	keyType, err := crypto.KeyTypeFromString(kf.KeyType)
+	if err != nil {
+		return nil, fmt.Errorf("keyfile key type %q: %w", kf.KeyType, err)
+	}
+
+	unmarshal, ok := ic.PrivKeyUnmarshallers[keyType]
+	if !ok {
+		return nil, fmt.Errorf("keyfile key type %q: %w", kf.KeyType, crypto.ErrBadKeyType)
+	}
+
+	buf, err := base64.StdEncoding.DecodeString(kf.PrivateKey)
+	if err != nil {
+		return nil, fmt.Errorf("decode private key: %w", err)
+	}
+
+	icSk, err := unmarshal(buf)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal private key: %w", err)
+	}
+
+	return crypto.PrivateKeyFromLibP2P(icSk)
+}
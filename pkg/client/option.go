@@ -0,0 +1,70 @@
+package client
+
+import (
+	"crypto/tls"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+
+	"github.com/sourcenetwork/orbis-go/pkg/crypto"
+	"github.com/sourcenetwork/orbis-go/pkg/types"
+)
+
+type Option func(c *Client, o *options)
+
+type options struct {
+	dialOpts []grpc.DialOption
+}
+
+// WithIdentity sets the key used to authenticate the client.
+func WithIdentity(sk crypto.PrivateKey) Option {
+	return func(c *Client, o *options) {
+		c.identity = sk
+	}
+}
+
+// WithDialOptions appends extra gRPC dial options, such as transport
+// credentials, which replace the default TLS ones. Transport credentials
+// without TLS also need WithInsecure, to send the tokens.
+func WithDialOptions(opts ...grpc.DialOption) Option {
+	return func(c *Client, o *options) {
+		o.dialOpts = append(o.dialOpts, opts...)
+	}
+}
+
+// WithTLS dials the server with TLS, using the given config to
+// verify the server and, for mTLS, to present a client certificate.
+func WithTLS(cfg *tls.Config) Option {
+	return WithDialOptions(grpc.WithTransportCredentials(credentials.NewTLS(cfg)))
+}
+
+// WithInsecure dials the node without TLS, for local development.
+// The bearer tokens are sent in plaintext.
+func WithInsecure() Option {
+	return func(c *Client, o *options) {
+		c.insecure = true
+	}
+}
+
+// WithTokenTTL sets the lifetime of the authentication tokens.
+func WithTokenTTL(ttl time.Duration) Option {
+	return func(c *Client, o *options) {
+		c.tokenTTL = ttl
+	}
+}
+
+// WithDEM sets the data encapsulation mechanism used to encrypt secrets.
+func WithDEM(dem crypto.DEM) Option {
+	return func(c *Client, o *options) {
+		c.dem = dem
+	}
+}
+
+// WithRingKey pins the expected public key of a ring, which is
+// required to encrypt to the ring and verify its signatures.
+func WithRingKey(ringID string, pk crypto.PublicKey) Option {
+	return func(c *Client, o *options) {
+		c.ringKeys[types.RingID(ringID)] = pk
+	}
+}
@@ -0,0 +1,170 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"go.dedis.ch/kyber/v3"
+
+	ringv1alpha1 "github.com/sourcenetwork/orbis-go/gen/proto/orbis/ring/v1alpha1"
+	"github.com/sourcenetwork/orbis-go/pkg/authn"
+	"github.com/sourcenetwork/orbis-go/pkg/crypto"
+	"github.com/sourcenetwork/orbis-go/pkg/did"
+	"github.com/sourcenetwork/orbis-go/pkg/pre/elgamal"
+	"github.com/sourcenetwork/orbis-go/pkg/types"
+)
+
+// StoreSecret encrypts the plaintext under the ring public key and
+// stores it in the ring, guarded by the authorization policy.
+// It returns the secret id.
+func (c *Client) StoreSecret(ctx context.Context, ringID string, plaintext []byte, policy string) (string, error) {
+	ringPk, err := c.RingPublicKey(ctx, ringID)
+	if err != nil {
+		return "", err
+	}
+
+	ste, err := crypto.SuiteForType(ringPk.Type())
+	if err != nil {
+		return "", fmt.Errorf("suite for type: %w", err)
+	}
+
+	encCmt, encScrt, encData, err := elgamal.EncryptSecretHybrid(ste, ringPk.Point(), c.dem, plaintext)
+	if err != nil {
+		return "", fmt.Errorf("encrypt secret: %w", err)
+	}
+
+	rawEncCmt, err := encCmt.MarshalBinary()
+	if err != nil {
+		return "", fmt.Errorf("marshal encCmt: %w", err)
+	}
+
+	rawEncScrt := make([][]byte, len(encScrt))
+	for i, encScrti := range encScrt {
+		rawEncScrt[i], err = encScrti.MarshalBinary()
+		if err != nil {
+			return "", fmt.Errorf("marshal encScrt: %w", err)
+		}
+	}
+
+	encDataCid, err := types.CidFromBytes(encData)
+	if err != nil {
+		return "", fmt.Errorf("cid from bytes: %w", err)
+	}
+
+	resp, err := c.Ring.StoreSecret(ctx, &ringv1alpha1.StoreSecretRequest{
+		RingId: ringID,
+		Secret: &ringv1alpha1.Secret{
+			EncCmt:     rawEncCmt,
+			EncScrt:    rawEncScrt,
+			AuthzCtx:   policy,
+			Dem:        string(c.dem),
+			EncDataCid: encDataCid.String(),
+			EncData:    encData,
+		},
+	})
+	if err != nil {
+		return "", fmt.Errorf("store secret: %w", err)
+	}
+
+	return resp.SecretId, nil
+}
+
+// RetrieveSecret re-encrypts the secret to the reader key, and decrypts
+// it locally. The request is authenticated with the reader key, so the
+// authorization policy is checked against the reader's `did:key`.
+// If readerKey is nil, the client identity is used.
+func (c *Client) RetrieveSecret(ctx context.Context, ringID string, sid string, readerKey crypto.PrivateKey) ([]byte, error) {
+	if readerKey == nil {
+		readerKey = c.identity
+	}
+
+	if readerKey.Type() != crypto.Ed25519 {
+		return nil, fmt.Errorf("%w: reader key %s", ErrUnsupportedKeyType, readerKey.Type())
+	}
+
+	ringPk, err := c.RingPublicKey(ctx, ringID)
+	if err != nil {
+		return nil, err
+	}
+
+	ste, err := crypto.SuiteForType(ringPk.Type())
+	if err != nil {
+		return nil, fmt.Errorf("suite for type: %w", err)
+	}
+
+	subject, kid, err := did.KeyDID(readerKey.GetPublic())
+	if err != nil {
+		return nil, fmt.Errorf("reader did: %w", err)
+	}
+
+	rdrPk, err := crypto.PublicKeyToProto(readerKey.GetPublic())
+	if err != nil {
+		return nil, fmt.Errorf("reader public key to proto: %w", err)
+	}
+
+	binding, err := authn.ReencryptBinding(sid, readerKey.GetPublic())
+	if err != nil {
+		return nil, fmt.Errorf("reencrypt binding: %w", err)
+	}
+
+	// the request is authenticated as the reader, instead of the
+	// client identity, since the ring reencrypts to the subject key.
+	// The token is bound to the request, so it can't be reused to
+	// re-encrypt other secrets.
+	creds := c.credentials(readerKey, kid, subject)
+	creds.binding = binding
+	ctx = withTokenCredentials(ctx, creds)
+	resp, err := c.Ring.ReencryptSecret(ctx,
+		&ringv1alpha1.ReencryptSecretRequest{
+			RingId:   ringID,
+			SecretId: sid,
+			RdrPk:    rdrPk,
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("reencrypt secret: %w", err)
+	}
+
+	xncCmt := ste.Point()
+	err = xncCmt.UnmarshalBinary(resp.XncCmt)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal xncCmt: %w", err)
+	}
+
+	encScrt := make([]kyber.Point, len(resp.EncScrt))
+	for i, rawEncScrti := range resp.EncScrt {
+		encScrt[i] = ste.Point()
+		err = encScrt[i].UnmarshalBinary(rawEncScrti)
+		if err != nil {
+			return nil, fmt.Errorf("unmarshal encScrt: %w", err)
+		}
+	}
+
+	rdrSk := readerKey.Scalar()
+
+	if resp.Dem == "" {
+		scrt, err := elgamal.DecryptSecret(ste, encScrt, ringPk.Point(), xncCmt, rdrSk)
+		if err != nil {
+			return nil, fmt.Errorf("decrypt secret: %w", err)
+		}
+		return scrt, nil
+	}
+
+	dem, err := crypto.DEMFromString(resp.Dem)
+	if err != nil {
+		return nil, fmt.Errorf("secret dem: %w", err)
+	}
+
+	encCmt := ste.Point()
+	err = encCmt.UnmarshalBinary(resp.EncCmt)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal encCmt: %w", err)
+	}
+
+	scrt, err := elgamal.DecryptSecretHybrid(ste, dem, encCmt, encScrt, resp.EncData, ringPk.Point(), xncCmt, rdrSk)
+	if err != nil {
+		return nil, fmt.Errorf("decrypt secret: %w", err)
+	}
+
+	return scrt, nil
+}
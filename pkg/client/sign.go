@@ -0,0 +1,62 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	ringv1alpha1 "github.com/sourcenetwork/orbis-go/gen/proto/orbis/ring/v1alpha1"
+	"github.com/sourcenetwork/orbis-go/pkg/crypto"
+	"github.com/sourcenetwork/orbis-go/pkg/tsig"
+	"github.com/sourcenetwork/orbis-go/pkg/tsig/frost"
+	"github.com/sourcenetwork/orbis-go/pkg/tsig/tbls"
+)
+
+var ErrInvalidRingSignature = fmt.Errorf("invalid ring signature")
+
+// Sign requests a threshold signature of the message from the ring. The
+// signature is verified against the verified ring public key, so the node
+// can't return a signature the ring didn't make.
+func (c *Client) Sign(ctx context.Context, ringID string, msg []byte) ([]byte, error) {
+	resp, err := c.Ring.Sign(ctx, &ringv1alpha1.SignRequest{
+		RingId:  ringID,
+		Message: msg,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("sign: %w", err)
+	}
+
+	err = c.VerifyRingSignature(ctx, ringID, msg, resp.Signature)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Signature, nil
+}
+
+// VerifyRingSignature checks the signature of the message against the
+// verified ring public key, with the threshold signature scheme of the
+// ring key.
+func (c *Client) VerifyRingSignature(ctx context.Context, ringID string, msg []byte, sig []byte) error {
+	ringPk, err := c.RingPublicKey(ctx, ringID)
+	if err != nil {
+		return err
+	}
+
+	var scheme tsig.Verifier
+	switch ringPk.Type() {
+	case crypto.BLS12381:
+		scheme = tbls.New()
+	default:
+		scheme, err = frost.New(ringPk.Type())
+		if err != nil {
+			return fmt.Errorf("%w: %w", ErrInvalidRingSignature, err)
+		}
+	}
+
+	err = scheme.Verify(ringPk, msg, sig)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidRingSignature, err)
+	}
+
+	return nil
+}
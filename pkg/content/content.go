@@ -0,0 +1,95 @@
+package content
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ipfs/go-cid"
+
+	contentv1alpha1 "github.com/sourcenetwork/orbis-go/gen/proto/orbis/content/v1alpha1"
+	"github.com/sourcenetwork/orbis-go/pkg/db"
+	"github.com/sourcenetwork/orbis-go/pkg/types"
+)
+
+var (
+	ErrNotFound    = fmt.Errorf("content not found")
+	ErrCidMismatch = fmt.Errorf("content doesn't match cid")
+)
+
+// Store is a content addressed store for bulk data, such as
+// the DEM ciphertext of hybrid encrypted secrets, which is
+// too large to be posted to the bulletin.
+type Store interface {
+	// Put stores the data and returns its content id.
+	Put(ctx context.Context, data []byte) (cid.Cid, error)
+	// Get returns the data for the given content id.
+	Get(ctx context.Context, c cid.Cid) ([]byte, error)
+	// Has reports whether the data for the given content id is stored.
+	Has(ctx context.Context, c cid.Cid) bool
+}
+
+type dbStore struct {
+	repo db.Repository[*contentv1alpha1.Block]
+}
+
+// NewDBStore returns a content Store backed by the given db.
+func NewDBStore(d *db.DB) (Store, error) {
+	repo, err := db.GetRepo(d, db.NewRepoKey("content"), blockPkFunc)
+	if err != nil {
+		return nil, fmt.Errorf("get content repo: %w", err)
+	}
+
+	return &dbStore{repo: repo}, nil
+}
+
+func (s *dbStore) Put(ctx context.Context, data []byte) (cid.Cid, error) {
+	c, err := types.CidFromBytes(data)
+	if err != nil {
+		return cid.Undef, fmt.Errorf("cid from bytes: %w", err)
+	}
+
+	blk := &contentv1alpha1.Block{
+		Cid:  c.String(),
+		Data: data,
+	}
+	if s.repo.Exists(ctx, blk) {
+		return c, nil
+	}
+
+	err = s.repo.Create(ctx, blk)
+	if err != nil {
+		return cid.Undef, fmt.Errorf("create block: %w", err)
+	}
+
+	return c, nil
+}
+
+func (s *dbStore) Get(ctx context.Context, c cid.Cid) ([]byte, error) {
+	blk, err := s.repo.Get(ctx, &contentv1alpha1.Block{Cid: c.String()})
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrNotFound, c)
+	}
+
+	return blk.Data, nil
+}
+
+func (s *dbStore) Has(ctx context.Context, c cid.Cid) bool {
+	return s.repo.Exists(ctx, &contentv1alpha1.Block{Cid: c.String()})
+}
+
+// Verify checks that the data matches the given content id.
+func Verify(c cid.Cid, data []byte) error {
+	dc, err := c.Prefix().Sum(data)
+	if err != nil {
+		return fmt.Errorf("sum data: %w", err)
+	}
+	if !dc.Equals(c) {
+		return ErrCidMismatch
+	}
+
+	return nil
+}
+
+func blockPkFunc(kb db.KeyBuilder, b *contentv1alpha1.Block) []byte {
+	return kb.AddStringField(b.Cid).Bytes()
+}
@@ -0,0 +1,112 @@
+package crypto
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"fmt"
+	"io"
+	"strings"
+
+	"golang.org/x/crypto/chacha20poly1305"
+)
+
+// DEM is a Data Encapsulation Mechanism, the symmetric half
+// of a KEM-DEM hybrid encryption scheme.
+type DEM string
+
+const (
+	AES256GCM         DEM = "aes-256-gcm"
+	XChaCha20Poly1305 DEM = "xchacha20-poly1305"
+
+	// DEMKeySize is the symmetric key size (in bytes) used
+	// by all the supported DEMs.
+	DEMKeySize = 32
+)
+
+var (
+	ErrBadDEM        = fmt.Errorf("unknown data encapsulation mechanism")
+	ErrBadDEMKeySize = fmt.Errorf("invalid data encapsulation key size")
+	ErrShortDEMData  = fmt.Errorf("data encapsulation ciphertext too short")
+)
+
+// DEMFromString parses a DEM name, it is case insensitive.
+func DEMFromString(dem string) (DEM, error) {
+	switch d := DEM(strings.ToLower(dem)); d {
+	case AES256GCM, XChaCha20Poly1305:
+		return d, nil
+	default:
+		return "", ErrBadDEM
+	}
+}
+
+// NewAEAD returns the AEAD cipher for the given DEM and key.
+func NewAEAD(dem DEM, key []byte) (cipher.AEAD, error) {
+	if len(key) != DEMKeySize {
+		return nil, ErrBadDEMKeySize
+	}
+
+	switch dem {
+	case AES256GCM:
+		block, err := aes.NewCipher(key)
+		if err != nil {
+			return nil, fmt.Errorf("aes cipher: %w", err)
+		}
+		return cipher.NewGCM(block)
+	case XChaCha20Poly1305:
+		return chacha20poly1305.NewX(key)
+	default:
+		return nil, ErrBadDEM
+	}
+}
+
+// NewDEMKey generates a random symmetric key for a DEM.
+func NewDEMKey(src io.Reader) ([]byte, error) {
+	if src == nil {
+		src = rand.Reader
+	}
+	key := make([]byte, DEMKeySize)
+	_, err := io.ReadFull(src, key)
+	if err != nil {
+		return nil, fmt.Errorf("read random key: %w", err)
+	}
+	return key, nil
+}
+
+// Seal encrypts and authenticates the plaintext with the given
+// DEM and key. The random nonce is prepended to the returned
+// ciphertext.
+func Seal(dem DEM, key, plaintext, additionalData []byte) ([]byte, error) {
+	aead, err := NewAEAD(dem, key)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, aead.NonceSize(), aead.NonceSize()+len(plaintext)+aead.Overhead())
+	_, err = io.ReadFull(rand.Reader, nonce)
+	if err != nil {
+		return nil, fmt.Errorf("read random nonce: %w", err)
+	}
+
+	return aead.Seal(nonce, nonce, plaintext, additionalData), nil
+}
+
+// Open decrypts and authenticates a ciphertext produced by Seal.
+func Open(dem DEM, key, ciphertext, additionalData []byte) ([]byte, error) {
+	aead, err := NewAEAD(dem, key)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(ciphertext) < aead.NonceSize()+aead.Overhead() {
+		return nil, ErrShortDEMData
+	}
+
+	nonce, data := ciphertext[:aead.NonceSize()], ciphertext[aead.NonceSize():]
+	plaintext, err := aead.Open(nil, nonce, data, additionalData)
+	if err != nil {
+		return nil, fmt.Errorf("open ciphertext: %w", err)
+	}
+
+	return plaintext, nil
+}
@@ -0,0 +1,37 @@
+package crypto
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDEMSealOpen(t *testing.T) {
+	plaintext := []byte("hello orbis")
+	ad := []byte("additional data")
+
+	for _, dem := range []DEM{AES256GCM, XChaCha20Poly1305} {
+		key, err := NewDEMKey(nil)
+		require.NoError(t, err)
+
+		ct, err := Seal(dem, key, plaintext, ad)
+		require.NoError(t, err)
+
+		pt, err := Open(dem, key, ct, ad)
+		require.NoError(t, err)
+		require.Equal(t, plaintext, pt)
+
+		_, err = Open(dem, key, ct, []byte("other data"))
+		require.Error(t, err)
+
+		_, err = Open(dem, key, ct[:4], ad)
+		require.ErrorIs(t, err, ErrShortDEMData)
+	}
+
+	_, err := DEMFromString("rot13")
+	require.ErrorIs(t, err, ErrBadDEM)
+
+	dem, err := DEMFromString("AES-256-GCM")
+	require.NoError(t, err)
+	require.Equal(t, AES256GCM, dem)
+}
@@ -3,5 +3,6 @@ package crypto
 import "fmt"
 
 var (
-	ErrBadKeyType = fmt.Errorf("unknown key type")
+	ErrBadKeyType       = fmt.Errorf("unknown key type")
+	ErrKeyNotExportable = fmt.Errorf("private key is held by a signer, and isn't exportable")
 )
@@ -9,6 +9,7 @@ import (
 	"io"
 	"strings"
 
+	"github.com/btcsuite/btcd/btcec/v2"
 	ic "github.com/libp2p/go-libp2p/core/crypto"
 	icpb "github.com/libp2p/go-libp2p/core/crypto/pb"
 	"go.dedis.ch/kyber/v3"
@@ -56,13 +57,15 @@ func PublicKeyFromLibP2P(pubkey ic.PubKey) (PublicKey, error) {
 }
 
 func PublicKeyFromProto(pk *icpb.PublicKey) (PublicKey, error) {
-	icpk, err := ic.PublicKeyFromProto(pk)
+	icpk, err := PubKeyFromProto(pk)
 	if err != nil {
 		return nil, err
 	}
 	return publicKeyFromLibP2P(icpk)
 }
 
+// PublicKeyFromStdPublicKey converts an Ed25519, ECDSA, or secp256k1
+// public key, as returned by the standard library and DID resolvers.
 func PublicKeyFromStdPublicKey(pubkey gocrypto.PublicKey) (PublicKey, error) {
 	var icpk ic.PubKey
 	var err error
@@ -70,7 +73,13 @@ func PublicKeyFromStdPublicKey(pubkey gocrypto.PublicKey) (PublicKey, error) {
 	case ed25519.PublicKey:
 		icpk, err = ic.UnmarshalEd25519PublicKey(pkt)
 	case ecdsa.PublicKey:
-		icpk, err = ic.ECDSAPublicKeyFromPubKey(pkt)
+		icpk, err = ecdsaPublicKey(&pkt)
+	case *ecdsa.PublicKey:
+		icpk, err = ecdsaPublicKey(pkt)
+	case btcec.PublicKey:
+		icpk, err = ic.UnmarshalSecp256k1PublicKey(pkt.SerializeCompressed())
+	case *btcec.PublicKey:
+		icpk, err = ic.UnmarshalSecp256k1PublicKey(pkt.SerializeCompressed())
 	default:
 		return nil, fmt.Errorf("unknown key type")
 	}
@@ -81,6 +90,21 @@ func PublicKeyFromStdPublicKey(pubkey gocrypto.PublicKey) (PublicKey, error) {
 	return publicKeyFromLibP2P(icpk)
 }
 
+// ecdsaPublicKey converts an ECDSA public key, where secp256k1
+// keys are converted to libp2p secp256k1 keys rather than ECDSA
+// keys, which only support the NIST curves.
+func ecdsaPublicKey(pk *ecdsa.PublicKey) (ic.PubKey, error) {
+	if pk.Curve != btcec.S256() {
+		return ic.ECDSAPublicKeyFromPubKey(*pk)
+	}
+
+	var x, y btcec.FieldVal
+	if x.SetByteSlice(pk.X.Bytes()) || y.SetByteSlice(pk.Y.Bytes()) {
+		return nil, fmt.Errorf("invalid secp256k1 public key")
+	}
+	return ic.UnmarshalSecp256k1PublicKey(btcec.NewPublicKey(&x, &y).SerializeCompressed())
+}
+
 func PublicKeyFromPoint(suite suites.Suite, point kyber.Point) (PublicKey, error) {
 
 	buf, err := point.MarshalBinary()
@@ -99,6 +123,8 @@ func PublicKeyFromPoint(suite suites.Suite, point kyber.Point) (PublicKey, error
 		pk, err = ic.UnmarshalECDSAPublicKey(buf)
 	case "rsa":
 		pk, err = ic.UnmarshalRsaPublicKey(buf)
+	case pairingSuite.String():
+		pk, err = UnmarshalPairingPublicKey(buf)
 	default:
 		return nil, ErrBadKeyType
 	}
@@ -111,6 +137,12 @@ func PublicKeyFromPoint(suite suites.Suite, point kyber.Point) (PublicKey, error
 }
 
 func publicKeyFromLibP2P(pubkey ic.PubKey) (*pubKey, error) {
+	// ECDSA keys are only used to authenticate subjects,
+	// they have no suite, so no point.
+	if pubkey.Type() == ECDSA {
+		return &pubKey{PubKey: pubkey}, nil
+	}
+
 	suite, err := SuiteForType(pubkey.Type())
 	if err != nil {
 		return nil, err
@@ -127,7 +159,12 @@ func PublicKeyToProto(pk PublicKey) (*icpb.PublicKey, error) {
 	return ic.PublicKeyToProto(pk)
 }
 
+// Point returns the key as a point of its suite, or
+// nil if the key type has no suite.
 func (p *pubKey) Point() kyber.Point {
+	if p.suite == nil {
+		return nil
+	}
 	buf, _ := p.PubKey.Raw()
 	point := p.suite.Point()
 	point.UnmarshalBinary(buf)
@@ -191,6 +228,11 @@ func PrivateKeyFromLibP2P(privkey ic.PrivKey) (PrivateKey, error) {
 //
 // WARNING: THIS ONLY WORDS WITH Edwards25519 CURVES RIGHT NOW.
 func (p *privKey) Scalar() kyber.Scalar {
+	// pairing keys are already scalars.
+	if pk, ok := p.PrivKey.(*pairingPrivKey); ok {
+		return pk.scalar.Clone()
+	}
+
 	// There is a discrepency between LibP2P private keys
 	// and "raw" EC scalars. LibP2P private keys is an
 	// (x, y) pair, where x is the given "seed" and y is
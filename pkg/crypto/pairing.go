@@ -0,0 +1,213 @@
+package crypto
+
+import (
+	"bytes"
+	"crypto/sha512"
+	"crypto/subtle"
+	"fmt"
+
+	ic "github.com/libp2p/go-libp2p/core/crypto"
+	icpb "github.com/libp2p/go-libp2p/core/crypto/pb"
+	"go.dedis.ch/kyber/v3"
+	"go.dedis.ch/kyber/v3/sign/bls"
+
+	"github.com/sourcenetwork/orbis-go/pkg/crypto/suites/bls12381"
+)
+
+// BLS12381 is the key type of pairing keys, which are points of the
+// G2 group of the BLS12-381 pairing, and sign with BLS signatures on
+// the G1 group. It isn't a libp2p key type, so it's outside of the
+// libp2p key type range.
+const BLS12381 KeyType = 16
+
+// pairingKeyDomain separates the pairing keys derived
+// from identity keys from any other use of the keys.
+const pairingKeyDomain = "orbis pairing key"
+
+// signerPairingKeyMessage is signed by identity keys held by a
+// signer, to derive their pairing key from the signature.
+const signerPairingKeyMessage = "orbis/pairing-key/v1"
+
+// pairingKeySignatureDomain separates the signatures of pairing
+// keys by identity keys from other signatures of the keys.
+const pairingKeySignatureDomain = "orbis pairing key signature"
+
+var pairingSuite = bls12381.NewSuite()
+
+// PairingSuite returns the pairing suite of BLS12-381 keys.
+func PairingSuite() *bls12381.Suite {
+	return pairingSuite
+}
+
+// PairingKey derives the pairing key of a node from its identity
+// key. The pairing key is the node long term key in rings using
+// the pairing suite, and is deterministic so it doesn't need to
+// be stored.
+func PairingKey(sk PrivateKey) (PrivateKey, error) {
+	raw, err := sk.Raw()
+	if err != nil {
+		return nil, fmt.Errorf("raw private key: %w", err)
+	}
+
+	return derivePairingKey(pairingKeyDomain, raw)
+}
+
+// SignerPairingKey derives the pairing key of a node whose identity key
+// is held by a signer, and never exported, from a signature by the
+// identity key. The signature scheme must be deterministic, like
+// Ed25519, so the pairing key is the same on each start, and the
+// identity key must not sign the message for anything else.
+func SignerPairingKey(sk ic.PrivKey) (PrivateKey, error) {
+	sig, err := sk.Sign([]byte(signerPairingKeyMessage))
+	if err != nil {
+		return nil, fmt.Errorf("sign pairing key derivation: %w", err)
+	}
+	again, err := sk.Sign([]byte(signerPairingKeyMessage))
+	if err != nil {
+		return nil, fmt.Errorf("sign pairing key derivation: %w", err)
+	}
+	if !bytes.Equal(sig, again) {
+		return nil, fmt.Errorf("%w: its signatures aren't deterministic, so they can't derive a pairing key", ErrKeyNotExportable)
+	}
+
+	return derivePairingKey(signerPairingKeyMessage, sig)
+}
+
+func derivePairingKey(domain string, secret []byte) (PrivateKey, error) {
+	h := sha512.New()
+	h.Write([]byte(domain))
+	h.Write(secret)
+	scalar := pairingSuite.Scalar().SetBytes(h.Sum(nil))
+
+	return PrivateKeyFromLibP2P(&pairingPrivKey{scalar: scalar})
+}
+
+// PubKeyFromProto converts a protobuf public key, of a libp2p key type
+// or a pairing key. Pairing keys aren't registered with libp2p, so
+// libp2p doesn't accept them in place of node identity keys.
+func PubKeyFromProto(pk *icpb.PublicKey) (ic.PubKey, error) {
+	if pk.GetType() == BLS12381 {
+		return UnmarshalPairingPublicKey(pk.GetData())
+	}
+	return ic.PublicKeyFromProto(pk)
+}
+
+// SignPairingKey signs the pairing key of a node with its identity key,
+// so the other nodes can tell the pairing key is the node's own.
+func SignPairingKey(sk PrivateKey, pairingKey ic.PubKey) ([]byte, error) {
+	msg, err := pairingKeySignatureMessage(pairingKey)
+	if err != nil {
+		return nil, err
+	}
+	return sk.Sign(msg)
+}
+
+// VerifyPairingKey checks the signature of the pairing key of a
+// node by its identity key.
+func VerifyPairingKey(pk ic.PubKey, pairingKey ic.PubKey, sig []byte) error {
+	msg, err := pairingKeySignatureMessage(pairingKey)
+	if err != nil {
+		return err
+	}
+	ok, err := pk.Verify(msg, sig)
+	if err != nil || !ok {
+		return fmt.Errorf("invalid pairing key signature")
+	}
+	return nil
+}
+
+func pairingKeySignatureMessage(pairingKey ic.PubKey) ([]byte, error) {
+	if pairingKey.Type() != BLS12381 {
+		return nil, fmt.Errorf("%w: expected a BLS12-381 key, got %s", ErrBadKeyType, pairingKey.Type())
+	}
+	raw, err := pairingKey.Raw()
+	if err != nil {
+		return nil, fmt.Errorf("raw pairing key: %w", err)
+	}
+	return append([]byte(pairingKeySignatureDomain), raw...), nil
+}
+
+// UnmarshalPairingPublicKey unmarshals a marshalled G2 point
+// into a BLS12-381 public key.
+func UnmarshalPairingPublicKey(data []byte) (ic.PubKey, error) {
+	point := pairingSuite.G2().Point()
+	err := point.UnmarshalBinary(data)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal pairing point: %w", err)
+	}
+	return &pairingPubKey{point: point}, nil
+}
+
+// UnmarshalPairingPrivateKey unmarshals a marshalled
+// scalar into a BLS12-381 private key.
+func UnmarshalPairingPrivateKey(data []byte) (ic.PrivKey, error) {
+	scalar := pairingSuite.Scalar()
+	err := scalar.UnmarshalBinary(data)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal pairing scalar: %w", err)
+	}
+	return &pairingPrivKey{scalar: scalar}, nil
+}
+
+// pairingPubKey is a BLS12-381 public key, verifying BLS signatures.
+type pairingPubKey struct {
+	point kyber.Point
+}
+
+func (k *pairingPubKey) Type() icpb.KeyType {
+	return BLS12381
+}
+
+func (k *pairingPubKey) Raw() ([]byte, error) {
+	return k.point.MarshalBinary()
+}
+
+func (k *pairingPubKey) Equals(o ic.Key) bool {
+	return keyEquals(k, o)
+}
+
+// Verify checks the BLS signature of data. Invalid
+// signatures aren't errors, like other libp2p keys.
+func (k *pairingPubKey) Verify(data []byte, sig []byte) (bool, error) {
+	return bls.Verify(pairingSuite, k.point, data, sig) == nil, nil
+}
+
+// pairingPrivKey is a BLS12-381 private key, creating BLS signatures.
+type pairingPrivKey struct {
+	scalar kyber.Scalar
+}
+
+func (k *pairingPrivKey) Type() icpb.KeyType {
+	return BLS12381
+}
+
+func (k *pairingPrivKey) Raw() ([]byte, error) {
+	return k.scalar.MarshalBinary()
+}
+
+func (k *pairingPrivKey) Equals(o ic.Key) bool {
+	return keyEquals(k, o)
+}
+
+func (k *pairingPrivKey) Sign(data []byte) ([]byte, error) {
+	return bls.Sign(pairingSuite, k.scalar, data)
+}
+
+func (k *pairingPrivKey) GetPublic() ic.PubKey {
+	return &pairingPubKey{point: pairingSuite.Point().Mul(k.scalar, nil)}
+}
+
+func keyEquals(k, o ic.Key) bool {
+	if k.Type() != o.Type() {
+		return false
+	}
+	a, err := k.Raw()
+	if err != nil {
+		return false
+	}
+	b, err := o.Raw()
+	if err != nil {
+		return false
+	}
+	return subtle.ConstantTimeCompare(a, b) == 1
+}
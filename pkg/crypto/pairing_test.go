@@ -0,0 +1,137 @@
+package crypto
+
+import (
+	"crypto/rand"
+	"testing"
+
+	ic "github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+func newPairingKey(t *testing.T) (PrivateKey, PrivateKey) {
+	icsk, _, err := ic.GenerateEd25519Key(rand.Reader)
+	require.NoError(t, err)
+	sk, err := PrivateKeyFromLibP2P(icsk)
+	require.NoError(t, err)
+	psk, err := PairingKey(sk)
+	require.NoError(t, err)
+	return sk, psk
+}
+
+func TestPairingKey(t *testing.T) {
+	sk, psk := newPairingKey(t)
+	require.Equal(t, BLS12381, psk.Type())
+
+	// derived deterministically from the identity key
+	psk2, err := PairingKey(sk)
+	require.NoError(t, err)
+	require.True(t, psk.Equals(psk2))
+	_, other := newPairingKey(t)
+	require.False(t, psk.Equals(other))
+
+	// the scalar is the key itself, so it matches the public key
+	pk := psk.GetPublic()
+	require.True(t, pk.Point().Equal(PairingSuite().Point().Mul(psk.Scalar(), nil)))
+
+	pk2, err := PublicKeyFromPoint(PairingSuite(), pk.Point())
+	require.NoError(t, err)
+	require.True(t, pk.Equals(pk2))
+	require.False(t, pk.Equals(other.GetPublic()))
+
+	ste, err := SuiteForType(BLS12381)
+	require.NoError(t, err)
+	require.Equal(t, PairingSuite().String(), ste.String())
+}
+
+func TestSignerPairingKey(t *testing.T) {
+	icsk, _, err := ic.GenerateEd25519Key(rand.Reader)
+	require.NoError(t, err)
+	sk, err := PrivateKeyFromLibP2P(icsk)
+	require.NoError(t, err)
+	psk, err := PairingKey(sk)
+	require.NoError(t, err)
+
+	// derived deterministically from the identity signature,
+	// apart from the keys derived from the identity scalar
+	ssk, err := SignerPairingKey(icsk)
+	require.NoError(t, err)
+	require.Equal(t, BLS12381, ssk.Type())
+	ssk2, err := SignerPairingKey(icsk)
+	require.NoError(t, err)
+	require.True(t, ssk.Equals(ssk2))
+	require.False(t, ssk.Equals(psk))
+
+	// randomized signatures would derive another key on each start
+	esk, _, err := ic.GenerateECDSAKeyPair(rand.Reader)
+	require.NoError(t, err)
+	_, err = SignerPairingKey(esk)
+	require.ErrorIs(t, err, ErrKeyNotExportable)
+}
+
+func TestPairingKeySignature(t *testing.T) {
+	_, psk := newPairingKey(t)
+	_, other := newPairingKey(t)
+	msg := []byte("ring attestation")
+
+	sig, err := psk.Sign(msg)
+	require.NoError(t, err)
+
+	ok, err := psk.GetPublic().Verify(msg, sig)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	ok, err = psk.GetPublic().Verify([]byte("other message"), sig)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	ok, err = other.GetPublic().Verify(msg, sig)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	ok, err = psk.GetPublic().Verify(msg, sig[1:])
+	require.NoError(t, err)
+	require.False(t, ok)
+}
+
+func TestPairingKeyProto(t *testing.T) {
+	_, psk := newPairingKey(t)
+
+	pbPk, err := PublicKeyToProto(psk.GetPublic())
+	require.NoError(t, err)
+	require.Equal(t, BLS12381, pbPk.GetType())
+
+	pk, err := PublicKeyFromProto(pbPk)
+	require.NoError(t, err)
+	require.True(t, psk.GetPublic().Equals(pk))
+
+	// libp2p doesn't take pairing keys for identity keys
+	_, err = ic.PublicKeyFromProto(pbPk)
+	require.Error(t, err)
+
+	raw, err := psk.Raw()
+	require.NoError(t, err)
+	icsk, err := UnmarshalPairingPrivateKey(raw)
+	require.NoError(t, err)
+	require.True(t, psk.Equals(icsk))
+
+	_, err = UnmarshalPairingPublicKey([]byte("not a point"))
+	require.Error(t, err)
+}
+
+func TestSignPairingKey(t *testing.T) {
+	sk, psk := newPairingKey(t)
+	other, _ := newPairingKey(t)
+
+	sig, err := SignPairingKey(sk, psk.GetPublic())
+	require.NoError(t, err)
+	require.NoError(t, VerifyPairingKey(sk.GetPublic(), psk.GetPublic(), sig))
+
+	// the signature is of the node identity key, for its pairing key
+	require.Error(t, VerifyPairingKey(other.GetPublic(), psk.GetPublic(), sig))
+	_, otherPsk := newPairingKey(t)
+	require.Error(t, VerifyPairingKey(sk.GetPublic(), otherPsk.GetPublic(), sig))
+
+	// only pairing keys are signed
+	_, err = SignPairingKey(sk, sk.GetPublic())
+	require.ErrorIs(t, err, ErrBadKeyType)
+}
@@ -0,0 +1,48 @@
+package crypto
+
+import (
+	"crypto/rand"
+	"fmt"
+	"io"
+
+	"golang.org/x/crypto/argon2"
+)
+
+// PasswordKDF holds the Argon2id parameters deriving
+// a DEM key from a password.
+type PasswordKDF struct {
+	Salt    []byte `json:"salt"`
+	Time    uint32 `json:"time"`
+	Memory  uint32 `json:"memory"` // KiB
+	Threads uint8  `json:"threads"`
+}
+
+// DefaultPasswordKDF follows the second recommended
+// option of RFC 9106, without the salt.
+var DefaultPasswordKDF = PasswordKDF{
+	Time:    3,
+	Memory:  64 * 1024,
+	Threads: 4,
+}
+
+// NewPasswordKDF returns the default parameters with a random salt.
+func NewPasswordKDF() (PasswordKDF, error) {
+	kdf := DefaultPasswordKDF
+	kdf.Salt = make([]byte, 16)
+	_, err := io.ReadFull(rand.Reader, kdf.Salt)
+	if err != nil {
+		return PasswordKDF{}, fmt.Errorf("read random salt: %w", err)
+	}
+	return kdf, nil
+}
+
+// Key derives the DEM key of a password.
+func (k PasswordKDF) Key(password []byte) ([]byte, error) {
+	if len(password) == 0 {
+		return nil, fmt.Errorf("empty password")
+	}
+	if len(k.Salt) == 0 || k.Time == 0 || k.Memory == 0 || k.Threads == 0 {
+		return nil, fmt.Errorf("invalid password kdf parameters")
+	}
+	return argon2.IDKey(password, k.Salt, k.Time, k.Memory, k.Threads, DEMKeySize), nil
+}
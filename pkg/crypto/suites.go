@@ -4,7 +4,7 @@ import (
 	"math/rand"
 
 	icpb "github.com/libp2p/go-libp2p/core/crypto/pb"
-	"github.com/sourcenetwork/orbis-go/pkg/crypto/suites/secp256k1"
+	"github.com/sourcenetwork/orbis-go/pkg/crypto/suites/secp256k1ct"
 	"go.dedis.ch/kyber/v3/group/edwards25519"
 	"go.dedis.ch/kyber/v3/suites"
 	"go.dedis.ch/kyber/v3/util/random"
@@ -14,24 +14,31 @@ import (
 // register protobuf custom reflect marshallers
 func init() {
 	ed25519 := edwards25519.NewBlakeSHA256Ed25519()
-	spk1 := secp256k1.NewBlakeKeccackSecp256k1()
+	spk1 := secp256k1ct.NewBlakeKeccackSecp256k1()
 
 	protobuf.RegisterInterface(func() interface{} { return ed25519.Point() })
 	protobuf.RegisterInterface(func() interface{} { return ed25519.Scalar() })
 
 	protobuf.RegisterInterface(func() interface{} { return spk1.Point() })
 	protobuf.RegisterInterface(func() interface{} { return spk1.Scalar() })
+
+	// pairing keys are G2 points, and signatures G1 points.
+	protobuf.RegisterInterface(func() interface{} { return pairingSuite.G1().Point() })
+	protobuf.RegisterInterface(func() interface{} { return pairingSuite.G2().Point() })
+	protobuf.RegisterInterface(func() interface{} { return pairingSuite.Scalar() })
 }
 
 func SuiteForType(kt icpb.KeyType) (suites.Suite, error) {
 	switch kt {
 	case icpb.KeyType_Secp256k1:
-		return secp256k1.NewBlakeKeccackSecp256k1(), nil
+		return secp256k1ct.NewBlakeKeccackSecp256k1(), nil
 	case icpb.KeyType_Ed25519:
 		// TODO
 		reader := rand.New(rand.NewSource(0))
 		r := random.New(reader)
 		return edwards25519.NewBlakeSHA256Ed25519WithRand(r), nil
+	case BLS12381:
+		return pairingSuite, nil
 	default:
 		return nil, ErrBadKeyType
 	}
@@ -0,0 +1,405 @@
+package bls12381
+
+import (
+	"crypto/cipher"
+	"encoding/hex"
+	"errors"
+	"io"
+	"math/big"
+
+	"go.dedis.ch/kyber/v3"
+	"go.dedis.ch/kyber/v3/group/mod"
+
+	bls12381 "github.com/kilic/bls12-381"
+)
+
+// marshaled sizes of the compressed G1 and G2 points,
+// and of the GT elements.
+const (
+	g1Size = 48
+	g2Size = 96
+	gtSize = 576
+)
+
+// g1Domain is the hash to curve domain of BLS signatures
+// on G1, from the minimal-signature-size ciphersuite.
+var g1Domain = []byte("BLS_SIG_BLS12381G1_XMD:SHA-256_SSWU_RO_NUL_")
+
+// marshal ids of the points, for go.dedis.ch/protobuf interfaces.
+var (
+	marshalPointG1ID = [8]byte{'b', 'l', 's', '1', '2', '.', 'g', '1'}
+	marshalPointG2ID = [8]byte{'b', 'l', 's', '1', '2', '.', 'g', '2'}
+	marshalPointGTID = [8]byte{'b', 'l', 's', '1', '2', '.', 'g', 't'}
+)
+
+var errEmbed = errors.New("bls12-381: embedding data isn't supported")
+
+// scalarBig returns the value of a scalar of the suite.
+func scalarBig(s kyber.Scalar) *big.Int {
+	return &s.(*mod.Int).V
+}
+
+// pickScalar picks a random non zero scalar.
+func pickScalar(rand cipher.Stream) *big.Int {
+	for {
+		s := scalarBig(mod.NewInt64(0, order).Pick(rand))
+		if s.Sign() != 0 {
+			return s
+		}
+	}
+}
+
+func marshalTo(p kyber.Point, w io.Writer) (int, error) {
+	buf, err := p.MarshalBinary()
+	if err != nil {
+		return 0, err
+	}
+	return w.Write(buf)
+}
+
+func unmarshalFrom(p kyber.Point, r io.Reader) (int, error) {
+	buf := make([]byte, p.MarshalSize())
+	n, err := io.ReadFull(r, buf)
+	if err != nil {
+		return n, err
+	}
+	return n, p.UnmarshalBinary(buf)
+}
+
+// pointG1 is a point of the G1 group. The kilic groups keep
+// temporary values, so each operation uses its own group.
+type pointG1 struct {
+	p *bls12381.PointG1
+}
+
+func newPointG1() *pointG1 {
+	return &pointG1{p: bls12381.NewG1().Zero()}
+}
+
+func (p *pointG1) Equal(q kyber.Point) bool {
+	return bls12381.NewG1().Equal(p.p, q.(*pointG1).p)
+}
+
+func (p *pointG1) Null() kyber.Point {
+	p.p = bls12381.NewG1().Zero()
+	return p
+}
+
+func (p *pointG1) Base() kyber.Point {
+	p.p = bls12381.NewG1().One()
+	return p
+}
+
+func (p *pointG1) Pick(rand cipher.Stream) kyber.Point {
+	g := bls12381.NewG1()
+	p.p = g.MulScalarBig(g.New(), g.One(), pickScalar(rand))
+	return p
+}
+
+func (p *pointG1) Set(q kyber.Point) kyber.Point {
+	p.p = new(bls12381.PointG1).Set(q.(*pointG1).p)
+	return p
+}
+
+func (p *pointG1) Clone() kyber.Point {
+	return &pointG1{p: new(bls12381.PointG1).Set(p.p)}
+}
+
+func (p *pointG1) EmbedLen() int {
+	return 0
+}
+
+func (p *pointG1) Embed(data []byte, rand cipher.Stream) kyber.Point {
+	if len(data) > 0 {
+		panic(errEmbed)
+	}
+	return p.Pick(rand)
+}
+
+func (p *pointG1) Data() ([]byte, error) {
+	return nil, errEmbed
+}
+
+func (p *pointG1) Add(a, b kyber.Point) kyber.Point {
+	g := bls12381.NewG1()
+	p.p = g.Add(g.New(), a.(*pointG1).p, b.(*pointG1).p)
+	return p
+}
+
+func (p *pointG1) Sub(a, b kyber.Point) kyber.Point {
+	g := bls12381.NewG1()
+	p.p = g.Sub(g.New(), a.(*pointG1).p, b.(*pointG1).p)
+	return p
+}
+
+func (p *pointG1) Neg(a kyber.Point) kyber.Point {
+	g := bls12381.NewG1()
+	p.p = g.Neg(g.New(), a.(*pointG1).p)
+	return p
+}
+
+func (p *pointG1) Mul(s kyber.Scalar, q kyber.Point) kyber.Point {
+	g := bls12381.NewG1()
+	base := g.One()
+	if q != nil {
+		base = q.(*pointG1).p
+	}
+	p.p = g.MulScalarBig(g.New(), base, scalarBig(s))
+	return p
+}
+
+// Hash hashes the message to a point, for BLS signatures.
+func (p *pointG1) Hash(msg []byte) kyber.Point {
+	hashed, err := bls12381.NewG1().HashToCurve(msg, g1Domain)
+	if err != nil {
+		// only fails on oversized domains.
+		panic(err)
+	}
+	p.p = hashed
+	return p
+}
+
+func (p *pointG1) MarshalBinary() ([]byte, error) {
+	// compression turns the point into its affine form in place.
+	return bls12381.NewG1().ToCompressed(new(bls12381.PointG1).Set(p.p)), nil
+}
+
+func (p *pointG1) UnmarshalBinary(buf []byte) error {
+	q, err := bls12381.NewG1().FromCompressed(buf)
+	if err != nil {
+		return err
+	}
+	p.p = q
+	return nil
+}
+
+func (p *pointG1) MarshalID() [8]byte                     { return marshalPointG1ID }
+func (p *pointG1) MarshalSize() int                       { return g1Size }
+func (p *pointG1) MarshalTo(w io.Writer) (int, error)     { return marshalTo(p, w) }
+func (p *pointG1) UnmarshalFrom(r io.Reader) (int, error) { return unmarshalFrom(p, r) }
+
+func (p *pointG1) String() string {
+	buf, _ := p.MarshalBinary()
+	return "bls12-381.G1:" + hex.EncodeToString(buf)
+}
+
+// pointG2 is a point of the G2 group.
+type pointG2 struct {
+	p *bls12381.PointG2
+}
+
+func newPointG2() *pointG2 {
+	return &pointG2{p: bls12381.NewG2().Zero()}
+}
+
+func (p *pointG2) Equal(q kyber.Point) bool {
+	return bls12381.NewG2().Equal(p.p, q.(*pointG2).p)
+}
+
+func (p *pointG2) Null() kyber.Point {
+	p.p = bls12381.NewG2().Zero()
+	return p
+}
+
+func (p *pointG2) Base() kyber.Point {
+	p.p = bls12381.NewG2().One()
+	return p
+}
+
+func (p *pointG2) Pick(rand cipher.Stream) kyber.Point {
+	g := bls12381.NewG2()
+	p.p = g.MulScalarBig(g.New(), g.One(), pickScalar(rand))
+	return p
+}
+
+func (p *pointG2) Set(q kyber.Point) kyber.Point {
+	p.p = new(bls12381.PointG2).Set(q.(*pointG2).p)
+	return p
+}
+
+func (p *pointG2) Clone() kyber.Point {
+	return &pointG2{p: new(bls12381.PointG2).Set(p.p)}
+}
+
+func (p *pointG2) EmbedLen() int {
+	return 0
+}
+
+func (p *pointG2) Embed(data []byte, rand cipher.Stream) kyber.Point {
+	if len(data) > 0 {
+		panic(errEmbed)
+	}
+	return p.Pick(rand)
+}
+
+func (p *pointG2) Data() ([]byte, error) {
+	return nil, errEmbed
+}
+
+func (p *pointG2) Add(a, b kyber.Point) kyber.Point {
+	g := bls12381.NewG2()
+	p.p = g.Add(g.New(), a.(*pointG2).p, b.(*pointG2).p)
+	return p
+}
+
+func (p *pointG2) Sub(a, b kyber.Point) kyber.Point {
+	g := bls12381.NewG2()
+	p.p = g.Sub(g.New(), a.(*pointG2).p, b.(*pointG2).p)
+	return p
+}
+
+func (p *pointG2) Neg(a kyber.Point) kyber.Point {
+	g := bls12381.NewG2()
+	p.p = g.Neg(g.New(), a.(*pointG2).p)
+	return p
+}
+
+func (p *pointG2) Mul(s kyber.Scalar, q kyber.Point) kyber.Point {
+	g := bls12381.NewG2()
+	base := g.One()
+	if q != nil {
+		base = q.(*pointG2).p
+	}
+	p.p = g.MulScalarBig(g.New(), base, scalarBig(s))
+	return p
+}
+
+func (p *pointG2) MarshalBinary() ([]byte, error) {
+	return bls12381.NewG2().ToCompressed(new(bls12381.PointG2).Set(p.p)), nil
+}
+
+func (p *pointG2) UnmarshalBinary(buf []byte) error {
+	q, err := bls12381.NewG2().FromCompressed(buf)
+	if err != nil {
+		return err
+	}
+	p.p = q
+	return nil
+}
+
+func (p *pointG2) MarshalID() [8]byte                     { return marshalPointG2ID }
+func (p *pointG2) MarshalSize() int                       { return g2Size }
+func (p *pointG2) MarshalTo(w io.Writer) (int, error)     { return marshalTo(p, w) }
+func (p *pointG2) UnmarshalFrom(r io.Reader) (int, error) { return unmarshalFrom(p, r) }
+
+func (p *pointG2) String() string {
+	buf, _ := p.MarshalBinary()
+	return "bls12-381.G2:" + hex.EncodeToString(buf)
+}
+
+// pointGT is an element of the GT group. GT is multiplicative,
+// so the kyber group operations are multiplications and powers.
+type pointGT struct {
+	e *bls12381.E
+}
+
+func newPointGT() *pointGT {
+	return &pointGT{e: bls12381.NewGT().New()}
+}
+
+func (p *pointGT) Equal(q kyber.Point) bool {
+	return p.e.Equal(q.(*pointGT).e)
+}
+
+func (p *pointGT) Null() kyber.Point {
+	p.e = bls12381.NewGT().New()
+	return p
+}
+
+// Base is the pairing of the G1 and G2 generators.
+func (p *pointGT) Base() kyber.Point {
+	p.e = bls12381.NewEngine().AddPair(bls12381.NewG1().One(), bls12381.NewG2().One()).Result()
+	return p
+}
+
+func (p *pointGT) Pick(rand cipher.Stream) kyber.Point {
+	base := newPointGT().Base().(*pointGT)
+	gt := bls12381.NewGT()
+	p.e = gt.New()
+	gt.Exp(p.e, base.e, pickScalar(rand))
+	return p
+}
+
+func (p *pointGT) Set(q kyber.Point) kyber.Point {
+	p.e = new(bls12381.E).Set(q.(*pointGT).e)
+	return p
+}
+
+func (p *pointGT) Clone() kyber.Point {
+	return &pointGT{e: new(bls12381.E).Set(p.e)}
+}
+
+func (p *pointGT) EmbedLen() int {
+	return 0
+}
+
+func (p *pointGT) Embed(data []byte, rand cipher.Stream) kyber.Point {
+	if len(data) > 0 {
+		panic(errEmbed)
+	}
+	return p.Pick(rand)
+}
+
+func (p *pointGT) Data() ([]byte, error) {
+	return nil, errEmbed
+}
+
+func (p *pointGT) Add(a, b kyber.Point) kyber.Point {
+	gt := bls12381.NewGT()
+	e := gt.New()
+	gt.Mul(e, a.(*pointGT).e, b.(*pointGT).e)
+	p.e = e
+	return p
+}
+
+func (p *pointGT) Sub(a, b kyber.Point) kyber.Point {
+	gt := bls12381.NewGT()
+	inv := gt.New()
+	gt.Inverse(inv, b.(*pointGT).e)
+	e := gt.New()
+	gt.Mul(e, a.(*pointGT).e, inv)
+	p.e = e
+	return p
+}
+
+func (p *pointGT) Neg(a kyber.Point) kyber.Point {
+	gt := bls12381.NewGT()
+	e := gt.New()
+	gt.Inverse(e, a.(*pointGT).e)
+	p.e = e
+	return p
+}
+
+func (p *pointGT) Mul(s kyber.Scalar, q kyber.Point) kyber.Point {
+	if q == nil {
+		q = newPointGT().Base()
+	}
+	gt := bls12381.NewGT()
+	e := gt.New()
+	gt.Exp(e, q.(*pointGT).e, scalarBig(s))
+	p.e = e
+	return p
+}
+
+func (p *pointGT) MarshalBinary() ([]byte, error) {
+	return bls12381.NewGT().ToBytes(p.e), nil
+}
+
+func (p *pointGT) UnmarshalBinary(buf []byte) error {
+	e, err := bls12381.NewGT().FromBytes(buf)
+	if err != nil {
+		return err
+	}
+	p.e = e
+	return nil
+}
+
+func (p *pointGT) MarshalID() [8]byte                     { return marshalPointGTID }
+func (p *pointGT) MarshalSize() int                       { return gtSize }
+func (p *pointGT) MarshalTo(w io.Writer) (int, error)     { return marshalTo(p, w) }
+func (p *pointGT) UnmarshalFrom(r io.Reader) (int, error) { return unmarshalFrom(p, r) }
+
+func (p *pointGT) String() string {
+	buf, _ := p.MarshalBinary()
+	return "bls12-381.GT:" + hex.EncodeToString(buf)
+}
@@ -0,0 +1,136 @@
+// Package bls12381 implements the kyber pairing.Suite of the BLS12-381
+// curve, based on github.com/kilic/bls12-381.
+//
+// The suite group is G2, where the pairing keys live, and BLS signatures
+// are points of G1, hashed to the curve as in the BLS signature draft
+// minimal-signature-size ciphersuite.
+package bls12381
+
+import (
+	"crypto/cipher"
+	"crypto/sha256"
+	"hash"
+	"io"
+	"reflect"
+
+	"go.dedis.ch/fixbuf"
+	"go.dedis.ch/kyber/v3"
+	"go.dedis.ch/kyber/v3/group/mod"
+	"go.dedis.ch/kyber/v3/util/random"
+	"go.dedis.ch/kyber/v3/xof/blake2xb"
+
+	bls12381 "github.com/kilic/bls12-381"
+)
+
+// order is the order of the G1, G2 and GT groups.
+var order = bls12381.NewG1().Q()
+
+// Suite is the BLS12-381 pairing suite. It's a kyber suite
+// of the G2 group, with the G1 and GT groups of the pairing.
+type Suite struct {
+	groupG2
+	g1 groupG1
+	gt groupGT
+	r  cipher.Stream
+}
+
+// NewSuite returns the BLS12-381 pairing suite.
+func NewSuite() *Suite {
+	return &Suite{}
+}
+
+func (s *Suite) G1() kyber.Group {
+	return &s.g1
+}
+
+func (s *Suite) G2() kyber.Group {
+	return &s.groupG2
+}
+
+func (s *Suite) GT() kyber.Group {
+	return &s.gt
+}
+
+// Pair computes the pairing of the G1 and G2 points, in GT.
+func (s *Suite) Pair(p1, p2 kyber.Point) kyber.Point {
+	// the engine turns the points into their affine form in
+	// place, so it works on copies of the shared points.
+	a := new(bls12381.PointG1).Set(p1.(*pointG1).p)
+	b := new(bls12381.PointG2).Set(p2.(*pointG2).p)
+	return &pointGT{e: bls12381.NewEngine().AddPair(a, b).Result()}
+}
+
+func (s *Suite) String() string {
+	return "bls12-381"
+}
+
+// Hash returns a new SHA-256 hash.
+func (s *Suite) Hash() hash.Hash {
+	return sha256.New()
+}
+
+// XOF returns a new blake2xb XOF.
+func (s *Suite) XOF(seed []byte) kyber.XOF {
+	return blake2xb.New(seed)
+}
+
+// RandomStream returns a stream of random bytes from crypto/rand.
+func (s *Suite) RandomStream() cipher.Stream {
+	if s.r != nil {
+		return s.r
+	}
+	return random.New()
+}
+
+func (s *Suite) Read(r io.Reader, objs ...interface{}) error {
+	return fixbuf.Read(r, s, objs...)
+}
+
+func (s *Suite) Write(w io.Writer, objs ...interface{}) error {
+	return fixbuf.Write(w, objs)
+}
+
+var aScalar kyber.Scalar
+var tScalar = reflect.TypeOf(&aScalar).Elem()
+var aPoint kyber.Point
+var tPoint = reflect.TypeOf(&aPoint).Elem()
+
+// New returns a new scalar or G2 point, for the kyber.Encoding interface.
+func (s *Suite) New(t reflect.Type) interface{} {
+	switch t {
+	case tScalar:
+		return s.Scalar()
+	case tPoint:
+		return s.Point()
+	}
+	return nil
+}
+
+// group has the scalars shared by the pairing groups.
+type group struct{}
+
+func (group) ScalarLen() int {
+	return mod.NewInt64(0, order).MarshalSize()
+}
+
+func (group) Scalar() kyber.Scalar {
+	return mod.NewInt64(0, order)
+}
+
+type groupG1 struct{ group }
+
+func (*groupG1) String() string     { return "bls12-381.G1" }
+func (*groupG1) PointLen() int      { return g1Size }
+func (*groupG1) Point() kyber.Point { return newPointG1() }
+
+type groupG2 struct{ group }
+
+func (*groupG2) String() string     { return "bls12-381.G2" }
+func (*groupG2) PointLen() int      { return g2Size }
+func (*groupG2) Point() kyber.Point { return newPointG2() }
+
+type groupGT struct{ group }
+
+func (*groupGT) String() string     { return "bls12-381.GT" }
+func (*groupGT) PointLen() int      { return gtSize }
+func (*groupGT) Point() kyber.Point { return newPointGT() }
@@ -0,0 +1,84 @@
+package bls12381
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.dedis.ch/kyber/v3/sign/bls"
+	"go.dedis.ch/kyber/v3/util/random"
+)
+
+func TestPairing(t *testing.T) {
+	ste := NewSuite()
+	a := ste.G1().Scalar().Pick(random.New())
+	b := ste.G2().Scalar().Pick(random.New())
+
+	p1 := ste.G1().Point().Mul(a, nil)
+	p2 := ste.G2().Point().Mul(b, nil)
+
+	// e(aG1, bG2) = e(G1, G2)^ab
+	ab := ste.GT().Scalar().Mul(a, b)
+	want := ste.GT().Point().Mul(ab, nil)
+	require.True(t, ste.Pair(p1, p2).Equal(want))
+
+	// e(aG1, G2) = e(G1, aG2)
+	g1, g2 := ste.G1().Point().Base(), ste.G2().Point().Base()
+	require.True(t, ste.Pair(p1, g2).Equal(ste.Pair(g1, ste.G2().Point().Mul(a, nil))))
+
+	// e(G1, G2)^a / e(G1, G2)^a = 1
+	gt := ste.Pair(p1, g2)
+	require.True(t, ste.GT().Point().Sub(gt, gt).Equal(ste.GT().Point().Null()))
+}
+
+func TestPointOperations(t *testing.T) {
+	ste := NewSuite()
+	s := ste.Scalar().Pick(random.New())
+	u := ste.Scalar().Pick(random.New())
+
+	for _, grp := range []string{"G1", "G2", "GT"} {
+		group := ste.G1()
+		switch grp {
+		case "G2":
+			group = ste.G2()
+		case "GT":
+			group = ste.GT()
+		}
+
+		// (s+u)B = sB + uB
+		sum := group.Point().Add(group.Point().Mul(s, nil), group.Point().Mul(u, nil))
+		require.True(t, sum.Equal(group.Point().Mul(ste.Scalar().Add(s, u), nil)), grp)
+
+		// sB - sB = 0
+		p := group.Point().Mul(s, nil)
+		require.True(t, group.Point().Sub(p, p).Equal(group.Point().Null()), grp)
+		require.True(t, group.Point().Add(p, group.Point().Neg(p)).Equal(group.Point().Null()), grp)
+
+		buf, err := p.MarshalBinary()
+		require.NoError(t, err, grp)
+		require.Len(t, buf, group.PointLen(), grp)
+
+		q := group.Point()
+		require.NoError(t, q.UnmarshalBinary(buf), grp)
+		require.True(t, q.Equal(p), grp)
+		require.True(t, p.Clone().Equal(p), grp)
+	}
+}
+
+func TestPointUnmarshalInvalid(t *testing.T) {
+	ste := NewSuite()
+	require.Error(t, ste.G1().Point().UnmarshalBinary(make([]byte, g1Size-1)))
+	require.Error(t, ste.G2().Point().UnmarshalBinary([]byte("not a point")))
+}
+
+func TestBLSSignature(t *testing.T) {
+	ste := NewSuite()
+	sk, pk := bls.NewKeyPair(ste, random.New())
+
+	msg := []byte("message")
+	sig, err := bls.Sign(ste, sk, msg)
+	require.NoError(t, err)
+	require.Len(t, sig, g1Size)
+
+	require.NoError(t, bls.Verify(ste, pk, msg, sig))
+	require.Error(t, bls.Verify(ste, pk, []byte("other"), sig))
+}
@@ -1,3 +1,6 @@
 // credit to:
 // https://github.com/smartcontractkit/chainlink/core/services/signatures/secp256k1
+
+// Deprecated: this variable time implementation is kept as the reference
+// of the constant time package secp256k1ct, which replaces it.
 package secp256k1
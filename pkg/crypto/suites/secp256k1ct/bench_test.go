@@ -0,0 +1,97 @@
+package secp256k1ct
+
+import (
+	"testing"
+
+	"go.dedis.ch/kyber/v3"
+	"go.dedis.ch/kyber/v3/xof/blake2xb"
+)
+
+// The benchmarks compare the constant time suite
+// to the reference one it replaces.
+var benchSuites = []struct {
+	name  string
+	suite kyber.Group
+}{
+	{"ct", ct},
+	{"ref", ref},
+}
+
+func BenchmarkPointBaseMul(b *testing.B) {
+	for _, bs := range benchSuites {
+		b.Run(bs.name, func(b *testing.B) {
+			s := bs.suite.Scalar().Pick(blake2xb.New(nil))
+			p := bs.suite.Point()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				p.Mul(s, nil)
+			}
+		})
+	}
+}
+
+func BenchmarkPointMul(b *testing.B) {
+	for _, bs := range benchSuites {
+		b.Run(bs.name, func(b *testing.B) {
+			rnd := blake2xb.New(nil)
+			s := bs.suite.Scalar().Pick(rnd)
+			q := bs.suite.Point().Pick(rnd)
+			p := bs.suite.Point()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				p.Mul(s, q)
+			}
+		})
+	}
+}
+
+func BenchmarkPointAdd(b *testing.B) {
+	for _, bs := range benchSuites {
+		b.Run(bs.name, func(b *testing.B) {
+			rnd := blake2xb.New(nil)
+			p, q := bs.suite.Point().Pick(rnd), bs.suite.Point().Pick(rnd)
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				p.Add(p, q)
+			}
+		})
+	}
+}
+
+func BenchmarkPointMarshal(b *testing.B) {
+	for _, bs := range benchSuites {
+		b.Run(bs.name, func(b *testing.B) {
+			p := bs.suite.Point().Pick(blake2xb.New(nil))
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				buf, _ := p.MarshalBinary()
+				_ = p.UnmarshalBinary(buf)
+			}
+		})
+	}
+}
+
+func BenchmarkScalarMul(b *testing.B) {
+	for _, bs := range benchSuites {
+		b.Run(bs.name, func(b *testing.B) {
+			rnd := blake2xb.New(nil)
+			s, t := bs.suite.Scalar().Pick(rnd), bs.suite.Scalar().Pick(rnd)
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				s.Mul(s, t)
+			}
+		})
+	}
+}
+
+func BenchmarkScalarInv(b *testing.B) {
+	for _, bs := range benchSuites {
+		b.Run(bs.name, func(b *testing.B) {
+			s := bs.suite.Scalar().Pick(blake2xb.New(nil))
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				s.Inv(s)
+			}
+		})
+	}
+}
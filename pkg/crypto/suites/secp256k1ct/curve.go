@@ -0,0 +1,25 @@
+package secp256k1ct
+
+import (
+	"go.dedis.ch/kyber/v3"
+)
+
+// Secp256k1 represents the secp256k1 group.
+// There are no parameters and no initialization is required
+// because it supports only this one specific curve.
+type Secp256k1 struct{}
+
+// String returns the name of the curve
+func (*Secp256k1) String() string { return "Secp256k1" }
+
+// ScalarLen returns the length of a marshalled Scalar
+func (*Secp256k1) ScalarLen() int { return scalarSize }
+
+// Scalar creates a new Scalar for the prime-order group on the secp256k1 curve
+func (*Secp256k1) Scalar() kyber.Scalar { return new(scalar) }
+
+// PointLen returns the length of a marshalled Point
+func (*Secp256k1) PointLen() int { return pointSize }
+
+// Point returns a new secp256k1 point, set to the identity
+func (*Secp256k1) Point() kyber.Point { return newPoint() }
@@ -0,0 +1,14 @@
+// Package secp256k1ct is a constant time implementation of the
+// kyber.{Group,Point,Scalar} interfaces for the secp256k1 curve.
+//
+// The field and scalar arithmetic is decred's secp256k1/v4, which is
+// constant time. Decred's point multiplications aren't, so points are
+// multiplied here with complete projective formulas (Renes, Costello,
+// and Batina, "Complete addition formulas for prime order elliptic
+// curves", 2016) over a fixed 4-bit window, whose table entries are
+// selected in constant time.
+//
+// It is a drop-in replacement of package secp256k1: points and scalars
+// have the same marshalling and MarshalID, and points embed data the
+// same way.
+package secp256k1ct
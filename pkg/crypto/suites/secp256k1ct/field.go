@@ -0,0 +1,41 @@
+package secp256k1ct
+
+// Constant time arithmetic in the base field of secp256k1, on top of decred's
+// field values. Every result is normalized, so the callers don't have to track
+// the field value magnitudes.
+
+import (
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+)
+
+type fieldVal = secp256k1.FieldVal
+
+// b3 is 3*b, where b=7 is the secp256k1 curve constant.
+const b3 = 21
+
+// fieldAdd sets r to a+b.
+func fieldAdd(r, a, b *fieldVal) {
+	r.Add2(a, b).Normalize()
+}
+
+// fieldSub sets r to a-b.
+func fieldSub(r, a, b *fieldVal) {
+	var nb fieldVal
+	nb.NegateVal(b, 1)
+	r.Add2(a, &nb).Normalize()
+}
+
+// fieldMul sets r to a*b.
+func fieldMul(r, a, b *fieldVal) {
+	r.Mul2(a, b).Normalize()
+}
+
+// fieldMulB3 sets r to 3*b*a.
+func fieldMulB3(r, a *fieldVal) {
+	r.Set(a).MulInt(b3).Normalize()
+}
+
+// fieldRHS sets r to x³+7, the right hand side of the curve equation.
+func fieldRHS(r, x *fieldVal) {
+	r.SquareVal(x).Mul(x).AddInt(7).Normalize()
+}
@@ -0,0 +1,421 @@
+package secp256k1ct
+
+// Implementation of kyber.Point interface for constant time elliptic-curve
+// arithmetic operations on secp256k1.
+
+import (
+	"crypto/cipher"
+	"crypto/subtle"
+	"fmt"
+	"io"
+
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"go.dedis.ch/kyber/v3"
+)
+
+const (
+	pointSize = 33
+
+	// windowBits is the scalar multiplication window width.
+	windowBits = 4
+	windowSize = 1 << windowBits
+)
+
+// point is a secp256k1 point in projective coordinates (X:Y:Z), which
+// is the affine point (X/Z, Y/Z), or the identity (0:1:0) when Z is 0.
+// The coordinates are always normalized.
+type point struct {
+	x, y, z fieldVal
+}
+
+func newPoint() *point {
+	p := new(point)
+	p.Null()
+	return p
+}
+
+func toPoint(p kyber.Point) *point {
+	return p.(*point)
+}
+
+// basePoint is the standard group generator.
+var basePoint = func() point {
+	var p point
+	g := secp256k1.Params()
+	p.x.SetByteSlice(g.Gx.Bytes())
+	p.y.SetByteSlice(g.Gy.Bytes())
+	p.z.SetInt(1)
+	return p
+}()
+
+// baseTable is the scalar multiplication window table of the generator.
+var baseTable = newWindowTable(&basePoint)
+
+// String returns a string representation of P
+func (P *point) String() string {
+	x, y, ok := P.affine()
+	if !ok {
+		return "Secp256k1{identity}"
+	}
+	return fmt.Sprintf("Secp256k1{X: %v, Y: %v}", x, y)
+}
+
+// affine returns the affine coordinates of P,
+// and false if P is the identity.
+func (P *point) affine() (x, y fieldVal, ok bool) {
+	if P.z.IsZero() {
+		return x, y, false
+	}
+	var zInv fieldVal
+	zInv.Set(&P.z).Inverse()
+	fieldMul(&x, &P.x, &zInv)
+	fieldMul(&y, &P.y, &zInv)
+	return x, y, true
+}
+
+// Equal returns true if P and pPrime represent the same point, false otherwise.
+func (P *point) Equal(pPrime kyber.Point) bool {
+	Q := toPoint(pPrime)
+	// (X1:Y1:Z1) = (X2:Y2:Z2) iff X1*Z2 = X2*Z1 and Y1*Z2 = Y2*Z1.
+	var l, r fieldVal
+	fieldMul(&l, &P.x, &Q.z)
+	fieldMul(&r, &Q.x, &P.z)
+	eqX := l.Equals(&r)
+	fieldMul(&l, &P.y, &Q.z)
+	fieldMul(&r, &Q.y, &P.z)
+	eqY := l.Equals(&r)
+	return eqX && eqY
+}
+
+// Null sets P to the group-identity value, and returns it.
+func (P *point) Null() kyber.Point {
+	P.x.Zero()
+	P.y.SetInt(1)
+	P.z.Zero()
+	return P
+}
+
+// Base sets P to a copy of the standard group generator, and returns it.
+func (P *point) Base() kyber.Point {
+	*P = basePoint
+	return P
+}
+
+// Pick sets P to a random point sampled from rand, and returns it.
+func (P *point) Pick(rand cipher.Stream) kyber.Point {
+	var b [32]byte
+	for { // Keep trying X's until one fits the curve (~50% probability of
+		// success each iteration
+		rand.XORKeyStream(b[:], b[:])
+		if P.setX(&b) {
+			// Take the negative with 50% probability
+			var s [1]byte
+			rand.XORKeyStream(s[:], s[:])
+			if s[0]&1 == 0 {
+				P.y.Negate(1).Normalize()
+			}
+			return P
+		}
+	}
+}
+
+// setX sets P to the point with the big-endian x ordinate
+// and an even y ordinate, and returns false if there is none.
+func (P *point) setX(x *[32]byte) bool {
+	var px, py fieldVal
+	if px.SetBytes(x) != 0 {
+		return false
+	}
+	if !secp256k1.DecompressY(&px, false, &py) {
+		return false
+	}
+	P.x = px
+	P.y = py
+	P.y.Normalize()
+	P.z.SetInt(1)
+	return true
+}
+
+// Set sets P to copies of pPrime's values, and returns it.
+func (P *point) Set(pPrime kyber.Point) kyber.Point {
+	*P = *toPoint(pPrime)
+	return P
+}
+
+// Clone returns a copy of P.
+func (P *point) Clone() kyber.Point {
+	Q := *P
+	return &Q
+}
+
+// EmbedLen returns the number of bytes of data which can be embedded in a point.
+func (*point) EmbedLen() int {
+	// Reserve the most-significant 8 bits for pseudo-randomness.
+	// Reserve the least-significant 8 bits for embedded data length.
+	return (255 - 8 - 8) / 8
+}
+
+// Embed encodes a limited amount of specified data in the Point, using r as a
+// source of cryptographically secure random data. Implementations only embed
+// the first EmbedLen bytes of the given data.
+//
+// The data is embedded in the x ordinate, like package secp256k1 does: its
+// first byte is the data length, followed by the data, and random bytes.
+func (P *point) Embed(data []byte, r cipher.Stream) kyber.Point {
+	numEmbedBytes := P.EmbedLen()
+	if len(data) > numEmbedBytes {
+		panic("too much data to embed in a point")
+	}
+	numEmbedBytes = len(data)
+	var x [32]byte
+	randStart := 1 // First byte to fill with random data
+	if data != nil {
+		x[0] = byte(numEmbedBytes)       // Encode length in low 8 bits
+		copy(x[1:1+numEmbedBytes], data) // Copy in data to embed
+		randStart = 1 + numEmbedBytes
+	}
+	maxAttempts := 10000
+	// Try random x ordinates satisfying the constraints, until one provides
+	// a point on secp256k1
+	for numAttempts := 0; numAttempts < maxAttempts; numAttempts++ {
+		// Fill the rest of the x ordinate with random data
+		r.XORKeyStream(x[randStart:], x[randStart:])
+		if P.setX(&x) {
+			return P
+		}
+	}
+	// Probability 2^{-maxAttempts}, under correct operation.
+	panic("failed to find point satisfying all constraints")
+}
+
+// Data returns data embedded in P, or an error if inconsistent with encoding
+func (P *point) Data() ([]byte, error) {
+	x, _, ok := P.affine()
+	if !ok {
+		return nil, fmt.Errorf("point at infinity has no data")
+	}
+	b := x.Bytes()
+	dataLength := int(b[0])
+	if dataLength > P.EmbedLen() {
+		return nil, fmt.Errorf("point specifies too much data")
+	}
+	return b[1 : dataLength+1], nil
+}
+
+// Add sets P to a+b (secp256k1 group operation) and returns it.
+func (P *point) Add(a, b kyber.Point) kyber.Point {
+	add(P, toPoint(a), toPoint(b))
+	return P
+}
+
+// Sub sets P to a-b (secp256k1 group operation), and returns it.
+func (P *point) Sub(a, b kyber.Point) kyber.Point {
+	var nb point
+	nb.Neg(b)
+	add(P, toPoint(a), &nb)
+	return P
+}
+
+// Neg sets P to -a (in the secp256k1 group), and returns it.
+func (P *point) Neg(a kyber.Point) kyber.Point {
+	A := toPoint(a)
+	P.x = A.x
+	P.y.NegateVal(&A.y, 1).Normalize()
+	P.z = A.z
+	return P
+}
+
+// Mul sets P to s*a (in the secp256k1 group, i.e. adding a to itself s times),
+// and returns it. If a is nil, it is replaced by the secp256k1 generator.
+func (P *point) Mul(s kyber.Scalar, a kyber.Point) kyber.Point {
+	table := &baseTable
+	if a != nil && a != (*point)(nil) {
+		table = new(windowTable)
+		*table = newWindowTable(toPoint(a))
+	}
+	k := toScalar(s).v.Bytes()
+
+	// fixed window double and add, from the most significant window.
+	var R, T point
+	R.Null()
+	for _, b := range k {
+		for _, w := range [2]byte{b >> windowBits, b & (windowSize - 1)} {
+			for i := 0; i < windowBits; i++ {
+				double(&R, &R)
+			}
+			table.lookup(&T, w)
+			add(&R, &R, &T)
+		}
+	}
+
+	*P = R
+	return P
+}
+
+// MarshalBinary returns the concatenated big-endian representation of the X
+// ordinate and a byte which is 0 if Y is even, 1 if it's odd. Or it returns an
+// error on failure, notably for the identity, which isn't a curve point.
+func (P *point) MarshalBinary() ([]byte, error) {
+	x, y, ok := P.affine()
+	if !ok {
+		return nil, fmt.Errorf("point at infinity can't be marshaled")
+	}
+	rv := make([]byte, P.MarshalSize())
+	x.PutBytesUnchecked(rv[:32])
+	if y.IsOdd() {
+		rv[32] = 1
+	}
+	return rv, nil
+}
+
+// MarshalSize returns the length of the byte representation of P
+func (P *point) MarshalSize() int { return pointSize }
+
+// MarshalID returns the ID for a secp256k1 point
+func (P *point) MarshalID() [8]byte {
+	return [8]byte{'s', 'p', '2', '5', '6', '.', 'p', 'o'}
+}
+
+// UnmarshalBinary sets P to the point represented by contents of buf, or
+// returns an non-nil error
+func (P *point) UnmarshalBinary(buf []byte) error {
+	if len(buf) != P.MarshalSize() {
+		return fmt.Errorf("wrong length for marshaled point")
+	}
+	if !(buf[32] == 0 || buf[32] == 1) {
+		return fmt.Errorf("bad sign byte (the last one)")
+	}
+
+	var x, y fieldVal
+	if x.SetByteSlice(buf[:32]) {
+		return fmt.Errorf("x ordinate not in the field")
+	}
+	if !secp256k1.DecompressY(&x, buf[32] == 1, &y) {
+		return fmt.Errorf("x ordinate does not correspond to a curve point")
+	}
+	P.x = x
+	P.y = y
+	P.y.Normalize()
+	P.z.SetInt(1)
+	return nil
+}
+
+// MarshalTo writes the serialized P to w, and returns the number of bytes
+// written, or an error on failure.
+func (P *point) MarshalTo(w io.Writer) (int, error) {
+	buf, err := P.MarshalBinary()
+	if err != nil {
+		return 0, err
+	}
+	return w.Write(buf)
+}
+
+// UnmarshalFrom sets P to the secp256k1 point represented by bytes read from r,
+// and returns the number of bytes read, or an error on failure.
+func (P *point) UnmarshalFrom(r io.Reader) (int, error) {
+	buf := make([]byte, P.MarshalSize())
+	n, err := io.ReadFull(r, buf)
+	if err != nil {
+		return 0, err
+	}
+	return n, P.UnmarshalBinary(buf)
+}
+
+// add sets r to p+q with the complete addition formulas for a=0
+// (algorithm 7 of Renes, Costello, and Batina), which have no special
+// case for the identity or doubling. r can alias p or q.
+func add(r, p, q *point) {
+	var t0, t1, t2, t3, t4, x3, y3, z3 fieldVal
+
+	fieldMul(&t0, &p.x, &q.x)
+	fieldMul(&t1, &p.y, &q.y)
+	fieldMul(&t2, &p.z, &q.z)
+	fieldAdd(&t3, &p.x, &p.y)
+	fieldAdd(&t4, &q.x, &q.y)
+	fieldMul(&t3, &t3, &t4)
+	fieldAdd(&t4, &t0, &t1)
+	fieldSub(&t3, &t3, &t4)
+	fieldAdd(&t4, &p.y, &p.z)
+	fieldAdd(&x3, &q.y, &q.z)
+	fieldMul(&t4, &t4, &x3)
+	fieldAdd(&x3, &t1, &t2)
+	fieldSub(&t4, &t4, &x3)
+	fieldAdd(&x3, &p.x, &p.z)
+	fieldAdd(&y3, &q.x, &q.z)
+	fieldMul(&x3, &x3, &y3)
+	fieldAdd(&y3, &t0, &t2)
+	fieldSub(&y3, &x3, &y3)
+	fieldAdd(&x3, &t0, &t0)
+	fieldAdd(&t0, &x3, &t0)
+	fieldMulB3(&t2, &t2)
+	fieldAdd(&z3, &t1, &t2)
+	fieldSub(&t1, &t1, &t2)
+	fieldMulB3(&y3, &y3)
+	fieldMul(&x3, &t4, &y3)
+	fieldMul(&t2, &t3, &t1)
+	fieldSub(&x3, &t2, &x3)
+	fieldMul(&y3, &y3, &t0)
+	fieldMul(&t1, &t1, &z3)
+	fieldAdd(&y3, &t1, &y3)
+	fieldMul(&t0, &t0, &t3)
+	fieldMul(&z3, &z3, &t4)
+	fieldAdd(&z3, &z3, &t0)
+
+	r.x, r.y, r.z = x3, y3, z3
+}
+
+// double sets r to 2p with the complete doubling formulas for a=0
+// (algorithm 9 of Renes, Costello, and Batina). r can alias p.
+func double(r, p *point) {
+	var t0, t1, t2, x3, y3, z3 fieldVal
+
+	fieldMul(&t0, &p.y, &p.y)
+	fieldAdd(&z3, &t0, &t0)
+	fieldAdd(&z3, &z3, &z3)
+	fieldAdd(&z3, &z3, &z3)
+	fieldMul(&t1, &p.y, &p.z)
+	fieldMul(&t2, &p.z, &p.z)
+	fieldMulB3(&t2, &t2)
+	fieldMul(&x3, &t2, &z3)
+	fieldAdd(&y3, &t0, &t2)
+	fieldMul(&z3, &t1, &z3)
+	fieldAdd(&t1, &t2, &t2)
+	fieldAdd(&t2, &t1, &t2)
+	fieldSub(&t0, &t0, &t2)
+	fieldMul(&y3, &t0, &y3)
+	fieldAdd(&y3, &x3, &y3)
+	fieldMul(&t1, &p.x, &p.y)
+	fieldMul(&x3, &t0, &t1)
+	fieldAdd(&x3, &x3, &x3)
+
+	r.x, r.y, r.z = x3, y3, z3
+}
+
+// windowTable holds the multiples 0P, 1P, ..., 15P of a point,
+// as their serialized coordinates for constant time lookups.
+type windowTable [windowSize][3 * 32]byte
+
+func newWindowTable(p *point) windowTable {
+	var table windowTable
+	var m point
+	m.Null()
+	for i := range table {
+		m.x.PutBytesUnchecked(table[i][0:32])
+		m.y.PutBytesUnchecked(table[i][32:64])
+		m.z.PutBytesUnchecked(table[i][64:96])
+		add(&m, &m, p)
+	}
+	return table
+}
+
+// lookup sets r to the multiple w of the table point, reading
+// every entry so the memory access pattern doesn't depend on w.
+func (t *windowTable) lookup(r *point, w byte) {
+	var buf [3 * 32]byte
+	for i := range t {
+		subtle.ConstantTimeCopy(subtle.ConstantTimeByteEq(byte(i), w), buf[:], t[i][:])
+	}
+	r.x.SetByteSlice(buf[0:32])
+	r.y.SetByteSlice(buf[32:64])
+	r.z.SetByteSlice(buf[64:96])
+}
@@ -0,0 +1,74 @@
+package secp256k1ct
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.dedis.ch/kyber/v3/xof/blake2xb"
+)
+
+func TestPointGroupLaws(t *testing.T) {
+	rnd := blake2xb.New([]byte("secp256k1ct group laws"))
+	for i := 0; i < numVectors; i++ {
+		p, q := ct.Point().Pick(rnd), ct.Point().Pick(rnd)
+		a, b := ct.Scalar().Pick(rnd), ct.Scalar().Pick(rnd)
+
+		require.True(t, ct.Point().Add(p, ct.Point().Null()).Equal(p), "identity")
+		require.True(t, ct.Point().Add(p, ct.Point().Neg(p)).Equal(ct.Point().Null()), "inverse")
+		require.True(t, ct.Point().Add(p, q).Equal(ct.Point().Add(q, p)), "commutativity")
+
+		// (a+b)P = aP + bP
+		sum := ct.Point().Mul(ct.Scalar().Add(a, b), p)
+		require.True(t, sum.Equal(ct.Point().Add(ct.Point().Mul(a, p), ct.Point().Mul(b, p))))
+
+		// a(bG) = (ab)G
+		require.True(t, ct.Point().Mul(a, ct.Point().Mul(b, nil)).Equal(ct.Point().Mul(ct.Scalar().Mul(a, b), nil)))
+
+		// aliasing
+		r := p.Clone()
+		r.Add(r, r)
+		require.True(t, r.Equal(ct.Point().Mul(ct.Scalar().SetInt64(2), p)))
+		r = p.Clone()
+		r.Mul(a, r)
+		require.True(t, r.Equal(ct.Point().Mul(a, p)))
+	}
+}
+
+func TestPointClone(t *testing.T) {
+	rnd := blake2xb.New([]byte("secp256k1ct clone"))
+	p := ct.Point().Pick(rnd)
+	q := p.Clone()
+	require.True(t, p.Equal(q))
+	q.Add(q, ct.Point().Base())
+	require.False(t, p.Equal(q), "modifying a clone shouldn't change original")
+}
+
+func TestPointMarshalTo(t *testing.T) {
+	rnd := blake2xb.New([]byte("secp256k1ct marshal"))
+	p := ct.Point().Pick(rnd)
+	var b bytes.Buffer
+	n, err := p.MarshalTo(&b)
+	require.NoError(t, err)
+	require.Equal(t, ct.PointLen(), n)
+
+	q := ct.Point()
+	n, err = q.UnmarshalFrom(&b)
+	require.NoError(t, err)
+	require.Equal(t, ct.PointLen(), n)
+	require.True(t, p.Equal(q))
+}
+
+func TestPointData(t *testing.T) {
+	rnd := blake2xb.New([]byte("secp256k1ct data"))
+	p := ct.Point()
+	require.Panics(t, func() { p.Embed(make([]byte, p.EmbedLen()+1), rnd) })
+
+	var x [32]byte
+	x[0] = 30
+	for !p.(*point).setX(&x) {
+		x[31]++
+	}
+	_, err := p.Data()
+	require.ErrorContains(t, err, "specifies too much data")
+}
@@ -0,0 +1,210 @@
+package secp256k1ct
+
+// Implementation of kyber.Scalar interface for constant time arithmetic
+// operations mod the order of the secp256k1 group, backed by decred's
+// ModNScalar.
+
+import (
+	"crypto/cipher"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math/big"
+
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"go.dedis.ch/kyber/v3"
+	"go.dedis.ch/kyber/v3/util/random"
+)
+
+const scalarSize = 32
+
+// GroupOrder is the order of the secp256k1 group.
+var GroupOrder = secp256k1.S256().N
+
+// orderMinus2 is the big-endian exponent inverting scalars, n-2.
+var orderMinus2 = func() (e [32]byte) {
+	new(big.Int).Sub(GroupOrder, big.NewInt(2)).FillBytes(e[:])
+	return e
+}()
+
+type scalar struct {
+	v secp256k1.ModNScalar
+}
+
+func toScalar(s kyber.Scalar) *scalar {
+	return s.(*scalar)
+}
+
+// String returns the hexadecimal representation of s
+func (s *scalar) String() string {
+	b := s.v.Bytes()
+	return fmt.Sprintf("scalar{%x}", new(big.Int).SetBytes(b[:]))
+}
+
+// Equal returns true if s and sPrime represent the same value
+// modulo the group order, false otherwise
+func (s *scalar) Equal(sPrime kyber.Scalar) bool {
+	return s.v.Equals(&toScalar(sPrime).v)
+}
+
+// Set copies sPrime's value to s, and returns it
+func (s *scalar) Set(sPrime kyber.Scalar) kyber.Scalar {
+	s.v.Set(&toScalar(sPrime).v)
+	return s
+}
+
+// Clone returns a copy of s
+func (s *scalar) Clone() kyber.Scalar {
+	return &scalar{v: s.v}
+}
+
+// SetInt64 returns s with value set to v modulo GroupOrder
+func (s *scalar) SetInt64(v int64) kyber.Scalar {
+	abs := uint64(v)
+	if v < 0 {
+		abs = uint64(-v)
+	}
+	var b [8]byte
+	binary.BigEndian.PutUint64(b[:], abs)
+	s.v.SetByteSlice(b[:])
+	if v < 0 {
+		s.v.Negate()
+	}
+	return s
+}
+
+// Zero sets s to 0, and returns it
+func (s *scalar) Zero() kyber.Scalar {
+	s.v.Zero()
+	return s
+}
+
+// One sets s to 1, and returns it
+func (s *scalar) One() kyber.Scalar {
+	s.v.SetInt(1)
+	return s
+}
+
+// Add sets s to a+b mod GroupOrder, and returns it
+func (s *scalar) Add(a, b kyber.Scalar) kyber.Scalar {
+	s.v.Add2(&toScalar(a).v, &toScalar(b).v)
+	return s
+}
+
+// Sub sets s to a-b mod GroupOrder, and returns it
+func (s *scalar) Sub(a, b kyber.Scalar) kyber.Scalar {
+	var nb secp256k1.ModNScalar
+	nb.NegateVal(&toScalar(b).v)
+	s.v.Add2(&toScalar(a).v, &nb)
+	return s
+}
+
+// Neg sets s to -a mod GroupOrder, and returns it
+func (s *scalar) Neg(a kyber.Scalar) kyber.Scalar {
+	s.v.NegateVal(&toScalar(a).v)
+	return s
+}
+
+// Mul sets s to a*b mod GroupOrder, and returns it
+func (s *scalar) Mul(a, b kyber.Scalar) kyber.Scalar {
+	s.v.Mul2(&toScalar(a).v, &toScalar(b).v)
+	return s
+}
+
+// Div sets s to a*b⁻¹ mod GroupOrder, and returns it
+func (s *scalar) Div(a, b kyber.Scalar) kyber.Scalar {
+	var inv scalar
+	inv.Inv(b)
+	s.v.Mul2(&toScalar(a).v, &inv.v)
+	return s
+}
+
+// Inv sets s to a⁻¹ mod GroupOrder, and returns it. Unlike decred's
+// inversion, it runs in constant time, as a^(GroupOrder-2).
+func (s *scalar) Inv(a kyber.Scalar) kyber.Scalar {
+	base := toScalar(a).v
+	if base.IsZero() {
+		panic("attempt to divide by zero")
+	}
+
+	var r secp256k1.ModNScalar
+	r.SetInt(1)
+	// the exponent is public, so branching on its bits doesn't leak a.
+	for _, b := range orderMinus2 {
+		for i := 7; i >= 0; i-- {
+			r.Square()
+			if b>>i&1 == 1 {
+				r.Mul(&base)
+			}
+		}
+	}
+	s.v = r
+	return s
+}
+
+// Pick sets s to a random value mod GroupOrder sampled from rand, and returns
+// it
+func (s *scalar) Pick(rand cipher.Stream) kyber.Scalar {
+	var b [scalarSize]byte
+	random.Int(GroupOrder, rand).FillBytes(b[:])
+	s.v.SetBytes(&b)
+	return s
+}
+
+// SetBytes sets s to the number with big-endian representation a mod
+// GroupOrder, and returns it. Values longer than 32 bytes are reduced
+// in variable time.
+func (s *scalar) SetBytes(a []byte) kyber.Scalar {
+	if len(a) > scalarSize {
+		var b [scalarSize]byte
+		new(big.Int).Mod(new(big.Int).SetBytes(a), GroupOrder).FillBytes(b[:])
+		a = b[:]
+	}
+	s.v.SetByteSlice(a)
+	return s
+}
+
+// MarshalBinary returns the 32 bytes big-endian representation of s
+func (s *scalar) MarshalBinary() ([]byte, error) {
+	b := s.v.Bytes()
+	return b[:], nil
+}
+
+// MarshalSize returns the length of the byte representation of s
+func (s *scalar) MarshalSize() int { return scalarSize }
+
+// MarshalID returns the ID for a secp256k1 scalar
+func (s *scalar) MarshalID() [8]byte {
+	return [8]byte{'s', 'p', '2', '5', '6', '.', 's', 'c'}
+}
+
+// UnmarshalBinary sets s to the scalar represented by the contents of buf,
+// reduced mod GroupOrder, returning error on failure.
+func (s *scalar) UnmarshalBinary(buf []byte) error {
+	if len(buf) != scalarSize {
+		return fmt.Errorf("cannot unmarshal to scalar: wrong length")
+	}
+	s.v.SetByteSlice(buf)
+	return nil
+}
+
+// MarshalTo writes the serialized s to w, and returns the number of bytes
+// written, or an error on failure.
+func (s *scalar) MarshalTo(w io.Writer) (int, error) {
+	buf, err := s.MarshalBinary()
+	if err != nil {
+		return 0, fmt.Errorf("cannot marshal binary: %s", err)
+	}
+	return w.Write(buf)
+}
+
+// UnmarshalFrom sets s to the scalar represented by bytes read from r, and
+// returns the number of bytes read, or an error on failure.
+func (s *scalar) UnmarshalFrom(r io.Reader) (int, error) {
+	buf := make([]byte, s.MarshalSize())
+	n, err := io.ReadFull(r, buf)
+	if err != nil {
+		return n, err
+	}
+	return n, s.UnmarshalBinary(buf)
+}
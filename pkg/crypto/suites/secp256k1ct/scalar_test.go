@@ -0,0 +1,46 @@
+package secp256k1ct
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.dedis.ch/kyber/v3/xof/blake2xb"
+)
+
+func TestScalarFieldLaws(t *testing.T) {
+	rnd := blake2xb.New([]byte("secp256k1ct field laws"))
+	one := ct.Scalar().One()
+	for i := 0; i < numVectors; i++ {
+		a, b := ct.Scalar().Pick(rnd), ct.Scalar().Pick(rnd)
+
+		require.True(t, ct.Scalar().Mul(a, ct.Scalar().Inv(a)).Equal(one))
+		require.True(t, ct.Scalar().Mul(ct.Scalar().Div(a, b), b).Equal(a))
+		require.True(t, ct.Scalar().Add(a, ct.Scalar().Neg(a)).Equal(ct.Scalar().Zero()))
+		require.True(t, ct.Scalar().Sub(a, b).Equal(ct.Scalar().Add(a, ct.Scalar().Neg(b))))
+
+		// aliasing
+		c := a.Clone()
+		c.Mul(c, c)
+		require.True(t, c.Equal(ct.Scalar().Mul(a, a)))
+	}
+
+	require.Panics(t, func() { ct.Scalar().Inv(ct.Scalar().Zero()) })
+}
+
+func TestScalarMarshal(t *testing.T) {
+	rnd := blake2xb.New([]byte("secp256k1ct scalar marshal"))
+	s := ct.Scalar().Pick(rnd)
+	var b bytes.Buffer
+	n, err := s.MarshalTo(&b)
+	require.NoError(t, err)
+	require.Equal(t, ct.ScalarLen(), n)
+
+	r := ct.Scalar()
+	_, err = r.UnmarshalFrom(&b)
+	require.NoError(t, err)
+	require.True(t, s.Equal(r))
+
+	require.Error(t, r.UnmarshalBinary(make([]byte, 31)))
+	require.Equal(t, "scalar{1}", ct.Scalar().One().String())
+}
@@ -0,0 +1,162 @@
+package secp256k1ct
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.dedis.ch/kyber/v3"
+	"go.dedis.ch/kyber/v3/xof/blake2xb"
+
+	"github.com/sourcenetwork/orbis-go/pkg/crypto/suites/secp256k1"
+)
+
+var (
+	ct  = NewBlakeKeccackSecp256k1()
+	ref = secp256k1.NewBlakeKeccackSecp256k1()
+)
+
+const numVectors = 32
+
+func mustMarshal(t *testing.T, m kyber.Marshaling) []byte {
+	t.Helper()
+	buf, err := m.MarshalBinary()
+	require.NoError(t, err)
+	return buf
+}
+
+// toRef converts a constant time point or scalar
+// to the reference implementation, by marshalling.
+func toRefScalar(t *testing.T, s kyber.Scalar) kyber.Scalar {
+	r := ref.Scalar()
+	require.NoError(t, r.UnmarshalBinary(mustMarshal(t, s)))
+	return r
+}
+
+func toRefPoint(t *testing.T, p kyber.Point) kyber.Point {
+	r := ref.Point()
+	require.NoError(t, r.UnmarshalBinary(mustMarshal(t, p)))
+	return r
+}
+
+// Known multiples of the generator, as marshalled points.
+func TestBaseMultiples(t *testing.T) {
+	vectors := []struct {
+		k     int64
+		point string
+	}{
+		{1, "79be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f8179800"},
+		{2, "c6047f9441ed7d6d3045406e95c07cd85c778e4b8cef3ca7abac09b95c709ee500"},
+		{3, "f9308a019258c31049344f85f89d5229b531c845836f99b08601f113bce036f900"},
+		{-1, "79be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f8179801"},
+	}
+	for _, v := range vectors {
+		p := ct.Point().Mul(ct.Scalar().SetInt64(v.k), nil)
+		require.Equal(t, v.point, hex.EncodeToString(mustMarshal(t, p)), "%dG", v.k)
+
+		q := ct.Point()
+		require.NoError(t, q.UnmarshalBinary(mustMarshal(t, p)))
+		require.True(t, p.Equal(q))
+	}
+
+	// nG is the identity, which can't be marshaled
+	n := ct.Scalar().SetInt64(-1)
+	p := ct.Point().Mul(n, nil).Add(ct.Point().Mul(n, nil), ct.Point().Base())
+	require.True(t, p.Equal(ct.Point().Null()))
+	require.False(t, p.Equal(ct.Point().Base()))
+	require.True(t, ct.Point().Mul(ct.Scalar().Zero(), nil).Equal(ct.Point().Null()))
+	_, err := ct.Point().Null().MarshalBinary()
+	require.Error(t, err)
+	_, err = ref.Point().Null().MarshalBinary()
+	require.Error(t, err)
+}
+
+// The constant time implementation must agree with the reference one.
+func TestCrossImplementationScalars(t *testing.T) {
+	rnd := blake2xb.New([]byte("secp256k1ct scalars"))
+	for i := 0; i < numVectors; i++ {
+		a, b := ct.Scalar().Pick(rnd), ct.Scalar().Pick(rnd)
+		ra, rb := toRefScalar(t, a), toRefScalar(t, b)
+
+		ops := []struct {
+			name    string
+			ct, ref kyber.Scalar
+		}{
+			{"add", ct.Scalar().Add(a, b), ref.Scalar().Add(ra, rb)},
+			{"sub", ct.Scalar().Sub(a, b), ref.Scalar().Sub(ra, rb)},
+			{"neg", ct.Scalar().Neg(a), ref.Scalar().Neg(ra)},
+			{"mul", ct.Scalar().Mul(a, b), ref.Scalar().Mul(ra, rb)},
+			{"div", ct.Scalar().Div(a, b), ref.Scalar().Div(ra, rb)},
+			{"inv", ct.Scalar().Inv(a), ref.Scalar().Inv(ra)},
+			{"setint64", ct.Scalar().SetInt64(-int64(i) * 7919), ref.Scalar().SetInt64(-int64(i) * 7919)},
+		}
+		for _, op := range ops {
+			require.Equal(t, mustMarshal(t, op.ref), mustMarshal(t, op.ct), op.name)
+		}
+
+		// hashes longer than a scalar are reduced
+		buf := make([]byte, 64)
+		rnd.Read(buf)
+		require.Equal(t, mustMarshal(t, ref.Scalar().SetBytes(buf)), mustMarshal(t, ct.Scalar().SetBytes(buf)))
+		require.Equal(t, mustMarshal(t, ref.Scalar().SetBytes(buf[:20])), mustMarshal(t, ct.Scalar().SetBytes(buf[:20])))
+	}
+}
+
+func TestCrossImplementationPoints(t *testing.T) {
+	rnd := blake2xb.New([]byte("secp256k1ct points"))
+	for i := 0; i < numVectors; i++ {
+		a, b := ct.Scalar().Pick(rnd), ct.Scalar().Pick(rnd)
+		ra, rb := toRefScalar(t, a), toRefScalar(t, b)
+		p, q := ct.Point().Pick(rnd), ct.Point().Pick(rnd)
+		rp, rq := toRefPoint(t, p), toRefPoint(t, q)
+
+		ops := []struct {
+			name    string
+			ct, ref kyber.Point
+		}{
+			{"base mul", ct.Point().Mul(a, nil), ref.Point().Mul(ra, nil)},
+			{"mul", ct.Point().Mul(b, p), ref.Point().Mul(rb, rp)},
+			{"add", ct.Point().Add(p, q), ref.Point().Add(rp, rq)},
+			{"sub", ct.Point().Sub(p, q), ref.Point().Sub(rp, rq)},
+			{"double", ct.Point().Add(p, p), ref.Point().Add(rp, rp)},
+			{"neg", ct.Point().Neg(p), ref.Point().Neg(rp)},
+			{"mul add", ct.Point().Add(ct.Point().Mul(a, p), ct.Point().Mul(b, nil)), ref.Point().Add(ref.Point().Mul(ra, rp), ref.Point().Mul(rb, nil))},
+		}
+		for _, op := range ops {
+			require.Equal(t, mustMarshal(t, op.ref), mustMarshal(t, op.ct), op.name)
+		}
+	}
+}
+
+func TestCrossImplementationEmbed(t *testing.T) {
+	rnd := blake2xb.New([]byte("secp256k1ct embed"))
+	for i := 0; i <= ct.Point().EmbedLen(); i++ {
+		data := make([]byte, i)
+		rnd.Read(data)
+
+		// embedded by one, extracted by the other
+		p := ct.Point().Embed(data, rnd)
+		out, err := toRefPoint(t, p).Data()
+		require.NoError(t, err)
+		require.Equal(t, data, out)
+
+		rp := ref.Point().Embed(data, rnd)
+		q := ct.Point()
+		require.NoError(t, q.UnmarshalBinary(mustMarshal(t, rp)))
+		out, err = q.Data()
+		require.NoError(t, err)
+		require.Equal(t, data, out)
+	}
+}
+
+func TestUnmarshalInvalid(t *testing.T) {
+	p := ct.Point()
+	require.Error(t, p.UnmarshalBinary(make([]byte, 32)))
+	require.Error(t, p.UnmarshalBinary(append(mustMarshal(t, ct.Point().Base())[:32], 2)))
+	// x = 0 isn't on the curve, as 7 has no square root
+	require.Error(t, p.UnmarshalBinary(make([]byte, 33)))
+
+	// x ordinates aren't reduced, unlike the reference implementation
+	fieldPrime, _ := hex.DecodeString("fffffffffffffffffffffffffffffffffffffffffffffffffffffffefffffc2f")
+	require.Error(t, p.UnmarshalBinary(append(fieldPrime, 0)))
+}
@@ -0,0 +1,143 @@
+// Package custody holds the identity key of a node. The key is
+// either in process memory, read from the host key settings or
+// from a password encrypted key file, or held by a remote signer
+// or a PKCS#11 token, which sign for the node and never release
+// the key.
+//
+// The transport and the libp2p host only sign with the identity,
+// so they work with any custody. The ring DKGs compute with the
+// scalar of the node long term key, so the custodies provide the
+// pairing key of the node, which the signer custodies derive from
+// a signature by the identity instead of its scalar. Nodes whose
+// identity is held by a signer only join pairing rings, and the
+// DKG shares are sealed at rest with the custody db wrapper.
+package custody
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"sync"
+
+	logging "github.com/ipfs/go-log"
+	libp2pcrypto "github.com/libp2p/go-libp2p/core/crypto"
+
+	"github.com/sourcenetwork/orbis-go/config"
+	"github.com/sourcenetwork/orbis-go/pkg/crypto"
+	"github.com/sourcenetwork/orbis-go/pkg/host"
+)
+
+var log = logging.Logger("orbis/custody")
+
+// PasswordEnv holds the password of the key file,
+// when no password file is configured.
+const PasswordEnv = "ORBIS_CUSTODY_PASSWORD"
+
+// PKCS11PinEnv holds the user PIN of the token,
+// when no PIN file is configured.
+const PKCS11PinEnv = "ORBIS_PKCS11_PIN"
+
+// Custody holds the identity key of a node.
+type Custody interface {
+	// Name of the custody provider.
+	Name() string
+
+	// PrivKey returns the identity key. Keys held by a signer
+	// fail to export with crypto.ErrKeyNotExportable.
+	PrivKey() libp2pcrypto.PrivKey
+
+	// PairingKey returns the long term key of the node in pairing
+	// rings, derived from the identity key. The ring DKGs deal with
+	// it, and its DKG shares sign, decrypt and re-encrypt.
+	PairingKey() (crypto.PrivateKey, error)
+
+	// Close releases the connection to the key, if any.
+	Close() error
+}
+
+// New opens the configured custody of the node identity.
+func New(ctx context.Context, cfg config.Config) (Custody, error) {
+	switch cfg.Custody.Provider {
+	case "", "plain":
+		priv, err := host.PrivateKey(cfg.Host)
+		if err != nil {
+			return nil, fmt.Errorf("host private key: %w", err)
+		}
+		return &plain{priv: priv}, nil
+	case "file":
+		password, err := ReadPassword(cfg.Custody.File.PasswordFile)
+		if err != nil {
+			return nil, err
+		}
+		return OpenFile(cfg.Custody.File.Path, password, cfg.Host)
+	case "remote":
+		return Dial(ctx, cfg.Custody)
+	case "pkcs11":
+		return OpenToken(cfg.Custody)
+	default:
+		return nil, fmt.Errorf("unsupported custody provider %q", cfg.Custody.Provider)
+	}
+}
+
+// ReadPassword reads the key file password from a file, or from
+// the environment if there's no file.
+func ReadPassword(file string) ([]byte, error) {
+	return ReadPasswordEnv(file, PasswordEnv)
+}
+
+// ReadPasswordEnv reads a password from a file, or from the
+// environment variable if there's no file.
+func ReadPasswordEnv(file, env string) ([]byte, error) {
+	if file == "" {
+		password, ok := os.LookupEnv(env)
+		if !ok || password == "" {
+			return nil, fmt.Errorf("missing password file or %s", env)
+		}
+		return []byte(password), nil
+	}
+
+	buf, err := os.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("read password file: %w", err)
+	}
+	return bytes.TrimRight(buf, "\r\n"), nil
+}
+
+// plain holds the key in process memory.
+type plain struct {
+	priv libp2pcrypto.PrivKey
+}
+
+func (p *plain) Name() string                  { return "plain" }
+func (p *plain) PrivKey() libp2pcrypto.PrivKey { return p.priv }
+func (p *plain) Close() error                  { return nil }
+
+func (p *plain) PairingKey() (crypto.PrivateKey, error) {
+	priv, err := crypto.PrivateKeyFromLibP2P(p.priv)
+	if err != nil {
+		return nil, fmt.Errorf("convert identity key: %w", err)
+	}
+	return crypto.PairingKey(priv)
+}
+
+// signerPairing derives the pairing key of a signer once,
+// since each derivation asks the signer for two signatures.
+type signerPairing struct {
+	mu  sync.Mutex
+	key crypto.PrivateKey
+}
+
+func (s *signerPairing) pairingKey(priv libp2pcrypto.PrivKey) (crypto.PrivateKey, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.key == nil {
+		key, err := crypto.SignerPairingKey(priv)
+		if err != nil {
+			return nil, err
+		}
+		s.key = key
+	}
+	return s.key, nil
+}
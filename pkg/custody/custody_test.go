@@ -0,0 +1,247 @@
+package custody
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/json"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	libp2pcrypto "github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/sourcenetwork/orbis-go/config"
+	custodyv1alpha1 "github.com/sourcenetwork/orbis-go/gen/proto/orbis/custody/v1alpha1"
+	"github.com/sourcenetwork/orbis-go/pkg/crypto"
+	"github.com/sourcenetwork/orbis-go/pkg/host"
+	p2ptransport "github.com/sourcenetwork/orbis-go/pkg/transport/p2p"
+)
+
+func defaultConfig(t *testing.T) config.Config {
+	hostCfg, err := config.Default[config.Host]()
+	require.NoError(t, err)
+	custodyCfg, err := config.Default[config.Custody]()
+	require.NoError(t, err)
+	hostCfg.ListenAddresses = []string{"/ip4/127.0.0.1/tcp/0"}
+	return config.Config{Host: hostCfg, Custody: custodyCfg}
+}
+
+func TestFileCustody(t *testing.T) {
+	cfg := defaultConfig(t)
+	path := filepath.Join(t.TempDir(), "identity.key.json")
+	password := []byte("password")
+
+	generated, err := OpenFile(path, password, cfg.Host)
+	require.NoError(t, err)
+	require.Equal(t, "file", generated.Name())
+
+	raw, err := generated.PrivKey().Raw()
+	require.NoError(t, err)
+	buf, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.NotContains(t, string(buf), string(raw))
+
+	opened, err := OpenFile(path, password, cfg.Host)
+	require.NoError(t, err)
+	require.True(t, generated.PrivKey().Equals(opened.PrivKey()))
+
+	// the pairing key is derived from the identity scalar
+	cpriv, err := crypto.PrivateKeyFromLibP2P(opened.PrivKey())
+	require.NoError(t, err)
+	want, err := crypto.PairingKey(cpriv)
+	require.NoError(t, err)
+	psk, err := opened.PairingKey()
+	require.NoError(t, err)
+	require.True(t, want.Equals(psk))
+
+	_, err = OpenFile(path, []byte("wrong"), cfg.Host)
+	require.ErrorIs(t, err, ErrDecrypt)
+
+	// the header is bound to the ciphertext
+	kf := &KeyFile{}
+	require.NoError(t, json.Unmarshal(buf, kf))
+	other, _, err := libp2pcrypto.GenerateEd25519Key(rand.Reader)
+	require.NoError(t, err)
+	pid, err := peer.IDFromPrivateKey(other)
+	require.NoError(t, err)
+	kf.Node = pid.String()
+	buf, err = json.Marshal(kf)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(path, buf, 0o600))
+	_, err = ReadFile(path, password)
+	require.ErrorIs(t, err, ErrDecrypt)
+}
+
+func newSigner(t *testing.T, priv libp2pcrypto.PrivKey) string {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	srv := grpc.NewServer()
+	custodyv1alpha1.RegisterSignerServiceServer(srv, NewSignerServer(priv))
+	go srv.Serve(lis) // nolint:errcheck
+	t.Cleanup(srv.Stop)
+
+	return lis.Addr().String()
+}
+
+func TestRemoteCustody(t *testing.T) {
+	ctx := context.Background()
+	priv, _, err := libp2pcrypto.GenerateEd25519Key(rand.Reader)
+	require.NoError(t, err)
+
+	cfg := defaultConfig(t)
+	cfg.Custody.Provider = "remote"
+	cfg.Custody.Remote.Address = newSigner(t, priv)
+
+	keys, err := New(ctx, cfg)
+	require.NoError(t, err)
+	defer keys.Close()
+
+	remote := keys.PrivKey()
+	require.True(t, remote.Equals(priv))
+	require.True(t, priv.GetPublic().Equals(remote.GetPublic()))
+
+	_, err = remote.Raw()
+	require.ErrorIs(t, err, crypto.ErrKeyNotExportable)
+
+	// keys derived from the scalar aren't available
+	cpriv, err := crypto.PrivateKeyFromLibP2P(remote)
+	require.NoError(t, err)
+	_, err = crypto.PairingKey(cpriv)
+	require.ErrorIs(t, err, crypto.ErrKeyNotExportable)
+
+	// but the pairing key is derived from a signature,
+	// and is the same on each connection to the signer
+	psk, err := keys.PairingKey()
+	require.NoError(t, err)
+	require.Equal(t, crypto.BLS12381, psk.Type())
+	again, err := New(ctx, cfg)
+	require.NoError(t, err)
+	defer again.Close()
+	psk2, err := again.PairingKey()
+	require.NoError(t, err)
+	require.True(t, psk.Equals(psk2))
+
+	// the host identity and transport signatures go through the signer
+	h, err := host.NewWithKey(ctx, cfg.Host, remote)
+	require.NoError(t, err)
+	defer h.Close()
+
+	tp, err := p2ptransport.New(ctx, h, config.Transport{})
+	require.NoError(t, err)
+	msg := []byte("message")
+	sig, err := tp.Host().Sign(msg)
+	require.NoError(t, err)
+	ok, err := priv.GetPublic().Verify(msg, sig)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	// peers authenticate the host with the remote key
+	other, err := host.New(ctx, cfg.Host)
+	require.NoError(t, err)
+	defer other.Close()
+	err = other.Connect(ctx, peer.AddrInfo{ID: h.ID(), Addrs: h.Addrs()})
+	require.NoError(t, err)
+	pid, err := peer.IDFromPrivateKey(priv)
+	require.NoError(t, err)
+	require.Equal(t, pid, h.ID())
+}
+
+// TestPKCS11Custody runs against the token of ORBIS_TEST_PKCS11_MODULE,
+// such as SoftHSM, holding a key labeled orbis-identity. The token
+// label and PIN are read from ORBIS_TEST_PKCS11_TOKEN and ORBIS_PKCS11_PIN.
+func TestPKCS11Custody(t *testing.T) {
+	module := os.Getenv("ORBIS_TEST_PKCS11_MODULE")
+	if module == "" {
+		t.Skip("ORBIS_TEST_PKCS11_MODULE isn't set")
+	}
+	ctx := context.Background()
+
+	cfg := defaultConfig(t)
+	cfg.Custody.Provider = "pkcs11"
+	cfg.Custody.PKCS11.Module = module
+	cfg.Custody.PKCS11.Token = os.Getenv("ORBIS_TEST_PKCS11_TOKEN")
+
+	keys, err := New(ctx, cfg)
+	require.NoError(t, err)
+	defer keys.Close()
+	require.Equal(t, "pkcs11", keys.Name())
+
+	key := keys.PrivKey()
+	_, err = key.Raw()
+	require.ErrorIs(t, err, crypto.ErrKeyNotExportable)
+
+	msg := []byte("message")
+	sig, err := key.Sign(msg)
+	require.NoError(t, err)
+	ok, err := key.GetPublic().Verify(msg, sig)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	// the host identity is the token key
+	h, err := host.NewWithKey(ctx, cfg.Host, key)
+	require.NoError(t, err)
+	defer h.Close()
+	pid, err := peer.IDFromPublicKey(key.GetPublic())
+	require.NoError(t, err)
+	require.Equal(t, pid, h.ID())
+
+	// Ed25519 token keys derive a pairing key
+	if key.Type() == libp2pcrypto.Ed25519 {
+		psk, err := keys.PairingKey()
+		require.NoError(t, err)
+		require.Equal(t, crypto.BLS12381, psk.Type())
+	}
+}
+
+func TestUnsupportedCustody(t *testing.T) {
+	cfg := defaultConfig(t)
+	cfg.Custody.Provider = "kms"
+	_, err := New(context.Background(), cfg)
+	require.Error(t, err)
+
+	// the token module is required
+	cfg.Custody.Provider = "pkcs11"
+	_, err = New(context.Background(), cfg)
+	require.ErrorContains(t, err, "missing PKCS#11 module")
+}
+
+func TestDBWrapper(t *testing.T) {
+	cfg := defaultConfig(t)
+
+	w, err := DBWrapper(cfg, nil)
+	require.NoError(t, err)
+	require.Nil(t, w)
+
+	cfg.DB.Encryption.Wrapper = "password"
+	t.Setenv(DBPasswordEnv, "password")
+	w, err = DBWrapper(cfg, nil)
+	require.NoError(t, err)
+	require.Equal(t, "password", w.Name())
+
+	// a random identity would lose the db keys on restart
+	cfg.DB.Encryption.Wrapper = "custody"
+	keys, err := New(context.Background(), cfg)
+	require.NoError(t, err)
+	_, err = DBWrapper(cfg, keys)
+	require.Error(t, err)
+
+	// anyone can derive a seeded identity
+	cfg.Host.Crypto.Seed = 1
+	keys, err = New(context.Background(), cfg)
+	require.NoError(t, err)
+	_, err = DBWrapper(cfg, keys)
+	require.ErrorContains(t, err, "host.crypto.seed")
+
+	cfg.Host.Crypto.Seed = 0
+	cfg.Host.Crypto.KeyFile = filepath.Join(t.TempDir(), "key")
+	keys, err = New(context.Background(), cfg)
+	require.NoError(t, err)
+	w, err = DBWrapper(cfg, keys)
+	require.NoError(t, err)
+	require.Equal(t, "signer", w.Name())
+}
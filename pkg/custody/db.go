@@ -0,0 +1,53 @@
+package custody
+
+import (
+	"fmt"
+
+	"github.com/sourcenetwork/orbis-go/config"
+	"github.com/sourcenetwork/orbis-go/pkg/db"
+)
+
+// DBPasswordEnv holds the DB passphrase, when no password
+// file is configured.
+const DBPasswordEnv = "ORBIS_DB_PASSWORD"
+
+// DBWrapper returns the configured wrapper of the DB data keys, or
+// nil if the DB isn't encrypted. The custody wrapper signs with the
+// node key, so keys is only needed for it.
+func DBWrapper(cfg config.Config, keys Custody) (db.Wrapper, error) {
+	enc := cfg.DB.Encryption
+	switch enc.Wrapper {
+	case "":
+		return nil, nil
+	case "password":
+		password, err := ReadPasswordEnv(enc.PasswordFile, DBPasswordEnv)
+		if err != nil {
+			return nil, err
+		}
+		return db.PasswordWrapper(password), nil
+	case "custody":
+		if keys == nil {
+			return nil, fmt.Errorf("no key custody to wrap the db keys")
+		}
+		local := keys.Name() == "plain" || keys.Name() == "file"
+		if local && cfg.Host.Crypto.Seed != 0 {
+			// the key is generated from the seed, anyone can derive it.
+			return nil, fmt.Errorf("the node identity is derived from host.crypto.seed, it can't wrap the db keys")
+		}
+		if keys.Name() == "plain" && cfg.Host.Crypto.KeyFile == "" {
+			return nil, fmt.Errorf("the node identity is random on each start, it can't wrap the db keys")
+		}
+		return db.SignerWrapper(keys.PrivKey()), nil
+	default:
+		return nil, fmt.Errorf("unsupported db key wrapper %q", enc.Wrapper)
+	}
+}
+
+// DBOptions returns the options opening the configured DB.
+func DBOptions(cfg config.Config, keys Custody) ([]db.Option, error) {
+	w, err := DBWrapper(cfg, keys)
+	if err != nil || w == nil {
+		return nil, err
+	}
+	return []db.Option{db.WithEncryption(w)}, nil
+}
@@ -0,0 +1,150 @@
+package custody
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+
+	libp2pcrypto "github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/peer"
+
+	"github.com/sourcenetwork/orbis-go/config"
+	"github.com/sourcenetwork/orbis-go/pkg/crypto"
+	"github.com/sourcenetwork/orbis-go/pkg/host"
+)
+
+// FileVersion of the key file format.
+const FileVersion = 1
+
+var ErrDecrypt = errors.New("wrong password or corrupted key file")
+
+// KeyFile is an identity key sealed under a password.
+type KeyFile struct {
+	Version    int                `json:"version"`
+	Node       string             `json:"node"` // peer ID of the key
+	KDF        crypto.PasswordKDF `json:"kdf"`
+	Ciphertext []byte             `json:"ciphertext"`
+}
+
+// file holds the key in process memory, and in a password
+// encrypted file at rest.
+type file struct {
+	plain
+}
+
+func (f *file) Name() string { return "file" }
+
+// OpenFile opens a password encrypted key file. A missing file
+// is generated from the host crypto settings.
+func OpenFile(path string, password []byte, cfg config.Host) (Custody, error) {
+	priv, err := ReadFile(path, password)
+	if errors.Is(err, fs.ErrNotExist) {
+		priv, err = host.GenerateKey(cfg)
+		if err != nil {
+			return nil, err
+		}
+		err = WriteFile(path, priv, password)
+		if err != nil {
+			return nil, err
+		}
+		log.Infof("Generated encrypted identity key file %s", path)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &file{plain{priv: priv}}, nil
+}
+
+// ReadFile reads and decrypts a key file written by WriteFile.
+func ReadFile(path string, password []byte) (libp2pcrypto.PrivKey, error) {
+	buf, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read key file: %w", err)
+	}
+	kf := &KeyFile{}
+	err = json.Unmarshal(buf, kf)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal key file %s: %w", path, err)
+	}
+	if kf.Version != FileVersion {
+		return nil, fmt.Errorf("unsupported key file version %d", kf.Version)
+	}
+
+	key, err := kf.KDF.Key(password)
+	if err != nil {
+		return nil, err
+	}
+	raw, err := crypto.Open(crypto.AES256GCM, key, kf.Ciphertext, kf.data())
+	if err != nil {
+		return nil, ErrDecrypt
+	}
+	priv, err := libp2pcrypto.UnmarshalPrivateKey(raw)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal private key: %w", err)
+	}
+
+	pid, err := peer.IDFromPrivateKey(priv)
+	if err != nil {
+		return nil, fmt.Errorf("peer id from private key: %w", err)
+	}
+	if pid.String() != kf.Node {
+		return nil, fmt.Errorf("key file holds %s, expected %s", pid, kf.Node)
+	}
+	return priv, nil
+}
+
+// WriteFile seals a key under a password into a file, readable
+// only by the current user. It never overwrites an existing file.
+func WriteFile(path string, priv libp2pcrypto.PrivKey, password []byte) error {
+	raw, err := libp2pcrypto.MarshalPrivateKey(priv)
+	if err != nil {
+		return fmt.Errorf("marshal private key: %w", err)
+	}
+	pid, err := peer.IDFromPrivateKey(priv)
+	if err != nil {
+		return fmt.Errorf("peer id from private key: %w", err)
+	}
+
+	kdf, err := crypto.NewPasswordKDF()
+	if err != nil {
+		return err
+	}
+	key, err := kdf.Key(password)
+	if err != nil {
+		return err
+	}
+
+	kf := &KeyFile{
+		Version: FileVersion,
+		Node:    pid.String(),
+		KDF:     kdf,
+	}
+	kf.Ciphertext, err = crypto.Seal(crypto.AES256GCM, key, raw, kf.data())
+	if err != nil {
+		return fmt.Errorf("seal private key: %w", err)
+	}
+
+	buf, err := json.MarshalIndent(kf, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshal key file: %w", err)
+	}
+	fd, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+	if err != nil {
+		return fmt.Errorf("create key file: %w", err)
+	}
+	_, err = fd.Write(buf)
+	if cerr := fd.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return fmt.Errorf("write key file: %w", err)
+	}
+	return nil
+}
+
+// data binds the ciphertext to the header of the key file.
+func (kf *KeyFile) data() []byte {
+	return []byte(fmt.Sprintf("orbis/custody/v%d/%s", kf.Version, kf.Node))
+}
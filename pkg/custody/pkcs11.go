@@ -0,0 +1,303 @@
+//go:build cgo
+
+package custody
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/asn1"
+	"fmt"
+	"math/big"
+	"sync"
+
+	libp2pcrypto "github.com/libp2p/go-libp2p/core/crypto"
+	cryptopb "github.com/libp2p/go-libp2p/core/crypto/pb"
+	"github.com/miekg/pkcs11"
+
+	"github.com/sourcenetwork/orbis-go/config"
+	"github.com/sourcenetwork/orbis-go/pkg/crypto"
+)
+
+// ckmEdDSA is the PKCS#11 3.0 EdDSA mechanism,
+// missing from the module bindings.
+const ckmEdDSA = 0x1057
+
+// curve object identifiers of the EC key parameters.
+var (
+	oidP256    = asn1.ObjectIdentifier{1, 2, 840, 10045, 3, 1, 7}
+	oidEd25519 = asn1.ObjectIdentifier{1, 3, 101, 112}
+)
+
+// token holds the key in a PKCS#11 token.
+type token struct {
+	ctx     *pkcs11.Ctx
+	session pkcs11.SessionHandle
+	key     libp2pcrypto.PrivKey
+	pairing signerPairing
+}
+
+func (t *token) Name() string                  { return "pkcs11" }
+func (t *token) PrivKey() libp2pcrypto.PrivKey { return t.key }
+
+// PairingKey derives the pairing key from an identity signature,
+// so only Ed25519 keys derive one, since P-256 signatures are
+// randomized.
+func (t *token) PairingKey() (crypto.PrivateKey, error) {
+	return t.pairing.pairingKey(t.key)
+}
+
+func (t *token) Close() error {
+	err := t.ctx.Logout(t.session)
+	if err != nil {
+		log.Warnf("Log out of the PKCS#11 token: %s", err)
+	}
+	err = t.ctx.CloseSession(t.session)
+	if err != nil {
+		log.Warnf("Close the PKCS#11 session: %s", err)
+	}
+	err = t.ctx.Finalize()
+	t.ctx.Destroy()
+	return err
+}
+
+// OpenToken logs in the token of the node identity, and finds
+// its identity key, an Ed25519 or P-256 key pair.
+func OpenToken(cfg config.Custody) (Custody, error) {
+	c := cfg.PKCS11
+	if c.Module == "" {
+		return nil, fmt.Errorf("missing PKCS#11 module")
+	}
+	pin, err := ReadPasswordEnv(c.PinFile, PKCS11PinEnv)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := pkcs11.New(c.Module)
+	if ctx == nil {
+		return nil, fmt.Errorf("load PKCS#11 module %s", c.Module)
+	}
+	err = ctx.Initialize()
+	if err != nil {
+		ctx.Destroy()
+		return nil, fmt.Errorf("initialize PKCS#11 module: %w", err)
+	}
+
+	t, err := openToken(ctx, c.Token, c.Key, string(pin))
+	if err != nil {
+		ctx.Finalize() // nolint:errcheck
+		ctx.Destroy()
+		return nil, err
+	}
+	log.Infof("Using PKCS#11 token %q key %q", c.Token, c.Key)
+
+	return t, nil
+}
+
+func openToken(ctx *pkcs11.Ctx, label, keyLabel, pin string) (*token, error) {
+	slot, err := findSlot(ctx, label)
+	if err != nil {
+		return nil, err
+	}
+	session, err := ctx.OpenSession(slot, pkcs11.CKF_SERIAL_SESSION)
+	if err != nil {
+		return nil, fmt.Errorf("open PKCS#11 session: %w", err)
+	}
+	t := &token{ctx: ctx, session: session}
+
+	err = ctx.Login(session, pkcs11.CKU_USER, pin)
+	if err != nil {
+		ctx.CloseSession(session) // nolint:errcheck
+		return nil, fmt.Errorf("log in PKCS#11 token: %w", err)
+	}
+
+	t.key, err = newTokenKey(ctx, session, keyLabel)
+	if err != nil {
+		ctx.Logout(session)       // nolint:errcheck
+		ctx.CloseSession(session) // nolint:errcheck
+		return nil, err
+	}
+	return t, nil
+}
+
+func findSlot(ctx *pkcs11.Ctx, label string) (uint, error) {
+	slots, err := ctx.GetSlotList(true)
+	if err != nil {
+		return 0, fmt.Errorf("list PKCS#11 slots: %w", err)
+	}
+	for _, slot := range slots {
+		info, err := ctx.GetTokenInfo(slot)
+		if err != nil {
+			return 0, fmt.Errorf("PKCS#11 token info: %w", err)
+		}
+		if info.Label == label {
+			return slot, nil
+		}
+	}
+	return 0, fmt.Errorf("no PKCS#11 token %q", label)
+}
+
+// tokenKey is a private key held by a PKCS#11 token. It only
+// signs, checking each signature against the public key.
+type tokenKey struct {
+	// the session can't run concurrent operations.
+	mu      sync.Mutex
+	ctx     *pkcs11.Ctx
+	session pkcs11.SessionHandle
+	obj     pkcs11.ObjectHandle
+	mech    uint
+	pub     libp2pcrypto.PubKey
+}
+
+func newTokenKey(ctx *pkcs11.Ctx, session pkcs11.SessionHandle, label string) (*tokenKey, error) {
+	obj, err := findObject(ctx, session, pkcs11.CKO_PRIVATE_KEY, label)
+	if err != nil {
+		return nil, err
+	}
+	pubObj, err := findObject(ctx, session, pkcs11.CKO_PUBLIC_KEY, label)
+	if err != nil {
+		return nil, err
+	}
+	attrs, err := ctx.GetAttributeValue(session, pubObj, []*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_EC_PARAMS, nil),
+		pkcs11.NewAttribute(pkcs11.CKA_EC_POINT, nil),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("PKCS#11 public key attributes: %w", err)
+	}
+
+	k := &tokenKey{ctx: ctx, session: session, obj: obj}
+	params, point := attrs[0].Value, ecPoint(attrs[1].Value)
+	switch {
+	case isCurve(params, oidEd25519, "edwards25519"):
+		k.mech = ckmEdDSA
+		k.pub, err = libp2pcrypto.UnmarshalEd25519PublicKey(point)
+	case isCurve(params, oidP256, "prime256v1"):
+		k.mech = pkcs11.CKM_ECDSA
+		k.pub, err = p256PublicKey(point)
+	default:
+		return nil, fmt.Errorf("unsupported PKCS#11 key, only Ed25519 and P-256 keys are")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("PKCS#11 public key: %w", err)
+	}
+	return k, nil
+}
+
+func findObject(ctx *pkcs11.Ctx, session pkcs11.SessionHandle, class uint, label string) (pkcs11.ObjectHandle, error) {
+	err := ctx.FindObjectsInit(session, []*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_CLASS, class),
+		pkcs11.NewAttribute(pkcs11.CKA_LABEL, label),
+	})
+	if err != nil {
+		return 0, fmt.Errorf("find PKCS#11 key: %w", err)
+	}
+	objs, _, err := ctx.FindObjects(session, 1)
+	ferr := ctx.FindObjectsFinal(session)
+	if err == nil {
+		err = ferr
+	}
+	if err != nil {
+		return 0, fmt.Errorf("find PKCS#11 key: %w", err)
+	}
+	if len(objs) == 0 {
+		return 0, fmt.Errorf("no PKCS#11 key %q", label)
+	}
+	return objs[0], nil
+}
+
+// isCurve checks the EC parameters name the curve, by its
+// object identifier or its printable name.
+func isCurve(params []byte, oid asn1.ObjectIdentifier, name string) bool {
+	var id asn1.ObjectIdentifier
+	if rest, err := asn1.Unmarshal(params, &id); err == nil && len(rest) == 0 {
+		return id.Equal(oid)
+	}
+	var printable string
+	rest, err := asn1.UnmarshalWithParams(params, &printable, "printable")
+	return err == nil && len(rest) == 0 && printable == name
+}
+
+// ecPoint unwraps the DER octet string of an EC point,
+// which some modules return without it.
+func ecPoint(buf []byte) []byte {
+	var point []byte
+	rest, err := asn1.Unmarshal(buf, &point)
+	if err != nil || len(rest) > 0 {
+		return buf
+	}
+	return point
+}
+
+func p256PublicKey(point []byte) (libp2pcrypto.PubKey, error) {
+	x, y := elliptic.Unmarshal(elliptic.P256(), point)
+	if x == nil {
+		return nil, fmt.Errorf("invalid P-256 point")
+	}
+	der, err := x509.MarshalPKIXPublicKey(&ecdsa.PublicKey{Curve: elliptic.P256(), X: x, Y: y})
+	if err != nil {
+		return nil, err
+	}
+	return libp2pcrypto.UnmarshalECDSAPublicKey(der)
+}
+
+func (k *tokenKey) Sign(msg []byte) ([]byte, error) {
+	data := msg
+	if k.mech == pkcs11.CKM_ECDSA {
+		digest := sha256.Sum256(msg)
+		data = digest[:]
+	}
+
+	k.mu.Lock()
+	err := k.ctx.SignInit(k.session, []*pkcs11.Mechanism{pkcs11.NewMechanism(k.mech, nil)}, k.obj)
+	var sig []byte
+	if err == nil {
+		sig, err = k.ctx.Sign(k.session, data)
+	}
+	k.mu.Unlock()
+	if err != nil {
+		return nil, fmt.Errorf("PKCS#11 sign: %w", err)
+	}
+
+	// libp2p ECDSA signatures are DER encoded,
+	// the token returns r and s concatenated.
+	if k.mech == pkcs11.CKM_ECDSA {
+		if len(sig)%2 != 0 {
+			return nil, fmt.Errorf("PKCS#11 returned an invalid signature")
+		}
+		half := len(sig) / 2
+		sig, err = asn1.Marshal(struct{ R, S *big.Int }{
+			R: new(big.Int).SetBytes(sig[:half]),
+			S: new(big.Int).SetBytes(sig[half:]),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("marshal signature: %w", err)
+		}
+	}
+
+	ok, err := k.pub.Verify(msg, sig)
+	if err != nil || !ok {
+		return nil, fmt.Errorf("PKCS#11 returned an invalid signature")
+	}
+	return sig, nil
+}
+
+func (k *tokenKey) Raw() ([]byte, error) {
+	return nil, crypto.ErrKeyNotExportable
+}
+
+func (k *tokenKey) Type() cryptopb.KeyType {
+	return k.pub.Type()
+}
+
+func (k *tokenKey) GetPublic() libp2pcrypto.PubKey {
+	return k.pub
+}
+
+// Equals compares the public keys, since the private
+// key never leaves the token.
+func (k *tokenKey) Equals(o libp2pcrypto.Key) bool {
+	other, ok := o.(libp2pcrypto.PrivKey)
+	return ok && k.pub.Equals(other.GetPublic())
+}
@@ -0,0 +1,14 @@
+//go:build !cgo
+
+package custody
+
+import (
+	"fmt"
+
+	"github.com/sourcenetwork/orbis-go/config"
+)
+
+// OpenToken fails, since the PKCS#11 module bindings need cgo.
+func OpenToken(cfg config.Custody) (Custody, error) {
+	return nil, fmt.Errorf("the pkcs11 custody needs a build with cgo")
+}
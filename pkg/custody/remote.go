@@ -0,0 +1,177 @@
+package custody
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+	"time"
+
+	libp2pcrypto "github.com/libp2p/go-libp2p/core/crypto"
+	cryptopb "github.com/libp2p/go-libp2p/core/crypto/pb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/sourcenetwork/orbis-go/config"
+	custodyv1alpha1 "github.com/sourcenetwork/orbis-go/gen/proto/orbis/custody/v1alpha1"
+	"github.com/sourcenetwork/orbis-go/pkg/crypto"
+)
+
+// remote holds the key in a remote signer.
+type remote struct {
+	conn    *grpc.ClientConn
+	key     libp2pcrypto.PrivKey
+	pairing signerPairing
+}
+
+func (r *remote) Name() string                  { return "remote" }
+func (r *remote) PrivKey() libp2pcrypto.PrivKey { return r.key }
+func (r *remote) Close() error                  { return r.conn.Close() }
+
+func (r *remote) PairingKey() (crypto.PrivateKey, error) {
+	return r.pairing.pairingKey(r.key)
+}
+
+// Dial connects to the remote signer of the node identity.
+func Dial(ctx context.Context, cfg config.Custody) (Custody, error) {
+	creds, err := remoteCredentials(cfg)
+	if err != nil {
+		return nil, err
+	}
+	conn, err := grpc.DialContext(ctx, cfg.Remote.Address, grpc.WithTransportCredentials(creds))
+	if err != nil {
+		return nil, fmt.Errorf("dial remote signer: %w", err)
+	}
+
+	timeout := time.Duration(cfg.Remote.Timeout) * time.Second
+	key, err := NewRemoteKey(ctx, custodyv1alpha1.NewSignerServiceClient(conn), timeout)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	log.Infof("Using remote signer %s", cfg.Remote.Address)
+
+	return &remote{conn: conn, key: key}, nil
+}
+
+func remoteCredentials(cfg config.Custody) (credentials.TransportCredentials, error) {
+	c := cfg.Remote
+	if c.CAFile == "" && c.CertFile == "" {
+		return insecure.NewCredentials(), nil
+	}
+
+	tlsCfg := &tls.Config{MinVersion: tls.VersionTLS12}
+	if c.CAFile != "" {
+		buf, err := os.ReadFile(c.CAFile)
+		if err != nil {
+			return nil, fmt.Errorf("read remote signer CA: %w", err)
+		}
+		tlsCfg.RootCAs = x509.NewCertPool()
+		if !tlsCfg.RootCAs.AppendCertsFromPEM(buf) {
+			return nil, fmt.Errorf("no certificates found in %s", c.CAFile)
+		}
+	}
+	if c.CertFile != "" || c.KeyFile != "" {
+		cert, err := tls.LoadX509KeyPair(c.CertFile, c.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("load remote signer client certificate: %w", err)
+		}
+		tlsCfg.Certificates = []tls.Certificate{cert}
+	}
+	return credentials.NewTLS(tlsCfg), nil
+}
+
+// remoteKey is a private key held by a remote signer. It only
+// signs, checking each signature against the public key.
+type remoteKey struct {
+	client  custodyv1alpha1.SignerServiceClient
+	pub     libp2pcrypto.PubKey
+	timeout time.Duration
+}
+
+// NewRemoteKey returns the private key held by a signer.
+func NewRemoteKey(ctx context.Context, client custodyv1alpha1.SignerServiceClient, timeout time.Duration) (libp2pcrypto.PrivKey, error) {
+	k := &remoteKey{client: client, timeout: timeout}
+
+	ctx, cancel := k.context(ctx)
+	defer cancel()
+	resp, err := client.PublicKey(ctx, &custodyv1alpha1.PublicKeyRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("remote signer public key: %w", err)
+	}
+	k.pub, err = libp2pcrypto.PublicKeyFromProto(resp.PublicKey)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal remote signer public key: %w", err)
+	}
+	return k, nil
+}
+
+func (k *remoteKey) context(ctx context.Context) (context.Context, context.CancelFunc) {
+	if k.timeout <= 0 {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, k.timeout)
+}
+
+func (k *remoteKey) Sign(msg []byte) ([]byte, error) {
+	ctx, cancel := k.context(context.Background())
+	defer cancel()
+
+	resp, err := k.client.Sign(ctx, &custodyv1alpha1.SignRequest{Message: msg})
+	if err != nil {
+		return nil, fmt.Errorf("remote signer sign: %w", err)
+	}
+	ok, err := k.pub.Verify(msg, resp.Signature)
+	if err != nil || !ok {
+		return nil, fmt.Errorf("remote signer returned an invalid signature")
+	}
+	return resp.Signature, nil
+}
+
+func (k *remoteKey) Raw() ([]byte, error) {
+	return nil, crypto.ErrKeyNotExportable
+}
+
+func (k *remoteKey) Type() cryptopb.KeyType {
+	return k.pub.Type()
+}
+
+func (k *remoteKey) GetPublic() libp2pcrypto.PubKey {
+	return k.pub
+}
+
+// Equals compares the public keys, since the private
+// key never leaves the signer.
+func (k *remoteKey) Equals(o libp2pcrypto.Key) bool {
+	other, ok := o.(libp2pcrypto.PrivKey)
+	return ok && k.pub.Equals(other.GetPublic())
+}
+
+// SignerServer serves a key to the remote custody of a node.
+type SignerServer struct {
+	custodyv1alpha1.UnimplementedSignerServiceServer
+	priv libp2pcrypto.PrivKey
+}
+
+// NewSignerServer returns a signer of the key.
+func NewSignerServer(priv libp2pcrypto.PrivKey) *SignerServer {
+	return &SignerServer{priv: priv}
+}
+
+func (s *SignerServer) PublicKey(context.Context, *custodyv1alpha1.PublicKeyRequest) (*custodyv1alpha1.PublicKeyResponse, error) {
+	pub, err := libp2pcrypto.PublicKeyToProto(s.priv.GetPublic())
+	if err != nil {
+		return nil, fmt.Errorf("marshal public key: %w", err)
+	}
+	return &custodyv1alpha1.PublicKeyResponse{PublicKey: pub}, nil
+}
+
+func (s *SignerServer) Sign(_ context.Context, req *custodyv1alpha1.SignRequest) (*custodyv1alpha1.SignResponse, error) {
+	sig, err := s.priv.Sign(req.Message)
+	if err != nil {
+		return nil, fmt.Errorf("sign: %w", err)
+	}
+	return &custodyv1alpha1.SignResponse{Signature: sig}, nil
+}
@@ -0,0 +1,213 @@
+package db
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	auditv1alpha1 "github.com/sourcenetwork/orbis-go/gen/proto/orbis/audit/v1alpha1"
+)
+
+var (
+	ErrAuditChainBroken = fmt.Errorf("audit log hash chain broken")
+)
+
+// AuditFilter selects audit records, empty fields match any record.
+type AuditFilter struct {
+	Subject  string
+	SecretID string
+}
+
+func (f AuditFilter) match(rec *auditv1alpha1.Record) bool {
+	return (f.Subject == "" || f.Subject == rec.Subject) &&
+		(f.SecretID == "" || f.SecretID == rec.SecretId)
+}
+
+// AuditLog is an append-only, hash chained log of audit records.
+// Each ring has its own chain, where every record hash covers
+// the record content and the hash of the previous record, so
+// any modification, removal or reordering of past records is
+// detected by Verify.
+type AuditLog struct {
+	repo Repository[*auditv1alpha1.Record]
+
+	mu sync.Mutex
+	// ring id => last record of the chain, loaded lazily.
+	heads map[string]*auditv1alpha1.Record
+}
+
+// NewAuditLog returns the audit log stored in the given db.
+func NewAuditLog(d *DB) (*AuditLog, error) {
+	repo, err := GetRepo(d, NewRepoKey("audit"), auditRecordPkFunc)
+	if err != nil {
+		return nil, fmt.Errorf("get audit repo: %w", err)
+	}
+
+	return &AuditLog{
+		repo:  repo,
+		heads: make(map[string]*auditv1alpha1.Record),
+	}, nil
+}
+
+// Append chains the record to the end of its ring log. The sequence
+// number, previous hash and hash are set on the given record, as
+// well as the timestamp if missing.
+func (l *AuditLog) Append(ctx context.Context, rec *auditv1alpha1.Record) error {
+	if rec.RingId == "" {
+		return fmt.Errorf("audit record missing ring id")
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	head, err := l.head(ctx, rec.RingId)
+	if err != nil {
+		return err
+	}
+
+	rec.Seq = 1
+	rec.PrevHash = nil
+	if head != nil {
+		rec.Seq = head.Seq + 1
+		rec.PrevHash = head.Hash
+	}
+	if rec.Timestamp == 0 {
+		rec.Timestamp = time.Now().UnixNano()
+	}
+
+	rec.Hash, err = AuditRecordHash(rec)
+	if err != nil {
+		return err
+	}
+
+	err = l.repo.Create(ctx, rec)
+	if err != nil {
+		return fmt.Errorf("append audit record: %w", err)
+	}
+	l.heads[rec.RingId] = rec
+
+	return nil
+}
+
+// Records returns the ring audit records matching the filter, in order.
+func (l *AuditLog) Records(ctx context.Context, ringID string, filter AuditFilter) ([]*auditv1alpha1.Record, error) {
+	recs, err := l.ringRecords(ctx, ringID)
+	if err != nil {
+		return nil, err
+	}
+
+	var matched []*auditv1alpha1.Record
+	for _, rec := range recs {
+		if filter.match(rec) {
+			matched = append(matched, rec)
+		}
+	}
+
+	return matched, nil
+}
+
+// Verify walks the ring chain and checks every record hash
+// and link to the previous record.
+func (l *AuditLog) Verify(ctx context.Context, ringID string) error {
+	recs, err := l.ringRecords(ctx, ringID)
+	if err != nil {
+		return err
+	}
+
+	return VerifyAuditChain(recs)
+}
+
+// VerifyAuditChain checks the records form a complete hash chain,
+// starting from the first record of a ring.
+func VerifyAuditChain(recs []*auditv1alpha1.Record) error {
+	var prev *auditv1alpha1.Record
+	for _, rec := range recs {
+		if prev == nil && (rec.Seq != 1 || len(rec.PrevHash) != 0) {
+			return fmt.Errorf("%w: chain doesn't start at the first record", ErrAuditChainBroken)
+		}
+		if prev != nil && (rec.Seq != prev.Seq+1 || !bytes.Equal(rec.PrevHash, prev.Hash)) {
+			return fmt.Errorf("%w: record %d doesn't follow record %d", ErrAuditChainBroken, rec.Seq, prev.Seq)
+		}
+
+		hash, err := AuditRecordHash(rec)
+		if err != nil {
+			return err
+		}
+		if !bytes.Equal(hash, rec.Hash) {
+			return fmt.Errorf("%w: record %d hash mismatch", ErrAuditChainBroken, rec.Seq)
+		}
+		prev = rec
+	}
+
+	return nil
+}
+
+// AuditRecordHash computes the chained hash of a record, which is the
+// SHA-256 of its deterministic encoding without the hash itself.
+// The previous hash is part of the encoding.
+func AuditRecordHash(rec *auditv1alpha1.Record) ([]byte, error) {
+	rec = proto.Clone(rec).(*auditv1alpha1.Record)
+	rec.Hash = nil
+
+	buf, err := proto.MarshalOptions{Deterministic: true}.Marshal(rec)
+	if err != nil {
+		return nil, fmt.Errorf("marshal audit record: %w", err)
+	}
+
+	sum := sha256.Sum256(buf)
+	return sum[:], nil
+}
+
+// head returns the last record of the ring chain, or nil if empty.
+func (l *AuditLog) head(ctx context.Context, ringID string) (*auditv1alpha1.Record, error) {
+	if head, ok := l.heads[ringID]; ok {
+		return head, nil
+	}
+
+	recs, err := l.ringRecords(ctx, ringID)
+	if err != nil {
+		return nil, err
+	}
+	if len(recs) == 0 {
+		return nil, nil
+	}
+
+	head := recs[len(recs)-1]
+	l.heads[ringID] = head
+	return head, nil
+}
+
+// ringRecords returns all the records of a ring, ordered by sequence.
+// The records are keyed by ring and sequence, so this is a range scan
+// of the ring records.
+func (l *AuditLog) ringRecords(ctx context.Context, ringID string) ([]*auditv1alpha1.Record, error) {
+	recs, err := l.repo.GetRange(ctx,
+		&auditv1alpha1.Record{RingId: ringID, Seq: 0},
+		&auditv1alpha1.Record{RingId: ringID, Seq: math.MaxUint64},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("get audit records: %w", err)
+	}
+
+	// ring ids aren't length prefixed in the keys, so the range
+	// could hold the records of a ring whose id extends this one.
+	n := 0
+	for _, rec := range recs {
+		if rec.RingId == ringID {
+			recs[n] = rec
+			n++
+		}
+	}
+
+	return recs[:n], nil
+}
+
+func auditRecordPkFunc(kb KeyBuilder, r *auditv1alpha1.Record) []byte {
+	return kb.AddStringField(r.RingId).AddUint64Field(r.Seq).Bytes()
+}
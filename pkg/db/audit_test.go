@@ -0,0 +1,113 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-bond/bond"
+	"github.com/stretchr/testify/require"
+
+	auditv1alpha1 "github.com/sourcenetwork/orbis-go/gen/proto/orbis/audit/v1alpha1"
+)
+
+func newTestDB(t *testing.T) *DB {
+	bdb, err := bond.Open(t.TempDir(), bond.DefaultOptions())
+	require.NoError(t, err)
+	t.Cleanup(func() { bdb.Close() })
+
+	return &DB{
+		bond:  bdb,
+		repos: make(map[RepoKey]any),
+	}
+}
+
+func TestAuditLog(t *testing.T) {
+	ctx := context.Background()
+	d := newTestDB(t)
+
+	log, err := NewAuditLog(d)
+	require.NoError(t, err)
+
+	appendRec := func(ring, subject, secret string, authorized bool) *auditv1alpha1.Record {
+		rec := &auditv1alpha1.Record{
+			RingId:     ring,
+			Subject:    subject,
+			SecretId:   secret,
+			Authorized: authorized,
+		}
+		require.NoError(t, log.Append(ctx, rec))
+		return rec
+	}
+
+	r1 := appendRec("ring1", "alice", "s1", true)
+	r2 := appendRec("ring1", "bob", "s1", false)
+	r3 := appendRec("ring1", "alice", "s2", true)
+	appendRec("ring2", "alice", "s1", true)
+	appendRec("ring10", "alice", "s1", true)
+
+	require.Equal(t, uint64(1), r1.Seq)
+	require.Empty(t, r1.PrevHash)
+	require.Equal(t, uint64(2), r2.Seq)
+	require.Equal(t, r1.Hash, r2.PrevHash)
+	require.Equal(t, r2.Hash, r3.PrevHash)
+	require.NotZero(t, r1.Timestamp)
+
+	recs, err := log.Records(ctx, "ring1", AuditFilter{})
+	require.NoError(t, err)
+	require.Len(t, recs, 3)
+
+	recs, err = log.Records(ctx, "ring1", AuditFilter{Subject: "alice"})
+	require.NoError(t, err)
+	require.Len(t, recs, 2)
+
+	recs, err = log.Records(ctx, "ring1", AuditFilter{SecretID: "s1", Subject: "bob"})
+	require.NoError(t, err)
+	require.Len(t, recs, 1)
+	require.False(t, recs[0].Authorized)
+
+	require.NoError(t, log.Verify(ctx, "ring1"))
+	require.NoError(t, log.Verify(ctx, "ring2"))
+	require.NoError(t, log.Verify(ctx, "ring10"))
+
+	// a new log over the same db continues the chain
+	log2, err := NewAuditLog(d)
+	require.NoError(t, err)
+	r4 := &auditv1alpha1.Record{RingId: "ring1", Subject: "carol"}
+	require.NoError(t, log2.Append(ctx, r4))
+	require.Equal(t, uint64(4), r4.Seq)
+	require.Equal(t, r3.Hash, r4.PrevHash)
+	require.NoError(t, log2.Verify(ctx, "ring1"))
+}
+
+func TestAuditLogTamper(t *testing.T) {
+	ctx := context.Background()
+	d := newTestDB(t)
+
+	log, err := NewAuditLog(d)
+	require.NoError(t, err)
+
+	for _, subject := range []string{"alice", "bob", "carol"} {
+		require.NoError(t, log.Append(ctx, &auditv1alpha1.Record{RingId: "ring1", Subject: subject}))
+	}
+
+	recs, err := log.Records(ctx, "ring1", AuditFilter{})
+	require.NoError(t, err)
+	require.NoError(t, VerifyAuditChain(recs))
+
+	// modified record
+	recs[1].Authorized = true
+	require.ErrorIs(t, VerifyAuditChain(recs), ErrAuditChainBroken)
+	recs[1].Authorized = false
+	require.NoError(t, VerifyAuditChain(recs))
+
+	// removed record
+	require.ErrorIs(t, VerifyAuditChain([]*auditv1alpha1.Record{recs[0], recs[2]}), ErrAuditChainBroken)
+
+	// truncated head
+	require.ErrorIs(t, VerifyAuditChain(recs[1:]), ErrAuditChainBroken)
+
+	// modified in the store
+	recs[1].Subject = "mallory"
+	require.NoError(t, log.repo.Update(ctx, recs[1]))
+	require.ErrorIs(t, log.Verify(ctx, "ring1"), ErrAuditChainBroken)
+}
@@ -39,6 +39,7 @@ func NewRepoKey(name string) RepoKey {
 
 type DB struct {
 	bond  bond.DB
+	keys  *Keyring        // nil if the db isn't encrypted
 	repos map[RepoKey]any // map[tableKey]Repository
 }
 
@@ -48,7 +49,7 @@ func GetRepo[R Record](db *DB, rkey RepoKey, pkFunc RepoPrimaryKeyFunc[R]) (Repo
 	}
 	repo, ok := db.repos[rkey]
 	if !ok {
-		repo = newSimpleRepo[R](db.bond, pkFunc)
+		repo = newSimpleRepo[R](db.bond, pkFunc, db.keys)
 		db.repos[rkey] = repo
 	}
 
@@ -59,25 +60,73 @@ func GetRepo[R Record](db *DB, rkey RepoKey, pkFunc RepoPrimaryKeyFunc[R]) (Repo
 	return repoTyped, nil
 }
 
-func New(path string) (*DB, error) {
-	opts := bond.DefaultOptions()
+func New(path string, opts ...Option) (*DB, error) {
+	var o options
+	for _, opt := range opts {
+		opt(&o)
+	}
 
-	dirname, err := os.UserHomeDir()
+	bdb, err := openBond(path)
 	if err != nil {
 		return nil, err
 	}
-	path = filepath.Join(dirname, ".orbis", path) //todo: Parameterize path
-	bdb, err := bond.Open(path, opts)
+
+	kpath, err := KeyringPath(path)
 	if err != nil {
+		bdb.Close()
 		return nil, err
 	}
+	var keys *Keyring
+	if o.wrapper != nil {
+		keys, err = openKeyring(bdb, path, o.wrapper)
+		if err != nil {
+			bdb.Close()
+			return nil, err
+		}
+	} else if _, err := os.Stat(kpath); err == nil {
+		bdb.Close()
+		return nil, ErrDBEncrypted
+	}
 
 	return &DB{
 		bond:  bdb,
+		keys:  keys,
 		repos: make(map[RepoKey]any),
 	}, nil
 }
 
+// Keyring returns the keyring of an encrypted DB, or nil.
+func (db *DB) Keyring() *Keyring {
+	return db.keys
+}
+
+// Batch returns a new batch, which the repos write to when it's
+// passed to them. Its writes apply together once it's committed.
+func (db *DB) Batch() Batch {
+	return db.bond.Batch()
+}
+
+// Close closes the DB.
+func (db *DB) Close() error {
+	return db.bond.Close()
+}
+
+func dbDir(path string) (string, error) {
+	dirname, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dirname, ".orbis", path), nil //todo: Parameterize path
+}
+
+func openBond(path string) (bond.DB, error) {
+	dir, err := dbDir(path)
+	if err != nil {
+		return nil, err
+	}
+	return bond.Open(dir, bond.DefaultOptions())
+}
+
 func (db *DB) Debug() error {
 	it, err := db.bond.Iter(&bond.IterOptions{})
 	if err != nil {
@@ -0,0 +1,226 @@
+package db
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+
+	"github.com/go-bond/bond"
+	"google.golang.org/protobuf/proto"
+)
+
+var (
+	ErrNotSealed   = fmt.Errorf("db value isn't sealed, encrypt the db with `orbisd db encrypt`")
+	ErrDBEncrypted = fmt.Errorf("db is encrypted, configure its passphrase or key")
+	ErrDBPlaintext = fmt.Errorf("db has plaintext records, encrypt it with `orbisd db encrypt`")
+
+	errSealedRow = fmt.Errorf("sealed db values are opened with their row key")
+)
+
+// Option configures how a DB is opened.
+type Option func(*options)
+
+type options struct {
+	wrapper Wrapper
+}
+
+// WithEncryption seals the values of the DB with the data keys of
+// its keyring, wrapped by the given wrapper. The keyring of an
+// empty DB is created.
+func WithEncryption(w Wrapper) Option {
+	return func(o *options) {
+		o.wrapper = w
+	}
+}
+
+// KeyringPath returns the path of the keyring of the DB at path.
+func KeyringPath(path string) (string, error) {
+	dir, err := dbDir(path)
+	if err != nil {
+		return "", err
+	}
+	return dir + ".keyring.json", nil
+}
+
+// sealedSerializer seals the serialized records of a table, bound to
+// the keys of their rows. Bond doesn't give the row key to Deserialize,
+// so the repo opens sealed values itself, with openRow.
+type sealedSerializer[T proto.Message] struct {
+	protoSerializer[T]
+	keys   *Keyring
+	table  bond.TableID
+	pkFunc RepoPrimaryKeyFunc[T]
+}
+
+func (s sealedSerializer[T]) Serialize(i T) ([]byte, error) {
+	buf, err := s.protoSerializer.Serialize(i)
+	if err != nil {
+		return nil, err
+	}
+	return s.keys.seal(s.rowKey(i), buf)
+}
+
+func (s sealedSerializer[T]) Deserialize(b []byte, i *T) error {
+	return errSealedRow
+}
+
+// openRow opens the sealed value of the row at key.
+func (s sealedSerializer[T]) openRow(key, value []byte) (T, error) {
+	var t T
+	buf, err := s.keys.open(key, value)
+	if err != nil {
+		return t, err
+	}
+	err = s.protoSerializer.Deserialize(buf, &t)
+	return t, err
+}
+
+// rowKey returns the bond key of the row of a record.
+func (s sealedSerializer[T]) rowKey(t T) []byte {
+	return bond.KeyEncodeRaw(s.table, bond.PrimaryIndexID, nil, nil, func(b []byte) []byte {
+		return s.pkFunc(bond.NewKeyBuilder(b), t)
+	})
+}
+
+// openKeyring opens the keyring of the DB at path, or creates it
+// if the DB has no records yet.
+func openKeyring(bdb bond.DB, path string, w Wrapper) (*Keyring, error) {
+	kpath, err := KeyringPath(path)
+	if err != nil {
+		return nil, err
+	}
+	keys, err := OpenKeyring(kpath, w)
+	if !errors.Is(err, fs.ErrNotExist) {
+		return keys, err
+	}
+
+	plain, err := hasRecords(bdb)
+	if err != nil {
+		return nil, err
+	}
+	if plain {
+		return nil, ErrDBPlaintext
+	}
+	log.Infof("Creating db keyring %s", kpath)
+	return CreateKeyring(kpath, w)
+}
+
+// Encrypt seals the plaintext records of the DB at path, under
+// a new keyring. The DB must be closed, since it's opened here.
+func Encrypt(path string, w Wrapper) (int, error) {
+	kpath, err := KeyringPath(path)
+	if err != nil {
+		return 0, err
+	}
+	if _, err := os.Stat(kpath); err == nil {
+		return 0, fmt.Errorf("db is already encrypted, its keyring %s exists", kpath)
+	}
+
+	bdb, err := openBond(path)
+	if err != nil {
+		return 0, err
+	}
+	defer bdb.Close()
+
+	keys, err := CreateKeyring(kpath, w)
+	if err != nil {
+		return 0, err
+	}
+	n, err := reseal(bdb, keys, func(row, value []byte) ([]byte, error) {
+		if _, ok := sealedKeyID(value); ok {
+			return nil, fmt.Errorf("db has sealed records without a keyring")
+		}
+		return value, nil
+	})
+	if err != nil {
+		// nothing was written, the records are resealed in one batch.
+		os.Remove(kpath)
+		return 0, fmt.Errorf("encrypt db: %w", err)
+	}
+	return n, nil
+}
+
+// Reseal seals every record of the encrypted DB at path with the
+// active data key, then retires the older keys. Run it after a
+// rotation to stop relying on the previous keys. The DB must be
+// closed, since it's opened here.
+func Reseal(path string, keys *Keyring) (int, error) {
+	bdb, err := openBond(path)
+	if err != nil {
+		return 0, err
+	}
+	defer bdb.Close()
+
+	n, err := reseal(bdb, keys, keys.open)
+	if err != nil {
+		return n, err
+	}
+	return n, keys.retire()
+}
+
+// reseal seals each record value with the active data key, after
+// opening it with open.
+func reseal(bdb bond.DB, keys *Keyring, open func(row, value []byte) ([]byte, error)) (int, error) {
+	it, err := bdb.Iter(&bond.IterOptions{})
+	if err != nil {
+		return 0, fmt.Errorf("iterate db: %w", err)
+	}
+	defer it.Close()
+
+	active := keys.Active()
+	batch := bdb.Batch()
+	defer batch.Close()
+
+	n := 0
+	for it.First(); it.Valid(); it.Next() {
+		key := bond.KeyBytes(it.Key())
+		if key.TableID() == bond.BOND_DB_DATA_TABLE_ID || !key.IsDataKey() {
+			continue
+		}
+		if id, ok := sealedKeyID(it.Value()); ok && id == active {
+			continue
+		}
+
+		row := append([]byte{}, key...)
+		plaintext, err := open(row, it.Value())
+		if err != nil {
+			return 0, err
+		}
+		sealed, err := keys.seal(row, plaintext)
+		if err != nil {
+			return 0, err
+		}
+		err = batch.Set(row, sealed, bond.Sync)
+		if err != nil {
+			return 0, fmt.Errorf("reseal db record: %w", err)
+		}
+		n++
+	}
+	if err := it.Error(); err != nil {
+		return 0, fmt.Errorf("iterate db: %w", err)
+	}
+
+	err = batch.Commit(bond.Sync)
+	if err != nil {
+		return 0, fmt.Errorf("commit resealed db records: %w", err)
+	}
+	return n, nil
+}
+
+// hasRecords reports whether the DB has any table records.
+func hasRecords(bdb bond.DB) (bool, error) {
+	it, err := bdb.Iter(&bond.IterOptions{})
+	if err != nil {
+		return false, fmt.Errorf("iterate db: %w", err)
+	}
+	defer it.Close()
+
+	for it.First(); it.Valid(); it.Next() {
+		key := bond.KeyBytes(it.Key())
+		if key.TableID() != bond.BOND_DB_DATA_TABLE_ID && key.IsDataKey() {
+			return true, nil
+		}
+	}
+	return false, it.Error()
+}
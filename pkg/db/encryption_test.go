@@ -0,0 +1,197 @@
+package db
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"os"
+	"testing"
+
+	"github.com/go-bond/bond"
+	libp2pcrypto "github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/stretchr/testify/require"
+
+	auditv1alpha1 "github.com/sourcenetwork/orbis-go/gen/proto/orbis/audit/v1alpha1"
+)
+
+var testRecordKey = NewRepoKey("record")
+
+func putRecord(t *testing.T, d *DB, seq uint64) {
+	repo, err := GetRepo(d, testRecordKey, auditRecordPkFunc)
+	require.NoError(t, err)
+	require.NoError(t, repo.Create(context.Background(), &auditv1alpha1.Record{
+		RingId:  "ring",
+		Seq:     seq,
+		Subject: "secret subject",
+	}))
+}
+
+func getRecords(t *testing.T, d *DB) []*auditv1alpha1.Record {
+	repo, err := GetRepo(d, testRecordKey, auditRecordPkFunc)
+	require.NoError(t, err)
+	recs, err := repo.GetAll(context.Background())
+	require.NoError(t, err)
+	return recs
+}
+
+// rawContains reports whether any raw db value contains s.
+func rawContains(t *testing.T, d *DB, s string) bool {
+	it, err := d.bond.Iter(&bond.IterOptions{})
+	require.NoError(t, err)
+	defer it.Close()
+	for it.First(); it.Valid(); it.Next() {
+		if bytes.Contains(it.Value(), []byte(s)) {
+			return true
+		}
+	}
+	return false
+}
+
+func keyringKeys(t *testing.T, path string) int {
+	kpath, err := KeyringPath(path)
+	require.NoError(t, err)
+	buf, err := os.ReadFile(kpath)
+	require.NoError(t, err)
+	return bytes.Count(buf, []byte(`"id"`))
+}
+
+func TestEncryptedDB(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	password := PasswordWrapper([]byte("password"))
+
+	d, err := New("data", WithEncryption(password))
+	require.NoError(t, err)
+	putRecord(t, d, 1)
+	require.False(t, rawContains(t, d, "secret subject"))
+	require.NoError(t, d.Close())
+
+	_, err = New("data")
+	require.ErrorIs(t, err, ErrDBEncrypted)
+
+	_, err = New("data", WithEncryption(PasswordWrapper([]byte("wrong"))))
+	require.ErrorIs(t, err, ErrKeyringDecrypt)
+
+	d, err = New("data", WithEncryption(password))
+	require.NoError(t, err)
+	recs := getRecords(t, d)
+	require.Len(t, recs, 1)
+	require.Equal(t, "secret subject", recs[0].Subject)
+	require.NoError(t, d.Close())
+}
+
+func TestEncryptPlaintextDB(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	password := PasswordWrapper([]byte("password"))
+
+	d, err := New("data")
+	require.NoError(t, err)
+	putRecord(t, d, 1)
+	putRecord(t, d, 2)
+	require.NoError(t, d.Close())
+
+	_, err = New("data", WithEncryption(password))
+	require.ErrorIs(t, err, ErrDBPlaintext)
+
+	n, err := Encrypt("data", password)
+	require.NoError(t, err)
+	require.Equal(t, 2, n)
+
+	d, err = New("data", WithEncryption(password))
+	require.NoError(t, err)
+	require.False(t, rawContains(t, d, "secret subject"))
+	require.Len(t, getRecords(t, d), 2)
+	require.NoError(t, d.Close())
+
+	_, err = Encrypt("data", password)
+	require.Error(t, err)
+}
+
+func TestRotateDBKeys(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	password := PasswordWrapper([]byte("password"))
+
+	d, err := New("data", WithEncryption(password))
+	require.NoError(t, err)
+	putRecord(t, d, 1)
+
+	// records sealed by either key are readable
+	id, err := d.Keyring().Rotate()
+	require.NoError(t, err)
+	require.Equal(t, uint32(2), id)
+	putRecord(t, d, 2)
+	require.Len(t, getRecords(t, d), 2)
+	require.NoError(t, d.Close())
+	require.Equal(t, 2, keyringKeys(t, "data"))
+
+	kpath, err := KeyringPath("data")
+	require.NoError(t, err)
+	keys, err := OpenKeyring(kpath, password)
+	require.NoError(t, err)
+	n, err := Reseal("data", keys)
+	require.NoError(t, err)
+	require.Equal(t, 1, n)
+	require.Equal(t, 1, keyringKeys(t, "data"))
+
+	// the keys can be wrapped by the node key instead
+	priv, _, err := libp2pcrypto.GenerateEd25519Key(rand.Reader)
+	require.NoError(t, err)
+	signer := SignerWrapper(priv)
+	require.NoError(t, keys.Rewrap(signer))
+
+	_, err = New("data", WithEncryption(password))
+	require.Error(t, err)
+
+	d, err = New("data", WithEncryption(signer))
+	require.NoError(t, err)
+	require.Len(t, getRecords(t, d), 2)
+	require.NoError(t, d.Close())
+}
+
+func TestSealedRowsBoundToKeys(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	ctx := context.Background()
+
+	d, err := New("data", WithEncryption(PasswordWrapper([]byte("password"))))
+	require.NoError(t, err)
+	defer d.Close()
+	putRecord(t, d, 1)
+	putRecord(t, d, 2)
+
+	repo, err := GetRepo(d, testRecordKey, auditRecordPkFunc)
+	require.NoError(t, err)
+	first := &auditv1alpha1.Record{RingId: "ring", Seq: 1}
+
+	// sealed records are updated and read by key
+	require.NoError(t, repo.Save(ctx, &auditv1alpha1.Record{RingId: "ring", Seq: 1, Subject: "updated"}))
+	rec, err := repo.Get(ctx, first)
+	require.NoError(t, err)
+	require.Equal(t, "updated", rec.Subject)
+	recs, err := repo.GetRange(ctx, first, &auditv1alpha1.Record{RingId: "ring", Seq: 2})
+	require.NoError(t, err)
+	require.Len(t, recs, 2)
+	recs, err = repo.Query().After(first).Execute(ctx)
+	require.NoError(t, err)
+	require.Len(t, recs, 1)
+	require.Equal(t, uint64(2), recs[0].Seq)
+
+	// swap the values of the rows
+	var keys, values [][]byte
+	it, err := d.bond.Iter(&bond.IterOptions{})
+	require.NoError(t, err)
+	for it.First(); it.Valid(); it.Next() {
+		key := bond.KeyBytes(it.Key())
+		if key.TableID() != bond.BOND_DB_DATA_TABLE_ID && key.IsDataKey() {
+			keys = append(keys, append([]byte{}, key...))
+			values = append(values, append([]byte{}, it.Value()...))
+		}
+	}
+	require.NoError(t, it.Close())
+	require.Len(t, keys, 2)
+	require.NoError(t, d.bond.Set(keys[0], values[1], bond.Sync))
+	require.NoError(t, d.bond.Set(keys[1], values[0], bond.Sync))
+
+	_, err = repo.Get(ctx, first)
+	require.Error(t, err)
+	_, err = repo.GetAll(ctx)
+	require.Error(t, err)
+}
@@ -0,0 +1,372 @@
+package db
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+
+	"golang.org/x/crypto/hkdf"
+
+	"github.com/sourcenetwork/orbis-go/pkg/crypto"
+)
+
+// keyringVersion of the keyring file format.
+const keyringVersion = 1
+
+var (
+	ErrKeyringDecrypt = fmt.Errorf("wrong db passphrase or key, can't unwrap the db keyring")
+	ErrUnknownDataKey = fmt.Errorf("unknown db data key")
+)
+
+// Wrapper derives the key wrapping the data keys of an encrypted DB.
+type Wrapper interface {
+	// Name of the wrapper, stored in the keyring.
+	Name() string
+
+	// Key derives the wrapping key, with the parameters
+	// stored in the keyring.
+	Key(kdf crypto.PasswordKDF) ([]byte, error)
+}
+
+// PasswordWrapper wraps the data keys under a passphrase.
+func PasswordWrapper(password []byte) Wrapper {
+	return passwordWrapper(password)
+}
+
+type passwordWrapper []byte
+
+func (w passwordWrapper) Name() string { return "password" }
+
+func (w passwordWrapper) Key(kdf crypto.PasswordKDF) ([]byte, error) {
+	return kdf.Key(w)
+}
+
+// Signer signs with the node identity, like the key custody.
+type Signer interface {
+	Sign([]byte) ([]byte, error)
+}
+
+// signerWrapperMessage is signed to derive the wrapping key.
+const signerWrapperMessage = "orbis/db/keyring/v1"
+
+// SignerWrapper wraps the data keys under a key derived from a
+// signature by the node identity, so keys held by a remote signer
+// can wrap them. The signature scheme must be deterministic, like
+// Ed25519.
+func SignerWrapper(signer Signer) Wrapper {
+	return signerWrapper{signer}
+}
+
+type signerWrapper struct {
+	signer Signer
+}
+
+func (w signerWrapper) Name() string { return "signer" }
+
+func (w signerWrapper) Key(kdf crypto.PasswordKDF) ([]byte, error) {
+	msg := append([]byte(signerWrapperMessage), kdf.Salt...)
+	sig, err := w.signer.Sign(msg)
+	if err != nil {
+		return nil, fmt.Errorf("sign db keyring: %w", err)
+	}
+	again, err := w.signer.Sign(msg)
+	if err != nil {
+		return nil, fmt.Errorf("sign db keyring: %w", err)
+	}
+	if !bytes.Equal(sig, again) {
+		return nil, fmt.Errorf("the node key signatures aren't deterministic, wrap the db keyring with a passphrase")
+	}
+
+	key := make([]byte, crypto.DEMKeySize)
+	_, err = io.ReadFull(hkdf.New(sha256.New, sig, kdf.Salt, []byte("orbis db keyring wrapping key")), key)
+	if err != nil {
+		return nil, fmt.Errorf("derive db keyring wrapping key: %w", err)
+	}
+	return key, nil
+}
+
+// keyringFile is the keyring stored next to the DB.
+type keyringFile struct {
+	Version int                `json:"version"`
+	Wrapper string             `json:"wrapper"`
+	KDF     crypto.PasswordKDF `json:"kdf"`
+	Active  uint32             `json:"active"`
+	Keys    []wrappedKey       `json:"keys"`
+}
+
+type wrappedKey struct {
+	ID  uint32 `json:"id"`
+	Key []byte `json:"key"` // sealed under the wrapping key
+}
+
+// Keyring holds the data keys sealing the values of an encrypted
+// DB, wrapped under a passphrase or the node key. Values are sealed
+// with the active key, and opened with the key they were sealed
+// with, so data keys rotate without rewriting the DB at once.
+type Keyring struct {
+	path string
+
+	mu      sync.RWMutex
+	file    keyringFile
+	wrapKey []byte
+	keys    map[uint32][]byte
+}
+
+// CreateKeyring creates the keyring file with a first data key.
+func CreateKeyring(path string, w Wrapper) (*Keyring, error) {
+	if _, err := os.Stat(path); err == nil {
+		return nil, fmt.Errorf("db keyring %s already exists", path)
+	}
+
+	kdf, err := crypto.NewPasswordKDF()
+	if err != nil {
+		return nil, err
+	}
+	wrapKey, err := w.Key(kdf)
+	if err != nil {
+		return nil, err
+	}
+
+	k := &Keyring{
+		path: path,
+		file: keyringFile{
+			Version: keyringVersion,
+			Wrapper: w.Name(),
+			KDF:     kdf,
+		},
+		wrapKey: wrapKey,
+		keys:    make(map[uint32][]byte),
+	}
+	err = k.addKey()
+	if err != nil {
+		return nil, err
+	}
+	err = k.save()
+	if err != nil {
+		return nil, err
+	}
+	return k, nil
+}
+
+// OpenKeyring opens the keyring file, and unwraps its data keys.
+func OpenKeyring(path string, w Wrapper) (*Keyring, error) {
+	buf, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read db keyring: %w", err)
+	}
+	k := &Keyring{
+		path: path,
+		keys: make(map[uint32][]byte),
+	}
+	err = json.Unmarshal(buf, &k.file)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal db keyring %s: %w", path, err)
+	}
+	if k.file.Version != keyringVersion {
+		return nil, fmt.Errorf("unsupported db keyring version %d", k.file.Version)
+	}
+	if k.file.Wrapper != w.Name() {
+		return nil, fmt.Errorf("db keyring is wrapped by %s, not %s", k.file.Wrapper, w.Name())
+	}
+
+	k.wrapKey, err = w.Key(k.file.KDF)
+	if err != nil {
+		return nil, err
+	}
+	for _, wk := range k.file.Keys {
+		key, err := crypto.Open(crypto.AES256GCM, k.wrapKey, wk.Key, wrappedKeyData(wk.ID))
+		if err != nil {
+			return nil, ErrKeyringDecrypt
+		}
+		k.keys[wk.ID] = key
+	}
+	if _, ok := k.keys[k.file.Active]; !ok {
+		return nil, fmt.Errorf("%w: active key %d", ErrUnknownDataKey, k.file.Active)
+	}
+	return k, nil
+}
+
+// Rotate adds a new data key, which seals the values written from
+// then on. Values sealed by the previous keys stay readable until
+// they're resealed.
+func (k *Keyring) Rotate() (uint32, error) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	err := k.addKey()
+	if err != nil {
+		return 0, err
+	}
+	return k.file.Active, k.save()
+}
+
+// Rewrap wraps the data keys under a new passphrase or node key.
+func (k *Keyring) Rewrap(w Wrapper) error {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	kdf, err := crypto.NewPasswordKDF()
+	if err != nil {
+		return err
+	}
+	wrapKey, err := w.Key(kdf)
+	if err != nil {
+		return err
+	}
+
+	keys := make([]wrappedKey, len(k.file.Keys))
+	for i, wk := range k.file.Keys {
+		sealed, err := crypto.Seal(crypto.AES256GCM, wrapKey, k.keys[wk.ID], wrappedKeyData(wk.ID))
+		if err != nil {
+			return fmt.Errorf("wrap data key %d: %w", wk.ID, err)
+		}
+		keys[i] = wrappedKey{ID: wk.ID, Key: sealed}
+	}
+
+	k.file.Wrapper = w.Name()
+	k.file.KDF = kdf
+	k.file.Keys = keys
+	k.wrapKey = wrapKey
+	return k.save()
+}
+
+// retire removes the data keys other than the active key, once
+// every value is sealed by the active key.
+func (k *Keyring) retire() error {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	for _, wk := range k.file.Keys {
+		if wk.ID == k.file.Active {
+			k.file.Keys = []wrappedKey{wk}
+			break
+		}
+	}
+	for id := range k.keys {
+		if id != k.file.Active {
+			delete(k.keys, id)
+		}
+	}
+	return k.save()
+}
+
+// Active returns the id of the data key sealing new values.
+func (k *Keyring) Active() uint32 {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+	return k.file.Active
+}
+
+// addKey generates the next data key and makes it active.
+func (k *Keyring) addKey() error {
+	key, err := crypto.NewDEMKey(rand.Reader)
+	if err != nil {
+		return err
+	}
+	id := k.file.Active + 1
+	sealed, err := crypto.Seal(crypto.AES256GCM, k.wrapKey, key, wrappedKeyData(id))
+	if err != nil {
+		return fmt.Errorf("wrap data key %d: %w", id, err)
+	}
+
+	k.file.Keys = append(k.file.Keys, wrappedKey{ID: id, Key: sealed})
+	k.file.Active = id
+	k.keys[id] = key
+	return nil
+}
+
+// save writes the keyring through a temporary file, so a crash
+// never leaves a partial keyring.
+func (k *Keyring) save() error {
+	buf, err := json.MarshalIndent(k.file, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshal db keyring: %w", err)
+	}
+
+	tmp := k.path + ".tmp"
+	err = os.WriteFile(tmp, buf, 0o600)
+	if err != nil {
+		return fmt.Errorf("write db keyring: %w", err)
+	}
+	err = os.Rename(tmp, k.path)
+	if err != nil {
+		return fmt.Errorf("replace db keyring: %w", err)
+	}
+	return nil
+}
+
+// seal seals the value of a row with the active data key. The
+// sealed value starts with a byte that's an invalid protobuf tag,
+// then the id of the data key.
+func (k *Keyring) seal(row []byte, plaintext []byte) ([]byte, error) {
+	k.mu.RLock()
+	id, key := k.file.Active, k.keys[k.file.Active]
+	k.mu.RUnlock()
+
+	header := sealedHeader(id)
+	ciphertext, err := crypto.Seal(crypto.AES256GCM, key, plaintext, sealedData(row, header))
+	if err != nil {
+		return nil, fmt.Errorf("seal db value: %w", err)
+	}
+	return append(header, ciphertext...), nil
+}
+
+// open opens the value of a row sealed by any of the data keys.
+func (k *Keyring) open(row []byte, value []byte) ([]byte, error) {
+	id, ok := sealedKeyID(value)
+	if !ok {
+		return nil, ErrNotSealed
+	}
+
+	k.mu.RLock()
+	key, ok := k.keys[id]
+	k.mu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("%w: %d", ErrUnknownDataKey, id)
+	}
+
+	header := value[:sealedHeaderSize]
+	plaintext, err := crypto.Open(crypto.AES256GCM, key, value[sealedHeaderSize:], sealedData(row, header))
+	if err != nil {
+		return nil, fmt.Errorf("open db value: %w", err)
+	}
+	return plaintext, nil
+}
+
+// sealedMarker starts sealed values. It's the tag of field 0,
+// which is invalid in protobuf, so it never starts a plain value.
+const (
+	sealedMarker     = 0x01
+	sealedHeaderSize = 5
+)
+
+func sealedHeader(id uint32) []byte {
+	header := make([]byte, sealedHeaderSize)
+	header[0] = sealedMarker
+	binary.BigEndian.PutUint32(header[1:], id)
+	return header
+}
+
+func sealedKeyID(value []byte) (uint32, bool) {
+	if len(value) < sealedHeaderSize || value[0] != sealedMarker {
+		return 0, false
+	}
+	return binary.BigEndian.Uint32(value[1:sealedHeaderSize]), true
+}
+
+// sealedData binds a sealed value to its data key and its row,
+// the bond key holding it, so values can't be moved between rows.
+func sealedData(row []byte, header []byte) []byte {
+	data := append([]byte("orbis/db/"), header...)
+	return append(data, row...)
+}
+
+func wrappedKeyData(id uint32) []byte {
+	return []byte(fmt.Sprintf("orbis/db/keyring/key/%d", id))
+}
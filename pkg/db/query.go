@@ -1,7 +1,9 @@
 package db
 
 import (
+	"bytes"
 	"context"
+	"sort"
 
 	"github.com/go-bond/bond"
 )
@@ -10,6 +12,9 @@ type KeyBuilder = bond.KeyBuilder
 
 type Batch = bond.Batch
 
+// Sync commits a batch durably.
+var Sync = bond.Sync
+
 type FilterFunc[R any] func(r R) bool
 type OrderLessFunc[R any] func(r, r2 R) bool
 
@@ -52,3 +57,77 @@ func (q rawQuery[R]) Execute(ctx context.Context) ([]R, error) {
 	err := q.bondQuery.Execute(ctx, records)
 	return *records, err
 }
+
+// sealedQuery runs a query over the records of a sealed table,
+// which are opened by the repo rather than by bond.
+type sealedQuery[R Record] struct {
+	repo   *simpleRepo[R]
+	after  *R
+	limit  uint64
+	offset uint64
+	less   OrderLessFunc[R]
+}
+
+func (q sealedQuery[R]) After(r R) Query[R] {
+	q.after = &r
+	return q
+}
+
+func (q sealedQuery[R]) Limit(limit uint64) Query[R] {
+	q.limit = limit
+	return q
+}
+
+func (q sealedQuery[R]) Offset(offset uint64) Query[R] {
+	q.offset = offset
+	return q
+}
+
+func (q sealedQuery[R]) Order(less OrderLessFunc[R]) Query[R] {
+	q.less = less
+	return q
+}
+
+// Execute applies the query like bond does: the order, then the
+// record to start after, the offset and the limit.
+func (q sealedQuery[R]) Execute(ctx context.Context) ([]R, error) {
+	records, err := q.repo.scanAllSealed(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if q.less != nil {
+		sort.Slice(records, func(i, j int) bool {
+			return q.less(records[i], records[j])
+		})
+	}
+
+	if q.after != nil {
+		after := q.repo.sealed.rowKey(*q.after)
+		start := len(records)
+		for i, r := range records {
+			key := q.repo.sealed.rowKey(r)
+			// records are in key order, unless they're sorted.
+			if bytes.Equal(key, after) || (q.less == nil && bytes.Compare(key, after) > 0) {
+				start = i
+				if bytes.Equal(key, after) {
+					start++
+				}
+				break
+			}
+		}
+		if q.less != nil && start == len(records) {
+			start = 0
+		}
+		records = records[start:]
+	}
+
+	if q.offset >= uint64(len(records)) {
+		return []R{}, nil
+	}
+	records = records[q.offset:]
+	if q.limit > 0 && q.limit < uint64(len(records)) {
+		records = records[:q.limit]
+	}
+	return records, nil
+}
@@ -26,18 +26,22 @@ type RingIDGetter interface {
 type RepoPrimaryKeyFunc[T any] bond.TablePrimaryKeyFunc[T]
 
 type Repository[T Record] interface {
-	Create(context.Context, T) error
-	CreateMany(context.Context, []T) error
-	Save(context.Context, T) error
-	Update(context.Context, T) error
+	Create(context.Context, T, ...Batch) error
+	CreateMany(context.Context, []T, ...Batch) error
+	Save(context.Context, T, ...Batch) error
+	Update(context.Context, T, ...Batch) error
 	Get(context.Context, T) (T, error)
 	GetAll(context.Context) ([]T, error)
+	GetRange(ctx context.Context, from, to T) ([]T, error)
 	Query() Query[T]
-	Exists(context.Context, T) bool
+	Exists(context.Context, T, ...Batch) bool
+	Delete(context.Context, T) error
 }
 
 type simpleRepo[T Record] struct {
 	table bond.Table[T]
+	// sealed is set for the tables of an encrypted db.
+	sealed *sealedSerializer[T]
 }
 
 type RepoOption func(*RepoOptions)
@@ -64,25 +68,33 @@ func WithRingID(rid string) RepoOption {
 // 	return b.Bytes()
 // }
 
-func newSimpleRepo[T Record](bdb bond.DB, pkFunc RepoPrimaryKeyFunc[T]) *simpleRepo[T] {
+func newSimpleRepo[T Record](bdb bond.DB, pkFunc RepoPrimaryKeyFunc[T], keys *Keyring) *simpleRepo[T] {
 	if pkFunc == nil {
 		panic("invalid primary key function, cant be nil")
 	}
 	var t T
 	name := getTableName(t)
+	tableID := bond.TableID(hash(name))
+
 	rr := &simpleRepo[T]{}
+	var serializer bond.Serializer[T] = protoSerializer[T]{}
+	if keys != nil {
+		rr.sealed = &sealedSerializer[T]{keys: keys, table: tableID, pkFunc: pkFunc}
+		serializer = *rr.sealed
+	}
+
 	rr.table = bond.NewTable(bond.TableOptions[T]{
 		DB:                  bdb,
-		TableID:             bond.TableID(hash(name)),
+		TableID:             tableID,
 		TableName:           name,
 		TablePrimaryKeyFunc: bond.TablePrimaryKeyFunc[T](pkFunc),
-		Serializer:          protoSerializer[T]{},
+		Serializer:          serializer,
 	})
 	return rr
 }
 
-func (rr *simpleRepo[T]) Create(ctx context.Context, t T) error {
-	err := rr.table.Insert(ctx, []T{t})
+func (rr *simpleRepo[T]) Create(ctx context.Context, t T, batch ...Batch) error {
+	err := rr.table.Insert(ctx, []T{t}, batch...)
 	if err != nil && strings.Contains(err.Error(), "already exists") {
 		return errors.Join(ErrRecordAlreadyExists, err)
 	} else if err != nil {
@@ -91,8 +103,8 @@ func (rr *simpleRepo[T]) Create(ctx context.Context, t T) error {
 	return nil
 }
 
-func (rr *simpleRepo[T]) CreateMany(ctx context.Context, ts []T) error {
-	err := rr.table.Insert(ctx, ts)
+func (rr *simpleRepo[T]) CreateMany(ctx context.Context, ts []T, batch ...Batch) error {
+	err := rr.table.Insert(ctx, ts, batch...)
 	if err != nil && strings.Contains(err.Error(), "already exists") {
 		return errors.Join(ErrRecordAlreadyExists, err)
 	} else if err != nil {
@@ -101,23 +113,64 @@ func (rr *simpleRepo[T]) CreateMany(ctx context.Context, ts []T) error {
 	return nil
 }
 
-func (rr *simpleRepo[T]) Save(ctx context.Context, t T) error {
+func (rr *simpleRepo[T]) Save(ctx context.Context, t T, batch ...Batch) error {
 	log.Debugf("Saving repo entry for %T", t)
-	if rr.table.Exist(t) {
+	if rr.table.Exist(t, batch...) {
 		log.Debug("entry exists, updating")
-		return rr.Update(ctx, t)
+		return rr.Update(ctx, t, batch...)
 	}
 	log.Debug("entry doesn't exist, creating")
-	return rr.Create(ctx, t)
+	return rr.Create(ctx, t, batch...)
+}
+
+func (rr *simpleRepo[T]) Update(ctx context.Context, t T, batch ...Batch) error {
+	if rr.sealed != nil {
+		return rr.replace(ctx, t, batch...)
+	}
+	return rr.table.Update(ctx, []T{t}, batch...)
 }
 
-func (rr *simpleRepo[T]) Update(ctx context.Context, t T) error {
-	return rr.table.Update(ctx, []T{t})
+// replace updates a sealed record. Bond's Update opens the previous
+// value without its row key, so the row is deleted and inserted again,
+// in one batch.
+func (rr *simpleRepo[T]) replace(ctx context.Context, t T, optBatch ...Batch) error {
+	if !rr.table.Exist(t, optBatch...) {
+		return fmt.Errorf("repo update: record not found")
+	}
+
+	batch, external := rr.table.DB().Batch(), len(optBatch) > 0 && optBatch[0] != nil
+	if external {
+		batch = optBatch[0]
+	} else {
+		defer batch.Close()
+	}
+
+	err := rr.table.Delete(ctx, []T{t}, batch)
+	if err != nil {
+		return fmt.Errorf("repo update: %w", err)
+	}
+	err = rr.table.Insert(ctx, []T{t}, batch)
+	if err != nil {
+		return fmt.Errorf("repo update: %w", err)
+	}
+	if !external {
+		return batch.Commit(Sync)
+	}
+	return nil
 }
 
 func (rr *simpleRepo[T]) Get(ctx context.Context, t T) (T, error) {
 	var zeroT T
-	ts, err := rr.table.Get(ctx, bond.NewSelectorPoint(t))
+	var ts []T
+	var err error
+	if rr.sealed != nil {
+		ts, err = rr.scanSealed(ctx, bond.NewSelectorPoints(t))
+		if err == nil && len(ts) == 0 {
+			err = fmt.Errorf("not found")
+		}
+	} else {
+		ts, err = rr.table.Get(ctx, bond.NewSelectorPoint(t))
+	}
 	if err != nil {
 		return zeroT, err
 	}
@@ -125,19 +178,66 @@ func (rr *simpleRepo[T]) Get(ctx context.Context, t T) (T, error) {
 }
 
 func (rr *simpleRepo[T]) GetAll(ctx context.Context) ([]T, error) {
-	var ts []T
-	if err := rr.table.Query().Execute(ctx, &ts); err != nil {
-		return nil, err
+	return rr.Query().Execute(ctx)
+}
+
+// GetRange returns the records whose primary keys are between the
+// keys of from and to, both included, in key order.
+func (rr *simpleRepo[T]) GetRange(ctx context.Context, from, to T) ([]T, error) {
+	if rr.sealed != nil {
+		return rr.scanSealed(ctx, bond.NewSelectorRange(from, to))
 	}
-	return ts, nil
+	return rr.table.Get(ctx, bond.NewSelectorRange(from, to))
 }
 
 func (rr *simpleRepo[T]) Query() Query[T] {
+	if rr.sealed != nil {
+		return sealedQuery[T]{repo: rr}
+	}
 	return rawQuery[T]{rr.table.Query()}
 }
 
-func (rr *simpleRepo[T]) Exists(ctx context.Context, t T) bool {
-	return rr.table.Exist(t)
+// scanSealed returns the sealed records selected by sel, in key
+// order, opening each value with the key of its row.
+func (rr *simpleRepo[T]) scanSealed(ctx context.Context, sel bond.Selector[T]) ([]T, error) {
+	it, err := rr.table.Iter(sel)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	var ts []T
+	for it.First(); it.Valid(); it.Next() {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		t, err := rr.sealed.openRow(it.Key(), it.Value())
+		if err != nil {
+			return nil, fmt.Errorf("get failed to deserialize: %w", err)
+		}
+		ts = append(ts, t)
+	}
+	return ts, it.Error()
+}
+
+// scanAllSealed returns every sealed record of the table.
+func (rr *simpleRepo[T]) scanAllSealed(ctx context.Context) ([]T, error) {
+	var t T
+	// like bond's Scan, select from the key of an empty record.
+	empty := t.ProtoReflect().New().Interface().(T)
+	return rr.scanSealed(ctx, bond.NewSelectorPoint(empty))
+}
+
+func (rr *simpleRepo[T]) Exists(ctx context.Context, t T, batch ...Batch) bool {
+	return rr.table.Exist(t, batch...)
+}
+
+func (rr *simpleRepo[T]) Delete(ctx context.Context, t T) error {
+	err := rr.table.Delete(ctx, []T{t})
+	if err != nil {
+		return fmt.Errorf("repo delete: %w", err)
+	}
+	return nil
 }
 
 func getTableName(r Record) string {
@@ -0,0 +1,60 @@
+package did
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"fmt"
+
+	ssicrypto "github.com/TBD54566975/ssi-sdk/crypto"
+	"github.com/TBD54566975/ssi-sdk/did/key"
+
+	"github.com/sourcenetwork/orbis-go/pkg/crypto"
+)
+
+// KeyDID returns the `did:key` identifier of the public key, along
+// with the key id of its verification method.
+func KeyDID(pk crypto.PublicKey) (did string, kid string, err error) {
+	var keyType ssicrypto.KeyType
+	var raw []byte
+	switch pk.Type() {
+	case crypto.Ed25519:
+		keyType = ssicrypto.Ed25519
+		raw, err = pk.Raw()
+	case crypto.Secp256k1:
+		keyType = ssicrypto.SECP256k1
+		raw, err = pk.Raw()
+	case crypto.ECDSA:
+		keyType = ssicrypto.P256
+		raw, err = p256Bytes(pk)
+	default:
+		return "", "", fmt.Errorf("unsupported did key type %s", pk.Type())
+	}
+	if err != nil {
+		return "", "", fmt.Errorf("raw public key: %w", err)
+	}
+
+	didKey, err := key.CreateDIDKey(keyType, raw)
+	if err != nil {
+		return "", "", fmt.Errorf("create did key: %w", err)
+	}
+
+	suffix, err := didKey.Suffix()
+	if err != nil {
+		return "", "", fmt.Errorf("did key suffix: %w", err)
+	}
+
+	return didKey.String(), didKey.String() + "#" + suffix, nil
+}
+
+// p256Bytes returns the encoded point of a P-256 public key.
+func p256Bytes(pk crypto.PublicKey) ([]byte, error) {
+	std, err := pk.Std()
+	if err != nil {
+		return nil, err
+	}
+	ecpk, ok := std.(*ecdsa.PublicKey)
+	if !ok || ecpk.Curve != elliptic.P256() {
+		return nil, fmt.Errorf("unsupported ecdsa curve")
+	}
+	return ssicrypto.PubKeyToBytes(ecpk)
+}
@@ -57,3 +57,13 @@ func (r resolver) Resolve(ctx context.Context, id string) (authn.SubjectInfo, er
 func NewResolver(r resolution.Resolver) authn.KeyResolver {
 	return resolver{Resolver: r}
 }
+
+// NewMultiMethodResolver returns a key resolver dispatching
+// DIDs to the resolver of their method.
+func NewMultiMethodResolver(resolvers ...resolution.Resolver) (authn.KeyResolver, error) {
+	r, err := resolution.NewResolver(resolvers...)
+	if err != nil {
+		return nil, fmt.Errorf("create did resolver: %w", err)
+	}
+	return NewResolver(r), nil
+}
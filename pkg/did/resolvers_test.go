@@ -0,0 +1,242 @@
+package did
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"testing"
+	"time"
+
+	ssicrypto "github.com/TBD54566975/ssi-sdk/crypto"
+	"github.com/TBD54566975/ssi-sdk/cryptosuite"
+	ssidid "github.com/TBD54566975/ssi-sdk/did"
+	"github.com/TBD54566975/ssi-sdk/did/jwk"
+	"github.com/TBD54566975/ssi-sdk/did/key"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	ic "github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/mr-tron/base58"
+	"github.com/stretchr/testify/require"
+	"go.dedis.ch/kyber/v3/suites"
+
+	"github.com/sourcenetwork/orbis-go/pkg/crypto"
+)
+
+type testFetcher map[string][]byte
+
+func (f testFetcher) Fetch(_ context.Context, url string) ([]byte, error) {
+	buf, ok := f[url]
+	if !ok {
+		return nil, fmt.Errorf("not found: %s", url)
+	}
+	return buf, nil
+}
+
+type testAccounts map[string][]byte
+
+func (a testAccounts) AccountPubKey(_ context.Context, address string) ([]byte, error) {
+	pk, ok := a[address]
+	if !ok {
+		return nil, fmt.Errorf("account %s not found", address)
+	}
+	return pk, nil
+}
+
+func newSecp256k1Key(t *testing.T) crypto.PublicKey {
+	_, icpk, err := ic.GenerateSecp256k1Key(rand.Reader)
+	require.NoError(t, err)
+	pk, err := crypto.PublicKeyFromLibP2P(icpk)
+	require.NoError(t, err)
+	return pk
+}
+
+func TestKeyDIDResolve(t *testing.T) {
+	_, ed25519Pk, err := crypto.GenerateKeyPair(suites.MustFind("ed25519"), rand.Reader)
+	require.NoError(t, err)
+	_, p256Pk, err := ic.GenerateECDSAKeyPair(rand.Reader)
+	require.NoError(t, err)
+	p256, err := crypto.PublicKeyFromLibP2P(p256Pk)
+	require.NoError(t, err)
+
+	r, err := NewMultiMethodResolver(key.Resolver{})
+	require.NoError(t, err)
+
+	for _, pk := range []crypto.PublicKey{ed25519Pk, newSecp256k1Key(t), p256} {
+		t.Run(pk.Type().String(), func(t *testing.T) {
+			id, kid, err := KeyDID(pk)
+			require.NoError(t, err)
+
+			info, err := r.Resolve(context.Background(), kid)
+			require.NoError(t, err)
+			require.Equal(t, id, info.Subject)
+			require.True(t, pk.Equals(info.PubKey))
+		})
+	}
+}
+
+func TestJWKDIDResolve(t *testing.T) {
+	_, id, err := jwk.GenerateDIDJWK(ssicrypto.P256)
+	require.NoError(t, err)
+
+	r, err := NewMultiMethodResolver(jwk.Resolver{})
+	require.NoError(t, err)
+
+	info, err := r.Resolve(context.Background(), id.String()+"#0")
+	require.NoError(t, err)
+	require.Equal(t, id.String(), info.Subject)
+	require.Equal(t, crypto.ECDSA, info.PubKey.Type())
+}
+
+func TestWebDIDResolve(t *testing.T) {
+	pk := newSecp256k1Key(t)
+	raw, err := pk.Raw()
+	require.NoError(t, err)
+
+	doc := func(id string) []byte {
+		buf, err := json.Marshal(ssidid.Document{
+			ID: id,
+			VerificationMethod: []ssidid.VerificationMethod{{
+				ID:              id + "#key-1",
+				Type:            cryptosuite.ECDSASECP256k1VerificationKey2019,
+				Controller:      id,
+				PublicKeyBase58: base58.Encode(raw),
+			}},
+		})
+		require.NoError(t, err)
+		return buf
+	}
+	fetcher := testFetcher{
+		"https://example.com/.well-known/did.json": doc("did:web:example.com"),
+		"https://example.com/user/alice/did.json":  doc("did:web:example.com:user:alice"),
+		"https://other.com/.well-known/did.json":   doc("did:web:example.com"),
+	}
+
+	r, err := NewMultiMethodResolver(NewWebResolver(fetcher))
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	info, err := r.Resolve(ctx, "did:web:example.com#key-1")
+	require.NoError(t, err)
+	require.Equal(t, "did:web:example.com", info.Subject)
+	require.True(t, pk.Equals(info.PubKey))
+
+	info, err = r.Resolve(ctx, "did:web:example.com:user:alice")
+	require.NoError(t, err)
+	require.Equal(t, "did:web:example.com:user:alice", info.Subject)
+
+	// documents must be of the resolved did
+	_, err = r.Resolve(ctx, "did:web:other.com")
+	require.Error(t, err)
+
+	_, err = r.Resolve(ctx, "did:key:z6Mkunsupported")
+	require.Error(t, err)
+}
+
+// countingFetcher counts the fetches of a fetcher.
+type countingFetcher struct {
+	Fetcher
+	n int
+}
+
+func (f *countingFetcher) Fetch(ctx context.Context, url string) ([]byte, error) {
+	f.n++
+	return f.Fetcher.Fetch(ctx, url)
+}
+
+func TestWebDIDCache(t *testing.T) {
+	buf, err := json.Marshal(ssidid.Document{ID: "did:web:example.com"})
+	require.NoError(t, err)
+	fetcher := &countingFetcher{Fetcher: testFetcher{
+		"https://example.com/.well-known/did.json": buf,
+	}}
+	r := NewWebResolver(fetcher)
+	now := time.Now()
+	r.cache.now = func() time.Time { return now }
+
+	ctx := context.Background()
+	for i := 0; i < 2; i++ {
+		_, err = r.Resolve(ctx, "did:web:example.com")
+		require.NoError(t, err)
+		_, err = r.Resolve(ctx, "did:web:missing.com")
+		require.Error(t, err)
+	}
+	require.Equal(t, 2, fetcher.n)
+
+	// failures are cached for a shorter time
+	now = now.Add(webFailureCacheTTL)
+	_, err = r.Resolve(ctx, "did:web:example.com")
+	require.NoError(t, err)
+	_, err = r.Resolve(ctx, "did:web:missing.com")
+	require.Error(t, err)
+	require.Equal(t, 3, fetcher.n)
+}
+
+func TestHTTPFetcherPublicOnly(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("{}"))
+	}))
+	defer srv.Close()
+
+	_, err := NewHTTPFetcher().Fetch(context.Background(), srv.URL)
+	require.ErrorIs(t, err, ErrNonPublicAddress)
+
+	for addr, public := range map[string]bool{
+		"8.8.8.8":              true,
+		"2606:4700::1111":      true,
+		"127.0.0.1":            false,
+		"::1":                  false,
+		"10.1.2.3":             false,
+		"172.16.0.1":           false,
+		"192.168.1.1":          false,
+		"169.254.169.254":      false,
+		"fe80::1":              false,
+		"fd00::1":              false,
+		"100.64.0.1":           false,
+		"0.0.0.0":              false,
+		"::ffff:127.0.0.1":     false,
+		"::ffff:192.168.0.1":   false,
+		"64:ff9b::7f00:1":      false,
+		"224.0.0.1":            false,
+		"255.255.255.255":      false,
+		"::":                   false,
+		"::ffff:8.8.4.4":       true,
+		"2001:4860:4860::8888": true,
+	} {
+		require.Equal(t, public, isPublic(netip.MustParseAddr(addr)), addr)
+	}
+}
+
+func TestSourceHubDIDResolve(t *testing.T) {
+	pk := newSecp256k1Key(t)
+	raw, err := pk.Raw()
+	require.NoError(t, err)
+	address, err := bech32.ConvertAndEncode("source", (&secp256k1.PubKey{Key: raw}).Address())
+	require.NoError(t, err)
+
+	other, err := newSecp256k1Key(t).Raw()
+	require.NoError(t, err)
+	otherAddress, err := bech32.ConvertAndEncode("source", (&secp256k1.PubKey{Key: other}).Address())
+	require.NoError(t, err)
+
+	r, err := NewMultiMethodResolver(NewSourceHubResolver(testAccounts{
+		address:      raw,
+		otherAddress: raw, // not the account key
+	}))
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	info, err := r.Resolve(ctx, "did:sourcehub:"+address+"#key-1")
+	require.NoError(t, err)
+	require.Equal(t, "did:sourcehub:"+address, info.Subject)
+	require.True(t, pk.Equals(info.PubKey))
+
+	_, err = r.Resolve(ctx, "did:sourcehub:"+otherAddress)
+	require.ErrorContains(t, err, "doesn't match the address")
+
+	_, err = r.Resolve(ctx, "did:sourcehub:notanaddress")
+	require.Error(t, err)
+}
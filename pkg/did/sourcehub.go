@@ -0,0 +1,126 @@
+package did
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/TBD54566975/ssi-sdk/cryptosuite"
+	"github.com/TBD54566975/ssi-sdk/did"
+	"github.com/TBD54566975/ssi-sdk/did/resolution"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/mr-tron/base58"
+	"google.golang.org/grpc"
+)
+
+const (
+	SourceHubMethod did.Method = "sourcehub"
+
+	sourceHubPrefix = "did:" + string(SourceHubMethod) + ":"
+
+	// sourceHubKeyFragment identifies the account
+	// key verification method.
+	sourceHubKeyFragment = "#key-1"
+
+	secp256k1PubKeyTypeURL = "/cosmos.crypto.secp256k1.PubKey"
+)
+
+// AccountKeyFetcher fetches the public key of SourceHub accounts.
+type AccountKeyFetcher interface {
+	// AccountPubKey returns the compressed secp256k1
+	// public key of the account with the address.
+	AccountPubKey(ctx context.Context, address string) ([]byte, error)
+}
+
+type accountKeyFetcher struct {
+	client authtypes.QueryClient
+}
+
+// NewAccountKeyFetcher returns a fetcher querying the SourceHub
+// auth module over the gRPC connection, which must be trusted.
+func NewAccountKeyFetcher(conn grpc.ClientConnInterface) AccountKeyFetcher {
+	return accountKeyFetcher{client: authtypes.NewQueryClient(conn)}
+}
+
+func (f accountKeyFetcher) AccountPubKey(ctx context.Context, address string) ([]byte, error) {
+	resp, err := f.client.AccountInfo(ctx, &authtypes.QueryAccountInfoRequest{Address: address})
+	if err != nil {
+		return nil, fmt.Errorf("query account: %w", err)
+	}
+	// accounts only have a public key once they signed a transaction.
+	if resp.Info == nil || resp.Info.PubKey == nil {
+		return nil, fmt.Errorf("account %s has no public key", address)
+	}
+
+	var pk secp256k1.PubKey
+	if resp.Info.PubKey.TypeUrl != secp256k1PubKeyTypeURL {
+		return nil, fmt.Errorf("unsupported account key type %s", resp.Info.PubKey.TypeUrl)
+	}
+	err = pk.Unmarshal(resp.Info.PubKey.Value)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal account key: %w", err)
+	}
+
+	return pk.Key, nil
+}
+
+var _ resolution.Resolver = SourceHubResolver{}
+
+// SourceHubResolver resolves did:sourcehub DIDs, identifying SourceHub
+// accounts by their address, such as did:sourcehub:source1..., to the
+// account secp256k1 key.
+type SourceHubResolver struct {
+	accounts AccountKeyFetcher
+}
+
+// NewSourceHubResolver returns a did:sourcehub resolver fetching the
+// account keys with the fetcher.
+func NewSourceHubResolver(accounts AccountKeyFetcher) SourceHubResolver {
+	return SourceHubResolver{accounts: accounts}
+}
+
+func (SourceHubResolver) Methods() []did.Method {
+	return []did.Method{SourceHubMethod}
+}
+
+func (r SourceHubResolver) Resolve(ctx context.Context, id string, _ ...resolution.ResolutionOption) (*resolution.ResolutionResult, error) {
+	address, ok := strings.CutPrefix(id, sourceHubPrefix)
+	if !ok {
+		return nil, fmt.Errorf("not a did:sourcehub DID: %s", id)
+	}
+	_, addr, err := bech32.DecodeAndConvert(address)
+	if err != nil {
+		return nil, fmt.Errorf("decode did:sourcehub address: %w", err)
+	}
+
+	key, err := r.accounts.AccountPubKey(ctx, address)
+	if err != nil {
+		return nil, fmt.Errorf("fetch did:sourcehub account key: %w", err)
+	}
+
+	// the account address is derived from its key.
+	pk := secp256k1.PubKey{Key: key}
+	if !bytes.Equal(pk.Address(), addr) {
+		return nil, fmt.Errorf("account key doesn't match the address %s", address)
+	}
+
+	kid := id + sourceHubKeyFragment
+	methods := []did.VerificationMethodSet{[]string{kid}}
+	return &resolution.ResolutionResult{
+		Document: did.Document{
+			Context: did.KnownDIDContext,
+			ID:      id,
+			VerificationMethod: []did.VerificationMethod{{
+				ID:              kid,
+				Type:            cryptosuite.ECDSASECP256k1VerificationKey2019,
+				Controller:      id,
+				PublicKeyBase58: base58.Encode(key),
+			}},
+			Authentication:  methods,
+			AssertionMethod: methods,
+		},
+	}, nil
+}
@@ -0,0 +1,260 @@
+package did
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/TBD54566975/ssi-sdk/did"
+	"github.com/TBD54566975/ssi-sdk/did/resolution"
+	"github.com/TBD54566975/ssi-sdk/did/web"
+)
+
+const (
+	// maxDocumentSize bounds the size of fetched DID documents.
+	maxDocumentSize = 1 << 20
+
+	fetchTimeout = 10 * time.Second
+	maxRedirects = 3
+
+	// resolved did:web documents are cached, and so are the failures,
+	// for a shorter time, so requests naming a DID don't each fetch it.
+	webCacheTTL        = 5 * time.Minute
+	webFailureCacheTTL = time.Minute
+	webCacheSize       = 1024
+)
+
+// ErrNonPublicAddress is returned when fetching from an address which
+// isn't public, such as a loopback, link-local or private address.
+var ErrNonPublicAddress = errors.New("non-public address")
+
+// nonPublicPrefixes are the unicast ranges which aren't reachable on
+// the internet, besides the private, loopback and link-local ones.
+var nonPublicPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),
+	netip.MustParsePrefix("100.64.0.0/10"),
+	netip.MustParsePrefix("198.18.0.0/15"),
+	netip.MustParsePrefix("64:ff9b::/96"),
+}
+
+// Fetcher fetches the content at a URL.
+type Fetcher interface {
+	Fetch(ctx context.Context, url string) ([]byte, error)
+}
+
+// HTTPFetcher fetches content with an HTTP GET.
+type HTTPFetcher struct {
+	Client *http.Client
+}
+
+// NewHTTPFetcher returns a fetcher using a client with a timeout, which
+// only connects to public addresses. The URLs come from the DIDs callers
+// authenticate with, which mustn't make the node reach its own network.
+func NewHTTPFetcher() HTTPFetcher {
+	dialer := &net.Dialer{
+		Timeout: fetchTimeout,
+		// checked once resolved, so DNS can't point elsewhere.
+		Control: dialPublic,
+	}
+	return HTTPFetcher{
+		Client: &http.Client{
+			Timeout: fetchTimeout,
+			Transport: &http.Transport{
+				// no proxy, which would connect on our behalf.
+				Proxy:                  nil,
+				DialContext:            dialer.DialContext,
+				TLSHandshakeTimeout:    fetchTimeout,
+				ResponseHeaderTimeout:  fetchTimeout,
+				MaxResponseHeaderBytes: 64 << 10,
+			},
+			CheckRedirect: func(req *http.Request, via []*http.Request) error {
+				if len(via) >= maxRedirects {
+					return fmt.Errorf("stopped after %d redirects", maxRedirects)
+				}
+				return nil
+			},
+		},
+	}
+}
+
+func (f HTTPFetcher) Fetch(ctx context.Context, url string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := f.Client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("fetch %s: %s", url, resp.Status)
+	}
+
+	buf, err := io.ReadAll(io.LimitReader(resp.Body, maxDocumentSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(buf) > maxDocumentSize {
+		return nil, fmt.Errorf("fetch %s: document larger than %d bytes", url, maxDocumentSize)
+	}
+	return buf, nil
+}
+
+// dialPublic refuses to connect to non-public addresses.
+func dialPublic(_, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	ip, err := netip.ParseAddr(host)
+	if err != nil {
+		return err
+	}
+	if !isPublic(ip) {
+		return fmt.Errorf("%w: %s", ErrNonPublicAddress, ip)
+	}
+	return nil
+}
+
+// isPublic reports whether the address is a public unicast address.
+func isPublic(ip netip.Addr) bool {
+	ip = ip.Unmap()
+	if !ip.IsGlobalUnicast() || ip.IsPrivate() {
+		return false
+	}
+	for _, prefix := range nonPublicPrefixes {
+		if prefix.Contains(ip) {
+			return false
+		}
+	}
+	return true
+}
+
+var _ resolution.Resolver = WebResolver{}
+
+// WebResolver resolves did:web DIDs, fetching their
+// document from the URL the DID is mapped to.
+type WebResolver struct {
+	fetcher Fetcher
+	cache   *webCache
+}
+
+// NewWebResolver returns a did:web resolver fetching the DID documents
+// with the fetcher, and caching them.
+func NewWebResolver(fetcher Fetcher) WebResolver {
+	return WebResolver{
+		fetcher: fetcher,
+		cache: &webCache{
+			entries: make(map[string]webEntry),
+			now:     time.Now,
+		},
+	}
+}
+
+func (WebResolver) Methods() []did.Method {
+	return []did.Method{did.WebMethod}
+}
+
+func (r WebResolver) Resolve(ctx context.Context, id string, _ ...resolution.ResolutionOption) (*resolution.ResolutionResult, error) {
+	if e, ok := r.cache.get(id); ok {
+		if e.err != nil {
+			return nil, e.err
+		}
+		return &resolution.ResolutionResult{Document: e.doc}, nil
+	}
+
+	doc, err := r.fetch(ctx, id)
+	if ctx.Err() == nil {
+		r.cache.put(id, doc, err)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &resolution.ResolutionResult{Document: doc}, nil
+}
+
+func (r WebResolver) fetch(ctx context.Context, id string) (did.Document, error) {
+	var doc did.Document
+	url, err := web.DIDWeb(id).GetDocURL()
+	if err != nil {
+		return doc, fmt.Errorf("did:web url: %w", err)
+	}
+
+	buf, err := r.fetcher.Fetch(ctx, url)
+	if err != nil {
+		return doc, fmt.Errorf("fetch did:web document: %w", err)
+	}
+
+	err = json.Unmarshal(buf, &doc)
+	if err != nil {
+		return doc, fmt.Errorf("unmarshal did:web document: %w", err)
+	}
+	if doc.ID != id {
+		return doc, fmt.Errorf("did:web document id %s doesn't match %s", doc.ID, id)
+	}
+
+	return doc, nil
+}
+
+// webCache caches the resolved documents and the failures, until
+// they expire. It's bounded, forgetting entries once full.
+type webCache struct {
+	mu      sync.Mutex
+	entries map[string]webEntry
+	now     func() time.Time
+}
+
+type webEntry struct {
+	doc    did.Document
+	err    error
+	expiry time.Time
+}
+
+func (c *webCache) get(id string) (webEntry, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e, ok := c.entries[id]
+	if !ok || !c.now().Before(e.expiry) {
+		return webEntry{}, false
+	}
+	return e, true
+}
+
+func (c *webCache) put(id string, doc did.Document, err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.now()
+	if len(c.entries) >= webCacheSize {
+		for k, e := range c.entries {
+			if !now.Before(e.expiry) {
+				delete(c.entries, k)
+			}
+		}
+	}
+	// still full, forget any entry.
+	for k := range c.entries {
+		if len(c.entries) < webCacheSize {
+			break
+		}
+		delete(c.entries, k)
+	}
+
+	ttl := webCacheTTL
+	if err != nil {
+		ttl = webFailureCacheTTL
+	}
+	c.entries[id] = webEntry{doc: doc, err: err, expiry: now.Add(ttl)}
+}
@@ -2,8 +2,13 @@ package dkg
 
 import (
 	"context"
+	"fmt"
+
+	"go.dedis.ch/kyber/v3"
+	"go.dedis.ch/kyber/v3/share"
 
 	"github.com/sourcenetwork/orbis-go/pkg/crypto"
+	"github.com/sourcenetwork/orbis-go/pkg/db"
 	"github.com/sourcenetwork/orbis-go/pkg/transport"
 	"github.com/sourcenetwork/orbis-go/pkg/types"
 )
@@ -56,3 +61,55 @@ type DKG interface {
 
 	// hooks?
 }
+
+// Excluder is implemented by the DKGs that can certify without
+// some of the nodes, and reports the nodes they left out.
+type Excluder interface {
+	Excluded() []Node
+}
+
+// ShareVerifier is implemented by the DKGs that can check their
+// certified share against the public polynomial, to catch a share
+// corrupted in the DB or by a bad restore.
+type ShareVerifier interface {
+	VerifyShare() error
+}
+
+// Archiver is implemented by the DKG factories whose persisted
+// state can be exported in a node backup, and restored from it.
+type Archiver interface {
+	Name() string
+
+	// Export returns the persisted state of the ring's DKG, or
+	// ErrNoShare if it hasn't certified a share yet.
+	Export(ctx context.Context, d *db.DB, rid types.RingID) ([]byte, error)
+
+	// Import verifies the share of an exported state against
+	// its public polynomial, and writes the state to the batch.
+	// It returns the ring public key the share belongs to.
+	Import(ctx context.Context, d *db.DB, rid types.RingID, state []byte, batch db.Batch) (crypto.PublicKey, error)
+}
+
+// VerifyShare checks that the share of the node at index is a
+// point of the public polynomial committed to by the DKG, and
+// that the polynomial holds the group public key.
+func VerifyShare(g kyber.Group, pubKey kyber.Point, dks crypto.DistKeyShare, index int, threshold int) error {
+	if dks.PriShare == nil || len(dks.Commits) == 0 {
+		return ErrNoShare
+	}
+	if len(dks.Commits) != threshold {
+		return fmt.Errorf("%w: %d commits for threshold %d", ErrInvalidShare, len(dks.Commits), threshold)
+	}
+	if dks.PriShare.I != index {
+		return fmt.Errorf("%w: share index %d for node %d", ErrInvalidShare, dks.PriShare.I, index)
+	}
+
+	pubPoly := share.NewPubPoly(g, nil, dks.Commits)
+	if pubKey == nil || !pubPoly.Commit().Equal(pubKey) {
+		return fmt.Errorf("%w: polynomial doesn't hold the public key", ErrInvalidShare)
+	}
+	if !pubPoly.Check(dks.PriShare) {
+		return ErrInvalidShare
+	}
+	return nil
+}
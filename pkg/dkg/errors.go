@@ -6,4 +6,6 @@ var (
 	ErrBadNodeSet     = fmt.Errorf("node set size doesn't match n")
 	ErrNotInitialized = fmt.Errorf("dkg not initialized")
 	ErrMissingSelf    = fmt.Errorf("missing self from node set")
+	ErrNoShare        = fmt.Errorf("dkg has no certified share")
+	ErrInvalidShare   = fmt.Errorf("share doesn't match the public polynomial")
 )
@@ -0,0 +1,37 @@
+// Package pedersen implements the Pedersen distributed key generation,
+// where each node deals a secret with Feldman VSS, as implemented in
+// kyber's share/dkg/pedersen.
+//
+// Unlike the rabin package, there is no secret commits round, and
+// the DKG doesn't need every node to deal. The protocol works as
+// follow:
+//
+//  1. Each node sends an encrypted deal to every other node, on the
+//     ring bulletin at /ring/<ringID>/dkg/pedersen/deal/<from>/<to>.
+//  2. Each node verifies the deals it receives, and sends its
+//     approval or complaint to every other node, including the
+//     dealer, at /ring/<ringID>/dkg/pedersen/response/<from>/<to>/<dealer>.
+//  3. A dealer answers a complaint against its deal with a
+//     justification revealing the deal, at
+//     /ring/<ringID>/dkg/pedersen/justification/<from>/<to>/<complainer>.
+//  4. Once the deals of every node are approved by every node, or
+//     after the configured timeout, once the deals of a threshold of
+//     nodes are approved by a threshold of nodes without complaints
+//     left, each node settles on the group key. It sends its
+//     certificate of the key and the QUAL set to every other node,
+//     at /ring/<ringID>/dkg/pedersen/certificate/<from>/<to>.
+//  5. The DKG is certified once the dealers of the QUAL set and a
+//     majority of the nodes sent certificates matching ours.
+//
+// The deals that are certified form the QUAL set, and the group key
+// is the sum of their secrets. Past the timeout, nodes may settle on
+// different QUAL sets if a dealer's messages only reached some of
+// them before it. A certificate which disagrees with ours then fails
+// the DKG, and since certifying takes the certificates of a majority,
+// two nodes never certify different keys, as long as every node
+// sends the same certificate to all the others.
+//
+// The dealer secret and polynomial are derived from a seed kept in
+// the DKG state, so a node restarting before the DKG is certified
+// resumes with the same deals it already sent.
+package pedersen
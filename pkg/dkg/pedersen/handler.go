@@ -0,0 +1,392 @@
+package pedersen
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	pedersendkg "go.dedis.ch/kyber/v3/share/dkg/pedersen"
+	pedersenvss "go.dedis.ch/kyber/v3/share/vss/pedersen"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/sourcenetwork/eventbus-go"
+	"github.com/sourcenetwork/orbis-go/pkg/bulletin"
+	"github.com/sourcenetwork/orbis-go/pkg/crypto"
+	orbisdkg "github.com/sourcenetwork/orbis-go/pkg/dkg"
+	"github.com/sourcenetwork/orbis-go/pkg/transport"
+)
+
+func (d *dkg) setupHandlers() error {
+	bus := d.bulletin.Events()
+	var err error
+	d.eventsCh, err = eventbus.Subscribe[bulletin.Event](bus)
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		for evt := range d.eventsCh {
+			if evt.Message.TargetId != d.NodeID() || !strings.HasPrefix(evt.ID, d.bbnamespace) {
+				continue
+			}
+
+			// process in a dedicated goroutine so we dont block
+			go func(evt bulletin.Event) {
+				err := d.ProcessMessage(evt.Message)
+				if err != nil {
+					log.Errorf("processing bulletin message %s: %v", evt.ID, err)
+				}
+			}(evt)
+		}
+	}()
+
+	return nil
+}
+
+// processDeal verifies a deal, and sends our response to
+// all the other nodes, including the dealer.
+func (d *dkg) processDeal(deal *pedersendkg.Deal) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.pdkg == nil {
+		return nil // certified before we restarted
+	}
+
+	ctx := context.TODO()
+
+	response, err := d.pdkg.ProcessDeal(deal)
+	if err != nil {
+		return fmt.Errorf("process pedersen dkg deal: %w", err)
+	}
+
+	buf, err := proto.Marshal(responseToProto(response))
+	if err != nil {
+		return fmt.Errorf("marshal response: %w", err)
+	}
+
+	// /ring/<ringID>/dkg/pedersen/response/<fromID>/<toID>/<dealerID>
+	dealerID := d.participants[deal.Index].ID()
+	for _, node := range d.participants {
+		if d.isMe(node) {
+			continue
+		}
+		msgID := fmt.Sprintf("%s/%s/%s/%s/%s", d.bbnamespace, ResponseNamespace, d.NodeID(), node.ID(), dealerID)
+		if err := d.post(ctx, ResponseNamespace, msgID, buf, node); err != nil {
+			return fmt.Errorf("send response: %w", err)
+		}
+	}
+
+	// replay the responses that arrived before the deal
+	pending := d.pendingResponses[deal.Index]
+	delete(d.pendingResponses, deal.Index)
+	for _, resp := range pending {
+		err := d.processResponseUnsafe(ctx, resp)
+		if err != nil {
+			log.Warnf("processing pending response for dealer %d: %v", deal.Index, err)
+		}
+	}
+
+	return d.checkCertifiedUnsafe(ctx)
+}
+
+func (d *dkg) processResponse(resp *pedersendkg.Response) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.pdkg == nil {
+		return nil // certified before we restarted
+	}
+
+	ctx := context.TODO()
+	err := d.processResponseUnsafe(ctx, resp)
+	if err != nil {
+		return err
+	}
+
+	return d.checkCertifiedUnsafe(ctx)
+}
+
+// processResponseUnsafe records a response, and if it's a
+// complaint against our deal, sends our justification to all
+// the other nodes. Responses to deals we haven't received yet
+// are kept until the deal arrives.
+// It requires the caller to aquire a lock
+func (d *dkg) processResponseUnsafe(ctx context.Context, resp *pedersendkg.Response) error {
+	if v, ok := d.pdkg.Verifiers()[resp.Index]; ok {
+		if _, ok := v.Responses()[resp.Response.Index]; ok {
+			return nil // replayed from the bulletin backlog
+		}
+	}
+
+	just, err := d.pdkg.ProcessResponse(resp)
+	if errors.Is(err, pedersenvss.ErrNoDealBeforeResponse) {
+		d.pendingResponses[resp.Index] = append(d.pendingResponses[resp.Index], resp)
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("process response: %w", err)
+	}
+
+	if just != nil {
+		log.Warnf("Node %d justifying our deal against the complaint of %d", d.index, resp.Response.Index)
+		err = d.sendJustification(ctx, just)
+		if err != nil {
+			return err
+		}
+	}
+
+	// replay the justifications that arrived before the complaint
+	pending := d.pendingJustifications[resp.Index]
+	delete(d.pendingJustifications, resp.Index)
+	for _, j := range pending {
+		err := d.processJustificationUnsafe(j)
+		if err != nil {
+			log.Warnf("processing pending justification for dealer %d: %v", resp.Index, err)
+		}
+	}
+
+	return nil
+}
+
+func (d *dkg) sendJustification(ctx context.Context, just *pedersendkg.Justification) error {
+	pj, err := justificationToProto(just)
+	if err != nil {
+		return fmt.Errorf("justification to proto: %w", err)
+	}
+	buf, err := proto.Marshal(pj)
+	if err != nil {
+		return fmt.Errorf("marshal justification: %w", err)
+	}
+
+	// /ring/<ringID>/dkg/pedersen/justification/<fromID>/<toID>/<complainerID>
+	complainerID := d.participants[just.Justification.Index].ID()
+	for _, node := range d.participants {
+		if d.isMe(node) {
+			continue
+		}
+		msgID := fmt.Sprintf("%s/%s/%s/%s/%s", d.bbnamespace, JustificationNamespace, d.NodeID(), node.ID(), complainerID)
+		if err := d.post(ctx, JustificationNamespace, msgID, buf, node); err != nil {
+			return fmt.Errorf("send justification: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (d *dkg) processJustification(j *pedersendkg.Justification) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.pdkg == nil {
+		return nil // certified before we restarted
+	}
+
+	err := d.processJustificationUnsafe(j)
+	if err != nil {
+		return err
+	}
+
+	return d.checkCertifiedUnsafe(context.TODO())
+}
+
+// processJustificationUnsafe verifies a dealer's justification.
+// Justifications to complaints we haven't received yet are kept
+// until the complaint arrives.
+// It requires the caller to aquire a lock
+func (d *dkg) processJustificationUnsafe(j *pedersendkg.Justification) error {
+	v, ok := d.pdkg.Verifiers()[j.Index]
+	if !ok {
+		return fmt.Errorf("justification for unknown dealer %d", j.Index)
+	}
+	if _, ok := v.Responses()[j.Justification.Index]; !ok {
+		d.pendingJustifications[j.Index] = append(d.pendingJustifications[j.Index], j)
+		return nil
+	}
+
+	err := d.pdkg.ProcessJustification(j)
+	if err != nil {
+		return fmt.Errorf("process justification: %w", err)
+	}
+	return nil
+}
+
+// checkCertifiedUnsafe settles on the group key once the deals of
+// all the nodes are certified, or after the timeout, once the deals
+// of a threshold of nodes are. The DKG then certifies the key once
+// the other nodes confirm it.
+// It requires the caller to aquire a lock
+func (d *dkg) checkCertifiedUnsafe(ctx context.Context) error {
+	if d.state == orbisdkg.CERTIFIED || d.state == FAILED || d.state < orbisdkg.STARTED {
+		return nil
+	}
+	if d.state == CONFIRMING {
+		return d.checkConfirmedUnsafe(ctx)
+	}
+
+	if d.pdkg.Certified() || (d.timedOut && d.pdkg.ThresholdCertified()) {
+		return d.confirmUnsafe(ctx)
+	}
+	return nil
+}
+
+// confirmUnsafe settles on the group key of the QUAL set, and sends
+// our certificate of it to the other nodes.
+// It requires the caller to aquire a lock
+func (d *dkg) confirmUnsafe(ctx context.Context) error {
+	distkey, err := d.pdkg.DistKeyShare()
+	if err != nil {
+		return fmt.Errorf("pedersen dkg dist key share: %w", err)
+	}
+
+	d.distKeyShare = crypto.DistKeyShare{
+		Commits:  distkey.Commitments(),
+		PriShare: distkey.PriShare(),
+	}
+
+	d.qual = d.pdkg.QUAL()
+	sort.Ints(d.qual)
+
+	d.pubKey = distkey.Public()
+	d.state = CONFIRMING
+	if d.timer != nil {
+		d.timer.Stop()
+	}
+
+	err = d.save(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = d.sendCertificate(ctx)
+	if err != nil {
+		return err
+	}
+
+	return d.checkConfirmedUnsafe(ctx)
+}
+
+func (d *dkg) sendCertificate(ctx context.Context) error {
+	c, err := signCertificate(d.suite, d.privKey, d.ringID, d.index, d.qual, d.pubKey)
+	if err != nil {
+		return err
+	}
+	buf, err := proto.Marshal(c)
+	if err != nil {
+		return fmt.Errorf("marshal certificate: %w", err)
+	}
+
+	// /ring/<ringID>/dkg/pedersen/certificate/<fromID>/<toID>
+	for _, node := range d.participants {
+		if d.isMe(node) {
+			continue
+		}
+		msgID := fmt.Sprintf("%s/%s/%s/%s", d.bbnamespace, CertificateNamespace, d.NodeID(), node.ID())
+		if err := d.post(ctx, CertificateNamespace, msgID, buf, node); err != nil {
+			return fmt.Errorf("send certificate: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// processCertificate records the certificate of another node. Until
+// we certify, a certificate which disagrees with ours fails the DKG.
+func (d *dkg) processCertificate(c *Certificate) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.pdkg == nil || d.state == FAILED {
+		return nil // certified or failed before we restarted
+	}
+
+	err := verifyCertificate(d.suite, d.ringID, d.participants, c)
+	if err != nil {
+		return err
+	}
+	index := int(c.Index)
+	if index == d.index {
+		return fmt.Errorf("certificate of our own index %d", index)
+	}
+	if _, ok := d.certificates[index]; ok {
+		return nil // replayed from the bulletin backlog
+	}
+	d.certificates[index] = c
+
+	if d.state == orbisdkg.CERTIFIED {
+		if !d.matchesUnsafe(c) {
+			return fmt.Errorf("%w: node %d certified another key than ours", ErrKeyMismatch, index)
+		}
+		return nil
+	}
+
+	return d.checkCertifiedUnsafe(context.TODO())
+}
+
+// checkConfirmedUnsafe certifies the group key once the dealers of
+// the QUAL set and a majority of the nodes sent matching certificates.
+// The majority keeps two nodes from certifying different keys, since
+// they have a certificate from a common node.
+// It requires the caller to aquire a lock
+func (d *dkg) checkConfirmedUnsafe(ctx context.Context) error {
+	for index, c := range d.certificates {
+		if !d.matchesUnsafe(c) {
+			return d.failUnsafe(ctx, index)
+		}
+	}
+
+	for _, q := range d.qual {
+		if _, ok := d.certificates[q]; !ok && q != d.index {
+			return nil
+		}
+	}
+	if len(d.certificates)+1 <= int(d.num)/2 {
+		return nil
+	}
+
+	return d.certifyUnsafe(ctx)
+}
+
+// matchesUnsafe reports whether the certificate is of our
+// QUAL set and group key.
+// It requires the caller to aquire a lock
+func (d *dkg) matchesUnsafe(c *Certificate) bool {
+	if len(c.Qual) != len(d.qual) {
+		return false
+	}
+	for i, q := range c.Qual {
+		if int(q) != d.qual[i] {
+			return false
+		}
+	}
+	pk := d.suite.Point()
+	return pk.UnmarshalBinary(c.PublicKey) == nil && pk.Equal(d.pubKey)
+}
+
+// failUnsafe fails the DKG, as the node at index disagrees with
+// us on the group key, and drops the share we settled on.
+// It requires the caller to aquire a lock
+func (d *dkg) failUnsafe(ctx context.Context, index int) error {
+	d.state = FAILED
+	d.pubKey = nil
+	d.distKeyShare = crypto.DistKeyShare{}
+	d.qual = nil
+	if d.timer != nil {
+		d.timer.Stop()
+	}
+
+	err := fmt.Errorf("%w: node %d certified another key than node %d", ErrKeyMismatch, index, d.index)
+	return errors.Join(err, d.save(ctx))
+}
+
+func (d *dkg) certifyUnsafe(ctx context.Context) error {
+	d.state = orbisdkg.CERTIFIED
+	log.Infof("Node %d finished setup with deals of %v and shared public key: %s", d.index, d.qual, d.pubKey)
+	return d.save(ctx)
+}
+
+func (d *dkg) isMe(node transport.Node) bool {
+	return d.NodeID() == node.ID()
+}
@@ -0,0 +1,664 @@
+package pedersen
+
+import (
+	"context"
+	"crypto/cipher"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	logging "github.com/ipfs/go-log"
+	"go.dedis.ch/kyber/v3"
+	pedersendkg "go.dedis.ch/kyber/v3/share/dkg/pedersen"
+	"go.dedis.ch/kyber/v3/suites"
+	"go.dedis.ch/kyber/v3/xof/blake2xb"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/sourcenetwork/eventbus-go"
+	pedersenv1alpha1 "github.com/sourcenetwork/orbis-go/gen/proto/orbis/pedersen/v1alpha1"
+	"github.com/sourcenetwork/orbis-go/pkg/bulletin"
+	"github.com/sourcenetwork/orbis-go/pkg/crypto"
+	"github.com/sourcenetwork/orbis-go/pkg/db"
+	orbisdkg "github.com/sourcenetwork/orbis-go/pkg/dkg"
+	"github.com/sourcenetwork/orbis-go/pkg/transport"
+	"github.com/sourcenetwork/orbis-go/pkg/types"
+)
+
+var log = logging.Logger("orbis/dkg/pedersen")
+
+const name = "pedersen"
+
+const (
+	peerConnectTimeout     = time.Second * 5
+	bulletinBacklogTimeout = time.Second * 5
+
+	seedSize = 32
+)
+
+var ErrNotCertified = fmt.Errorf("dkg not certified")
+
+type dkg struct {
+	mu sync.Mutex
+
+	ringID types.RingID
+
+	// dkg internal state
+	pdkg         *pedersendkg.DistKeyGenerator
+	participants []orbisdkg.Node
+	privKey      kyber.Scalar
+	index        int
+
+	rkeys []db.RepoKey
+
+	// dkg params
+	num       int32
+	threshold int32
+	suite     suites.Suite
+	timeout   time.Duration
+
+	pubKey       kyber.Point         // DKG group Public key
+	distKeyShare crypto.DistKeyShare // DKG node private share
+	qual         []int               // dealers of the DKG group key
+
+	// verified certificates of the other nodes, by index
+	certificates map[int]*Certificate
+
+	seed []byte // seed of the vss dealer secret and polynomial
+
+	// messages recieved before the deal they refer to,
+	// indexed by dealer
+	pendingResponses      map[uint32][]*pedersendkg.Response
+	pendingJustifications map[uint32][]*pedersendkg.Justification
+
+	timer    *time.Timer
+	timedOut bool
+
+	// state repos
+	dkgRepo db.Repository[*pedersenv1alpha1.DKG]
+
+	// dependency services
+	db        *db.DB
+	transport transport.Transport
+	bulletin  bulletin.Bulletin
+
+	bbnamespace string
+	eventsCh    eventbus.Subscription[bulletin.Event]
+
+	state orbisdkg.State
+}
+
+// New returns a pedersen DKG, which waits up to timeout for the deals
+// and responses of every node, before certifying with the deals of a
+// threshold of nodes. A zero timeout waits for every node.
+func New(repo *db.DB, rkeys []db.RepoKey, t transport.Transport, b bulletin.Bulletin, timeout time.Duration) (*dkg, error) {
+	if len(rkeys) != 1 {
+		return nil, ErrMissingRepoKeys
+	}
+	dkgRepo, err := db.GetRepo(repo, rkeys[0], dkgPkFunc)
+	if err != nil {
+		return nil, errors.Join(ErrCouldntGetRepo, err)
+	}
+
+	return &dkg{
+		db:                    repo,
+		rkeys:                 rkeys,
+		dkgRepo:               dkgRepo,
+		transport:             t,
+		bulletin:              b,
+		timeout:               timeout,
+		index:                 -1,
+		pendingResponses:      make(map[uint32][]*pedersendkg.Response),
+		pendingJustifications: make(map[uint32][]*pedersendkg.Justification),
+		certificates:          make(map[int]*Certificate),
+	}, nil
+}
+
+// Init initializes the DKG with the target nodes
+func (d *dkg) Init(ctx context.Context, pk crypto.PrivateKey, rid types.RingID, nodes []orbisdkg.Node, n int32, threshold int32, fromState bool) error {
+	if fromState {
+		return d.initFromState(ctx, pk, rid, nodes)
+	}
+	return d.initFromNew(ctx, pk, rid, nodes, n, threshold)
+}
+
+func (d *dkg) initFromState(ctx context.Context, pk crypto.PrivateKey, rid types.RingID, nodes []orbisdkg.Node) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if pk == nil {
+		return fmt.Errorf("missing private key")
+	}
+
+	d.ringID = rid
+	err := d.loadUnsafe(ctx)
+	if err != nil {
+		return err
+	}
+
+	if len(nodes) != len(d.participants) {
+		return orbisdkg.ErrBadNodeSet
+	}
+	for i, p := range d.participants {
+		node := nodes[i]
+		if p.ID() != node.ID() || !p.Address().Equal(node.Address()) || !p.PublicKey().Equals(node.PublicKey()) {
+			return fmt.Errorf("invalid participant set while loading from state: expected %v got %v", p.ID(), node.ID())
+		}
+	}
+
+	d.privKey = pk.Scalar()
+
+	// Nothing left to do once certified or failed, otherwise
+	// rebuild the generator and resume the deals.
+	if d.state == orbisdkg.CERTIFIED || d.state == FAILED {
+		return nil
+	}
+
+	err = d.newGenerator()
+	if err != nil {
+		return fmt.Errorf("building dkg from state: %w", err)
+	}
+
+	err = d.initCommon(ctx)
+	if err != nil {
+		return err
+	}
+
+	if d.state == orbisdkg.UNSPECIFIED || d.state == orbisdkg.INITIALIZED {
+		return nil
+	}
+
+	log.Infof("Resuming pedersen DKG for ring %s from state %s", d.ringID, d.State())
+	return d.dealUnsafe(ctx)
+}
+
+func (d *dkg) initFromNew(ctx context.Context, pk crypto.PrivateKey, rid types.RingID, nodes []orbisdkg.Node, n int32, threshold int32) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if pk == nil {
+		return fmt.Errorf("missing private key")
+	}
+
+	suite, err := crypto.SuiteForType(pk.Type())
+	if err != nil {
+		return fmt.Errorf("get suite for type: %w", err)
+	}
+
+	if len(nodes) != int(n) {
+		return orbisdkg.ErrBadNodeSet
+	}
+
+	d.ringID = rid
+	d.suite = suite
+	d.privKey = pk.Scalar()
+	d.num = n
+	d.threshold = threshold
+	d.participants = nodes
+
+	pub := suite.Point().Mul(d.privKey, nil)
+	for i, n := range d.participants {
+		if n.PublicKey().Point().Equal(pub) {
+			d.index = i
+		}
+	}
+
+	// we didn't find ourselves in the list
+	if d.index == -1 {
+		return orbisdkg.ErrMissingSelf
+	}
+
+	d.seed = make([]byte, seedSize)
+	_, err = rand.Read(d.seed)
+	if err != nil {
+		return fmt.Errorf("generate seed: %w", err)
+	}
+
+	err = d.newGenerator()
+	if err != nil {
+		return fmt.Errorf("create DKG: %w", err)
+	}
+
+	d.state = orbisdkg.INITIALIZED
+
+	err = d.initCommon(ctx)
+	if err != nil {
+		return err
+	}
+	return d.save(ctx) // save the initialized state
+}
+
+// newGenerator creates the pedersen DKG, with the vss dealer
+// secret and polynomial derived from the DKG seed.
+func (d *dkg) newGenerator() error {
+	points := make([]kyber.Point, 0, len(d.participants))
+	for _, n := range d.participants {
+		points = append(points, n.PublicKey().Point())
+	}
+
+	pdkg, err := pedersendkg.NewDistKeyHandler(&pedersendkg.Config{
+		Suite:          &seededSuite{Suite: d.suite, stream: seedStream(d.seed, "poly")},
+		Longterm:       d.privKey,
+		NewNodes:       points,
+		Threshold:      int(d.threshold),
+		Reader:         seedStream(d.seed, "secret"),
+		UserReaderOnly: true,
+	})
+	if err != nil {
+		return err
+	}
+
+	d.pdkg = pdkg
+	return nil
+}
+
+// seedStream derives a labeled random stream from the DKG seed.
+func seedStream(seed []byte, label string) kyber.XOF {
+	return blake2xb.New(append([]byte(label), seed...))
+}
+
+// seededSuite derives the dealer polynomial from the DKG seed, so
+// that a restarted node deals the same polynomial it already sent
+// to its peers. Only the first random stream, which the vss dealer
+// uses to pick the polynomial, is seeded. The later streams, used
+// for the deal encryption and the schnorr signatures, are not.
+type seededSuite struct {
+	suites.Suite
+
+	mu     sync.Mutex
+	stream cipher.Stream
+}
+
+func (s *seededSuite) RandomStream() cipher.Stream {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.stream != nil {
+		stream := s.stream
+		s.stream = nil
+		return stream
+	}
+	return s.Suite.RandomStream()
+}
+
+// initCommon does all the none state initialization. Shared
+// between initFromNew() and initFromState()
+func (d *dkg) initCommon(ctx context.Context) error {
+	d.bbnamespace = fmt.Sprintf("/ring/%s/dkg/pedersen", string(d.ringID))
+
+	err := d.setupHandlers()
+	if err != nil {
+		return err
+	}
+
+	err = d.bulletin.Register(ctx, d.bbnamespace)
+	if err != nil {
+		return err
+	}
+	log.Infof("registered to namespace %s", d.bbnamespace)
+
+	return d.queryBulletinBacklog(ctx)
+}
+
+// queryBulletinBacklog runs a query on the ring dkg bulletin
+// namespace for any messages that were posted before we joined,
+// or while we were offline.
+//
+// Note: The bulletin internal cache has automatic deduplication
+// so theres no need to worry about duplicate messages
+func (d *dkg) queryBulletinBacklog(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, bulletinBacklogTimeout)
+	defer cancel()
+
+	log.Info("Querying for missed bulletin messages")
+	resps, err := d.bulletin.Query(ctx, d.bbnamespace+"*")
+	if err != nil {
+		return fmt.Errorf("bulletin query: %w", err)
+	}
+	for resp := range resps {
+		if resp.Err != nil {
+			return fmt.Errorf("bulletin query response: %w", resp.Err)
+		}
+
+		d.eventsCh <- bulletin.Event{
+			Message: resp.Resp.Data,
+			ID:      resp.Resp.ID,
+		}
+	}
+	log.Info("Finished bulletin query backlog")
+	return nil
+}
+
+func (d *dkg) Name() string {
+	return name
+}
+
+func (d *dkg) PublicKey() (crypto.PublicKey, error) {
+	if d.state != orbisdkg.CERTIFIED || d.pubKey == nil {
+		return nil, ErrNotCertified
+	}
+	return crypto.PublicKeyFromPoint(d.suite, d.pubKey)
+}
+
+func (d *dkg) Share() crypto.DistKeyShare {
+	if d.state != orbisdkg.CERTIFIED {
+		return crypto.DistKeyShare{}
+	}
+	return d.distKeyShare
+}
+
+func (d *dkg) State() string {
+	return stateToString[d.state]
+}
+
+// Excluded returns the nodes whose deals didn't make it
+// into the group key, once certified.
+func (d *dkg) Excluded() []orbisdkg.Node {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.state != orbisdkg.CERTIFIED {
+		return nil
+	}
+
+	qual := make(map[int]bool)
+	for _, i := range d.qual {
+		qual[i] = true
+	}
+	var nodes []orbisdkg.Node
+	for i, p := range d.participants {
+		if !qual[i] {
+			nodes = append(nodes, p)
+		}
+	}
+	return nodes
+}
+
+// VerifyShare checks our certified share against the public
+// polynomial of the group key.
+func (d *dkg) VerifyShare() error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.state != orbisdkg.CERTIFIED {
+		return orbisdkg.ErrNoShare
+	}
+	if d.index < 0 || d.index >= len(d.participants) || !d.isMe(d.participants[d.index]) {
+		return fmt.Errorf("%w: we aren't participant %d", orbisdkg.ErrInvalidShare, d.index)
+	}
+	return orbisdkg.VerifyShare(d.suite, d.pubKey, d.distKeyShare, d.index, int(d.threshold))
+}
+
+// Start the DKG setup process.
+func (d *dkg) Start(ctx context.Context) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	log.Debug("Starting pedersen DKG")
+
+	d.state = orbisdkg.STARTED
+	if err := d.save(ctx); err != nil {
+		return err
+	}
+
+	return d.dealUnsafe(ctx)
+}
+
+// dealUnsafe sends our deals to the other nodes, and starts
+// the timeout for the deals and responses of the other nodes.
+// It requires the caller to aquire a lock
+func (d *dkg) dealUnsafe(ctx context.Context) error {
+	d.connectToPeers(ctx)
+
+	log.Debug("Generating deals")
+	deals, err := d.pdkg.Deals()
+	if err != nil {
+		return fmt.Errorf("generate deals: %w", err)
+	}
+
+	for i, deal := range deals {
+		buf, err := proto.Marshal(dealToProto(deal))
+		if err != nil {
+			return fmt.Errorf("marshal deal: %w", err)
+		}
+
+		log.Debugf("node %s sending deal to partitipants %s", d.NodeID(), d.participants[i].ID())
+		msgID := fmt.Sprintf("%s/%s/%s/%s", d.bbnamespace, DealNamespace, d.NodeID(), d.participants[i].ID())
+		err = d.post(ctx, DealNamespace, msgID, buf, d.participants[i])
+		if err != nil {
+			return fmt.Errorf("send deal: %w", err)
+		}
+	}
+
+	// resumed after sending our certificate, which the
+	// other nodes hold us to.
+	if d.state == CONFIRMING {
+		err = d.sendCertificate(ctx)
+		if err != nil {
+			return err
+		}
+		return d.checkCertifiedUnsafe(ctx)
+	}
+
+	d.state = RECEIVING
+	if err := d.save(ctx); err != nil {
+		return err
+	}
+
+	if d.timeout > 0 {
+		if d.timer != nil {
+			d.timer.Stop()
+		}
+		d.timer = time.AfterFunc(d.timeout, d.onTimeout)
+	}
+
+	return d.checkCertifiedUnsafe(ctx)
+}
+
+// onTimeout ends the period in which every node is expected
+// to deal. From then on, the DKG settles on the deals
+// approved by a threshold of nodes, as soon as there are a
+// threshold of them.
+func (d *dkg) onTimeout() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.state != RECEIVING {
+		return
+	}
+
+	log.Warnf("Node %d timed out waiting for deals, %d/%d certified", d.index, len(d.pdkg.QUAL()), d.num)
+	d.timedOut = true
+	d.pdkg.SetTimeout()
+
+	ctx := context.TODO()
+	if d.pdkg.ThresholdCertified() {
+		err := d.confirmUnsafe(ctx)
+		if err != nil {
+			log.Errorf("confirm after timeout: %v", err)
+		}
+		return
+	}
+
+	d.state = TIMED_OUT
+	err := d.save(ctx)
+	if err != nil {
+		log.Errorf("failed to save DKG state: %v", err)
+	}
+}
+
+// connectToPeers tries to connect to the other nodes for at
+// most peerConnectTimeout. Nodes that are offline can catch
+// up on our deals from the bulletin backlog once they join.
+func (d *dkg) connectToPeers(ctx context.Context) {
+	ctx, cancel := context.WithTimeout(ctx, peerConnectTimeout)
+	defer cancel()
+
+	wg := sync.WaitGroup{}
+	for _, p := range d.participants {
+		if d.isMe(p) {
+			continue
+		}
+		wg.Add(1)
+		go func(p transport.Node) {
+			defer wg.Done()
+
+			for {
+				err := d.transport.Connect(ctx, p)
+				if err == nil {
+					log.Infof("Connected to %s", p.ID())
+					return
+				}
+				log.Debugf("Can't connect %s, retry in 1 sec", err)
+
+				select {
+				case <-ctx.Done():
+					log.Warnf("Couldn't connect to %s, continuing without it", p.ID())
+					return
+				case <-time.After(time.Second):
+				}
+			}
+		}(p)
+	}
+
+	wg.Wait()
+}
+
+func (d *dkg) post(ctx context.Context, msgType string, msgID string, buf []byte, node transport.Node) error {
+	cid, err := types.CidFromBytes(buf)
+	if err != nil {
+		return fmt.Errorf("cid from bytes: %w", err)
+	}
+
+	msg, err := d.transport.NewMessage(d.ringID, cid.String(), false, buf, msgType, node)
+	if err != nil {
+		return fmt.Errorf("new message: %w", err)
+	}
+
+	_, err = d.bulletin.Post(ctx, msgID, msg)
+	if errors.Is(err, bulletin.ErrDuplicateMessage) {
+		// already posted before we resumed from state
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("dkg bulletin post: %w", err)
+	}
+
+	return nil
+}
+
+func (d *dkg) Close(_ context.Context) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.timer != nil {
+		d.timer.Stop()
+	}
+	return nil
+}
+
+func (d *dkg) ProcessMessage(msg *transport.Message) error {
+	switch msg.GetType() {
+	case DealNamespace:
+		var protoDeal Deal
+		err := proto.Unmarshal(msg.Payload, &protoDeal)
+		if err != nil {
+			return fmt.Errorf("unmarshal deal message: %w", err)
+		}
+		deal, err := dealFromProto(&protoDeal)
+		if err != nil {
+			return fmt.Errorf("deal from proto: %w", err)
+		}
+		return d.processDeal(deal)
+
+	case ResponseNamespace:
+		var protoResponse Response
+		err := proto.Unmarshal(msg.Payload, &protoResponse)
+		if err != nil {
+			return fmt.Errorf("unmarshal response message: %w", err)
+		}
+		resp, err := responseFromProto(&protoResponse)
+		if err != nil {
+			return fmt.Errorf("response from proto: %w", err)
+		}
+		return d.processResponse(resp)
+
+	case JustificationNamespace:
+		var protoJustification Justification
+		err := proto.Unmarshal(msg.Payload, &protoJustification)
+		if err != nil {
+			return fmt.Errorf("unmarshal justification message: %w", err)
+		}
+		j, err := justificationFromProto(d.suite, &protoJustification)
+		if err != nil {
+			return fmt.Errorf("justification from proto: %w", err)
+		}
+		return d.processJustification(j)
+
+	case CertificateNamespace:
+		var c Certificate
+		err := proto.Unmarshal(msg.Payload, &c)
+		if err != nil {
+			return fmt.Errorf("unmarshal certificate message: %w", err)
+		}
+		return d.processCertificate(&c)
+
+	default:
+		return fmt.Errorf("unknown message type: %q", msg.GetType())
+	}
+}
+
+// save will persist the current DKG state to the DKG Repo.
+func (d *dkg) save(ctx context.Context) error {
+	dkgp, err := dkgToProto(d)
+	if err != nil {
+		return fmt.Errorf("proto conversion: %w", err)
+	}
+
+	err = d.dkgRepo.Save(ctx, dkgp)
+	if err != nil {
+		return fmt.Errorf("saving dkg: %w", err)
+	}
+	return nil
+}
+
+// load will get the persisted DKG state from the DKG Repo.
+// It directly writes the result into the pointer, in place.
+func (d *dkg) load(ctx context.Context) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.loadUnsafe(ctx)
+}
+
+// loadUnsafe is the same as load, but without locks.
+// It requires the caller to aquire a lock
+func (d *dkg) loadUnsafe(ctx context.Context) error {
+	dkgp, err := d.dkgRepo.Get(ctx, &pedersenv1alpha1.DKG{RingId: string(d.ringID)})
+	if err != nil {
+		return fmt.Errorf("dkg from repo: %w", err)
+	}
+
+	_d, err := dkgFromProto(dkgp)
+	if err != nil {
+		return fmt.Errorf("dkg from proto: %w", err)
+	}
+
+	// inplace mutation of state defined on pointer reciever
+	d.index = _d.index
+	d.num = _d.num
+	d.threshold = _d.threshold
+	d.suite = _d.suite
+	d.state = _d.state
+	d.pubKey = _d.pubKey
+	d.distKeyShare = _d.distKeyShare
+	d.participants = _d.participants
+	d.seed = _d.seed
+	d.qual = _d.qual
+
+	return nil
+}
+
+func (d *dkg) NodeID() string {
+	return d.transport.Host().ID()
+}
@@ -0,0 +1,353 @@
+package pedersen
+
+import (
+	"context"
+	cryptorand "crypto/rand"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/libp2p/go-libp2p/core/protocol"
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.dedis.ch/kyber/v3/share"
+	"go.dedis.ch/kyber/v3/suites"
+	"google.golang.org/protobuf/proto"
+
+	pedersenv1alpha1 "github.com/sourcenetwork/orbis-go/gen/proto/orbis/pedersen/v1alpha1"
+	"github.com/sourcenetwork/orbis-go/pkg/bulletin"
+	"github.com/sourcenetwork/orbis-go/pkg/bulletin/memmap"
+	"github.com/sourcenetwork/orbis-go/pkg/crypto"
+	"github.com/sourcenetwork/orbis-go/pkg/db"
+	orbisdkg "github.com/sourcenetwork/orbis-go/pkg/dkg"
+	"github.com/sourcenetwork/orbis-go/pkg/transport"
+	p2ptransport "github.com/sourcenetwork/orbis-go/pkg/transport/p2p"
+	"github.com/sourcenetwork/orbis-go/pkg/types"
+)
+
+const testRingID = types.RingID("0x123")
+
+// testTransport is an in memory transport, the DKG
+// messages are all exchanged over the bulletin.
+type testTransport struct {
+	host testHost
+}
+
+type testHost struct {
+	transport.Node
+}
+
+func (testHost) Sign([]byte) ([]byte, error) {
+	return nil, nil
+}
+
+func (t *testTransport) Name() string { return "test" }
+
+func (t *testTransport) Send(context.Context, transport.Node, *transport.Message) error { return nil }
+
+func (t *testTransport) Gossip(context.Context, string, *transport.Message) error { return nil }
+
+func (t *testTransport) Connect(context.Context, transport.Node) error { return nil }
+
+func (t *testTransport) Host() transport.Host { return t.host }
+
+func (t *testTransport) AddHandler(protocol.ID, transport.Handler) {}
+
+func (t *testTransport) RemoveHandler(protocol.ID) {}
+
+func (t *testTransport) NewMessage(rid types.RingID, id string, gossip bool, payload []byte, msgType string, target transport.Node) (*transport.Message, error) {
+	return &transport.Message{
+		Id:       id,
+		RingId:   string(rid),
+		NodeId:   t.host.ID(),
+		Type:     msgType,
+		Payload:  payload,
+		Gossip:   gossip,
+		TargetId: target.ID(),
+	}, nil
+}
+
+type testNode struct {
+	priv crypto.PrivateKey
+	node transport.Node
+	db   *db.DB
+}
+
+func newTestNodes(t *testing.T, num int) []testNode {
+	nodes := make([]testNode, num)
+	for i := range nodes {
+		priv, pub, err := crypto.GenerateKeyPair(suites.MustFind("Ed25519"), cryptorand.Reader)
+		require.NoError(t, err)
+		pid, err := peer.IDFromPublicKey(pub)
+		require.NoError(t, err)
+		addr, err := ma.NewMultiaddr(fmt.Sprintf("/tcp/%d", 9000+i))
+		require.NoError(t, err)
+
+		d, err := db.New(t.TempDir())
+		require.NoError(t, err)
+
+		nodes[i] = testNode{
+			priv: priv,
+			node: p2ptransport.NewNode(pid.String(), pub, addr),
+			db:   d,
+		}
+	}
+	return nodes
+}
+
+func participants(nodes []testNode) []orbisdkg.Node {
+	ps := make([]orbisdkg.Node, len(nodes))
+	for i, n := range nodes {
+		ps[i] = n.node
+	}
+	return ps
+}
+
+func newTestDKG(t *testing.T, n testNode, b bulletin.Bulletin, timeout time.Duration) *dkg {
+	d, err := New(n.db, []db.RepoKey{db.NewRepoKey("dkg")}, &testTransport{host: testHost{n.node}}, b, timeout)
+	require.NoError(t, err)
+	return d
+}
+
+// runDKG initializes and starts a DKG for each of the nodes.
+func runDKG(t *testing.T, nodes []testNode, online []int, b bulletin.Bulletin, threshold int32, timeout time.Duration) []*dkg {
+	dkgs := make([]*dkg, len(online))
+	for i, idx := range online {
+		dkgs[i] = newTestDKG(t, nodes[idx], b, timeout)
+		err := dkgs[i].Init(context.Background(), nodes[idx].priv, testRingID, participants(nodes), int32(len(nodes)), threshold, false)
+		require.NoError(t, err)
+	}
+
+	var wg sync.WaitGroup
+	for _, d := range dkgs {
+		wg.Add(1)
+		go func(d *dkg) {
+			defer wg.Done()
+			assert.NoError(t, d.Start(context.Background()))
+		}(d)
+	}
+	wg.Wait()
+
+	return dkgs
+}
+
+func requireCertified(t *testing.T, dkgs []*dkg, wait time.Duration) {
+	require.Eventually(t, func() bool {
+		for _, d := range dkgs {
+			d.mu.Lock()
+			state := d.state
+			d.mu.Unlock()
+			if state != orbisdkg.CERTIFIED {
+				return false
+			}
+		}
+		return true
+	}, wait, 50*time.Millisecond)
+}
+
+// requireSameKey checks the nodes agree on the group key,
+// and that a threshold of their shares recovers its secret.
+func requireSameKey(t *testing.T, dkgs []*dkg, threshold int, num int) {
+	suite := dkgs[0].suite
+	pubPoly := share.NewPubPoly(suite, nil, dkgs[0].distKeyShare.Commits)
+	require.True(t, pubPoly.Commit().Equal(dkgs[0].pubKey))
+
+	var shares []*share.PriShare
+	for _, d := range dkgs {
+		require.True(t, d.pubKey.Equal(dkgs[0].pubKey))
+		require.Equal(t, dkgs[0].qual, d.qual)
+		require.True(t, pubPoly.Check(d.distKeyShare.PriShare))
+		require.Equal(t, d.index, d.distKeyShare.PriShare.I)
+		shares = append(shares, d.distKeyShare.PriShare)
+	}
+
+	secret, err := share.RecoverSecret(suite, shares[:threshold], threshold, num)
+	require.NoError(t, err)
+	require.True(t, suite.Point().Mul(secret, nil).Equal(dkgs[0].pubKey))
+}
+
+func TestDKGAllNodes(t *testing.T) {
+	nodes := newTestNodes(t, 4)
+	dkgs := runDKG(t, nodes, []int{0, 1, 2, 3}, memmap.New(), 3, 0)
+
+	requireCertified(t, dkgs, 10*time.Second)
+	requireSameKey(t, dkgs, 3, 4)
+	require.Equal(t, []int{0, 1, 2, 3}, dkgs[0].qual)
+
+	pk, err := dkgs[0].PublicKey()
+	require.NoError(t, err)
+	require.True(t, pk.Point().Equal(dkgs[0].pubKey))
+}
+
+func TestDKGThresholdAfterTimeout(t *testing.T) {
+	nodes := newTestNodes(t, 4)
+	dkgs := runDKG(t, nodes, []int{0, 1, 3}, memmap.New(), 3, time.Second)
+
+	// the offline node keeps the DKG from certifying until the timeout
+	time.Sleep(500 * time.Millisecond)
+	for _, d := range dkgs {
+		d.mu.Lock()
+		require.Equal(t, RECEIVING, d.state)
+		d.mu.Unlock()
+	}
+
+	requireCertified(t, dkgs, 10*time.Second)
+	requireSameKey(t, dkgs, 3, 4)
+	require.Equal(t, []int{0, 1, 3}, dkgs[0].qual)
+}
+
+func TestDKGBelowThresholdTimesOut(t *testing.T) {
+	nodes := newTestNodes(t, 4)
+	dkgs := runDKG(t, nodes, []int{0, 1}, memmap.New(), 3, 500*time.Millisecond)
+
+	require.Eventually(t, func() bool {
+		dkgs[0].mu.Lock()
+		defer dkgs[0].mu.Unlock()
+		return dkgs[0].state == TIMED_OUT
+	}, 10*time.Second, 50*time.Millisecond)
+
+	_, err := dkgs[0].PublicKey()
+	require.ErrorIs(t, err, ErrNotCertified)
+}
+
+func TestDKGKeyMismatchFails(t *testing.T) {
+	ctx := context.Background()
+	nodes := newTestNodes(t, 4)
+	b := memmap.New()
+
+	// the offline node certified every deal, with another group key
+	suite := suites.MustFind("Ed25519")
+	c, err := signCertificate(suite, nodes[3].priv.Scalar(), testRingID, 3, []int{0, 1, 2, 3}, suite.Point().Pick(suite.RandomStream()))
+	require.NoError(t, err)
+	buf, err := proto.Marshal(c)
+	require.NoError(t, err)
+	tp := &testTransport{host: testHost{nodes[3].node}}
+	for _, n := range nodes[:3] {
+		msg, err := tp.NewMessage(testRingID, "cert", false, buf, CertificateNamespace, n.node)
+		require.NoError(t, err)
+		_, err = b.Post(ctx, fmt.Sprintf("/ring/%s/dkg/pedersen/%s/%s/%s", testRingID, CertificateNamespace, nodes[3].node.ID(), n.node.ID()), msg)
+		require.NoError(t, err)
+	}
+
+	dkgs := runDKG(t, nodes, []int{0, 1, 2}, b, 3, 500*time.Millisecond)
+
+	require.Eventually(t, func() bool {
+		for _, d := range dkgs {
+			d.mu.Lock()
+			state := d.state
+			d.mu.Unlock()
+			if state != FAILED {
+				return false
+			}
+		}
+		return true
+	}, 10*time.Second, 50*time.Millisecond)
+
+	_, err = dkgs[0].PublicKey()
+	require.ErrorIs(t, err, ErrNotCertified)
+	require.Nil(t, dkgs[0].Share().PriShare)
+
+	// a certificate signed by another node is rejected
+	c.Index = 2
+	require.Error(t, verifyCertificate(suite, testRingID, participants(nodes), c))
+}
+
+func TestDKGResumeFromState(t *testing.T) {
+	ctx := context.Background()
+	nodes := newTestNodes(t, 3)
+	b := memmap.New()
+
+	// node 0 deals, and stops before the others are online
+	offline := memmap.New()
+	d0 := newTestDKG(t, nodes[0], offline, 0)
+	require.NoError(t, d0.Init(ctx, nodes[0].priv, testRingID, participants(nodes), 3, 2, false))
+	require.NoError(t, d0.Start(ctx))
+	require.NoError(t, d0.Close(ctx))
+
+	resps, err := offline.Query(ctx, "*")
+	require.NoError(t, err)
+	for resp := range resps {
+		require.NoError(t, resp.Err)
+		_, err = b.Post(ctx, resp.Resp.ID, resp.Resp.Data)
+		require.NoError(t, err)
+	}
+
+	// the restarted node must deal the same polynomial
+	resumed := newTestDKG(t, nodes[0], b, 0)
+	require.NoError(t, resumed.Init(ctx, nodes[0].priv, testRingID, participants(nodes), 3, 2, true))
+	require.Equal(t, d0.seed, resumed.seed)
+	require.Equal(t, d0.pdkg.Verifiers()[0].Commits(), resumed.pdkg.Verifiers()[0].Commits())
+
+	dkgs := append([]*dkg{resumed}, runDKG(t, nodes, []int{1, 2}, b, 2, 0)...)
+	requireCertified(t, dkgs, 10*time.Second)
+	requireSameKey(t, dkgs, 2, 3)
+
+	// a certified DKG loads its share
+	loaded := newTestDKG(t, nodes[0], memmap.New(), 0)
+	require.NoError(t, loaded.Init(ctx, nodes[0].priv, testRingID, participants(nodes), 3, 2, true))
+	require.Equal(t, orbisdkg.CERTIFIED, loaded.state)
+	require.True(t, loaded.pubKey.Equal(resumed.pubKey))
+	require.Equal(t, resumed.qual, loaded.qual)
+	require.Equal(t, resumed.distKeyShare.PriShare.String(), loaded.distKeyShare.PriShare.String())
+	require.Len(t, loaded.distKeyShare.Commits, 2)
+}
+
+func TestDKGProtoSerialization(t *testing.T) {
+	nodes := newTestNodes(t, 3)
+	d := newTestDKG(t, nodes[1], memmap.New(), 0)
+	require.NoError(t, d.Init(context.Background(), nodes[1].priv, testRingID, participants(nodes), 3, 2, false))
+
+	dkgp, err := dkgToProto(d)
+	require.NoError(t, err)
+	d2, err := dkgFromProto(dkgp)
+	require.NoError(t, err)
+
+	assert.Equal(t, d.ringID, d2.ringID)
+	assert.Equal(t, 1, d2.index)
+	assert.Equal(t, d.num, d2.num)
+	assert.Equal(t, d.threshold, d2.threshold)
+	assert.Equal(t, d.suite.String(), d2.suite.String())
+	assert.Equal(t, orbisdkg.INITIALIZED, d2.state)
+	assert.Equal(t, d.seed, d2.seed)
+	assert.Len(t, d2.participants, 3)
+	for i, p := range d2.participants {
+		assert.Equal(t, d.participants[i].ID(), p.ID())
+	}
+}
+
+func TestArchiveImportVerifiesShare(t *testing.T) {
+	ctx := context.Background()
+	nodes := newTestNodes(t, 3)
+	dkgs := runDKG(t, nodes, []int{0, 1, 2}, memmap.New(), 2, 0)
+	requireCertified(t, dkgs, 10*time.Second)
+
+	state, err := Factory.Export(ctx, nodes[0].db, testRingID)
+	require.NoError(t, err)
+
+	restoredDB, err := db.New(t.TempDir())
+	require.NoError(t, err)
+	batch := restoredDB.Batch()
+	pk, err := Factory.Import(ctx, restoredDB, testRingID, state, batch)
+	require.NoError(t, err)
+	require.True(t, pk.Point().Equal(dkgs[0].pubKey))
+	require.NoError(t, batch.Commit(db.Sync))
+	require.NoError(t, batch.Close())
+
+	loaded := newTestDKG(t, testNode{priv: nodes[0].priv, node: nodes[0].node, db: restoredDB}, memmap.New(), 0)
+	require.NoError(t, loaded.Init(ctx, nodes[0].priv, testRingID, participants(nodes), 3, 2, true))
+	require.True(t, loaded.pubKey.Equal(dkgs[0].pubKey))
+
+	// the share of another node doesn't match our index
+	other, err := Factory.Export(ctx, nodes[1].db, testRingID)
+	require.NoError(t, err)
+	dkgp := &pedersenv1alpha1.DKG{}
+	require.NoError(t, proto.Unmarshal(other, dkgp))
+	dkgp.Index = 0
+	tampered, err := proto.Marshal(dkgp)
+	require.NoError(t, err)
+	_, err = Factory.Import(ctx, restoredDB, testRingID, tampered, restoredDB.Batch())
+	require.ErrorIs(t, err, orbisdkg.ErrInvalidShare)
+}
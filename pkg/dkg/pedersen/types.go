@@ -0,0 +1,452 @@
+package pedersen
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	ic "github.com/libp2p/go-libp2p/core/crypto"
+	ma "github.com/multiformats/go-multiaddr"
+	"go.dedis.ch/kyber/v3"
+	"go.dedis.ch/kyber/v3/group/edwards25519"
+	"go.dedis.ch/kyber/v3/share"
+	pedersendkg "go.dedis.ch/kyber/v3/share/dkg/pedersen"
+	pedersenvss "go.dedis.ch/kyber/v3/share/vss/pedersen"
+	"go.dedis.ch/kyber/v3/sign/schnorr"
+	"go.dedis.ch/kyber/v3/suites"
+
+	pedersenv1alpha1 "github.com/sourcenetwork/orbis-go/gen/proto/orbis/pedersen/v1alpha1"
+	"github.com/sourcenetwork/orbis-go/pkg/crypto"
+	"github.com/sourcenetwork/orbis-go/pkg/crypto/suites/secp256k1ct"
+	"github.com/sourcenetwork/orbis-go/pkg/db"
+	orbisdkg "github.com/sourcenetwork/orbis-go/pkg/dkg"
+	p2ptransport "github.com/sourcenetwork/orbis-go/pkg/transport/p2p"
+	"github.com/sourcenetwork/orbis-go/pkg/types"
+)
+
+const (
+	// Sent our deals, waiting for the deals and responses of the other nodes
+	RECEIVING orbisdkg.State = orbisdkg.CUSTOM_STATE_MASK | iota + 1 // 0b10000001
+	// Timed out before a threshold of deals were certified
+	TIMED_OUT // 0b10000010
+	// Sent our certificate, waiting for the matching certificates of the other nodes
+	CONFIRMING // 0b10000011
+	// The certificates of the nodes disagree on the group key
+	FAILED // 0b10000100
+)
+
+var (
+	ErrMissingRepoKeys = fmt.Errorf("missing repo keys")
+	ErrCouldntGetRepo  = fmt.Errorf("dkg: can't get repo")
+	ErrKeyMismatch     = fmt.Errorf("nodes disagree on the dkg group key")
+
+	stateToString = map[orbisdkg.State]string{
+		orbisdkg.UNSPECIFIED: orbisdkg.UNSPECIFIED.String(),
+		orbisdkg.STARTED:     orbisdkg.STARTED.String(),
+		orbisdkg.INITIALIZED: orbisdkg.INITIALIZED.String(),
+		orbisdkg.CERTIFIED:   orbisdkg.CERTIFIED.String(),
+		RECEIVING:            "Receiving Deals",
+		TIMED_OUT:            "Timed Out",
+		CONFIRMING:           "Confirming Key",
+		FAILED:               "Failed",
+	}
+)
+
+var (
+	DealNamespace          string = "deal"
+	ResponseNamespace      string = "response"
+	JustificationNamespace string = "justification"
+	CertificateNamespace   string = "certificate"
+)
+
+type (
+	Deal          = pedersenv1alpha1.Deal
+	Response      = pedersenv1alpha1.Response
+	Justification = pedersenv1alpha1.Justification
+	Certificate   = pedersenv1alpha1.Certificate
+)
+
+// certificateDomain separates the certificate signatures
+// from the other signatures of the long term keys.
+const certificateDomain = "orbis pedersen certificate"
+
+// signCertificate returns the certificate of the group key and
+// QUAL set of the node at index, signed by its long term key.
+func signCertificate(suite suites.Suite, priv kyber.Scalar, rid types.RingID, index int, qual []int, pubKey kyber.Point) (*Certificate, error) {
+	pk, err := pubKey.MarshalBinary()
+	if err != nil {
+		return nil, fmt.Errorf("marshal public key: %w", err)
+	}
+
+	c := &Certificate{
+		Index:     uint32(index),
+		Qual:      make([]uint32, len(qual)),
+		PublicKey: pk,
+	}
+	for i, q := range qual {
+		c.Qual[i] = uint32(q)
+	}
+
+	c.Signature, err = schnorr.Sign(suite, priv, certificateMessage(rid, c))
+	if err != nil {
+		return nil, fmt.Errorf("sign certificate: %w", err)
+	}
+	return c, nil
+}
+
+// verifyCertificate checks the certificate is signed by the
+// long term key of the node it claims to be from.
+func verifyCertificate(suite suites.Suite, rid types.RingID, nodes []orbisdkg.Node, c *Certificate) error {
+	if int(c.Index) >= len(nodes) {
+		return fmt.Errorf("certificate of unknown node %d", c.Index)
+	}
+	pub := nodes[c.Index].PublicKey().Point()
+	err := schnorr.Verify(suite, pub, certificateMessage(rid, c), c.Signature)
+	if err != nil {
+		return fmt.Errorf("certificate of node %d: %w", c.Index, err)
+	}
+	return nil
+}
+
+func certificateMessage(rid types.RingID, c *Certificate) []byte {
+	msg := []byte(certificateDomain)
+	msg = binary.BigEndian.AppendUint32(msg, uint32(len(rid)))
+	msg = append(msg, rid...)
+	msg = binary.BigEndian.AppendUint32(msg, c.Index)
+	msg = binary.BigEndian.AppendUint32(msg, uint32(len(c.Qual)))
+	for _, q := range c.Qual {
+		msg = binary.BigEndian.AppendUint32(msg, q)
+	}
+	return append(msg, c.PublicKey...)
+}
+
+func dkgPkFunc(kb db.KeyBuilder, d *pedersenv1alpha1.DKG) []byte {
+	return kb.AddStringField(d.RingId).Bytes()
+}
+
+func dealToProto(deal *pedersendkg.Deal) *Deal {
+	return &Deal{
+		Index: deal.Index,
+		Deal: &pedersenv1alpha1.EncryptedDeal{
+			Dhkey:     deal.Deal.DHKey,
+			Signature: deal.Deal.Signature,
+			Nonce:     deal.Deal.Nonce,
+			Cipher:    deal.Deal.Cipher,
+		},
+		Signature: deal.Signature,
+	}
+}
+
+func dealFromProto(deal *Deal) (*pedersendkg.Deal, error) {
+	if deal.Deal == nil {
+		return nil, fmt.Errorf("missing encrypted deal")
+	}
+
+	return &pedersendkg.Deal{
+		Index: deal.Index,
+		Deal: &pedersenvss.EncryptedDeal{
+			DHKey:     deal.Deal.Dhkey,
+			Signature: deal.Deal.Signature,
+			Nonce:     deal.Deal.Nonce,
+			Cipher:    deal.Deal.Cipher,
+		},
+		Signature: deal.Signature,
+	}, nil
+}
+
+func responseToProto(resp *pedersendkg.Response) *Response {
+	return &Response{
+		Index: resp.Index,
+		Response: &pedersenv1alpha1.VerifiableResponse{
+			SessionId: resp.Response.SessionID,
+			Index:     resp.Response.Index,
+			Approved:  resp.Response.Status,
+			Signature: resp.Response.Signature,
+		},
+	}
+}
+
+func responseFromProto(resp *Response) (*pedersendkg.Response, error) {
+	if resp.Response == nil {
+		return nil, fmt.Errorf("missing verifiable response")
+	}
+
+	return &pedersendkg.Response{
+		Index: resp.Index,
+		Response: &pedersenvss.Response{
+			SessionID: resp.Response.SessionId,
+			Index:     resp.Response.Index,
+			Status:    resp.Response.Approved,
+			Signature: resp.Response.Signature,
+		},
+	}, nil
+}
+
+func justificationToProto(j *pedersendkg.Justification) (*Justification, error) {
+	deal := j.Justification.Deal
+	v, err := deal.SecShare.V.MarshalBinary()
+	if err != nil {
+		return nil, fmt.Errorf("marshal share: %w", err)
+	}
+
+	commits := make([][]byte, len(deal.Commitments))
+	for i, c := range deal.Commitments {
+		commits[i], err = c.MarshalBinary()
+		if err != nil {
+			return nil, fmt.Errorf("marshal commitment: %w", err)
+		}
+	}
+
+	return &Justification{
+		Index:       j.Index,
+		SessionId:   j.Justification.SessionID,
+		TargetIndex: j.Justification.Index,
+		Deal: &pedersenv1alpha1.PlainDeal{
+			SessionId: deal.SessionID,
+			SecShare: &pedersenv1alpha1.PriShare{
+				Index: int32(deal.SecShare.I),
+				V:     v,
+			},
+			T:           deal.T,
+			Commitments: commits,
+		},
+		Signature: j.Justification.Signature,
+	}, nil
+}
+
+func justificationFromProto(suite suites.Suite, j *Justification) (*pedersendkg.Justification, error) {
+	if j.Deal == nil || j.Deal.SecShare == nil {
+		return nil, fmt.Errorf("missing justified deal")
+	}
+
+	v := suite.Scalar()
+	err := v.UnmarshalBinary(j.Deal.SecShare.V)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal share: %w", err)
+	}
+
+	commits := make([]kyber.Point, len(j.Deal.Commitments))
+	for i, c := range j.Deal.Commitments {
+		commits[i] = suite.Point()
+		err = commits[i].UnmarshalBinary(c)
+		if err != nil {
+			return nil, fmt.Errorf("unmarshal commitment: %w", err)
+		}
+	}
+
+	return &pedersendkg.Justification{
+		Index: j.Index,
+		Justification: &pedersenvss.Justification{
+			SessionID: j.SessionId,
+			Index:     j.TargetIndex,
+			Deal: &pedersenvss.Deal{
+				SessionID: j.Deal.SessionId,
+				SecShare: &share.PriShare{
+					I: int(j.Deal.SecShare.Index),
+					V: v,
+				},
+				T:           j.Deal.T,
+				Commitments: commits,
+			},
+			Signature: j.Signature,
+		},
+	}, nil
+}
+
+func dkgToProto(d *dkg) (*pedersenv1alpha1.DKG, error) {
+	var suiteType pedersenv1alpha1.SuiteType
+	if d.suite != nil {
+		switch d.suite.String() {
+		case "Ed25519":
+			suiteType = pedersenv1alpha1.SuiteType_Ed25519
+		case "Secp256k1":
+			suiteType = pedersenv1alpha1.SuiteType_Secp256k1
+		case crypto.PairingSuite().String():
+			suiteType = pedersenv1alpha1.SuiteType_BLS12_381
+		default:
+			return nil, fmt.Errorf("invalid suite type: %v", d.suite.String())
+		}
+	}
+
+	var state pedersenv1alpha1.State
+	switch d.state {
+	case orbisdkg.UNSPECIFIED:
+		state = pedersenv1alpha1.State_STATE_UNSPECIFIED
+	case orbisdkg.INITIALIZED:
+		state = pedersenv1alpha1.State_STATE_INITIALIZED
+	case orbisdkg.STARTED:
+		state = pedersenv1alpha1.State_STATE_STARTED
+	case orbisdkg.CERTIFIED:
+		state = pedersenv1alpha1.State_STATE_CERTIFIED
+	case RECEIVING:
+		state = pedersenv1alpha1.State_STATE_RECEIVING
+	case TIMED_OUT:
+		state = pedersenv1alpha1.State_STATE_TIMED_OUT
+	case CONFIRMING:
+		state = pedersenv1alpha1.State_STATE_CONFIRMING
+	case FAILED:
+		state = pedersenv1alpha1.State_STATE_FAILED
+	default:
+		return nil, fmt.Errorf("invalid state: %v, 0x%0x", d.state, d.state)
+	}
+
+	var nodes []*pedersenv1alpha1.Node
+	if d.participants != nil {
+		nodes = make([]*pedersenv1alpha1.Node, len(d.participants))
+		for i, p := range d.participants {
+			pk, err := ic.PublicKeyToProto(p.PublicKey())
+			if err != nil {
+				return nil, fmt.Errorf("couldnt convert public key to proto: %w", err)
+			}
+			nodes[i] = &pedersenv1alpha1.Node{
+				Id:        p.ID(),
+				Address:   p.Address().String(),
+				PublicKey: pk,
+			}
+		}
+	}
+
+	var pubkey []byte
+	var err error
+	if d.pubKey != nil {
+		pubkey, err = d.pubKey.MarshalBinary()
+		if err != nil {
+			return nil, fmt.Errorf("couldn't marshal pubkey: %w", err)
+		}
+	}
+
+	var prishare *pedersenv1alpha1.PriShare
+	if share := d.distKeyShare.PriShare; share != nil {
+		sbuf, err := share.V.MarshalBinary()
+		if err != nil {
+			return nil, fmt.Errorf("couldn't marshal private share: %w", err)
+		}
+		prishare = &pedersenv1alpha1.PriShare{
+			Index: int32(share.I),
+			V:     sbuf,
+		}
+	}
+
+	commits := make([][]byte, len(d.distKeyShare.Commits))
+	for i, c := range d.distKeyShare.Commits {
+		commits[i], err = c.MarshalBinary()
+		if err != nil {
+			return nil, fmt.Errorf("couldn't marshal commitment: %w", err)
+		}
+	}
+
+	qual := make([]int32, len(d.qual))
+	for i, q := range d.qual {
+		qual[i] = int32(q)
+	}
+
+	return &pedersenv1alpha1.DKG{
+		RingId:    string(d.ringID),
+		Index:     int32(d.index),
+		Num:       d.num,
+		Threshold: d.threshold,
+		Suite:     suiteType,
+		State:     state,
+		Nodes:     nodes,
+		Pubkey:    pubkey,
+		PriShare:  prishare,
+		Seed:      d.seed,
+		Commits:   commits,
+		Qual:      qual,
+	}, nil
+}
+
+func dkgFromProto(d *pedersenv1alpha1.DKG) (dkg, error) {
+	var suite suites.Suite
+	switch d.Suite {
+	case pedersenv1alpha1.SuiteType_Ed25519:
+		suite = edwards25519.NewBlakeSHA256Ed25519()
+	case pedersenv1alpha1.SuiteType_Secp256k1:
+		suite = secp256k1ct.NewBlakeKeccackSecp256k1()
+	case pedersenv1alpha1.SuiteType_BLS12_381:
+		suite = crypto.PairingSuite()
+	default:
+		return dkg{}, fmt.Errorf("bad key type: %v", d.Suite.String())
+	}
+
+	var state orbisdkg.State
+	switch d.State {
+	case pedersenv1alpha1.State_STATE_UNSPECIFIED:
+		state = orbisdkg.UNSPECIFIED
+	case pedersenv1alpha1.State_STATE_INITIALIZED:
+		state = orbisdkg.INITIALIZED
+	case pedersenv1alpha1.State_STATE_STARTED:
+		state = orbisdkg.STARTED
+	case pedersenv1alpha1.State_STATE_CERTIFIED:
+		state = orbisdkg.CERTIFIED
+	case pedersenv1alpha1.State_STATE_RECEIVING:
+		state = RECEIVING
+	case pedersenv1alpha1.State_STATE_TIMED_OUT:
+		state = TIMED_OUT
+	case pedersenv1alpha1.State_STATE_CONFIRMING:
+		state = CONFIRMING
+	case pedersenv1alpha1.State_STATE_FAILED:
+		state = FAILED
+	}
+
+	participants := make([]orbisdkg.Node, len(d.Nodes))
+	for i, n := range d.Nodes {
+		pk, err := crypto.PubKeyFromProto(n.PublicKey)
+		if err != nil {
+			return dkg{}, fmt.Errorf("couldnt convert proto to public key: %w", err)
+		}
+		addr, err := ma.NewMultiaddr(n.Address)
+		if err != nil {
+			return dkg{}, fmt.Errorf("invalid address: %w", err)
+		}
+		participants[i] = p2ptransport.NewNode(n.Id, pk, addr)
+	}
+
+	var pubkey kyber.Point
+	if d.Pubkey != nil {
+		pubkey = suite.Point()
+		err := pubkey.UnmarshalBinary(d.Pubkey)
+		if err != nil {
+			return dkg{}, fmt.Errorf("unmarshaling pubkey: %w", err)
+		}
+	}
+
+	var distKeyShare crypto.DistKeyShare
+	if d.PriShare != nil {
+		distKeyShare.PriShare = &share.PriShare{
+			I: int(d.PriShare.Index),
+			V: suite.Scalar(),
+		}
+		err := distKeyShare.PriShare.V.UnmarshalBinary(d.PriShare.V)
+		if err != nil {
+			return dkg{}, fmt.Errorf("unmarshaling prishare: %w", err)
+		}
+	}
+
+	for _, c := range d.Commits {
+		commit := suite.Point()
+		err := commit.UnmarshalBinary(c)
+		if err != nil {
+			return dkg{}, fmt.Errorf("unmarshaling commitment: %w", err)
+		}
+		distKeyShare.Commits = append(distKeyShare.Commits, commit)
+	}
+
+	var qual []int
+	for _, q := range d.Qual {
+		qual = append(qual, int(q))
+	}
+
+	return dkg{
+		ringID:       types.RingID(d.RingId),
+		index:        int(d.Index),
+		num:          d.Num,
+		threshold:    d.Threshold,
+		suite:        suite,
+		state:        state,
+		participants: participants,
+		pubKey:       pubkey,
+		distKeyShare: distKeyShare,
+		seed:         d.Seed,
+		qual:         qual,
+	}, nil
+}
@@ -0,0 +1,78 @@
+package rabin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+
+	rabinv1alpha1 "github.com/sourcenetwork/orbis-go/gen/proto/orbis/rabin/v1alpha1"
+	"github.com/sourcenetwork/orbis-go/pkg/crypto"
+	odb "github.com/sourcenetwork/orbis-go/pkg/db"
+	orbisdkg "github.com/sourcenetwork/orbis-go/pkg/dkg"
+	"github.com/sourcenetwork/orbis-go/pkg/types"
+)
+
+var _ orbisdkg.Archiver = (*factory)(nil)
+
+// archiveRepoKey mounts the DKG repo for backups, which are
+// taken while the node is stopped.
+var archiveRepoKey = odb.NewRepoKey("dkg")
+
+// Export returns the persisted state of a certified ring DKG.
+func (factory) Export(ctx context.Context, d *odb.DB, rid types.RingID) ([]byte, error) {
+	repo, err := odb.GetRepo(d, archiveRepoKey, dkgPkFunc)
+	if err != nil {
+		return nil, errors.Join(ErrCouldntGetRepo, err)
+	}
+
+	dkgp, err := repo.Get(ctx, &rabinv1alpha1.DKG{RingId: string(rid)})
+	if err != nil {
+		return nil, fmt.Errorf("dkg from repo: %w", err)
+	}
+	if dkgp.State != rabinv1alpha1.State_STATE_CERTIFIED {
+		return nil, orbisdkg.ErrNoShare
+	}
+
+	return proto.Marshal(dkgp)
+}
+
+// Import verifies the share of an exported DKG state, and writes
+// it to the batch, so the ring DKG loads it on the next start.
+func (factory) Import(ctx context.Context, d *odb.DB, rid types.RingID, state []byte, batch odb.Batch) (crypto.PublicKey, error) {
+	dkgp := &rabinv1alpha1.DKG{}
+	err := proto.Unmarshal(state, dkgp)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal dkg: %w", err)
+	}
+	if dkgp.RingId != string(rid) {
+		return nil, fmt.Errorf("dkg state of ring %s, expected %s", dkgp.RingId, rid)
+	}
+	if dkgp.State != rabinv1alpha1.State_STATE_CERTIFIED {
+		return nil, orbisdkg.ErrNoShare
+	}
+
+	restored, err := dkgFromProto(dkgp)
+	if err != nil {
+		return nil, fmt.Errorf("dkg from proto: %w", err)
+	}
+	err = orbisdkg.VerifyShare(restored.suite, restored.pubKey, restored.distKeyShare, restored.index, int(restored.threshold))
+	if err != nil {
+		return nil, fmt.Errorf("verify share: %w", err)
+	}
+	pk, err := crypto.PublicKeyFromPoint(restored.suite, restored.pubKey)
+	if err != nil {
+		return nil, fmt.Errorf("ring public key: %w", err)
+	}
+
+	repo, err := odb.GetRepo(d, archiveRepoKey, dkgPkFunc)
+	if err != nil {
+		return nil, errors.Join(ErrCouldntGetRepo, err)
+	}
+	err = repo.Save(ctx, dkgp, batch)
+	if err != nil {
+		return nil, fmt.Errorf("save dkg: %w", err)
+	}
+	return pk, nil
+}
@@ -2,6 +2,7 @@ package rabin
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/samber/do"
 	"github.com/sourcenetwork/orbis-go/config"
@@ -19,7 +20,7 @@ var (
 
 type factory struct{}
 
-func (factory) New(inj *do.Injector, rkeys []odb.RepoKey, _ config.Config) (orbisdkg.DKG, error) {
+func (factory) New(inj *do.Injector, rkeys []odb.RepoKey, cfg config.Config) (orbisdkg.DKG, error) {
 	db, err := do.Invoke[*odb.DB](inj)
 	if err != nil {
 		return nil, fmt.Errorf("invoke db: %w", err)
@@ -35,7 +36,7 @@ func (factory) New(inj *do.Injector, rkeys []odb.RepoKey, _ config.Config) (orbi
 		return nil, fmt.Errorf("invoke bulletin: %w", err)
 	}
 
-	return New(db, rkeys, t, b)
+	return New(db, rkeys, t, b, time.Duration(cfg.DKG.Timeout)*time.Second)
 }
 
 func (factory) Name() string {
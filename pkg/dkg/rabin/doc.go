@@ -33,5 +33,13 @@
 //    must be broadcasted to all the QUAL participant.
 //   7. At this point, every QUAL participant can issue the distributed key by
 //    calling `DistKeyShare()`.
+//
+// Each of the deals, responses and secret commits phases waits at most
+// the DKG timeout for the messages of the other nodes. Nodes missing at the
+// end of the responses phase count as complaints, and the dealers left out of
+// QUAL are recorded as excluded. A QUAL dealer whose secret commits are still
+// missing at the end of the commits phase has them reconstructed from the
+// shares the other nodes reveal. The nodes only agree on QUAL if the messages
+// of the nodes that took part arrived within the deadlines.
 
 package rabin
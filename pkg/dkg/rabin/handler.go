@@ -3,9 +3,13 @@ package rabin
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strings"
 
+	"go.dedis.ch/kyber/v3"
 	rabindkg "go.dedis.ch/kyber/v3/share/dkg/rabin"
-	"go.dedis.ch/protobuf"
+	rabinvss "go.dedis.ch/kyber/v3/share/vss/rabin"
+	"go.dedis.ch/kyber/v3/sign/schnorr"
 	"google.golang.org/protobuf/proto"
 
 	"github.com/sourcenetwork/eventbus-go"
@@ -26,7 +30,7 @@ func (d *dkg) setupHandlers() error {
 	go func() {
 		for evt := range d.eventsCh {
 			log.Debugf("recieved eventbus on %s from %s for %s (%s)", d.transport.Host().ID(), evt.Message.NodeId, evt.Message.TargetId, evt.Message.GetType())
-			if evt.Message.TargetId != d.transport.Host().ID() {
+			if evt.Message.TargetId != d.transport.Host().ID() || !strings.HasPrefix(evt.ID, d.bbnamespace) {
 				log.Debugf("ignoring bulletin event not for us")
 				continue
 			}
@@ -44,6 +48,9 @@ func (d *dkg) setupHandlers() error {
 	return nil
 }
 
+// processDeal verifies a deal, and sends our response to all
+// the other nodes. A deal that doesn't verify is answered with
+// a complaint, which the dealer has to justify.
 func (d *dkg) processDeal(deal *rabindkg.Deal) error {
 	d.mu.Lock()
 	defer d.mu.Unlock()
@@ -58,37 +65,61 @@ func (d *dkg) processDeal(deal *rabindkg.Deal) error {
 		log.Debugf("succesfully processed deal %0x", deal.Deal.Signature)
 	}
 
-	buf, err := protobuf.Encode(response)
+	if response.Response.Approved {
+		plain, err := d.openDeal(deal)
+		if err != nil {
+			log.Warnf("Node %d opening deal of %d: %v", d.index, deal.Index, err)
+		} else {
+			d.openDeals[deal.Index] = plain
+		}
+	} else {
+		log.Warnf("Node %d complaining about the deal of %d", d.index, deal.Index)
+		d.addComplaintUnsafe(response.Index, response.Response.Index)
+	}
+
+	buf, err := proto.Marshal(d.responseToProto(response))
 	if err != nil {
-		return fmt.Errorf("encode response: %w", err)
+		return fmt.Errorf("marshal response: %w", err)
 	}
 
-	for _, node := range d.participants {
-		if d.isMe(node) {
-			continue // skip ourselves
-		}
-		// TODO: can we skip the sender of the deal as well?
-
-		log.Debugf("sending response on %s for %s", d.transport.Host().ID(), node.ID())
-		// /ring/<ringID>/dkg/rabin/<action>/<fromID>/<toID>
-
-		// each node generates deals [d0, d1, d2]
-		// each node processes each deal [d1, d2, d2]
-		// each node creates response for (dN, SELF, TARGET)
-
-		// /ring/<ringID>/dkg/rabin/RESPONSE/JOHN/ROY/<FOR>
-		forNodeID1 := d.participants[response.Index].ID()
-		// forNodeID2 := d.participants[response.Target].ID()
-		log.Debugf("creating identifier from %s to %s for %s", d.NodeID(), node.ID(), forNodeID1)
-		msgID := fmt.Sprintf("%s/%s/%s/%s/%s", d.bbnamespace, ResponseNamespace, d.NodeID(), node.ID(), forNodeID1)
-		if err := d.post(ctx, ResponseNamespace, msgID, buf, node); err != nil {
-			return fmt.Errorf("send response: %w", err)
-		}
+	// each node generates deals [d0, d1, d2]
+	// each node processes each deal [d1, d2, d2]
+	// each node creates response for (dN, SELF, TARGET)
+	//
+	// /ring/<ringID>/dkg/rabin/RESPONSE/JOHN/ROY/<FOR>
+	return d.broadcast(ctx, ResponseNamespace, response.Index, buf)
+}
+
+// openDeal decrypts our deal from a dealer. The rabin dkg
+// doesn't expose the deals it verified, and we need our share
+// to help the other nodes reconstruct the secret commits of a
+// dealer.
+func (d *dkg) openDeal(deal *rabindkg.Deal) (*rabinvss.Deal, error) {
+	points := d.participantPoints()
+	ver, err := rabinvss.NewVerifier(d.suite, d.privKey, points[deal.Index], points)
+	if err != nil {
+		return nil, fmt.Errorf("new verifier: %w", err)
 	}
 
-	return nil
+	resp, err := ver.ProcessEncryptedDeal(deal.Deal)
+	if err != nil {
+		return nil, fmt.Errorf("process encrypted deal: %w", err)
+	}
+	if !resp.Approved {
+		return nil, fmt.Errorf("deal doesn't verify")
+	}
+
+	// the verifier only hands out the deal once it's certified,
+	// which is for the dkg to decide, not this throwaway one
+	for i := range points {
+		ver.UnsafeSetResponseDKG(uint32(i), true)
+	}
+	return ver.Deal(), nil
 }
 
+// processResponse records a response to a deal, and if it's a
+// complaint against our deal, sends our justification to all
+// the other nodes.
 func (d *dkg) processResponse(resp *rabindkg.Response) error {
 	d.mu.Lock()
 	defer d.mu.Unlock()
@@ -97,30 +128,150 @@ func (d *dkg) processResponse(resp *rabindkg.Response) error {
 	// have processed the cooresponding deal
 	//
 	// theres a chance that we missed it from the p2p
-	// network or bulletin board.
-	//
-	// For now, lets just design it assuming all is well (temp)
+	// network or bulletin board, in which case the
+	// dealer won't make it into our qualified set.
 	just, err := d.rdkg.ProcessResponse(resp)
 	if err != nil {
 		return fmt.Errorf("process response: %w", err)
 	}
 
-	if just != nil {
-		log.Warnf("Got justification during response process for %d: %v", resp.Index, just)
+	if !resp.Response.Approved && resp.Index != uint32(d.index) {
+		log.Warnf("Node %d got a complaint from %d against the deal of %d", d.index, resp.Response.Index, resp.Index)
+		d.addComplaintUnsafe(resp.Index, resp.Response.Index)
+
+		// replay the justifications that arrived before the complaint
+		pending := d.pendingJustifications[resp.Index]
+		delete(d.pendingJustifications, resp.Index)
+		for _, j := range pending {
+			err := d.processJustificationUnsafe(j)
+			if err != nil {
+				log.Warnf("processing pending justification for dealer %d: %v", resp.Index, err)
+			}
+		}
+	}
+
+	if just == nil {
 		return nil
 	}
 
-	if !d.rdkg.Certified() {
+	log.Warnf("Node %d justifying our deal against the complaint of %d", d.index, resp.Response.Index)
+	pj, err := justificationToProto(just)
+	if err != nil {
+		return fmt.Errorf("justification to proto: %w", err)
+	}
+	buf, err := proto.Marshal(pj)
+	if err != nil {
+		return fmt.Errorf("marshal justification: %w", err)
+	}
+
+	// /ring/<ringID>/dkg/rabin/justification/<fromID>/<toID>/<complainerID>
+	return d.broadcast(context.TODO(), JustificationNamespace, just.Justification.Index, buf)
+}
+
+func (d *dkg) processJustification(j *rabindkg.Justification) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.processJustificationUnsafe(j)
+}
+
+// processJustificationUnsafe verifies a dealer's justification
+// to a complaint. A dealer that fails to justify itself won't
+// make it into the qualified set. Justifications to complaints
+// we haven't received yet are kept until the complaint arrives.
+// It requires the caller to aquire a lock
+func (d *dkg) processJustificationUnsafe(j *rabindkg.Justification) error {
+	complainer := j.Justification.Index
+	if !d.complaints[j.Index][complainer] {
+		d.pendingJustifications[j.Index] = append(d.pendingJustifications[j.Index], j)
 		return nil
 	}
 
+	delete(d.complaints[j.Index], complainer)
+	if len(d.complaints[j.Index]) == 0 {
+		delete(d.complaints, j.Index)
+	}
+
+	err := d.rdkg.ProcessJustification(j)
+	if err != nil {
+		return fmt.Errorf("dealer %d failed to justify the complaint of %d: %w", j.Index, complainer, err)
+	}
+
+	if complainer == uint32(d.index) {
+		d.openDeals[j.Index] = j.Justification.Deal
+	}
+	return nil
+}
+
+// addComplaintUnsafe records a complaint that the dealer
+// still has to justify.
+// It requires the caller to aquire a lock
+func (d *dkg) addComplaintUnsafe(dealer, complainer uint32) {
+	if d.complaints[dealer] == nil {
+		d.complaints[dealer] = make(map[uint32]bool)
+	}
+	d.complaints[dealer][complainer] = true
+}
+
+// qualify ends the responses phase. Nodes that haven't responded
+// by now count as complaints, and the dealers whose deals are
+// still certified form the qualified set (QUAL). If there are
+// enough of them, we send our secret commits to the others.
+// It reports whether the DKG can carry on.
+func (d *dkg) qualify(ctx context.Context) (bool, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.rdkg.SetTimeout()
+	d.rdkg.Dealer().SetTimeout()
+
+	qual := make(map[int]bool)
+	for _, i := range d.rdkg.QUAL() {
+		qual[i] = true
+	}
+	d.excluded = nil
+	for i := range d.participants {
+		if !qual[i] {
+			d.excluded = append(d.excluded, i)
+		}
+	}
+	sort.Ints(d.excluded)
+	if len(d.excluded) > 0 {
+		log.Warnf("Node %d excluded nodes %v from the DKG", d.index, d.excluded)
+	}
+
+	if !d.rdkg.Certified() {
+		log.Errorf("Node %d has %d qualified nodes, below the threshold of %d", d.index, len(qual), d.threshold)
+		d.state = TIMED_OUT
+		return false, d.save(ctx)
+	}
+
+	d.state = PROCESSED_RESPONSES
+	err := d.save(ctx)
+	if err != nil {
+		return true, err
+	}
+
+	err = d.sendSecretCommitsUnsafe(ctx)
+	if err != nil {
+		return true, err
+	}
+	return true, d.checkFinishedUnsafe(ctx)
+}
+
+// sendSecretCommitsUnsafe sends the commits of our secret to
+// all the other nodes, if our deal made it into the qualified
+// set.
+// It requires the caller to aquire a lock
+func (d *dkg) sendSecretCommitsUnsafe(ctx context.Context) error {
 	sc, err := d.rdkg.SecretCommits()
 	if err != nil {
 		if err.Error() == ErrDealNotCertified.Error() {
+			log.Warnf("Node %d deal isn't certified, continuing without it", d.index)
 			return nil
 		}
 		return fmt.Errorf("generate secret commit: %w", err)
 	}
+	d.commitsFrom[sc.Index] = true
 
 	protoSC, err := secretCommitsToProto(sc)
 	if err != nil {
@@ -132,35 +283,216 @@ func (d *dkg) processResponse(resp *rabindkg.Response) error {
 		return fmt.Errorf("encode response: %w", err)
 	}
 
-	for i, node := range d.participants {
-		if d.isMe(node) {
-			continue
+	log.Debugf("Node %d sending secret commits", d.index)
+	return d.broadcast(ctx, SecretCommitsNamespace, sc.Index, buf)
+}
+
+// processSecretCommits verifies a dealer's secret commits against
+// our share. If they don't match, we complain to the other nodes
+// and reveal our share, so they can reconstruct the right ones.
+func (d *dkg) processSecretCommits(sc *rabindkg.SecretCommits) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	ctx := context.TODO()
+
+	log.Debugf("Node %d processing secret commits", d.index)
+	cc, err := d.rdkg.ProcessSecretCommits(sc)
+	if err != nil {
+		return fmt.Errorf("process rabin dkg secret commits: %w", err)
+	}
+
+	if cc != nil {
+		log.Warnf("Node %d complaining about the secret commits of %d", d.index, sc.Index)
+		pcc, err := complaintCommitsToProto(cc)
+		if err != nil {
+			return fmt.Errorf("complaint commits to proto: %w", err)
+		}
+		buf, err := proto.Marshal(pcc)
+		if err != nil {
+			return fmt.Errorf("marshal complaint commits: %w", err)
 		}
 
-		// /ring/<ringID>/dkg/rabin/RESPONSE/JOHN/ROY/<FOR>
-		forNodeID1 := d.participants[sc.Index].ID()
-		// forNodeID2 := d.participants[response.Target].ID()
-		log.Debugf("Node %d sending secret commits to pariticipant %d", d.index, i)
-		msgID := fmt.Sprintf("%s/%s/%s/%s/%s", d.bbnamespace, SecretCommitsNamespace, d.NodeID(), d.participants[i].ID(), forNodeID1)
-		err := d.post(context.TODO(), SecretCommitsNamespace, msgID, buf, node)
+		// /ring/<ringID>/dkg/rabin/complaintcommits/<fromID>/<toID>/<dealerID>
+		err = d.broadcast(ctx, ComplaintCommitsNamespace, cc.DealerIndex, buf)
 		if err != nil {
-			return fmt.Errorf("send secret commits: %w", err)
+			return err
 		}
+		return d.revealShareUnsafe(ctx, cc.DealerIndex)
 	}
 
+	d.commitsFrom[sc.Index] = true
+
+	// replay the complaints that arrived before the secret commits
+	pending := d.pendingComplaints[sc.Index]
+	delete(d.pendingComplaints, sc.Index)
+	for _, cc := range pending {
+		err := d.processComplaintCommitsUnsafe(ctx, cc)
+		if err != nil {
+			log.Warnf("processing pending complaint for dealer %d: %v", sc.Index, err)
+		}
+	}
+
+	return d.checkFinishedUnsafe(ctx)
+}
+
+func (d *dkg) processComplaintCommits(cc *rabindkg.ComplaintCommits) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	ctx := context.TODO()
+	err := d.processComplaintCommitsUnsafe(ctx, cc)
+	if err != nil {
+		return err
+	}
+	return d.checkFinishedUnsafe(ctx)
+}
+
+// processComplaintCommitsUnsafe verifies a complaint against a
+// dealer's secret commits. A valid complaint makes us drop the
+// dealer's commits, and reveal our share to reconstruct them.
+// Complaints against commits we haven't received yet are kept
+// until they arrive.
+// It requires the caller to aquire a lock
+func (d *dkg) processComplaintCommitsUnsafe(ctx context.Context, cc *rabindkg.ComplaintCommits) error {
+	if d.reconstructing[cc.DealerIndex] {
+		return nil // already reconstructing
+	}
+	if !d.commitsFrom[cc.DealerIndex] {
+		d.pendingComplaints[cc.DealerIndex] = append(d.pendingComplaints[cc.DealerIndex], cc)
+		return nil
+	}
+
+	rc, err := d.rdkg.ProcessComplaintCommits(cc)
+	if err != nil {
+		return fmt.Errorf("process complaint commits: %w", err)
+	}
+
+	log.Warnf("Node %d reconstructing the secret commits of %d after the complaint of %d", d.index, cc.DealerIndex, cc.Index)
+	delete(d.commitsFrom, cc.DealerIndex)
+	d.reconstructing[cc.DealerIndex] = true
+	err = d.sendReconstructCommitsUnsafe(ctx, rc)
+	if err != nil {
+		return err
+	}
+
+	// replay the shares revealed before we got the complaint
+	pending := d.pendingReconstructs[cc.DealerIndex]
+	delete(d.pendingReconstructs, cc.DealerIndex)
+	for _, rc := range pending {
+		err := d.processReconstructCommitsUnsafe(ctx, rc)
+		if err != nil {
+			log.Warnf("processing pending reconstruction share for dealer %d: %v", cc.DealerIndex, err)
+		}
+	}
 	return nil
 }
 
-func (d *dkg) processSecretCommits(sc *rabindkg.SecretCommits) error {
+func (d *dkg) processReconstructCommits(rc *rabindkg.ReconstructCommits) error {
 	d.mu.Lock()
 	defer d.mu.Unlock()
 
-	log.Debugf("Node %d processing secret commits", d.index)
-	_, err := d.rdkg.ProcessSecretCommits(sc)
+	ctx := context.TODO()
+	err := d.processReconstructCommitsUnsafe(ctx, rc)
 	if err != nil {
-		return fmt.Errorf("process rabin dkg secret commits: %w", err)
+		return err
+	}
+	return d.checkFinishedUnsafe(ctx)
+}
+
+// processReconstructCommitsUnsafe records a share revealed to
+// reconstruct a dealer's secret commits. Shares for a dealer we
+// hold valid commits for are kept, in case a complaint against
+// them arrives later.
+// It requires the caller to aquire a lock
+func (d *dkg) processReconstructCommitsUnsafe(_ context.Context, rc *rabindkg.ReconstructCommits) error {
+	if d.commitsFrom[rc.DealerIndex] {
+		d.pendingReconstructs[rc.DealerIndex] = append(d.pendingReconstructs[rc.DealerIndex], rc)
+		return nil
+	}
+
+	err := d.rdkg.ProcessReconstructCommits(rc)
+	if err != nil {
+		return fmt.Errorf("process reconstruct commits: %w", err)
+	}
+	return nil
+}
+
+// reconstructMissingCommits reveals our share of the qualified
+// dealers we are still missing secret commits from, once the
+// commits phase is over, so the nodes can reconstruct them.
+func (d *dkg) reconstructMissingCommits(ctx context.Context) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	for _, i := range d.rdkg.QUAL() {
+		dealer := uint32(i)
+		if d.commitsFrom[dealer] || d.reconstructing[dealer] {
+			continue
+		}
+
+		log.Warnf("Node %d missing the secret commits of %d, revealing our share", d.index, dealer)
+		err := d.revealShareUnsafe(ctx, dealer)
+		if err != nil {
+			return err
+		}
+	}
+
+	return d.checkFinishedUnsafe(ctx)
+}
+
+// revealShareUnsafe reveals our share of a dealer's secret to
+// all the other nodes, so they can reconstruct the dealer's
+// secret commits.
+// It requires the caller to aquire a lock
+func (d *dkg) revealShareUnsafe(ctx context.Context, dealer uint32) error {
+	if d.reconstructing[dealer] {
+		return nil
+	}
+
+	deal, ok := d.openDeals[dealer]
+	if !ok {
+		return fmt.Errorf("no share of dealer %d to reveal", dealer)
+	}
+
+	rc := &rabindkg.ReconstructCommits{
+		SessionID:   deal.SessionID,
+		Index:       uint32(d.index),
+		DealerIndex: dealer,
+		Share:       deal.SecShare,
+	}
+	var err error
+	rc.Signature, err = schnorr.Sign(d.suite, d.privKey, rc.Hash(d.suite))
+	if err != nil {
+		return fmt.Errorf("sign reconstruct commits: %w", err)
+	}
+
+	d.reconstructing[dealer] = true
+	err = d.rdkg.ProcessReconstructCommits(rc)
+	if err != nil {
+		return fmt.Errorf("process reconstruct commits: %w", err)
 	}
+	return d.sendReconstructCommitsUnsafe(ctx, rc)
+}
 
+func (d *dkg) sendReconstructCommitsUnsafe(ctx context.Context, rc *rabindkg.ReconstructCommits) error {
+	prc, err := reconstructCommitsToProto(rc)
+	if err != nil {
+		return fmt.Errorf("reconstruct commits to proto: %w", err)
+	}
+	buf, err := proto.Marshal(prc)
+	if err != nil {
+		return fmt.Errorf("marshal reconstruct commits: %w", err)
+	}
+
+	// /ring/<ringID>/dkg/rabin/reconstructcommits/<fromID>/<toID>/<dealerID>
+	return d.broadcast(ctx, ReconstructCommitsNamespace, rc.DealerIndex, buf)
+}
+
+// checkFinishedUnsafe certifies the DKG once we hold the secret
+// commits of all the nodes in the qualified set.
+// It requires the caller to aquire a lock
+func (d *dkg) checkFinishedUnsafe(ctx context.Context) error {
 	// If we haven't collected all deals, responses, and secret commits
 	// then we can't compute the dist key share
 	//
@@ -184,8 +516,34 @@ func (d *dkg) processSecretCommits(sc *rabindkg.SecretCommits) error {
 	d.pubKey = distkey.Public()
 	d.state = orbisdkg.CERTIFIED
 
-	log.Infof("Node %d finished setup with shared publick Key: %s", d.index, d.pubKey)
-	return d.save(context.TODO())
+	log.Infof("Node %d finished setup without nodes %v, with shared public key: %s", d.index, d.excluded, d.pubKey)
+	return d.save(ctx)
+}
+
+// broadcast posts a message to all the other nodes, at
+// /ring/<ringID>/dkg/rabin/<namespace>/<fromID>/<toID>/<forID>
+func (d *dkg) broadcast(ctx context.Context, namespace string, forIndex uint32, buf []byte) error {
+	forID := d.participants[forIndex].ID()
+	for _, node := range d.participants {
+		if d.isMe(node) {
+			continue // skip ourselves
+		}
+
+		log.Debugf("creating identifier from %s to %s for %s", d.NodeID(), node.ID(), forID)
+		msgID := fmt.Sprintf("%s/%s/%s/%s/%s", d.bbnamespace, namespace, d.NodeID(), node.ID(), forID)
+		if err := d.post(ctx, namespace, msgID, buf, node); err != nil {
+			return fmt.Errorf("send %s: %w", namespace, err)
+		}
+	}
+	return nil
+}
+
+func (d *dkg) participantPoints() []kyber.Point {
+	points := make([]kyber.Point, 0, len(d.participants))
+	for _, n := range d.participants {
+		points = append(points, n.PublicKey().Point())
+	}
+	return points
 }
 
 func (d *dkg) isMe(node transport.Node) bool {
@@ -59,6 +59,18 @@ type dkg struct {
 	fPoly  *share.PriPoly // rabin dkg internal private polynomial (f)
 	gPoly  *share.PriPoly // rabin dkg internal private polynimial (g)
 
+	timeout  time.Duration // deadline of each phase, 0 waits for every node
+	excluded []int         // nodes left out of the qualified set (QUAL)
+
+	// protocol bookkeeping, only touched by the dispatcher
+	openDeals             map[uint32]*rabinvss.Deal                 // our deal from each dealer
+	complaints            map[uint32]map[uint32]bool                // dealer -> complainers waiting on a justification
+	pendingJustifications map[uint32][]*rabindkg.Justification      // dealer -> justifications waiting on their complaint
+	commitsFrom           map[uint32]bool                           // dealers we hold valid secret commits for
+	pendingComplaints     map[uint32][]*rabindkg.ComplaintCommits   // dealer -> complaints waiting on their secret commits
+	pendingReconstructs   map[uint32][]*rabindkg.ReconstructCommits // dealer -> shares revealed while we trusted its commits
+	reconstructing        map[uint32]bool                           // dealers we revealed our share for
+
 	// state repos
 	// dealRepo          db.Repository[*rabinv1alpha1.Deal]
 	// respRepo          db.Repository[*rabinv1alpha1.Response]
@@ -66,9 +78,17 @@ type dkg struct {
 	dkgRepo db.Repository[*rabinv1alpha1.DKG]
 
 	// internal channels
-	deals     chan dealDispatch
-	responses chan responseDispatch
-	commits   chan secretCommitsDispatch
+	deals              chan dealDispatch
+	responses          chan responseDispatch
+	justifications     chan justificationDispatch
+	commits            chan secretCommitsDispatch
+	complaintCommits   chan complaintCommitsDispatch
+	reconstructCommits chan reconstructCommitsDispatch
+
+	// closed once the dispatcher is done with each phase
+	dealsDone     chan struct{}
+	responsesDone chan struct{}
+	commitsDone   chan struct{}
 
 	// dependency services
 	db        *db.DB
@@ -81,7 +101,11 @@ type dkg struct {
 	state orbisdkg.State
 }
 
-func New(repo *db.DB, rkeys []db.RepoKey, t transport.Transport, b bulletin.Bulletin) (*dkg, error) {
+// New creates a rabin DKG. Each phase of the protocol waits at
+// most timeout for the messages of the other nodes, after which
+// the nodes that didn't take part are left out of the qualified
+// set. A timeout of 0 waits for every node.
+func New(repo *db.DB, rkeys []db.RepoKey, t transport.Transport, b bulletin.Bulletin, timeout time.Duration) (*dkg, error) {
 	if len(rkeys) != 1 {
 		return nil, ErrMissingRepoKeys
 	}
@@ -112,6 +136,7 @@ func New(repo *db.DB, rkeys []db.RepoKey, t transport.Transport, b bulletin.Bull
 		transport: t,
 		bulletin:  b,
 		index:     -1,
+		timeout:   timeout,
 	}, nil
 }
 
@@ -135,9 +160,11 @@ func (d *dkg) initFromState(ctx context.Context, pk crypto.PrivateKey, rid types
 		return err
 	}
 
-	// load longterm keys
+	// load longterm keys, a certified DKG keeps the group key
 	d.privKey = pk.Scalar()
-	d.pubKey = d.suite.Point().Mul(d.privKey, nil) // public point for scalar
+	if d.state != orbisdkg.CERTIFIED {
+		d.pubKey = d.suite.Point().Mul(d.privKey, nil) // public point for scalar
+	}
 
 	// participant points
 	for i := 0; i < len(d.participants); i++ {
@@ -250,15 +277,33 @@ func (d *dkg) initFromNew(ctx context.Context, pk crypto.PrivateKey, rid types.R
 // initCommon does all the none state initialization. Shared
 // between initFromNew() and initFromState()
 func (d *dkg) initCommon(ctx context.Context) error {
-	// setup stream handler for transport
-	d.setupHandlers()
+	d.openDeals = make(map[uint32]*rabinvss.Deal)
+	d.complaints = make(map[uint32]map[uint32]bool)
+	d.pendingJustifications = make(map[uint32][]*rabindkg.Justification)
+	d.commitsFrom = make(map[uint32]bool)
+	d.pendingComplaints = make(map[uint32][]*rabindkg.ComplaintCommits)
+	d.pendingReconstructs = make(map[uint32][]*rabindkg.ReconstructCommits)
+	d.reconstructing = make(map[uint32]bool)
 
 	d.deals = make(chan dealDispatch, d.numExpectedDeals())
 	d.responses = make(chan responseDispatch, d.numExpectedResponses())
+	d.justifications = make(chan justificationDispatch, d.numExpectedResponses())
 	d.commits = make(chan secretCommitsDispatch, d.numExpectedCommits())
+	d.complaintCommits = make(chan complaintCommitsDispatch, d.numExpectedCommits())
+	d.reconstructCommits = make(chan reconstructCommitsDispatch, d.numExpectedCommits())
+	d.dealsDone = make(chan struct{})
+	d.responsesDone = make(chan struct{})
+	d.commitsDone = make(chan struct{})
 
 	d.bbnamespace = fmt.Sprintf("/ring/%s/dkg/rabin", string(d.ringID))
-	err := d.bulletin.Register(ctx, d.bbnamespace)
+
+	// setup stream handler for transport
+	err := d.setupHandlers()
+	if err != nil {
+		return err
+	}
+
+	err = d.bulletin.Register(ctx, d.bbnamespace)
 	if err != nil {
 		return err
 	}
@@ -318,6 +363,34 @@ func (d *dkg) State() string {
 	return stateToString[d.state]
 }
 
+// Excluded returns the nodes left out of the qualified set,
+// once the responses phase is over.
+func (d *dkg) Excluded() []orbisdkg.Node {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	nodes := make([]orbisdkg.Node, len(d.excluded))
+	for i, idx := range d.excluded {
+		nodes[i] = d.participants[idx]
+	}
+	return nodes
+}
+
+// VerifyShare checks our certified share against the public
+// polynomial of the group key.
+func (d *dkg) VerifyShare() error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.state != orbisdkg.CERTIFIED {
+		return orbisdkg.ErrNoShare
+	}
+	if d.index < 0 || d.index >= len(d.participants) || !d.isMe(d.participants[d.index]) {
+		return fmt.Errorf("%w: we aren't participant %d", orbisdkg.ErrInvalidShare, d.index)
+	}
+	return orbisdkg.VerifyShare(d.suite, d.pubKey, d.distKeyShare, d.index, int(d.threshold))
+}
+
 // Start the DKG setup process.
 func (d *dkg) Start(ctx context.Context) error {
 	d.mu.Lock()
@@ -371,7 +444,16 @@ func (d *dkg) Start(ctx context.Context) error {
 	return nil
 }
 
+// connectToPeers tries to connect to all the other nodes. With
+// a phase timeout, it gives up on the nodes it can't reach
+// within it, and the DKG goes on without them.
 func (d *dkg) connectToPeers(ctx context.Context) {
+	if d.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, d.timeout)
+		defer cancel()
+	}
+
 	wg := sync.WaitGroup{}
 
 	for _, p := range d.participants {
@@ -383,18 +465,22 @@ func (d *dkg) connectToPeers(ctx context.Context) {
 			defer wg.Done()
 
 			for {
-				ctx, cancel := context.WithTimeout(ctx, peerConnectTimeout)
-				defer cancel()
-
+				connCtx, cancel := context.WithTimeout(ctx, peerConnectTimeout)
 				log.Debugf("trying to connect to: %v %v", p.ID(), p.Address())
-				err := d.transport.Connect(ctx, p)
-				if err != nil {
-					log.Debugf("Can't connect %s, retry in 2 sec", err)
-					time.Sleep(2 * time.Second)
-					continue
+				err := d.transport.Connect(connCtx, p)
+				cancel()
+				if err == nil {
+					log.Infof("Connected to %s", p.ID())
+					return
+				}
+				log.Debugf("Can't connect %s, retry in 2 sec", err)
+
+				select {
+				case <-ctx.Done():
+					log.Warnf("Couldn't connect to %s, continuing without it", p.ID())
+					return
+				case <-time.After(2 * time.Second):
 				}
-				log.Infof("Connected to %s", p.ID())
-				break
 			}
 		}(p)
 	}
@@ -461,6 +547,20 @@ func (d *dkg) ProcessMessage(msg *transport.Message) error {
 			return fmt.Errorf("dispatch response message: %w", err)
 		}
 
+	case JustificationNamespace:
+		log.Debugf("dkg.ProcessMessage() ProtocolJustification: id: %s", msg.Id)
+		var protoJustification rabinv1alpha1.Justification
+
+		err := proto.Unmarshal(msg.Payload, &protoJustification)
+		if err != nil {
+			return fmt.Errorf("unmarshal justification: %w", err)
+		}
+
+		err = d.dispatchJustificationProto(&protoJustification)
+		if err != nil {
+			return fmt.Errorf("dispatch justification: %w", err)
+		}
+
 	case SecretCommitsNamespace:
 		log.Debugf("dkg.ProcessMessage() ProtocolSecretCommits: id: %s", msg.Id)
 		var protoSecretCommits rabinv1alpha1.SecretCommits
@@ -475,6 +575,34 @@ func (d *dkg) ProcessMessage(msg *transport.Message) error {
 			return fmt.Errorf("dispatch secret commits: %w", err)
 		}
 
+	case ComplaintCommitsNamespace:
+		log.Debugf("dkg.ProcessMessage() ProtocolComplaintCommits: id: %s", msg.Id)
+		var protoComplaintCommits rabinv1alpha1.ComplaintCommits
+
+		err := proto.Unmarshal(msg.Payload, &protoComplaintCommits)
+		if err != nil {
+			return fmt.Errorf("unmarshal complaint commits: %w", err)
+		}
+
+		err = d.dispatchComplaintCommitsProto(&protoComplaintCommits)
+		if err != nil {
+			return fmt.Errorf("dispatch complaint commits: %w", err)
+		}
+
+	case ReconstructCommitsNamespace:
+		log.Debugf("dkg.ProcessMessage() ProtocolReconstructCommits: id: %s", msg.Id)
+		var protoReconstructCommits rabinv1alpha1.ReconstructCommits
+
+		err := proto.Unmarshal(msg.Payload, &protoReconstructCommits)
+		if err != nil {
+			return fmt.Errorf("unmarshal reconstruct commits: %w", err)
+		}
+
+		err = d.dispatchReconstructCommitsProto(&protoReconstructCommits)
+		if err != nil {
+			return fmt.Errorf("dispatch reconstruct commits: %w", err)
+		}
+
 	default:
 		return fmt.Errorf("unknown message type: %q", msg.GetType())
 	}
@@ -488,48 +616,128 @@ func (d *dkg) ProcessMessage(msg *transport.Message) error {
 // that we handle all the events at their appropriate
 // time.
 //
+// Each phase ends once we have all the messages we expect,
+// or once its deadline passes. Nodes that didn't take part
+// in time are left out of the qualified set (QUAL).
+//
 // It is designed to run in a gourinte
 func (d *dkg) dispatch() {
+	ctx := context.TODO()
 
 	// processDeals
-	for i := 0; i < d.numExpectedDeals() && d.state < PROCESSED_DEALS; i++ {
-		dd := <-d.deals
-		log.Debugf("Node %s handling deal for dealer %s (%d/%d)", d.NodeID(), d.participants[dd.deal.Index].ID(), i+1, d.numExpectedDeals())
-		dd.err <- d.processDeal(dd.deal)
+	deadline := d.phaseDeadline()
+	received := 0
+deals:
+	for ; received < d.numExpectedDeals() && d.currentState() < PROCESSED_DEALS; received++ {
+		select {
+		case dd := <-d.deals:
+			log.Debugf("Node %s handling deal for dealer %s (%d/%d)", d.NodeID(), d.participants[dd.deal.Index].ID(), received+1, d.numExpectedDeals())
+			dd.err <- d.processDeal(dd.deal)
+		case <-deadline:
+			log.Warnf("Node %d timed out waiting for deals (%d/%d)", d.index, received, d.numExpectedDeals())
+			break deals
+		}
 	}
+	close(d.dealsDone)
 
-	d.state = PROCESSED_DEALS
-	err := d.save(context.TODO())
+	err := d.setState(ctx, PROCESSED_DEALS)
 	if err != nil {
 		log.Fatalf("failed to save DKG state: %w", err)
 	}
 	log.Debug("Processed all deals, moving to responses")
 
-	// processResponses
-	for i := 0; i < d.numExpectedResponses() && d.state < PROCESSED_RESPONSES; i++ {
-		rd := <-d.responses
-		log.Debugf("Node %d handling response for dealer %d (%d/%d)", d.index, rd.respone.Index, i+1, d.numExpectedResponses())
-		rd.err <- d.processResponse(rd.respone)
+	// processResponses, and the justifications of the
+	// dealers we have complaints against
+	deadline = d.phaseDeadline()
+	received = 0
+responses:
+	for (received < d.numExpectedResponses() || len(d.complaints) > 0) && d.currentState() < PROCESSED_RESPONSES {
+		select {
+		case rd := <-d.responses:
+			received++
+			log.Debugf("Node %d handling response for dealer %d (%d/%d)", d.index, rd.respone.Index, received, d.numExpectedResponses())
+			rd.err <- d.processResponse(rd.respone)
+		case jd := <-d.justifications:
+			log.Debugf("Node %d handling justification for dealer %d", d.index, jd.justification.Index)
+			jd.err <- d.processJustification(jd.justification)
+		case <-deadline:
+			log.Warnf("Node %d timed out waiting for responses (%d/%d) and justifications (%d)", d.index, received, d.numExpectedResponses(), len(d.complaints))
+			break responses
+		}
 	}
+	close(d.responsesDone)
 
-	d.state = PROCESSED_RESPONSES
-	err = d.save(context.TODO())
+	qualified, err := d.qualify(ctx)
 	if err != nil {
-		log.Fatalf("failed to save DKG state: %w", err)
+		log.Errorf("Node %d qualifying nodes: %v", d.index, err)
+	}
+	if !qualified {
+		close(d.commitsDone)
+		return
 	}
 	log.Debug("Processed all responses, moving to secrets")
 
-	// processSecrets
-	for i := 0; i < d.numExpectedCommits(); i++ {
-		sd := <-d.commits
-		log.Debugf("Node %d handling secret for dealer %d (%d/%d)", d.index, sd.secretCommits.Index, i+1, d.numExpectedCommits())
-		sd.err <- d.processSecretCommits(sd.secretCommits)
+	// processSecrets, and the complaints against them. Once
+	// the deadline passes, we reveal our shares of the dealers
+	// we still miss secret commits for, and give the others
+	// another phase to reconstruct them.
+	deadline = d.phaseDeadline()
+	reconstructing := false
+commits:
+	for d.currentState() != orbisdkg.CERTIFIED {
+		select {
+		case sd := <-d.commits:
+			log.Debugf("Node %d handling secret for dealer %d", d.index, sd.secretCommits.Index)
+			sd.err <- d.processSecretCommits(sd.secretCommits)
+		case cd := <-d.complaintCommits:
+			log.Debugf("Node %d handling complaint of %d against dealer %d", d.index, cd.complaintCommits.Index, cd.complaintCommits.DealerIndex)
+			cd.err <- d.processComplaintCommits(cd.complaintCommits)
+		case rd := <-d.reconstructCommits:
+			log.Debugf("Node %d handling reconstruction share of %d for dealer %d", d.index, rd.reconstructCommits.Index, rd.reconstructCommits.DealerIndex)
+			rd.err <- d.processReconstructCommits(rd.reconstructCommits)
+		case <-deadline:
+			if reconstructing {
+				log.Errorf("Node %d timed out waiting for secret commits", d.index)
+				err := d.setState(ctx, TIMED_OUT)
+				if err != nil {
+					log.Fatalf("failed to save DKG state: %w", err)
+				}
+				break commits
+			}
+			err := d.reconstructMissingCommits(ctx)
+			if err != nil {
+				log.Errorf("Node %d reconstructing missing secret commits: %v", d.index, err)
+			}
+			reconstructing = true
+			deadline = d.phaseDeadline()
+		}
 	}
+	close(d.commitsDone)
+}
 
-	// don't need to update state and save
-	// like the above dispatch loops
-	// since `processSecreCommits` will do
-	// this for us.
+// phaseDeadline returns a channel that fires once the
+// phase timeout has passed, or never without a timeout.
+func (d *dkg) phaseDeadline() <-chan time.Time {
+	if d.timeout <= 0 {
+		return nil
+	}
+	return time.After(d.timeout)
+}
+
+// await waits for the dispatcher to handle an event, or
+// for the phase of the event to end without handling it.
+func await(errCh chan error, phaseDone chan struct{}) error {
+	select {
+	case err := <-errCh:
+		return err
+	case <-phaseDone:
+		select {
+		case err := <-errCh:
+			return err
+		default:
+			return ErrPhaseEnded
+		}
+	}
 }
 
 func (d *dkg) dispatchDealProto(dealproto *rabinv1alpha1.Deal) error {
@@ -548,17 +756,16 @@ func (d *dkg) dispatchDealProto(dealproto *rabinv1alpha1.Deal) error {
 
 func (d *dkg) dispatchDeal(deal *rabindkg.Deal) error {
 	dealDispatchEvent := dealDispatch{
-		err:  make(chan error),
+		err:  make(chan error, 1),
 		deal: deal,
 	}
-	// TODO: proper handle full channel failure
 	select {
 	case d.deals <- dealDispatchEvent:
 		// send
-	default:
-		log.Warn("can't send on deals dispatch channel")
+	case <-d.dealsDone:
+		return ErrPhaseEnded
 	}
-	return <-dealDispatchEvent.err // recieve
+	return await(dealDispatchEvent.err, d.dealsDone) // recieve
 }
 
 func (d *dkg) dispatchResponseProto(respproto *rabinv1alpha1.Response) error {
@@ -566,7 +773,7 @@ func (d *dkg) dispatchResponseProto(respproto *rabinv1alpha1.Response) error {
 
 	err := d.dispatchResponse(resp)
 	if err != nil {
-		return fmt.Errorf("process secret commits: %w", err)
+		return fmt.Errorf("process response: %w", err)
 	}
 	return nil
 }
@@ -574,19 +781,45 @@ func (d *dkg) dispatchResponseProto(respproto *rabinv1alpha1.Response) error {
 func (d *dkg) dispatchResponse(resp *rabindkg.Response) error {
 	log.Debugf("dispatching response")
 	respDispatchEvent := responseDispatch{
-		err:     make(chan error),
+		err:     make(chan error, 1),
 		respone: resp,
 	}
-	// TODO: proper handle full channel failure
 	select {
 	case d.responses <- respDispatchEvent:
 		// send
 		log.Debugf("response succesfully dispatched")
-	default:
-		log.Warnf("cant send on response dispatch channel")
+	case <-d.responsesDone:
+		return ErrPhaseEnded
 	}
 	log.Debugf("waiting for dispatch error status")
-	return <-respDispatchEvent.err
+	return await(respDispatchEvent.err, d.responsesDone)
+}
+
+func (d *dkg) dispatchJustificationProto(jproto *rabinv1alpha1.Justification) error {
+	j, err := justificationFromProto(d.suite, jproto)
+	if err != nil {
+		return fmt.Errorf("justification from proto: %w", err)
+	}
+
+	err = d.dispatchJustification(j)
+	if err != nil {
+		return fmt.Errorf("process justification: %w", err)
+	}
+	return nil
+}
+
+func (d *dkg) dispatchJustification(j *rabindkg.Justification) error {
+	jDispatchEvent := justificationDispatch{
+		err:           make(chan error, 1),
+		justification: j,
+	}
+	select {
+	case d.justifications <- jDispatchEvent:
+		// send
+	case <-d.responsesDone:
+		return ErrPhaseEnded
+	}
+	return await(jDispatchEvent.err, d.responsesDone) // recieve
 }
 
 func (d *dkg) dispatchSecretCommitsProto(scproto *rabinv1alpha1.SecretCommits) error {
@@ -604,17 +837,70 @@ func (d *dkg) dispatchSecretCommitsProto(scproto *rabinv1alpha1.SecretCommits) e
 
 func (d *dkg) dispatchSecretCommit(sc *rabindkg.SecretCommits) error {
 	scDispatchEvent := secretCommitsDispatch{
-		err:           make(chan error),
+		err:           make(chan error, 1),
 		secretCommits: sc,
 	}
-	// TODO: proper handle full channel failure
 	select {
 	case d.commits <- scDispatchEvent:
 		// send
-	default:
-		log.Warnf("can't send on commits dispatch channel")
+	case <-d.commitsDone:
+		return ErrPhaseEnded
+	}
+	return await(scDispatchEvent.err, d.commitsDone) // recieve
+}
+
+func (d *dkg) dispatchComplaintCommitsProto(ccproto *rabinv1alpha1.ComplaintCommits) error {
+	cc, err := complaintCommitsFromProto(d.suite, ccproto)
+	if err != nil {
+		return fmt.Errorf("complaint commits from proto: %w", err)
+	}
+
+	err = d.dispatchComplaintCommits(cc)
+	if err != nil {
+		return fmt.Errorf("process complaint commits: %w", err)
+	}
+	return nil
+}
+
+func (d *dkg) dispatchComplaintCommits(cc *rabindkg.ComplaintCommits) error {
+	ccDispatchEvent := complaintCommitsDispatch{
+		err:              make(chan error, 1),
+		complaintCommits: cc,
+	}
+	select {
+	case d.complaintCommits <- ccDispatchEvent:
+		// send
+	case <-d.commitsDone:
+		return ErrPhaseEnded
+	}
+	return await(ccDispatchEvent.err, d.commitsDone) // recieve
+}
+
+func (d *dkg) dispatchReconstructCommitsProto(rcproto *rabinv1alpha1.ReconstructCommits) error {
+	rc, err := reconstructCommitsFromProto(d.suite, rcproto)
+	if err != nil {
+		return fmt.Errorf("reconstruct commits from proto: %w", err)
+	}
+
+	err = d.dispatchReconstructCommits(rc)
+	if err != nil {
+		return fmt.Errorf("process reconstruct commits: %w", err)
 	}
-	return <-scDispatchEvent.err // recieve
+	return nil
+}
+
+func (d *dkg) dispatchReconstructCommits(rc *rabindkg.ReconstructCommits) error {
+	rcDispatchEvent := reconstructCommitsDispatch{
+		err:                make(chan error, 1),
+		reconstructCommits: rc,
+	}
+	select {
+	case d.reconstructCommits <- rcDispatchEvent:
+		// send
+	case <-d.commitsDone:
+		return ErrPhaseEnded
+	}
+	return await(rcDispatchEvent.err, d.commitsDone) // recieve
 }
 
 func (d *dkg) numExpectedDeals() int {
@@ -631,6 +917,19 @@ func (d *dkg) numExpectedCommits() int {
 	return (l - 1) * (l - 1)
 }
 
+func (d *dkg) currentState() orbisdkg.State {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.state
+}
+
+func (d *dkg) setState(ctx context.Context, state orbisdkg.State) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.state = state
+	return d.save(ctx)
+}
+
 // save will persist the current DKG state to the DKG Repo.
 // It only saves state from the DKG struct, and not the dynamic
 // deals, responses, and secret commmits from the internal
@@ -681,10 +980,12 @@ func (d *dkg) loadUnsafe(ctx context.Context) error {
 	d.suite = _d.suite
 	d.state = _d.state
 	d.pubKey = _d.pubKey
+	d.distKeyShare = _d.distKeyShare
 	d.participants = _d.participants
 	d.fPoly = _d.fPoly
 	d.gPoly = _d.gPoly
 	d.secret = _d.secret
+	d.excluded = _d.excluded
 
 	return nil
 }
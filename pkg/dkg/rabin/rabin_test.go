@@ -5,22 +5,33 @@ import (
 	cryptorand "crypto/rand"
 	"fmt"
 	"math/rand"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/libp2p/go-libp2p/core/protocol"
 	ma "github.com/multiformats/go-multiaddr"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.dedis.ch/kyber/v3"
 	"go.dedis.ch/kyber/v3/group/edwards25519"
+	"go.dedis.ch/kyber/v3/share"
 	rabindkg "go.dedis.ch/kyber/v3/share/dkg/rabin"
+	rabinvss "go.dedis.ch/kyber/v3/share/vss/rabin"
+	"go.dedis.ch/kyber/v3/sign/schnorr"
 	"go.dedis.ch/kyber/v3/suites"
 	"go.dedis.ch/kyber/v3/util/random"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/sourcenetwork/orbis-go/config"
+	rabinv1alpha1 "github.com/sourcenetwork/orbis-go/gen/proto/orbis/rabin/v1alpha1"
+	"github.com/sourcenetwork/orbis-go/pkg/bulletin"
 	"github.com/sourcenetwork/orbis-go/pkg/bulletin/memmap"
 	"github.com/sourcenetwork/orbis-go/pkg/crypto"
 	"github.com/sourcenetwork/orbis-go/pkg/db"
+	orbisdkg "github.com/sourcenetwork/orbis-go/pkg/dkg"
 	"github.com/sourcenetwork/orbis-go/pkg/host"
 	"github.com/sourcenetwork/orbis-go/pkg/transport"
 	p2ptransport "github.com/sourcenetwork/orbis-go/pkg/transport/p2p"
@@ -138,7 +149,7 @@ func newBasicDKG(t *testing.T, ctx context.Context) (*dkg, crypto.PrivateKey) {
 	rkeys := []db.RepoKey{
 		db.NewRepoKey("dkg"),
 	}
-	dkg, err := New(d, rkeys, tp, b)
+	dkg, err := New(d, rkeys, tp, b, 0)
 	require.NoError(t, err)
 
 	lpriv := h.Peerstore().PrivKey(h.ID())
@@ -177,6 +188,7 @@ func assertEqualDKG(t *testing.T, dkg1, dkg2 *dkg) {
 	assert.Equal(t, dkg1.rdkg.Dealer().GPoly().String(), dkg2.gPoly.String())
 	assert.Equal(t, dkg1.rdkg.Dealer().Secret(), dkg2.secret)
 	assert.NotEmpty(t, dkg2.secret)
+	assert.Equal(t, dkg1.excluded, dkg2.excluded)
 }
 
 func TestDKGProtoSerialization(t *testing.T) {
@@ -206,7 +218,7 @@ func TestDKGSaveAndLoad(t *testing.T) {
 	// require.NoError(t, dkg1.db.Debug())
 	fmt.Println("========")
 
-	dkg3, err := New(dkg1.db, dkg1.rkeys, dkg1.transport, dkg1.bulletin)
+	dkg3, err := New(dkg1.db, dkg1.rkeys, dkg1.transport, dkg1.bulletin, 0)
 	require.NoError(t, err)
 	err = dkg3.Init(ctx, priv, dkg1.ringID, dkg1.participants, dkg1.num, dkg1.threshold, true)
 	require.NoError(t, err)
@@ -216,3 +228,404 @@ func TestDKGSaveAndLoad(t *testing.T) {
 
 	assertEqualDKG(t, dkg1, dkg3)
 }
+
+// newPairingDKG initializes a DKG over the pairing suite,
+// using the node pairing keys as long term keys.
+func newPairingDKG(t *testing.T, ctx context.Context) *dkg {
+	d, priv := newBasicDKG(t, ctx)
+
+	dkg, err := New(newTestDB(t), d.rkeys, d.transport, d.bulletin, 0)
+	require.NoError(t, err)
+
+	nodes := make([]transport.Node, 0, 3)
+	for i := 0; i < 2; i++ {
+		sk, _, err := crypto.GenerateKeyPair(suites.MustFind("Ed25519"), cryptorand.Reader)
+		require.NoError(t, err)
+		pairingSk, err := crypto.PairingKey(sk)
+		require.NoError(t, err)
+		nodes = append(nodes, randomNodeFromPublicKey(pairingSk.GetPublic()))
+	}
+
+	pairingPriv, err := crypto.PairingKey(priv)
+	require.NoError(t, err)
+	nodes = append(nodes, randomNodeFromPublicKey(pairingPriv.GetPublic()))
+
+	err = dkg.Init(ctx, pairingPriv, types.RingID("0x456"), nodes, 3, 2, false)
+	require.NoError(t, err)
+
+	return dkg
+}
+
+func TestPairingDKGProtoSerialization(t *testing.T) {
+	ctx := context.Background()
+	dkg1 := newPairingDKG(t, ctx)
+	require.Equal(t, crypto.PairingSuite().String(), dkg1.suite.String())
+	require.Equal(t, 2, dkg1.index)
+
+	dkgp, err := dkgToProto(dkg1)
+	require.NoError(t, err)
+
+	dkg2, err := dkgFromProto(dkgp)
+	require.NoError(t, err)
+
+	assertEqualDKG(t, dkg1, &dkg2)
+}
+
+// testTransport is an in memory transport, the DKG
+// messages are all exchanged over the bulletin.
+type testTransport struct {
+	host testHost
+}
+
+type testHost struct {
+	transport.Node
+}
+
+func (testHost) Sign([]byte) ([]byte, error) {
+	return nil, nil
+}
+
+func (t *testTransport) Name() string { return "test" }
+
+func (t *testTransport) Send(context.Context, transport.Node, *transport.Message) error { return nil }
+
+func (t *testTransport) Gossip(context.Context, string, *transport.Message) error { return nil }
+
+func (t *testTransport) Connect(context.Context, transport.Node) error { return nil }
+
+func (t *testTransport) Host() transport.Host { return t.host }
+
+func (t *testTransport) AddHandler(protocol.ID, transport.Handler) {}
+
+func (t *testTransport) RemoveHandler(protocol.ID) {}
+
+func (t *testTransport) NewMessage(rid types.RingID, id string, gossip bool, payload []byte, msgType string, target transport.Node) (*transport.Message, error) {
+	return &transport.Message{
+		Id:       id,
+		RingId:   string(rid),
+		NodeId:   t.host.ID(),
+		Type:     msgType,
+		Payload:  payload,
+		Gossip:   gossip,
+		TargetId: target.ID(),
+	}, nil
+}
+
+// filteredBulletin rewrites the messages posted to it, to
+// simulate misbehaving nodes. Messages filtered to nil are
+// dropped.
+type filteredBulletin struct {
+	bulletin.Bulletin
+	filter func(id string, msg *transport.Message) *transport.Message
+}
+
+func (b *filteredBulletin) Post(ctx context.Context, id string, msg *transport.Message) (bulletin.Response, error) {
+	if msg = b.filter(id, msg); msg == nil {
+		return bulletin.Response{}, nil
+	}
+	return b.Bulletin.Post(ctx, id, msg)
+}
+
+type testNode struct {
+	priv crypto.PrivateKey
+	node transport.Node
+	db   *db.DB
+}
+
+func newTestNodes(t *testing.T, num int) []testNode {
+	nodes := make([]testNode, num)
+	for i := range nodes {
+		priv, pub, err := crypto.GenerateKeyPair(suites.MustFind("Ed25519"), cryptorand.Reader)
+		require.NoError(t, err)
+
+		nodes[i] = testNode{
+			priv: priv,
+			node: randomNodeFromPublicKey(pub),
+			db:   newTestDB(t),
+		}
+	}
+	return nodes
+}
+
+// runDKG initializes and starts a DKG for each of the online
+// nodes, with their own view of the bulletin.
+func runDKG(t *testing.T, nodes []testNode, online []int, bulletins []bulletin.Bulletin, threshold int32, timeout time.Duration) []*dkg {
+	participants := make([]orbisdkg.Node, len(nodes))
+	for i, n := range nodes {
+		participants[i] = n.node
+	}
+
+	dkgs := make([]*dkg, len(online))
+	for i, idx := range online {
+		var err error
+		dkgs[i], err = New(nodes[idx].db, []db.RepoKey{db.NewRepoKey("dkg")}, &testTransport{host: testHost{nodes[idx].node}}, bulletins[idx], timeout)
+		require.NoError(t, err)
+	}
+
+	var wg sync.WaitGroup
+	for i, idx := range online {
+		wg.Add(1)
+		go func(d *dkg, n testNode) {
+			defer wg.Done()
+			assert.NoError(t, d.Init(context.Background(), n.priv, types.RingID("0x123"), participants, int32(len(nodes)), threshold, false))
+		}(dkgs[i], nodes[idx])
+	}
+	wg.Wait()
+
+	for _, d := range dkgs {
+		wg.Add(1)
+		go func(d *dkg) {
+			defer wg.Done()
+			assert.NoError(t, d.Start(context.Background()))
+		}(d)
+	}
+	wg.Wait()
+
+	return dkgs
+}
+
+func sameBulletin(b bulletin.Bulletin, num int) []bulletin.Bulletin {
+	bs := make([]bulletin.Bulletin, num)
+	for i := range bs {
+		bs[i] = b
+	}
+	return bs
+}
+
+func requireCertified(t *testing.T, dkgs []*dkg, wait time.Duration) {
+	require.Eventually(t, func() bool {
+		for _, d := range dkgs {
+			if d.currentState() != orbisdkg.CERTIFIED {
+				return false
+			}
+		}
+		return true
+	}, wait, 50*time.Millisecond)
+}
+
+// requireSameKey checks the nodes agree on the group key,
+// and that a threshold of their shares recovers its secret.
+func requireSameKey(t *testing.T, dkgs []*dkg, threshold int, num int) {
+	suite := dkgs[0].suite
+	pubPoly := share.NewPubPoly(suite, nil, dkgs[0].distKeyShare.Commits)
+	require.True(t, pubPoly.Commit().Equal(dkgs[0].pubKey))
+
+	var shares []*share.PriShare
+	for _, d := range dkgs {
+		require.True(t, d.pubKey.Equal(dkgs[0].pubKey))
+		require.True(t, pubPoly.Check(d.distKeyShare.PriShare))
+		shares = append(shares, d.distKeyShare.PriShare)
+	}
+
+	secret, err := share.RecoverSecret(suite, shares[:threshold], threshold, num)
+	require.NoError(t, err)
+	require.True(t, suite.Point().Mul(secret, nil).Equal(dkgs[0].pubKey))
+}
+
+func requireExcluded(t *testing.T, dkgs []*dkg, nodes []testNode, excluded []int) {
+	for _, d := range dkgs {
+		d.mu.Lock()
+		require.Equal(t, excluded, d.excluded)
+		d.mu.Unlock()
+
+		ex := d.Excluded()
+		require.Len(t, ex, len(excluded))
+		for i, idx := range excluded {
+			require.Equal(t, nodes[idx].node.ID(), ex[i].ID())
+		}
+	}
+}
+
+func TestDKGAllNodes(t *testing.T) {
+	nodes := newTestNodes(t, 4)
+	dkgs := runDKG(t, nodes, []int{0, 1, 2, 3}, sameBulletin(memmap.New(), 4), 3, 0)
+
+	requireCertified(t, dkgs, 20*time.Second)
+	requireSameKey(t, dkgs, 3, 4)
+	requireExcluded(t, dkgs, nodes, nil)
+}
+
+func TestDKGExcludesOfflineNode(t *testing.T) {
+	nodes := newTestNodes(t, 4)
+	dkgs := runDKG(t, nodes, []int{0, 1, 3}, sameBulletin(memmap.New(), 4), 3, time.Second)
+
+	requireCertified(t, dkgs, 20*time.Second)
+	requireSameKey(t, dkgs, 3, 4)
+	requireExcluded(t, dkgs, nodes, []int{2})
+
+	// the exclusions are persisted
+	dkgp, err := dkgs[0].dkgRepo.Get(context.Background(), &rabinv1alpha1.DKG{RingId: "0x123"})
+	require.NoError(t, err)
+	require.Equal(t, []int32{2}, dkgp.Excluded)
+	require.Equal(t, rabinv1alpha1.State_STATE_CERTIFIED, dkgp.State)
+}
+
+func TestDKGBelowThresholdTimesOut(t *testing.T) {
+	nodes := newTestNodes(t, 4)
+	dkgs := runDKG(t, nodes, []int{0, 1}, sameBulletin(memmap.New(), 4), 3, 500*time.Millisecond)
+
+	require.Eventually(t, func() bool {
+		return dkgs[0].currentState() == TIMED_OUT
+	}, 10*time.Second, 50*time.Millisecond)
+	// no dealer gets enough approvals without a threshold of nodes
+	requireExcluded(t, dkgs[:1], nodes, []int{0, 1, 2, 3})
+}
+
+func TestDKGExcludesDealerWithholdingDeals(t *testing.T) {
+	nodes := newTestNodes(t, 4)
+	b := memmap.New()
+	bulletins := sameBulletin(b, 4)
+
+	// node 3 only deals to node 0
+	bulletins[3] = &filteredBulletin{Bulletin: b, filter: func(id string, msg *transport.Message) *transport.Message {
+		if msg.Type == DealNamespace && msg.TargetId != nodes[0].node.ID() {
+			return nil
+		}
+		return msg
+	}}
+
+	dkgs := runDKG(t, nodes, []int{0, 1, 2, 3}, bulletins, 3, time.Second)
+
+	requireCertified(t, dkgs, 20*time.Second)
+	requireSameKey(t, dkgs, 3, 4)
+	requireExcluded(t, dkgs, nodes, []int{3})
+}
+
+func TestDKGJustifiesComplaint(t *testing.T) {
+	nodes := newTestNodes(t, 3)
+	b := memmap.New()
+	bulletins := sameBulletin(b, 3)
+
+	// node 1 complains about the deal of node 0, which
+	// has to be justified for node 0 to stay qualified
+	suite := edwards25519.NewBlakeSHA256Ed25519()
+	bulletins[1] = &filteredBulletin{Bulletin: b, filter: func(id string, msg *transport.Message) *transport.Message {
+		if msg.Type != ResponseNamespace || !strings.HasSuffix(id, "/"+nodes[0].node.ID()) {
+			return msg
+		}
+
+		var resp rabinv1alpha1.Response
+		require.NoError(t, proto.Unmarshal(msg.Payload, &resp))
+		vresp := &rabinvss.Response{
+			SessionID: resp.Response.SessionId,
+			Index:     resp.Response.Index,
+			Approved:  false,
+		}
+		sig, err := schnorr.Sign(suite, nodes[1].priv.Scalar(), vresp.Hash(suite))
+		require.NoError(t, err)
+		resp.Response.Approved = false
+		resp.Response.Signature = sig
+
+		complaint := proto.Clone(msg).(*transport.Message)
+		complaint.Payload, err = proto.Marshal(&resp)
+		require.NoError(t, err)
+		return complaint
+	}}
+
+	dkgs := runDKG(t, nodes, []int{0, 1, 2}, bulletins, 3, 0)
+
+	requireCertified(t, dkgs, 20*time.Second)
+	requireSameKey(t, dkgs, 3, 3)
+	requireExcluded(t, dkgs, nodes, nil)
+}
+
+func TestDKGReconstructsMissingCommits(t *testing.T) {
+	nodes := newTestNodes(t, 4)
+	b := memmap.New()
+	bulletins := sameBulletin(b, 4)
+
+	// node 3 never sends its secret commits
+	bulletins[3] = &filteredBulletin{Bulletin: b, filter: func(id string, msg *transport.Message) *transport.Message {
+		if msg.Type == SecretCommitsNamespace {
+			return nil
+		}
+		return msg
+	}}
+
+	dkgs := runDKG(t, nodes, []int{0, 1, 2, 3}, bulletins, 3, time.Second)
+
+	requireCertified(t, dkgs, 20*time.Second)
+	requireSameKey(t, dkgs, 3, 4)
+	requireExcluded(t, dkgs, nodes, nil)
+}
+
+func TestArchiveRestoresShare(t *testing.T) {
+	ctx := context.Background()
+	nodes := newTestNodes(t, 3)
+	dkgs := runDKG(t, nodes, []int{0, 1, 2}, sameBulletin(memmap.New(), 3), 2, 0)
+	requireCertified(t, dkgs, 20*time.Second)
+
+	rid := types.RingID("0x123")
+	state, err := Factory.Export(ctx, nodes[0].db, rid)
+	require.NoError(t, err)
+
+	// a node restored from the backup loads the same share
+	restoredDB := newTestDB(t)
+	batch := restoredDB.Batch()
+	pk, err := Factory.Import(ctx, restoredDB, rid, state, batch)
+	require.NoError(t, err)
+	require.True(t, pk.Point().Equal(dkgs[0].pubKey))
+	require.NoError(t, batch.Commit(db.Sync))
+	require.NoError(t, batch.Close())
+
+	participants := make([]orbisdkg.Node, len(nodes))
+	for i, n := range nodes {
+		participants[i] = n.node
+	}
+	restored, err := New(restoredDB, []db.RepoKey{db.NewRepoKey("dkg")}, &testTransport{host: testHost{nodes[0].node}}, memmap.New(), 0)
+	require.NoError(t, err)
+	require.NoError(t, restored.Init(ctx, nodes[0].priv, rid, participants, 3, 2, true))
+	require.Equal(t, orbisdkg.CERTIFIED, restored.currentState())
+	require.True(t, restored.pubKey.Equal(dkgs[0].pubKey))
+	require.Equal(t, dkgs[0].distKeyShare.PriShare.String(), restored.Share().PriShare.String())
+	require.Len(t, restored.Share().Commits, 2)
+
+	// a share off the public polynomial is refused
+	dkgp := &rabinv1alpha1.DKG{}
+	require.NoError(t, proto.Unmarshal(state, dkgp))
+	dkgp.PriShare.V, err = dkgs[0].suite.Scalar().Pick(random.New()).MarshalBinary()
+	require.NoError(t, err)
+	tampered, err := proto.Marshal(dkgp)
+	require.NoError(t, err)
+	_, err = Factory.Import(ctx, restoredDB, rid, tampered, restoredDB.Batch())
+	require.ErrorIs(t, err, orbisdkg.ErrInvalidShare)
+
+	// so is the share of another node
+	other, err := Factory.Export(ctx, nodes[1].db, rid)
+	require.NoError(t, err)
+	require.NoError(t, proto.Unmarshal(other, dkgp))
+	dkgp.Index = 0
+	tampered, err = proto.Marshal(dkgp)
+	require.NoError(t, err)
+	_, err = Factory.Import(ctx, restoredDB, rid, tampered, restoredDB.Batch())
+	require.ErrorIs(t, err, orbisdkg.ErrInvalidShare)
+}
+
+func TestDKGVerifyShare(t *testing.T) {
+	nodes := newTestNodes(t, 3)
+	dkgs := runDKG(t, nodes, []int{0, 1, 2}, sameBulletin(memmap.New(), 3), 2, 0)
+	requireCertified(t, dkgs, 20*time.Second)
+
+	for _, d := range dkgs {
+		require.NoError(t, d.VerifyShare())
+	}
+
+	// a corrupted share no longer matches the public polynomial
+	d := dkgs[1]
+	d.mu.Lock()
+	d.distKeyShare.PriShare.V = d.suite.Scalar().Pick(random.New())
+	d.mu.Unlock()
+	require.ErrorIs(t, d.VerifyShare(), orbisdkg.ErrInvalidShare)
+
+	// so does the share of another node
+	d = dkgs[2]
+	d.mu.Lock()
+	d.distKeyShare = dkgs[0].distKeyShare
+	d.mu.Unlock()
+	require.ErrorIs(t, d.VerifyShare(), orbisdkg.ErrInvalidShare)
+
+	d.mu.Lock()
+	d.state = PROCESSED_COMMITS
+	d.mu.Unlock()
+	require.ErrorIs(t, d.VerifyShare(), orbisdkg.ErrNoShare)
+}
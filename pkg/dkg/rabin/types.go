@@ -15,7 +15,7 @@ import (
 
 	rabinv1alpha1 "github.com/sourcenetwork/orbis-go/gen/proto/orbis/rabin/v1alpha1"
 	"github.com/sourcenetwork/orbis-go/pkg/crypto"
-	"github.com/sourcenetwork/orbis-go/pkg/crypto/suites/secp256k1"
+	"github.com/sourcenetwork/orbis-go/pkg/crypto/suites/secp256k1ct"
 	"github.com/sourcenetwork/orbis-go/pkg/db"
 	orbisdkg "github.com/sourcenetwork/orbis-go/pkg/dkg"
 	p2ptransport "github.com/sourcenetwork/orbis-go/pkg/transport/p2p"
@@ -28,6 +28,8 @@ const (
 	PROCESSED_DEALS     // 0b10000001
 	PROCESSED_RESPONSES // 0b10000010
 	PROCESSED_COMMITS   // 0b10000011
+	// A phase deadline passed without a threshold of qualified nodes
+	TIMED_OUT // 0b10000100
 
 	// PROCESSING = PROCESSED_DEALS | PROCESSED_RESPONSES
 )
@@ -44,6 +46,7 @@ var (
 		PROCESSED_DEALS:      "Processed Deals",
 		PROCESSED_RESPONSES:  "Processed Reponses",
 		PROCESSED_COMMITS:    "Processed Commits",
+		TIMED_OUT:            "Timed Out",
 	}
 )
 
@@ -90,10 +93,14 @@ var (
 
 	ErrDealNotCertified = fmt.Errorf("dkg: can't give SecretCommits if deal not certified")
 	ErrCouldntGetRepo   = fmt.Errorf("dkg: can't get repo")
-
-	DealNamespace          string = "deal"
-	ResponseNamespace      string = "response"
-	SecretCommitsNamespace string = "secretcommits"
+	ErrPhaseEnded       = fmt.Errorf("dkg: message arrived after its phase ended")
+
+	DealNamespace               string = "deal"
+	ResponseNamespace           string = "response"
+	JustificationNamespace      string = "justification"
+	SecretCommitsNamespace      string = "secretcommits"
+	ComplaintCommitsNamespace   string = "complaintcommits"
+	ReconstructCommitsNamespace string = "reconstructcommits"
 )
 
 func (d *dkg) dealToProto(deal *rabindkg.Deal) (*Deal, error) {
@@ -208,6 +215,193 @@ func secretCommitsFromProto(suite suites.Suite, sc *SecretCommits) (*rabindkg.Se
 	}, nil
 }
 
+func justificationToProto(j *rabindkg.Justification) (*rabinv1alpha1.Justification, error) {
+	deal, err := plainDealToProto(j.Justification.Deal)
+	if err != nil {
+		return nil, err
+	}
+
+	return &rabinv1alpha1.Justification{
+		Index: j.Index,
+		Justification: &rabinv1alpha1.VerifiableJustification{
+			SessionId: j.Justification.SessionID,
+			Index:     j.Justification.Index,
+			Deal:      deal,
+			Signature: j.Justification.Signature,
+		},
+	}, nil
+}
+
+func justificationFromProto(suite suites.Suite, j *rabinv1alpha1.Justification) (*rabindkg.Justification, error) {
+	if j.Justification == nil {
+		return nil, fmt.Errorf("missing justification")
+	}
+	deal, err := plainDealFromProto(suite, j.Justification.Deal)
+	if err != nil {
+		return nil, err
+	}
+
+	return &rabindkg.Justification{
+		Index: j.Index,
+		Justification: &rabinvss.Justification{
+			SessionID: j.Justification.SessionId,
+			Index:     j.Justification.Index,
+			Deal:      deal,
+			Signature: j.Justification.Signature,
+		},
+	}, nil
+}
+
+func complaintCommitsToProto(cc *rabindkg.ComplaintCommits) (*rabinv1alpha1.ComplaintCommits, error) {
+	deal, err := plainDealToProto(cc.Deal)
+	if err != nil {
+		return nil, err
+	}
+
+	return &rabinv1alpha1.ComplaintCommits{
+		Index:       cc.Index,
+		DealerIndex: cc.DealerIndex,
+		Deal:        deal,
+		Signature:   cc.Signature,
+	}, nil
+}
+
+func complaintCommitsFromProto(suite suites.Suite, cc *rabinv1alpha1.ComplaintCommits) (*rabindkg.ComplaintCommits, error) {
+	deal, err := plainDealFromProto(suite, cc.Deal)
+	if err != nil {
+		return nil, err
+	}
+
+	return &rabindkg.ComplaintCommits{
+		Index:       cc.Index,
+		DealerIndex: cc.DealerIndex,
+		Deal:        deal,
+		Signature:   cc.Signature,
+	}, nil
+}
+
+func reconstructCommitsToProto(rc *rabindkg.ReconstructCommits) (*rabinv1alpha1.ReconstructCommits, error) {
+	sh, err := priShareToProto(rc.Share)
+	if err != nil {
+		return nil, err
+	}
+
+	return &rabinv1alpha1.ReconstructCommits{
+		SessionId:   rc.SessionID,
+		Index:       rc.Index,
+		DealerIndex: rc.DealerIndex,
+		Share:       sh,
+		Signature:   rc.Signature,
+	}, nil
+}
+
+func reconstructCommitsFromProto(suite suites.Suite, rc *rabinv1alpha1.ReconstructCommits) (*rabindkg.ReconstructCommits, error) {
+	sh, err := priShareFromProto(suite, rc.Share)
+	if err != nil {
+		return nil, err
+	}
+
+	return &rabindkg.ReconstructCommits{
+		SessionID:   rc.SessionId,
+		Index:       rc.Index,
+		DealerIndex: rc.DealerIndex,
+		Share:       sh,
+		Signature:   rc.Signature,
+	}, nil
+}
+
+func plainDealToProto(deal *rabinvss.Deal) (*rabinv1alpha1.PlainDeal, error) {
+	if deal == nil {
+		return nil, fmt.Errorf("missing deal")
+	}
+
+	secShare, err := priShareToProto(deal.SecShare)
+	if err != nil {
+		return nil, err
+	}
+	rndShare, err := priShareToProto(deal.RndShare)
+	if err != nil {
+		return nil, err
+	}
+
+	commits := make([][]byte, len(deal.Commitments))
+	for i, c := range deal.Commitments {
+		commits[i], err = c.MarshalBinary()
+		if err != nil {
+			return nil, fmt.Errorf("marshal commitment: %w", err)
+		}
+	}
+
+	return &rabinv1alpha1.PlainDeal{
+		SessionId:   deal.SessionID,
+		SecShare:    secShare,
+		RndShare:    rndShare,
+		T:           deal.T,
+		Commitments: commits,
+	}, nil
+}
+
+func plainDealFromProto(suite suites.Suite, deal *rabinv1alpha1.PlainDeal) (*rabinvss.Deal, error) {
+	if deal == nil {
+		return nil, fmt.Errorf("missing deal")
+	}
+
+	secShare, err := priShareFromProto(suite, deal.SecShare)
+	if err != nil {
+		return nil, err
+	}
+	rndShare, err := priShareFromProto(suite, deal.RndShare)
+	if err != nil {
+		return nil, err
+	}
+
+	commits := make([]kyber.Point, len(deal.Commitments))
+	for i, c := range deal.Commitments {
+		commits[i] = suite.Point()
+		err := commits[i].UnmarshalBinary(c)
+		if err != nil {
+			return nil, fmt.Errorf("unmarshal commitment: %w", err)
+		}
+	}
+
+	return &rabinvss.Deal{
+		SessionID:   deal.SessionId,
+		SecShare:    secShare,
+		RndShare:    rndShare,
+		T:           deal.T,
+		Commitments: commits,
+	}, nil
+}
+
+func priShareToProto(s *share.PriShare) (*rabinv1alpha1.PriShare, error) {
+	if s == nil {
+		return nil, fmt.Errorf("missing share")
+	}
+	v, err := s.V.MarshalBinary()
+	if err != nil {
+		return nil, fmt.Errorf("marshal share: %w", err)
+	}
+	return &rabinv1alpha1.PriShare{
+		Index: int32(s.I),
+		V:     v,
+	}, nil
+}
+
+func priShareFromProto(suite suites.Suite, s *rabinv1alpha1.PriShare) (*share.PriShare, error) {
+	if s == nil {
+		return nil, fmt.Errorf("missing share")
+	}
+	v := suite.Scalar()
+	err := v.UnmarshalBinary(s.V)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal share: %w", err)
+	}
+	return &share.PriShare{
+		I: int(s.Index),
+		V: v,
+	}, nil
+}
+
 func dkgToProto(d *dkg) (*rabinv1alpha1.DKG, error) {
 	var suiteType rabinv1alpha1.SuiteType
 	if d.suite != nil {
@@ -216,6 +410,8 @@ func dkgToProto(d *dkg) (*rabinv1alpha1.DKG, error) {
 			suiteType = rabinv1alpha1.SuiteType_Ed25519
 		case "Secp256k1":
 			suiteType = rabinv1alpha1.SuiteType_Secp256k1
+		case crypto.PairingSuite().String():
+			suiteType = rabinv1alpha1.SuiteType_BLS12_381
 		default:
 			return nil, fmt.Errorf("invalid suite type: %v", d.suite.String())
 		}
@@ -239,6 +435,8 @@ func dkgToProto(d *dkg) (*rabinv1alpha1.DKG, error) {
 		state = rabinv1alpha1.State_STATE_PROCESSED_RESPONSES
 	case PROCESSED_COMMITS:
 		state = rabinv1alpha1.State_STATE_PROCESSED_COMMITS
+	case TIMED_OUT:
+		state = rabinv1alpha1.State_STATE_TIMED_OUT
 	default:
 		return nil, fmt.Errorf("invalid state: %v, 0x%0x", d.state, d.state)
 	}
@@ -281,6 +479,14 @@ func dkgToProto(d *dkg) (*rabinv1alpha1.DKG, error) {
 		}
 	}
 
+	commits := make([][]byte, len(d.distKeyShare.Commits))
+	for i, c := range d.distKeyShare.Commits {
+		commits[i], err = c.MarshalBinary()
+		if err != nil {
+			return nil, fmt.Errorf("couldn't marshal commitment: %w", err)
+		}
+	}
+
 	// polynomials and secrets
 	var fPoly *rabinv1alpha1.PriPoly
 	var gPoly *rabinv1alpha1.PriPoly
@@ -302,6 +508,11 @@ func dkgToProto(d *dkg) (*rabinv1alpha1.DKG, error) {
 		}
 	}
 
+	excluded := make([]int32, len(d.excluded))
+	for i, idx := range d.excluded {
+		excluded[i] = int32(idx)
+	}
+
 	return &rabinv1alpha1.DKG{
 		RingId:     string(d.ringID),
 		Index:      int32(d.index),
@@ -315,6 +526,8 @@ func dkgToProto(d *dkg) (*rabinv1alpha1.DKG, error) {
 		F:          fPoly,
 		G:          gPoly,
 		PolySecret: polySecret,
+		Excluded:   excluded,
+		Commits:    commits,
 	}, nil
 }
 
@@ -351,7 +564,9 @@ func dkgFromProto(d *rabinv1alpha1.DKG) (dkg, error) {
 	case rabinv1alpha1.SuiteType_Ed25519:
 		suite = edwards25519.NewBlakeSHA256Ed25519()
 	case rabinv1alpha1.SuiteType_Secp256k1:
-		suite = secp256k1.NewBlakeKeccackSecp256k1()
+		suite = secp256k1ct.NewBlakeKeccackSecp256k1()
+	case rabinv1alpha1.SuiteType_BLS12_381:
+		suite = crypto.PairingSuite()
 	default:
 		return dkg{}, fmt.Errorf("bad key type: %v", d.Suite.String())
 	}
@@ -374,11 +589,13 @@ func dkgFromProto(d *rabinv1alpha1.DKG) (dkg, error) {
 		state = PROCESSED_RESPONSES
 	case rabinv1alpha1.State_STATE_PROCESSED_COMMITS:
 		state = PROCESSED_COMMITS
+	case rabinv1alpha1.State_STATE_TIMED_OUT:
+		state = TIMED_OUT
 	}
 
 	participants := make([]orbisdkg.Node, len(d.Nodes))
 	for i, n := range d.Nodes {
-		pk, err := ic.PublicKeyFromProto(n.PublicKey)
+		pk, err := crypto.PubKeyFromProto(n.PublicKey)
 		if err != nil {
 			return dkg{}, fmt.Errorf("couldnt convert proto to public key: %w", err)
 		}
@@ -399,17 +616,27 @@ func dkgFromProto(d *rabinv1alpha1.DKG) (dkg, error) {
 	}
 
 	// share
-	// TODO: Commitments
 	var distKeyShare crypto.DistKeyShare
 	if d.PriShare != nil {
-		distKeyShare.PriShare.I = int(d.PriShare.Index)
-		distKeyShare.PriShare.V = suite.Scalar()
+		distKeyShare.PriShare = &share.PriShare{
+			I: int(d.PriShare.Index),
+			V: suite.Scalar(),
+		}
 		err := distKeyShare.PriShare.V.UnmarshalBinary(d.PriShare.V)
 		if err != nil {
 			return dkg{}, fmt.Errorf("unmarshaling prishare: %w", err)
 		}
 	}
 
+	for _, c := range d.Commits {
+		commit := suite.Point()
+		err := commit.UnmarshalBinary(c)
+		if err != nil {
+			return dkg{}, fmt.Errorf("unmarshaling commitment: %w", err)
+		}
+		distKeyShare.Commits = append(distKeyShare.Commits, commit)
+	}
+
 	// f and g polys
 	fPoly, err := polyFromProto(suite, d.F)
 	if err != nil {
@@ -430,6 +657,11 @@ func dkgFromProto(d *rabinv1alpha1.DKG) (dkg, error) {
 		}
 	}
 
+	var excluded []int
+	for _, idx := range d.Excluded {
+		excluded = append(excluded, int(idx))
+	}
+
 	return dkg{
 		ringID:       types.RingID(d.RingId),
 		index:        int(d.Index),
@@ -443,6 +675,7 @@ func dkgFromProto(d *rabinv1alpha1.DKG) (dkg, error) {
 		fPoly:        fPoly,
 		gPoly:        gPoly,
 		secret:       secret,
+		excluded:     excluded,
 	}, nil
 }
 
@@ -456,7 +689,22 @@ type responseDispatch struct {
 	respone *rabindkg.Response
 }
 
+type justificationDispatch struct {
+	err           chan error
+	justification *rabindkg.Justification
+}
+
 type secretCommitsDispatch struct {
 	err           chan error
 	secretCommits *rabindkg.SecretCommits
 }
+
+type complaintCommitsDispatch struct {
+	err              chan error
+	complaintCommits *rabindkg.ComplaintCommits
+}
+
+type reconstructCommitsDispatch struct {
+	err                chan error
+	reconstructCommits *rabindkg.ReconstructCommits
+}
@@ -3,9 +3,7 @@ package host
 import (
 	"context"
 
-	"crypto/rand"
 	"fmt"
-	mrand "math/rand"
 	"sync"
 	"time"
 
@@ -43,27 +41,17 @@ type Host struct {
 
 func New(ctx context.Context, cfg config.Host) (*Host, error) {
 
-	// Convert string to libp2p crypto type.
-	// Invalid types and/or bits are handled by libp2p.
-	cryptoType := libp2pcrypto.RSA
-	switch cfg.Crypto.Type {
-	case "ed25519":
-		cryptoType = libp2pcrypto.Ed25519
-	case "secp256k1":
-		cryptoType = libp2pcrypto.Secp256k1
-	case "ecdsa":
-		cryptoType = libp2pcrypto.ECDSA
+	priv, err := PrivateKey(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("host private key: %w", err)
 	}
 
-	randomness := rand.Reader
-	if seed := cfg.Crypto.Seed; seed != 0 {
-		randomness = mrand.New(mrand.NewSource(int64(seed)))
-	}
+	return NewWithKey(ctx, cfg, priv)
+}
 
-	priv, _, err := libp2pcrypto.GenerateKeyPairWithReader(cryptoType, cfg.Crypto.Bits, randomness)
-	if err != nil {
-		return nil, fmt.Errorf("generate key pair: %w", err)
-	}
+// NewWithKey creates a host with the identity key from the node
+// key custody, which may only sign.
+func NewWithKey(ctx context.Context, cfg config.Host, priv libp2pcrypto.PrivKey) (*Host, error) {
 
 	cpriv, err := crypto.PrivateKeyFromLibP2P(priv)
 	if err != nil {
@@ -87,7 +75,7 @@ func New(ctx context.Context, cfg config.Host) (*Host, error) {
 		dhtOptions = append(dhtOptions, libp2pdht.Mode(libp2pdht.ModeServer))
 	}
 
-	h, err := libp2p.New(
+	opts := []libp2p.Option{
 		// Use the keypair we generated
 		libp2p.Identity(priv),
 		// Multiple listen addresses
@@ -109,7 +97,15 @@ func New(ctx context.Context, cfg config.Host) (*Host, error) {
 			return idht, err
 		}),
 		libp2p.EnableNATService(),
-	)
+	}
+
+	// libp2p derives the QUIC keys from the raw identity key,
+	// which a remote signer doesn't release.
+	if _, err := priv.Raw(); err != nil {
+		opts = append(opts, libp2p.QUICReuse(quicConnManager(priv)))
+	}
+
+	h, err := libp2p.New(opts...)
 	if err != nil {
 		return nil, fmt.Errorf("create libp2p host: %w", err)
 	}
@@ -0,0 +1,138 @@
+package host
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	mrand "math/rand"
+	"os"
+
+	libp2pcrypto "github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/p2p/transport/quicreuse"
+	"github.com/quic-go/quic-go"
+	"golang.org/x/crypto/hkdf"
+
+	"github.com/sourcenetwork/orbis-go/config"
+)
+
+// PrivateKey returns the host identity key. It's read from the
+// configured key file, and otherwise generated from the crypto
+// type and seed. A generated key is written to the key file, so
+// the node keeps its identity across restarts.
+func PrivateKey(cfg config.Host) (libp2pcrypto.PrivKey, error) {
+	if file := cfg.Crypto.KeyFile; file != "" {
+		priv, err := ReadKeyFile(file)
+		if err == nil || !errors.Is(err, fs.ErrNotExist) {
+			return priv, err
+		}
+	}
+
+	priv, err := GenerateKey(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	if file := cfg.Crypto.KeyFile; file != "" {
+		err = WriteKeyFile(file, priv)
+		if err != nil {
+			return nil, err
+		}
+		log.Infof("Generated host key file %s", file)
+	}
+
+	return priv, nil
+}
+
+// GenerateKey generates a host identity key from the crypto
+// type and seed.
+func GenerateKey(cfg config.Host) (libp2pcrypto.PrivKey, error) {
+	// Convert string to libp2p crypto type.
+	// Invalid types and/or bits are handled by libp2p.
+	cryptoType := libp2pcrypto.RSA
+	switch cfg.Crypto.Type {
+	case "ed25519":
+		cryptoType = libp2pcrypto.Ed25519
+	case "secp256k1":
+		cryptoType = libp2pcrypto.Secp256k1
+	case "ecdsa":
+		cryptoType = libp2pcrypto.ECDSA
+	}
+
+	randomness := rand.Reader
+	if seed := cfg.Crypto.Seed; seed != 0 {
+		randomness = mrand.New(mrand.NewSource(int64(seed)))
+	}
+
+	priv, _, err := libp2pcrypto.GenerateKeyPairWithReader(cryptoType, cfg.Crypto.Bits, randomness)
+	if err != nil {
+		return nil, fmt.Errorf("generate key pair: %w", err)
+	}
+	return priv, nil
+}
+
+// ReadKeyFile reads a host identity key written by WriteKeyFile.
+func ReadKeyFile(file string) (libp2pcrypto.PrivKey, error) {
+	buf, err := os.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("read key file: %w", err)
+	}
+	priv, err := libp2pcrypto.UnmarshalPrivateKey(buf)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal key file %s: %w", file, err)
+	}
+	return priv, nil
+}
+
+// WriteKeyFile writes a host identity key, readable only by
+// the current user. It never overwrites an existing file.
+func WriteKeyFile(file string, priv libp2pcrypto.PrivKey) error {
+	buf, err := libp2pcrypto.MarshalPrivateKey(priv)
+	if err != nil {
+		return fmt.Errorf("marshal private key: %w", err)
+	}
+
+	f, err := os.OpenFile(file, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+	if err != nil {
+		return fmt.Errorf("create key file: %w", err)
+	}
+	_, err = f.Write(buf)
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return fmt.Errorf("write key file: %w", err)
+	}
+	return nil
+}
+
+// quicKeysMessage is signed by the identity to derive the QUIC keys.
+const quicKeysMessage = "orbis/host/quic-keys/v1"
+
+// quicConnManager returns a QUIC connection manager whose stateless
+// reset and token keys are derived from a signature by the identity,
+// for identities held by a signer. The keys are stable across
+// restarts with deterministic signatures, like Ed25519.
+func quicConnManager(priv libp2pcrypto.PrivKey) func() (*quicreuse.ConnManager, error) {
+	return func() (*quicreuse.ConnManager, error) {
+		sig, err := priv.Sign([]byte(quicKeysMessage))
+		if err != nil {
+			return nil, fmt.Errorf("sign quic keys: %w", err)
+		}
+
+		var resetKey quic.StatelessResetKey
+		_, err = io.ReadFull(hkdf.New(sha256.New, sig, nil, []byte("libp2p quic stateless reset key")), resetKey[:])
+		if err != nil {
+			return nil, fmt.Errorf("derive quic stateless reset key: %w", err)
+		}
+		var tokenKey quic.TokenGeneratorKey
+		_, err = io.ReadFull(hkdf.New(sha256.New, sig, nil, []byte("libp2p quic token generator key")), tokenKey[:])
+		if err != nil {
+			return nil, fmt.Errorf("derive quic token key: %w", err)
+		}
+
+		return quicreuse.NewConnManager(resetKey, tokenKey)
+	}
+}
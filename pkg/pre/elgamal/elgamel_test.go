@@ -7,6 +7,8 @@ import (
 	"go.dedis.ch/kyber/v3/share"
 	"go.dedis.ch/kyber/v3/suites"
 	"go.dedis.ch/kyber/v3/util/random"
+
+	"github.com/sourcenetwork/orbis-go/pkg/crypto"
 )
 
 func TestReencryptAndVerify(t *testing.T) {
@@ -60,3 +62,48 @@ func TestReencryptAndVerify(t *testing.T) {
 	require.NoErrorf(t, err, "failed to decode key")
 	require.Equal(t, scrt, scrtHat)
 }
+
+func TestHybridReencrypt(t *testing.T) {
+
+	var (
+		n       = 5
+		th      = 3
+		ste     = suites.MustFind("ed25519")
+		s       = ste.Scalar().Pick(ste.RandomStream())
+		priPoly = share.NewPriPoly(ste, th, s, ste.RandomStream())
+		pubPoly = priPoly.Commit(nil)
+		dkgPk   = pubPoly.Commit()
+
+		rdrSk = ste.Scalar().Pick(ste.RandomStream())
+		rdrPk = ste.Point().Mul(rdrSk, nil)
+	)
+
+	// Generate a secret much larger than what fits in a few points.
+	scrt := make([]byte, 1<<20)
+	random.Bytes(scrt, random.New())
+
+	for _, dem := range []crypto.DEM{crypto.AES256GCM, crypto.XChaCha20Poly1305} {
+		encCmt, encKey, encData, err := EncryptSecretHybrid(ste, dkgPk, dem, scrt)
+		require.NoError(t, err)
+		require.Len(t, encKey, 2, "only the symmetric key is encapsulated")
+
+		var pubShares []*share.PubShare
+		for idx := 0; idx < n; idx++ {
+			xncSki, _, _, err := reencrypt(ste, priPoly.Eval(idx).V, rdrPk, encCmt)
+			require.NoError(t, err)
+			pubShares = append(pubShares, &share.PubShare{I: idx, V: xncSki})
+		}
+
+		xncCmt, err := share.RecoverCommit(ste, pubShares, th, n)
+		require.NoError(t, err)
+
+		scrtHat, err := DecryptSecretHybrid(ste, dem, encCmt, encKey, encData, dkgPk, xncCmt, rdrSk)
+		require.NoError(t, err)
+		require.Equal(t, scrt, scrtHat)
+
+		// Tampered ciphertext must not open.
+		encData[len(encData)-1] ^= 0xff
+		_, err = DecryptSecretHybrid(ste, dem, encCmt, encKey, encData, dkgPk, xncCmt, rdrSk)
+		require.Error(t, err)
+	}
+}
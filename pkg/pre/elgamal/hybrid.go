@@ -0,0 +1,89 @@
+package elgamal
+
+import (
+	"fmt"
+
+	"go.dedis.ch/kyber/v3"
+	"go.dedis.ch/kyber/v3/suites"
+
+	"github.com/sourcenetwork/orbis-go/pkg/crypto"
+)
+
+// EncryptSecretHybrid encrypts an arbitrarily large secret using a
+// KEM-DEM construction. A random symmetric key is encapsulated with
+// EncryptSecret under the aggregate public key of the DKG, and the
+// secret itself is sealed with the symmetric key using the given DEM.
+//
+// The encrypted commitment (encCmt) is bound to the DEM ciphertext as
+// additional data, so the bulk ciphertext can't be swapped between
+// secrets.
+//
+// Output:
+//
+//	encCmt  - Schnorr commit (rG)
+//	encKey  - Encrypted symmetric key-slices (rsG + Ki)
+//	encData - DEM ciphertext of the secret.
+func EncryptSecretHybrid(
+	ste suites.Suite,
+	dkgPk kyber.Point,
+	dem crypto.DEM,
+	scrt []byte,
+) (
+	encCmt kyber.Point,
+	encKey []kyber.Point,
+	encData []byte,
+	err error,
+) {
+	key, err := crypto.NewDEMKey(nil)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	encCmt, encKey = EncryptSecret(ste, dkgPk, key)
+
+	ad, err := encCmt.MarshalBinary()
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("marshal encCmt: %w", err)
+	}
+
+	encData, err = crypto.Seal(dem, key, scrt, ad)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("seal secret: %w", err)
+	}
+
+	return encCmt, encKey, encData, nil
+}
+
+// DecryptSecretHybrid decrypts a secret produced by EncryptSecretHybrid.
+// The symmetric key is recovered with DecryptSecret from the encrypted
+// key-slices, and then used to open the DEM ciphertext.
+func DecryptSecretHybrid(
+	ste suites.Suite,
+	dem crypto.DEM,
+	encCmt kyber.Point,
+	encKey []kyber.Point,
+	encData []byte,
+	dkgPk kyber.Point,
+	xncCmt kyber.Point,
+	rdrSk kyber.Scalar,
+) (
+	scrt []byte,
+	err error,
+) {
+	key, err := DecryptSecret(ste, encKey, dkgPk, xncCmt, rdrSk)
+	if err != nil {
+		return nil, fmt.Errorf("decrypt key: %w", err)
+	}
+
+	ad, err := encCmt.MarshalBinary()
+	if err != nil {
+		return nil, fmt.Errorf("marshal encCmt: %w", err)
+	}
+
+	scrt, err = crypto.Open(dem, key, encData, ad)
+	if err != nil {
+		return nil, fmt.Errorf("open secret: %w", err)
+	}
+
+	return scrt, nil
+}
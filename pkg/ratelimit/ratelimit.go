@@ -0,0 +1,76 @@
+package ratelimit
+
+import (
+	"sync"
+	"time"
+
+	lru "github.com/hashicorp/golang-lru/v2"
+)
+
+// maxKeys bounds the number of tracked keys, the least recently
+// used keys are evicted, which resets their bucket.
+const maxKeys = 10_000
+
+// Limiter is a keyed token bucket rate limiter. Each key gets its
+// own bucket of burst tokens, refilled at rate tokens per second.
+// A nil Limiter, or one with a non positive rate, allows everything.
+type Limiter struct {
+	rate  float64
+	burst float64
+
+	mu      sync.Mutex
+	buckets *lru.Cache[string, *bucket]
+
+	now func() time.Time
+}
+
+type bucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// New returns a limiter allowing rate events per second per key,
+// with bursts of up to burst events.
+func New(rate float64, burst int) *Limiter {
+	if burst < 1 {
+		burst = 1
+	}
+	buckets, _ := lru.New[string, *bucket](maxKeys) // only errors on a non positive size
+
+	return &Limiter{
+		rate:    rate,
+		burst:   float64(burst),
+		buckets: buckets,
+		now:     time.Now,
+	}
+}
+
+// Allow reports whether an event for key may happen now,
+// consuming a token if so.
+func (l *Limiter) Allow(key string) bool {
+	if l == nil || l.rate <= 0 {
+		return true
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	b, ok := l.buckets.Get(key)
+	if !ok {
+		b = &bucket{tokens: l.burst, last: now}
+		l.buckets.Add(key, b)
+	}
+
+	b.tokens += now.Sub(b.last).Seconds() * l.rate
+	if b.tokens > l.burst {
+		b.tokens = l.burst
+	}
+	b.last = now
+
+	if b.tokens < 1 {
+		return false
+	}
+	b.tokens--
+	return true
+}
@@ -0,0 +1,45 @@
+package ratelimit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLimiter(t *testing.T) {
+	now := time.Unix(0, 0)
+	l := New(2, 3) // 2/s, burst of 3
+	l.now = func() time.Time { return now }
+
+	// burst
+	for i := 0; i < 3; i++ {
+		require.True(t, l.Allow("alice"))
+	}
+	require.False(t, l.Allow("alice"))
+
+	// keys are independent
+	require.True(t, l.Allow("bob"))
+
+	// refill at 2 tokens per second
+	now = now.Add(500 * time.Millisecond)
+	require.True(t, l.Allow("alice"))
+	require.False(t, l.Allow("alice"))
+
+	// refill is capped at the burst
+	now = now.Add(time.Hour)
+	for i := 0; i < 3; i++ {
+		require.True(t, l.Allow("alice"))
+	}
+	require.False(t, l.Allow("alice"))
+}
+
+func TestLimiterDisabled(t *testing.T) {
+	var nilLimiter *Limiter
+	require.True(t, nilLimiter.Allow("alice"))
+
+	l := New(0, 1)
+	for i := 0; i < 100; i++ {
+		require.True(t, l.Allow("alice"))
+	}
+}
@@ -0,0 +1,334 @@
+package elgamal
+
+import (
+	"crypto/sha256"
+	"fmt"
+
+	"go.dedis.ch/kyber/v3"
+	"go.dedis.ch/kyber/v3/share"
+	"go.dedis.ch/kyber/v3/suites"
+
+	"github.com/sourcenetwork/orbis-go/pkg/crypto"
+	"github.com/sourcenetwork/orbis-go/pkg/db"
+	"github.com/sourcenetwork/orbis-go/pkg/tdec"
+	"github.com/sourcenetwork/orbis-go/pkg/types"
+)
+
+const name = "threshold-elgamal"
+
+var (
+	// For P2P handlers
+	DecryptRequest string = "decscrtrequest"
+	DecryptReply   string = "decscrtreply"
+)
+
+var (
+	_ tdec.TDEC = (*ThresholdDecrypter)(nil)
+)
+
+// ThresholdDecrypter is the threshold elgamal decryption of the
+// secrets encrypted with pre/elgamal.EncryptSecret.
+//
+// Given the terms defined:
+//
+//	dkgPk   (sG)       - Aggregate public key of DKG.
+//	dkgSki  (ski)      - Private share of secret key of DKG.
+//	dkgCmt  (ci)       - Commitment (public polynomial) of DKG at index i.
+//	encCmt  (rG)       - Schnorr commitment of encoded keys.
+//	encScrt (rsG + K)  - Encrypted key-slices.
+//	decSki  (Di)       - Partial decryption at index i.
+//	decCmt  (rsG)      - Decryption point.
+//	chlgi   (ei)       - Random oracle challenge at index i.
+//	proofi  (fi)       - NIZK proof of decryption at index i. (ri + ei * ski)
+//	rndi    (ri)       - Random number.
+//
+// A minimal flow of secret decryption is as follows:
+//
+// 1. Decrypt:
+//
+//	decSki, chlgi, proofi = Decrypt(dkgSki, encCmt)
+//
+//	- Each DKG node:
+//	  - Decrypts the commitment (encCmt) into its partial decryption (decSki/Di)
+//	    using its private share of DKG secret key (dkgSki).
+//	    - Di = ski * rG
+//	  - Proves the discrete log of Di to the base rG equals the discrete
+//	    log of ci to the base G, both being ski.
+//	    - Generates a random number (ri).
+//	    - DiHat = ri * rG
+//	    - HiHat = ri * G
+//	    - chlgi = Hash(rG, ci, Di, DiHat, HiHat)
+//	    - proofi = ri + ei * ski
+//
+// 2. Verify:
+//
+//	Each partial decryption is verified against the DKG commitment of its
+//	node, by reconstructing DiHat and HiHat.
+//
+//	  DiHat(verifier) = fi * rG - ei * Di
+//	                  = (ri + ei * ski) * rG - ei * ski * rG
+//	                  = ri * rG
+//
+//	  HiHat(verifier) = fi * G - ei * ci
+//	                  = (ri + ei * ski) * G - ei * ski * G
+//	                  = ri * G
+//
+// 3. Recover:
+//
+//	decCmt = RecoverCommit([]decSki, th, n)
+//
+//	- Given at least threshold(th) verified decSki, the decryption point
+//	  is recovered using lagrange interpolation.
+//
+//	  ski * rG ==> rsG
+//
+// 4. Decode the encrypted key (encScrt) with the decryption point.
+//
+//	encScrt - decCmt
+//	= rsG + K - rsG
+//	= K
+type ThresholdDecrypter struct {
+}
+
+func New(db *db.DB, repoKey []db.RepoKey) (tdec.TDEC, error) {
+
+	return &ThresholdDecrypter{}, nil
+}
+
+func (e *ThresholdDecrypter) Init(rid types.RingID, n int32, t int32) error {
+	return nil
+}
+
+func (e *ThresholdDecrypter) Name() string {
+	return name
+}
+
+func (e *ThresholdDecrypter) Decrypt(ste suites.Suite, distKeyShare crypto.DistKeyShare, scrt *types.Secret) (tdec.DecryptReply, error) {
+
+	var reply tdec.DecryptReply
+	if distKeyShare.PriShare == nil || len(distKeyShare.Commits) == 0 {
+		return reply, fmt.Errorf("missing private share")
+	}
+
+	idx := distKeyShare.PriShare.I
+	ski := distKeyShare.PriShare.V
+
+	encCmt := ste.Point()
+	err := encCmt.UnmarshalBinary(scrt.EncCmt)
+	if err != nil {
+		return reply, fmt.Errorf("unmarshal encCmt: %w", err)
+	}
+
+	ci := ste.Point().Mul(ski, nil)
+	decSki, chlgi, proofi, err := decrypt(ste, ski, ci, encCmt)
+	if err != nil {
+		return reply, err
+	}
+
+	reply = tdec.DecryptReply{
+		Share: share.PubShare{
+			I: idx,
+			V: decSki,
+		},
+		Challenge: chlgi,
+		Proof:     proofi,
+	}
+
+	return reply, nil
+}
+
+// Verify verifies an incoming decryption reply from another node.
+func (e *ThresholdDecrypter) Verify(ste suites.Suite, dkgCmt crypto.PubPoly, encCmt kyber.Point, r tdec.DecryptReply) error {
+
+	err := verify(ste,
+		encCmt,
+		r.Share.V,
+		r.Challenge,
+		r.Proof,
+		dkgCmt.PubPoly.Eval(r.Share.I).V,
+	)
+	if err != nil {
+		return fmt.Errorf("verification: %w", err)
+	}
+
+	return nil
+}
+
+func (e *ThresholdDecrypter) Recover(ste suites.Suite, decSki []*share.PubShare, t int, n int) (kyber.Point, error) {
+	if len(decSki) < t {
+		return nil, fmt.Errorf("not enough partial decryptions: %d/%d", len(decSki), t)
+	}
+
+	return share.RecoverCommit(ste, decSki, t, n)
+}
+
+// decrypt partially decrypts the commitment with a secret share.
+//
+// Input:
+//
+//	ste          - Crypto suite
+//	dkgSki (ski) - Private share of secret key of DKG.
+//	dkgCmt (ci)  - Commitment of DKG at the share index.
+//	encCmt (rG)  - Schnorr commit of encoded keys.
+//
+// Output:
+//
+//	decSki (Di) - Partial decryption.
+//	chlgi  (ei) - Random oracle challenge.
+//	proofi (fi) - NIZK proof of decryption.
+//	err         - Error if decryption fails.
+func decrypt(
+	ste suites.Suite,
+	dkgSki kyber.Scalar,
+	dkgCmt kyber.Point,
+	encCmt kyber.Point,
+) (
+	decSki kyber.Point,
+	chlgi kyber.Scalar,
+	proofi kyber.Scalar,
+	err error,
+) {
+	// Partial decryption (Di)
+	decSki = ste.Point().Mul(dkgSki, encCmt) // Di = ski * rG
+
+	// Produce random oracle challenge (ei)
+	// ei = Hash(rG, ci, Di, DiHat, HiHat)
+	ri := ste.Scalar().Pick(ste.RandomStream()) // ri    = Random scalar
+	diHat := ste.Point().Mul(ri, encCmt)        // DiHat = ri * rG
+	hiHat := ste.Point().Mul(ri, nil)           // HiHat = ri * G
+
+	b, err := hashPoints(encCmt, dkgCmt, decSki, diHat, hiHat)
+	if err != nil {
+		return decSki, chlgi, proofi, fmt.Errorf("marshal Di: %w", err)
+	}
+	chlgi = ste.Scalar().SetBytes(b)
+
+	// Produce NIZK proof of decryption (fi)
+	// fi = ri + ei * ski
+	proofi = ste.Scalar().Add(ri, ste.Scalar().Mul(chlgi, dkgSki))
+
+	return decSki, chlgi, proofi, nil
+}
+
+// Input:
+//
+//	ste          - Crypto suite
+//	encCmt (rG)  - Schnorr commit of encoded keys.
+//	decSki (Di)  - Partial decryption at index i.
+//	chlgi  (ei)  - Random oracle challenge at index i.
+//	proofi (fi)  - NIZK proof of decryption at index i.
+//	dkgCmt (ci)  - Commitment (public polynomial) of DKG at index i.
+//
+// Output:
+//
+//	err - Error if verification fails.
+func verify(
+	ste suites.Suite,
+	encCmt kyber.Point,
+	decSki kyber.Point,
+	chlgi kyber.Scalar,
+	proofi kyber.Scalar,
+	dkgCmt kyber.Point,
+) error {
+
+	// Reconstruct DiHat.
+	firG := ste.Point().Mul(proofi, encCmt) // fi * rG
+	eidi := ste.Point().Mul(chlgi, decSki)  // ei * Di
+	diHat := ste.Point().Sub(firG, eidi)    // DiHat = fi * rG - ei * Di
+
+	// Reconstruct HiHat.
+	fig := ste.Point().Mul(proofi, nil)    // FiG   = fi * G
+	eici := ste.Point().Mul(chlgi, dkgCmt) // EiCi  = ei * ci
+	hiHat := ste.Point().Sub(fig, eici)    // HiHat = fi * G - ei * ci
+
+	// Reconstruct random oracle challenge (ei).
+	// ei = Hash(rG, ci, Di, DiHat, HiHat)
+	b, err := hashPoints(encCmt, dkgCmt, decSki, diHat, hiHat)
+	if err != nil {
+		return fmt.Errorf("failed to marshal Di: %w", err)
+	}
+	chlg := ste.Scalar().SetBytes(b)
+
+	// Verify local challenge
+	if !chlg.Equal(chlgi) {
+		return fmt.Errorf("failed verification")
+	}
+
+	return nil
+}
+
+// DecryptSecret decodes the encrypted key-slices
+// with the recovered decryption point.
+//
+// Input:
+//
+//	ste              - Crypto suite.
+//	encScrt (rsG + K) - Encrypted key-slices.
+//	decCmt  (rsG)     - Recovered decryption point.
+//
+// Output:
+//
+//	scrt - Recovered secret.
+//	err  - Error if decryption failed.
+func DecryptSecret(
+	ste suites.Suite,
+	encScrt []kyber.Point,
+	decCmt kyber.Point,
+) (
+	scrt []byte,
+	err error,
+) {
+	for _, encKey := range encScrt {
+		k := ste.Point().Sub(encKey, decCmt) // K = (rsG + K) - rsG
+		keyi, err := k.Data()
+		if err != nil {
+			return nil, fmt.Errorf("extract key share from key point: %w", err)
+		}
+		scrt = append(scrt, keyi...)
+	}
+
+	return scrt, nil
+}
+
+// DecryptSecretHybrid decrypts a secret produced by
+// pre/elgamal.EncryptSecretHybrid with the recovered
+// decryption point.
+func DecryptSecretHybrid(
+	ste suites.Suite,
+	dem crypto.DEM,
+	encCmt kyber.Point,
+	encKey []kyber.Point,
+	encData []byte,
+	decCmt kyber.Point,
+) (
+	scrt []byte,
+	err error,
+) {
+	key, err := DecryptSecret(ste, encKey, decCmt)
+	if err != nil {
+		return nil, fmt.Errorf("decrypt key: %w", err)
+	}
+
+	ad, err := encCmt.MarshalBinary()
+	if err != nil {
+		return nil, fmt.Errorf("marshal encCmt: %w", err)
+	}
+
+	scrt, err = crypto.Open(dem, key, encData, ad)
+	if err != nil {
+		return nil, fmt.Errorf("open secret: %w", err)
+	}
+
+	return scrt, nil
+}
+
+func hashPoints(points ...kyber.Point) ([]byte, error) {
+	hash := sha256.New()
+	for _, p := range points {
+		_, err := p.MarshalTo(hash)
+		if err != nil {
+			return nil, fmt.Errorf("marshal point: %w", err)
+		}
+	}
+	return hash.Sum(nil), nil
+}
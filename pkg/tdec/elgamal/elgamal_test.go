@@ -0,0 +1,128 @@
+package elgamal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.dedis.ch/kyber/v3/share"
+	"go.dedis.ch/kyber/v3/suites"
+	"go.dedis.ch/kyber/v3/util/random"
+
+	ringv1alpha1 "github.com/sourcenetwork/orbis-go/gen/proto/orbis/ring/v1alpha1"
+	"github.com/sourcenetwork/orbis-go/pkg/crypto"
+	"github.com/sourcenetwork/orbis-go/pkg/pre/elgamal"
+	"github.com/sourcenetwork/orbis-go/pkg/tdec"
+	"github.com/sourcenetwork/orbis-go/pkg/types"
+)
+
+func TestDecryptAndVerify(t *testing.T) {
+
+	var (
+		n       = 5
+		th      = 3
+		ste     = suites.MustFind("ed25519")
+		priPoly = share.NewPriPoly(ste, th, nil, ste.RandomStream())
+		pubPoly = priPoly.Commit(nil)
+		_, cmts = pubPoly.Info()
+		dkgPk   = pubPoly.Commit()
+		poly    = crypto.PubPoly{PubPoly: pubPoly}
+		td      = &ThresholdDecrypter{}
+	)
+
+	// Generate a random secret.
+	scrt := make([]byte, 32)
+	random.Bytes(scrt, random.New())
+
+	// 1. Encrypt the secret under the DKG public key.
+	encCmt, encScrt := elgamal.EncryptSecret(ste, dkgPk, scrt)
+	rawEncCmt, err := encCmt.MarshalBinary()
+	require.NoError(t, err)
+	secret := &types.Secret{Secret: &ringv1alpha1.Secret{EncCmt: rawEncCmt}}
+
+	// 2. Each node partially decrypts, and the replies are verified.
+	var decSki []*share.PubShare
+	for _, ps := range priPoly.Shares(n) {
+		reply, err := td.Decrypt(ste, crypto.DistKeyShare{Commits: cmts, PriShare: ps}, secret)
+		require.NoError(t, err)
+		require.NoError(t, td.Verify(ste, poly, encCmt, reply))
+
+		decSki = append(decSki, &reply.Share)
+	}
+
+	// 3. Any threshold of partial decryptions recovers the secret.
+	for _, subset := range [][]*share.PubShare{decSki[:th], decSki[n-th:]} {
+		decCmt, err := td.Recover(ste, subset, th, n)
+		require.NoError(t, err)
+
+		got, err := DecryptSecret(ste, encScrt, decCmt)
+		require.NoError(t, err)
+		require.Equal(t, scrt, got)
+	}
+
+	_, err = td.Recover(ste, decSki[:th-1], th, n)
+	require.Error(t, err)
+}
+
+func TestDecryptHybrid(t *testing.T) {
+	ste := suites.MustFind("ed25519")
+	priPoly := share.NewPriPoly(ste, 2, nil, ste.RandomStream())
+	pubPoly := priPoly.Commit(nil)
+	_, cmts := pubPoly.Info()
+
+	scrt := []byte("a secret larger than a single embedded point")
+	encCmt, encKey, encData, err := elgamal.EncryptSecretHybrid(ste, pubPoly.Commit(), crypto.AES256GCM, scrt)
+	require.NoError(t, err)
+	rawEncCmt, err := encCmt.MarshalBinary()
+	require.NoError(t, err)
+	secret := &types.Secret{Secret: &ringv1alpha1.Secret{EncCmt: rawEncCmt}}
+
+	td := &ThresholdDecrypter{}
+	var decSki []*share.PubShare
+	for _, ps := range priPoly.Shares(3)[1:] {
+		reply, err := td.Decrypt(ste, crypto.DistKeyShare{Commits: cmts, PriShare: ps}, secret)
+		require.NoError(t, err)
+		decSki = append(decSki, &reply.Share)
+	}
+
+	decCmt, err := td.Recover(ste, decSki, 2, 3)
+	require.NoError(t, err)
+
+	got, err := DecryptSecretHybrid(ste, crypto.AES256GCM, encCmt, encKey, encData, decCmt)
+	require.NoError(t, err)
+	require.Equal(t, scrt, got)
+}
+
+func TestVerifyInvalidDecryption(t *testing.T) {
+	ste := suites.MustFind("ed25519")
+	priPoly := share.NewPriPoly(ste, 2, nil, ste.RandomStream())
+	pubPoly := priPoly.Commit(nil)
+	_, cmts := pubPoly.Info()
+	poly := crypto.PubPoly{PubPoly: pubPoly}
+
+	encCmt := ste.Point().Pick(ste.RandomStream())
+	rawEncCmt, err := encCmt.MarshalBinary()
+	require.NoError(t, err)
+	secret := &types.Secret{Secret: &ringv1alpha1.Secret{EncCmt: rawEncCmt}}
+
+	td := &ThresholdDecrypter{}
+	reply, err := td.Decrypt(ste, crypto.DistKeyShare{Commits: cmts, PriShare: priPoly.Eval(0)}, secret)
+	require.NoError(t, err)
+
+	// claiming the index of another node
+	forged := reply
+	forged.Share.I = 1
+	require.Error(t, td.Verify(ste, poly, encCmt, forged))
+
+	// a partial decryption of another commitment
+	require.Error(t, td.Verify(ste, poly, ste.Point().Pick(ste.RandomStream()), reply))
+
+	// a wrong partial decryption
+	forged = reply
+	forged.Share.V = ste.Point().Pick(ste.RandomStream())
+	require.Error(t, td.Verify(ste, poly, encCmt, forged))
+
+	_, err = td.Decrypt(ste, crypto.DistKeyShare{}, secret)
+	require.Error(t, err)
+
+	var _ tdec.TDEC = td
+}
@@ -0,0 +1,37 @@
+package tdec
+
+import (
+	"go.dedis.ch/kyber/v3"
+	"go.dedis.ch/kyber/v3/share"
+	"go.dedis.ch/kyber/v3/suites"
+
+	"github.com/sourcenetwork/orbis-go/pkg/crypto"
+	"github.com/sourcenetwork/orbis-go/pkg/types"
+)
+
+type DecryptReply struct {
+	Share     share.PubShare // nodes partial decryption
+	Challenge kyber.Scalar   // random oracle challenge
+	Proof     kyber.Scalar   // nizk proof of discrete log equality
+}
+
+// Threshold decryption via the ring DKG shares. Unlike PRE, the
+// plaintext is recovered by the node requesting the decryption,
+// so it must only be used for trusted aggregators.
+type TDEC interface {
+
+	// Initialize the threshold decryption system
+	Init(rid types.RingID, n int32, t int32) error
+
+	// Name of the threshold decryption implementation
+	Name() string
+
+	// Decrypt using a nodes local private share
+	Decrypt(ste suites.Suite, prishare crypto.DistKeyShare, scrt *types.Secret) (DecryptReply, error)
+
+	// Verify incoming replies from other nodes
+	Verify(ste suites.Suite, dkgCmt crypto.PubPoly, encCmt kyber.Point, reply DecryptReply) error
+
+	// Recover the decryption point of the secret
+	Recover(ste suites.Suite, decSki []*share.PubShare, t int, n int) (kyber.Point, error)
+}
@@ -2,11 +2,15 @@ package transport
 
 import (
 	"context"
+	"encoding/binary"
+	"fmt"
 
 	transportv1alpha "github.com/sourcenetwork/orbis-go/gen/proto/orbis/transport/v1alpha1"
 	"github.com/sourcenetwork/orbis-go/pkg/crypto"
 	"github.com/sourcenetwork/orbis-go/pkg/types"
 
+	ic "github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/libp2p/go-libp2p/core/protocol"
 	ma "github.com/multiformats/go-multiaddr"
 )
@@ -36,3 +40,73 @@ type Host interface {
 	Node
 	Sign(msg []byte) ([]byte, error)
 }
+
+var (
+	ErrMissingSignature = fmt.Errorf("transport message isn't signed")
+	ErrInvalidSignature = fmt.Errorf("invalid transport message signature")
+)
+
+// messageSignaturePrefix separates message signatures
+// from the other signatures of the node key.
+const messageSignaturePrefix = "orbis/transport/message/v1"
+
+// SignMessage signs the message with the host key, for messages
+// relayed by third parties, such as bulletins, which don't
+// authenticate their author.
+func SignMessage(h Host, msg *Message) error {
+	sig, err := h.Sign(messageSigningBytes(msg))
+	if err != nil {
+		return fmt.Errorf("sign transport message: %w", err)
+	}
+	msg.Signature = sig
+	return nil
+}
+
+// VerifyMessage checks the message is signed by the key of
+// its author node.
+func VerifyMessage(msg *Message, pk ic.PubKey) error {
+	if len(msg.Signature) == 0 {
+		return ErrMissingSignature
+	}
+
+	id, err := peer.Decode(msg.NodeId)
+	if err != nil {
+		return fmt.Errorf("decode message node id: %w", err)
+	}
+	if !id.MatchesPublicKey(pk) {
+		return fmt.Errorf("%w: key doesn't match node %s", ErrInvalidSignature, msg.NodeId)
+	}
+
+	ok, err := pk.Verify(messageSigningBytes(msg), msg.Signature)
+	if err != nil || !ok {
+		return ErrInvalidSignature
+	}
+	return nil
+}
+
+// messageSigningBytes encodes every field of the message but its
+// signature, each prefixed by its length.
+func messageSigningBytes(msg *Message) []byte {
+	var buf []byte
+	field := func(b []byte) {
+		buf = binary.BigEndian.AppendUint32(buf, uint32(len(b)))
+		buf = append(buf, b...)
+	}
+
+	field([]byte(messageSignaturePrefix))
+	field(binary.BigEndian.AppendUint64(nil, uint64(msg.Timestamp)))
+	field([]byte(msg.Id))
+	field([]byte(msg.Type))
+	field(msg.Payload)
+	if msg.Gossip {
+		field([]byte{1})
+	} else {
+		field([]byte{0})
+	}
+	field([]byte(msg.NodeId))
+	field(msg.NodePubKey)
+	field([]byte(msg.RingId))
+	field([]byte(msg.TargetId))
+	field(msg.TargetPubKey)
+	return buf
+}
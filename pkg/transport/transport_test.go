@@ -0,0 +1,54 @@
+package transport
+
+import (
+	"crypto/rand"
+	"testing"
+
+	ic "github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+// testHost signs with a libp2p key.
+type testHost struct {
+	Node
+	sk ic.PrivKey
+}
+
+func (h testHost) Sign(msg []byte) ([]byte, error) {
+	return h.sk.Sign(msg)
+}
+
+func TestSignMessage(t *testing.T) {
+	sk, pk, err := ic.GenerateEd25519Key(rand.Reader)
+	require.NoError(t, err)
+	id, err := peer.IDFromPublicKey(pk)
+	require.NoError(t, err)
+
+	msg := &Message{
+		Id:      "id",
+		Type:    "type",
+		NodeId:  id.String(),
+		RingId:  "ring",
+		Payload: []byte("payload"),
+	}
+	require.ErrorIs(t, VerifyMessage(msg, pk), ErrMissingSignature)
+
+	require.NoError(t, SignMessage(testHost{sk: sk}, msg))
+	require.NoError(t, VerifyMessage(msg, pk))
+
+	// every field is signed
+	tampered := proto.Clone(msg).(*Message)
+	tampered.Payload = []byte("other")
+	require.ErrorIs(t, VerifyMessage(tampered, pk), ErrInvalidSignature)
+	tampered = proto.Clone(msg).(*Message)
+	tampered.RingId = "other"
+	require.ErrorIs(t, VerifyMessage(tampered, pk), ErrInvalidSignature)
+
+	// a node claiming another node id
+	_, other, err := ic.GenerateEd25519Key(rand.Reader)
+	require.NoError(t, err)
+	require.ErrorIs(t, VerifyMessage(msg, other), ErrInvalidSignature)
+
+}
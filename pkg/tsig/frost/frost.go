@@ -0,0 +1,488 @@
+package frost
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/sha512"
+	"encoding/binary"
+	"fmt"
+	"hash"
+	"sort"
+
+	"go.dedis.ch/kyber/v3"
+	"go.dedis.ch/kyber/v3/group/edwards25519"
+	"go.dedis.ch/kyber/v3/suites"
+	"golang.org/x/crypto/sha3"
+
+	"github.com/sourcenetwork/orbis-go/pkg/crypto"
+	"github.com/sourcenetwork/orbis-go/pkg/crypto/suites/secp256k1ct"
+	"github.com/sourcenetwork/orbis-go/pkg/tsig"
+)
+
+const name = "frost"
+
+var (
+	_ tsig.InteractiveScheme = (*Scheme)(nil)
+)
+
+var (
+	ErrBadCommitments = fmt.Errorf("invalid nonce commitments")
+	ErrNotSigner      = fmt.Errorf("signer isn't part of the nonce commitments")
+)
+
+// Scheme is the FROST threshold Schnorr signature scheme, following
+// RFC 9591. The signers commit to a pair of single use nonces, and
+// once a threshold of commitments is known, each signer creates its
+// partial signature, which are summed into a Schnorr signature R || z.
+//
+// Over Ed25519, the ciphersuite is FROST(Ed25519, SHA-512), where the
+// ring signature is a plain Ed25519 signature of the ring key. Over
+// secp256k1 the scheme uses Keccak-256 hashes instead of the RFC hash
+// to field, so its signatures are only verifiable by this scheme.
+type Scheme struct {
+	suite   suites.Suite
+	context string
+	newHash func() hash.Hash
+
+	// the Ed25519 challenge isn't domain separated,
+	// for compatibility with Ed25519 signatures.
+	challengeContext bool
+}
+
+// NewEd25519 returns the FROST(Ed25519, SHA-512) scheme.
+func NewEd25519() *Scheme {
+	return &Scheme{
+		suite:   edwards25519.NewBlakeSHA256Ed25519(),
+		context: "FROST-ED25519-SHA512-v1",
+		newHash: sha512.New,
+	}
+}
+
+// NewSecp256k1 returns the FROST scheme over secp256k1.
+func NewSecp256k1() *Scheme {
+	return &Scheme{
+		suite:            secp256k1ct.NewBlakeKeccackSecp256k1(),
+		context:          "FROST-secp256k1-KECCAK256-orbis-v1",
+		newHash:          sha3.NewLegacyKeccak256,
+		challengeContext: true,
+	}
+}
+
+// New returns the FROST scheme over the suite of the ring key.
+func New(kt crypto.KeyType) (*Scheme, error) {
+	switch kt {
+	case crypto.Ed25519:
+		return NewEd25519(), nil
+	case crypto.Secp256k1:
+		return NewSecp256k1(), nil
+	default:
+		return nil, fmt.Errorf("frost: unsupported key type %s", kt)
+	}
+}
+
+func (s *Scheme) Name() string {
+	return name
+}
+
+// nonces are the hiding and binding nonces of a signer.
+type nonces struct {
+	hiding  kyber.Scalar
+	binding kyber.Scalar
+	cmt     []byte
+}
+
+func (n *nonces) Commitment() []byte {
+	return n.cmt
+}
+
+// commitment is a decoded signer commitment.
+type commitment struct {
+	id      kyber.Scalar
+	index   int
+	hiding  kyber.Point
+	binding kyber.Point
+}
+
+func (s *Scheme) Commit(prishare crypto.DistKeyShare) (tsig.Nonces, error) {
+	if prishare.PriShare == nil {
+		return nil, fmt.Errorf("missing private share")
+	}
+
+	hiding, err := s.nonce(prishare.PriShare.V)
+	if err != nil {
+		return nil, err
+	}
+	binding, err := s.nonce(prishare.PriShare.V)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	_, err = s.suite.Point().Mul(hiding, nil).MarshalTo(&buf)
+	if err != nil {
+		return nil, fmt.Errorf("marshal hiding commitment: %w", err)
+	}
+	_, err = s.suite.Point().Mul(binding, nil).MarshalTo(&buf)
+	if err != nil {
+		return nil, fmt.Errorf("marshal binding commitment: %w", err)
+	}
+
+	return &nonces{
+		hiding:  hiding,
+		binding: binding,
+		cmt:     buf.Bytes(),
+	}, nil
+}
+
+func (s *Scheme) Sign(prishare crypto.DistKeyShare, n tsig.Nonces, msg []byte, cmts []tsig.Commitment) ([]byte, error) {
+	if prishare.PriShare == nil || len(prishare.Commits) == 0 {
+		return nil, fmt.Errorf("missing private share")
+	}
+	nonces, ok := n.(*nonces)
+	if !ok {
+		return nil, fmt.Errorf("frost: unexpected nonces %T", n)
+	}
+
+	coms, err := s.decodeCommitments(cmts)
+	if err != nil {
+		return nil, err
+	}
+
+	idx := prishare.PriShare.I
+	own, ok := findCommitment(cmts, idx)
+	if !ok || !bytes.Equal(own.Data, nonces.cmt) {
+		return nil, ErrNotSigner
+	}
+
+	groupPk := prishare.Commits[0]
+	rhos, r, err := s.groupCommitment(groupPk, msg, coms)
+	if err != nil {
+		return nil, err
+	}
+	c, err := s.challenge(r, groupPk, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	// z = d + e * rho + lambda * s * c
+	lambda := s.lagrange(idx, coms)
+	z := s.suite.Scalar().Mul(nonces.binding, rhos[idx])
+	z.Add(z, nonces.hiding)
+	z.Add(z, s.suite.Scalar().Mul(lambda, s.suite.Scalar().Mul(prishare.PriShare.V, c)))
+
+	return encodePartial(idx, z)
+}
+
+func (s *Scheme) VerifyPartial(pubPoly crypto.PubPoly, msg []byte, cmts []tsig.Commitment, partial []byte) (int, error) {
+	idx, z, err := s.decodePartial(partial)
+	if err != nil {
+		return -1, err
+	}
+
+	coms, err := s.decodeCommitments(cmts)
+	if err != nil {
+		return -1, err
+	}
+
+	var com *commitment
+	for _, c := range coms {
+		if c.index == idx {
+			com = c
+		}
+	}
+	if com == nil {
+		return -1, ErrNotSigner
+	}
+
+	groupPk := pubPoly.Commit()
+	rhos, r, err := s.groupCommitment(groupPk, msg, coms)
+	if err != nil {
+		return -1, err
+	}
+	c, err := s.challenge(r, groupPk, msg)
+	if err != nil {
+		return -1, err
+	}
+
+	// z * G == D + rho * E + (lambda * c) * Y
+	lambda := s.lagrange(idx, coms)
+	y := pubPoly.Eval(idx).V
+	expected := s.suite.Point().Mul(rhos[idx], com.binding)
+	expected.Add(expected, com.hiding)
+	expected.Add(expected, s.suite.Point().Mul(s.suite.Scalar().Mul(lambda, c), y))
+
+	if !s.suite.Point().Mul(z, nil).Equal(expected) {
+		return -1, fmt.Errorf("invalid partial signature of share %d", idx)
+	}
+
+	return idx, nil
+}
+
+func (s *Scheme) Recover(pubPoly crypto.PubPoly, msg []byte, cmts []tsig.Commitment, partials [][]byte) ([]byte, error) {
+	coms, err := s.decodeCommitments(cmts)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[int]bool)
+	z := s.suite.Scalar().Zero()
+	for _, p := range partials {
+		idx, err := s.VerifyPartial(pubPoly, msg, cmts, p)
+		if err != nil {
+			return nil, err
+		}
+		if seen[idx] {
+			return nil, fmt.Errorf("duplicate partial signature of share %d", idx)
+		}
+		seen[idx] = true
+
+		_, zi, err := s.decodePartial(p)
+		if err != nil {
+			return nil, err
+		}
+		z.Add(z, zi)
+	}
+	if len(seen) != len(coms) {
+		return nil, fmt.Errorf("missing partial signatures, got %d of %d", len(seen), len(coms))
+	}
+
+	_, r, err := s.groupCommitment(pubPoly.Commit(), msg, coms)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	_, err = r.MarshalTo(&buf)
+	if err != nil {
+		return nil, fmt.Errorf("marshal group commitment: %w", err)
+	}
+	_, err = z.MarshalTo(&buf)
+	if err != nil {
+		return nil, fmt.Errorf("marshal signature: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// Verify checks the Schnorr signature R || z of the ring key.
+func (s *Scheme) Verify(pk crypto.PublicKey, msg []byte, sig []byte) error {
+	y := pk.Point()
+	if y == nil || pk.Type() == crypto.BLS12381 {
+		return fmt.Errorf("%w: %s ring key", tsig.ErrInvalidSignature, pk.Type())
+	}
+
+	pointLen, scalarLen := s.suite.PointLen(), s.suite.ScalarLen()
+	if len(sig) != pointLen+scalarLen {
+		return fmt.Errorf("%w: bad length", tsig.ErrInvalidSignature)
+	}
+
+	r := s.suite.Point()
+	err := r.UnmarshalBinary(sig[:pointLen])
+	if err != nil {
+		return fmt.Errorf("%w: %w", tsig.ErrInvalidSignature, err)
+	}
+	z := s.suite.Scalar()
+	err = z.UnmarshalBinary(sig[pointLen:])
+	if err != nil {
+		return fmt.Errorf("%w: %w", tsig.ErrInvalidSignature, err)
+	}
+
+	c, err := s.challenge(r, y, msg)
+	if err != nil {
+		return err
+	}
+
+	// z * G == R + c * Y
+	expected := s.suite.Point().Add(r, s.suite.Point().Mul(c, y))
+	if !s.suite.Point().Mul(z, nil).Equal(expected) {
+		return tsig.ErrInvalidSignature
+	}
+	return nil
+}
+
+// nonce generates a nonce from fresh randomness
+// and the secret share, as nonce_generate does.
+func (s *Scheme) nonce(secret kyber.Scalar) (kyber.Scalar, error) {
+	var buf [32]byte
+	_, err := rand.Read(buf[:])
+	if err != nil {
+		return nil, fmt.Errorf("read random bytes: %w", err)
+	}
+
+	sec, err := secret.MarshalBinary()
+	if err != nil {
+		return nil, fmt.Errorf("marshal secret: %w", err)
+	}
+
+	return s.hashToScalar("nonce", buf[:], sec), nil
+}
+
+// groupCommitment computes the binding factors of the signers,
+// keyed by share index, and the group commitment R.
+func (s *Scheme) groupCommitment(groupPk kyber.Point, msg []byte, coms []*commitment) (map[int]kyber.Scalar, kyber.Point, error) {
+	pk, err := groupPk.MarshalBinary()
+	if err != nil {
+		return nil, nil, fmt.Errorf("marshal group public key: %w", err)
+	}
+
+	var encoded bytes.Buffer
+	for _, c := range coms {
+		for _, m := range []kyber.Marshaling{c.id, c.hiding, c.binding} {
+			_, err = m.MarshalTo(&encoded)
+			if err != nil {
+				return nil, nil, fmt.Errorf("encode commitment list: %w", err)
+			}
+		}
+	}
+
+	prefix := append(pk, s.hash("msg", msg)...)
+	prefix = append(prefix, s.hash("com", encoded.Bytes())...)
+
+	rhos := make(map[int]kyber.Scalar, len(coms))
+	r := s.suite.Point().Null()
+	for _, c := range coms {
+		id, err := c.id.MarshalBinary()
+		if err != nil {
+			return nil, nil, fmt.Errorf("marshal identifier: %w", err)
+		}
+
+		rho := s.hashToScalar("rho", prefix, id)
+		rhos[c.index] = rho
+		r.Add(r, c.hiding)
+		r.Add(r, s.suite.Point().Mul(rho, c.binding))
+	}
+
+	return rhos, r, nil
+}
+
+// challenge computes the Schnorr challenge H2(R || Y || msg).
+func (s *Scheme) challenge(r, y kyber.Point, msg []byte) (kyber.Scalar, error) {
+	rb, err := r.MarshalBinary()
+	if err != nil {
+		return nil, fmt.Errorf("marshal group commitment: %w", err)
+	}
+	yb, err := y.MarshalBinary()
+	if err != nil {
+		return nil, fmt.Errorf("marshal group public key: %w", err)
+	}
+
+	if !s.challengeContext {
+		h := s.newHash()
+		h.Write(rb)
+		h.Write(yb)
+		h.Write(msg)
+		return s.suite.Scalar().SetBytes(h.Sum(nil)), nil
+	}
+	return s.hashToScalar("chal", rb, yb, msg), nil
+}
+
+// lagrange computes the lagrange coefficient of the
+// share at index, at zero, over the signer set.
+func (s *Scheme) lagrange(index int, coms []*commitment) kyber.Scalar {
+	xi := s.identifier(index)
+	num, den := s.suite.Scalar().One(), s.suite.Scalar().One()
+	for _, c := range coms {
+		if c.index == index {
+			continue
+		}
+		num.Mul(num, c.id)
+		den.Mul(den, s.suite.Scalar().Sub(c.id, xi))
+	}
+	return num.Div(num, den)
+}
+
+// identifier is the FROST identifier of the share
+// at index, its evaluation point on the polynomial.
+func (s *Scheme) identifier(index int) kyber.Scalar {
+	return s.suite.Scalar().SetInt64(int64(index) + 1)
+}
+
+func (s *Scheme) hash(tag string, parts ...[]byte) []byte {
+	h := s.newHash()
+	h.Write([]byte(s.context))
+	h.Write([]byte(tag))
+	for _, p := range parts {
+		h.Write(p)
+	}
+	return h.Sum(nil)
+}
+
+func (s *Scheme) hashToScalar(tag string, parts ...[]byte) kyber.Scalar {
+	return s.suite.Scalar().SetBytes(s.hash(tag, parts...))
+}
+
+// decodeCommitments decodes the signer commitments, which must be
+// sorted by share index, without duplicates or identity points.
+func (s *Scheme) decodeCommitments(cmts []tsig.Commitment) ([]*commitment, error) {
+	if len(cmts) == 0 {
+		return nil, fmt.Errorf("%w: empty", ErrBadCommitments)
+	}
+	if !sort.SliceIsSorted(cmts, func(i, j int) bool { return cmts[i].Index < cmts[j].Index }) {
+		return nil, fmt.Errorf("%w: not sorted", ErrBadCommitments)
+	}
+
+	pointLen := s.suite.PointLen()
+	coms := make([]*commitment, len(cmts))
+	for i, c := range cmts {
+		if c.Index < 0 || c.Index > 0xffff || (i > 0 && c.Index == cmts[i-1].Index) {
+			return nil, fmt.Errorf("%w: bad index %d", ErrBadCommitments, c.Index)
+		}
+		if len(c.Data) != 2*pointLen {
+			return nil, fmt.Errorf("%w: bad length", ErrBadCommitments)
+		}
+
+		com := &commitment{
+			id:      s.identifier(c.Index),
+			index:   c.Index,
+			hiding:  s.suite.Point(),
+			binding: s.suite.Point(),
+		}
+		if com.hiding.UnmarshalBinary(c.Data[:pointLen]) != nil ||
+			com.binding.UnmarshalBinary(c.Data[pointLen:]) != nil ||
+			com.hiding.Equal(s.suite.Point().Null()) ||
+			com.binding.Equal(s.suite.Point().Null()) {
+			return nil, fmt.Errorf("%w: bad commitment of share %d", ErrBadCommitments, c.Index)
+		}
+		coms[i] = com
+	}
+
+	return coms, nil
+}
+
+func findCommitment(cmts []tsig.Commitment, index int) (tsig.Commitment, bool) {
+	for _, c := range cmts {
+		if c.Index == index {
+			return c, true
+		}
+	}
+	return tsig.Commitment{}, false
+}
+
+// partial signatures are encoded as i || z, where the 2-byte
+// big-endian value i is the share index, like tbls shares.
+func encodePartial(index int, z kyber.Scalar) ([]byte, error) {
+	var buf bytes.Buffer
+	err := binary.Write(&buf, binary.BigEndian, uint16(index))
+	if err != nil {
+		return nil, err
+	}
+	_, err = z.MarshalTo(&buf)
+	if err != nil {
+		return nil, fmt.Errorf("marshal partial signature: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+func (s *Scheme) decodePartial(partial []byte) (int, kyber.Scalar, error) {
+	if len(partial) != 2+s.suite.ScalarLen() {
+		return -1, nil, fmt.Errorf("partial signature: bad length")
+	}
+
+	z := s.suite.Scalar()
+	err := z.UnmarshalBinary(partial[2:])
+	if err != nil {
+		return -1, nil, fmt.Errorf("unmarshal partial signature: %w", err)
+	}
+
+	return int(binary.BigEndian.Uint16(partial[:2])), z, nil
+}
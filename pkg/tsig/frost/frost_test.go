@@ -0,0 +1,180 @@
+package frost
+
+import (
+	"crypto/ed25519"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.dedis.ch/kyber/v3"
+	"go.dedis.ch/kyber/v3/share"
+	"go.dedis.ch/kyber/v3/util/random"
+
+	"github.com/sourcenetwork/orbis-go/pkg/crypto"
+	"github.com/sourcenetwork/orbis-go/pkg/tsig"
+)
+
+// dealShares splits a random ring key into n shares, as the DKG would.
+func dealShares(s *Scheme, t int, n int) ([]crypto.DistKeyShare, crypto.PubPoly) {
+	priPoly := share.NewPriPoly(s.suite, t, nil, random.New())
+	pubPoly := priPoly.Commit(nil)
+	_, commits := pubPoly.Info()
+
+	shares := make([]crypto.DistKeyShare, n)
+	for i, sh := range priPoly.Shares(n) {
+		shares[i] = crypto.DistKeyShare{
+			Commits:  commits,
+			PriShare: sh,
+		}
+	}
+
+	return shares, crypto.PubPoly{PubPoly: pubPoly}
+}
+
+// signWith runs a signing session with the given signers,
+// and returns the commitments and partial signatures.
+func signWith(t *testing.T, s *Scheme, shares []crypto.DistKeyShare, pubPoly crypto.PubPoly, signers []int, msg []byte) ([]tsig.Commitment, [][]byte) {
+	nonces := make(map[int]tsig.Nonces)
+	var cmts []tsig.Commitment
+	for _, i := range signers {
+		n, err := s.Commit(shares[i])
+		require.NoError(t, err)
+		nonces[i] = n
+		cmts = append(cmts, tsig.Commitment{Index: i, Data: n.Commitment()})
+	}
+
+	var partials [][]byte
+	for _, i := range signers {
+		partial, err := s.Sign(shares[i], nonces[i], msg, cmts)
+		require.NoError(t, err)
+
+		idx, err := s.VerifyPartial(pubPoly, msg, cmts, partial)
+		require.NoError(t, err)
+		require.Equal(t, i, idx)
+
+		partials = append(partials, partial)
+	}
+
+	return cmts, partials
+}
+
+func TestEd25519Signature(t *testing.T) {
+	s := NewEd25519()
+	shares, pubPoly := dealShares(s, 3, 5)
+	msg := []byte("ring attestation")
+
+	ringPk, err := crypto.PublicKeyFromPoint(s.suite, pubPoly.Commit())
+	require.NoError(t, err)
+	raw, err := ringPk.Raw()
+	require.NoError(t, err)
+
+	for _, signers := range [][]int{{0, 1, 2}, {1, 3, 4}, {0, 2, 3, 4}} {
+		cmts, partials := signWith(t, s, shares, pubPoly, signers, msg)
+
+		sig, err := s.Recover(pubPoly, msg, cmts, partials)
+		require.NoError(t, err)
+
+		// the ring signature is a plain Ed25519 signature
+		require.True(t, ed25519.Verify(ed25519.PublicKey(raw), msg, sig))
+		ok, err := ringPk.Verify(msg, sig)
+		require.NoError(t, err)
+		require.True(t, ok)
+		require.NoError(t, s.Verify(ringPk, msg, sig))
+
+		require.ErrorIs(t, s.Verify(ringPk, []byte("other message"), sig), tsig.ErrInvalidSignature)
+	}
+}
+
+func TestSecp256k1Signature(t *testing.T) {
+	s := NewSecp256k1()
+	shares, pubPoly := dealShares(s, 2, 3)
+	msg := []byte("ring attestation")
+
+	cmts, partials := signWith(t, s, shares, pubPoly, []int{0, 2}, msg)
+	sig, err := s.Recover(pubPoly, msg, cmts, partials)
+	require.NoError(t, err)
+
+	ringPk := pointKey{point: pubPoly.Commit()}
+	require.NoError(t, s.Verify(ringPk, msg, sig))
+	require.ErrorIs(t, s.Verify(ringPk, []byte("other message"), sig), tsig.ErrInvalidSignature)
+
+	// signatures of one suite don't verify with the other
+	require.Error(t, NewEd25519().Verify(ringPk, msg, sig))
+}
+
+// pointKey is a secp256k1 ring key, only used for its point.
+type pointKey struct {
+	crypto.PublicKey
+	point kyber.Point
+}
+
+func (k pointKey) Type() crypto.KeyType {
+	return crypto.Secp256k1
+}
+
+func (k pointKey) Point() kyber.Point {
+	return k.point
+}
+
+func TestInvalidPartials(t *testing.T) {
+	s := NewEd25519()
+	shares, pubPoly := dealShares(s, 2, 3)
+	msg := []byte("ring attestation")
+
+	cmts, partials := signWith(t, s, shares, pubPoly, []int{0, 1}, msg)
+
+	_, err := s.VerifyPartial(pubPoly, []byte("other message"), cmts, partials[0])
+	require.Error(t, err)
+
+	// claiming the index of another signer
+	forged := append([]byte{}, partials[0]...)
+	forged[1] = 1
+	_, err = s.VerifyPartial(pubPoly, msg, cmts, forged)
+	require.Error(t, err)
+
+	// claiming the index of a non signer
+	forged[1] = 2
+	_, err = s.VerifyPartial(pubPoly, msg, cmts, forged)
+	require.ErrorIs(t, err, ErrNotSigner)
+
+	_, err = s.VerifyPartial(pubPoly, msg, cmts, partials[0][:2])
+	require.Error(t, err)
+
+	// all the signers must contribute
+	_, err = s.Recover(pubPoly, msg, cmts, partials[:1])
+	require.Error(t, err)
+	_, err = s.Recover(pubPoly, msg, cmts, [][]byte{partials[0], partials[0]})
+	require.Error(t, err)
+}
+
+func TestInvalidCommitments(t *testing.T) {
+	s := NewEd25519()
+	shares, _ := dealShares(s, 2, 3)
+	msg := []byte("ring attestation")
+
+	n0, err := s.Commit(shares[0])
+	require.NoError(t, err)
+	n1, err := s.Commit(shares[1])
+	require.NoError(t, err)
+
+	valid := []tsig.Commitment{{Index: 0, Data: n0.Commitment()}, {Index: 1, Data: n1.Commitment()}}
+	identity, err := s.suite.Point().Null().MarshalBinary()
+	require.NoError(t, err)
+
+	cases := map[string][]tsig.Commitment{
+		"empty":     nil,
+		"unsorted":  {valid[1], valid[0]},
+		"duplicate": {valid[0], valid[0]},
+		"length":    {valid[0], {Index: 1, Data: n1.Commitment()[:10]}},
+		"identity":  {valid[0], {Index: 1, Data: append(identity, identity...)}},
+	}
+	for name, cmts := range cases {
+		_, err = s.Sign(shares[0], n0, msg, cmts)
+		require.ErrorIs(t, err, ErrBadCommitments, name)
+	}
+
+	// the nonces must match our own commitment
+	_, err = s.Sign(shares[0], n1, msg, valid)
+	require.ErrorIs(t, err, ErrNotSigner)
+	_, err = s.Sign(shares[2], n0, msg, valid)
+	require.ErrorIs(t, err, ErrNotSigner)
+}
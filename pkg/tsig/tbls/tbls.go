@@ -0,0 +1,80 @@
+package tbls
+
+import (
+	"fmt"
+
+	"go.dedis.ch/kyber/v3/pairing"
+	kybertbls "go.dedis.ch/kyber/v3/sign/tbls"
+
+	"github.com/sourcenetwork/orbis-go/pkg/crypto"
+	"github.com/sourcenetwork/orbis-go/pkg/tsig"
+)
+
+const name = "tbls"
+
+var (
+	_ tsig.Scheme = (*Scheme)(nil)
+)
+
+// Scheme is the threshold BLS signature scheme. Partial
+// signatures and the recovered signature are points of the
+// G1 group, verified against public keys of the G2 group.
+//
+// The recovered signature is a plain BLS signature, so it
+// is verified by the ring public key as any BLS12-381 key.
+type Scheme struct {
+	suite pairing.Suite
+}
+
+func New() *Scheme {
+	return &Scheme{
+		suite: crypto.PairingSuite(),
+	}
+}
+
+func (s *Scheme) Name() string {
+	return name
+}
+
+func (s *Scheme) Sign(prishare crypto.DistKeyShare, msg []byte) ([]byte, error) {
+	if prishare.PriShare == nil {
+		return nil, fmt.Errorf("missing private share")
+	}
+	return kybertbls.Sign(s.suite, prishare.PriShare, msg)
+}
+
+func (s *Scheme) VerifyPartial(pubPoly crypto.PubPoly, msg []byte, partial []byte) (int, error) {
+	i, err := kybertbls.SigShare(partial).Index()
+	if err != nil {
+		return -1, fmt.Errorf("partial signature index: %w", err)
+	}
+	err = kybertbls.Verify(s.suite, pubPoly.PubPoly, msg, partial)
+	if err != nil {
+		return -1, fmt.Errorf("verify partial signature: %w", err)
+	}
+	return i, nil
+}
+
+func (s *Scheme) Recover(pubPoly crypto.PubPoly, msg []byte, partials [][]byte, t int, n int) ([]byte, error) {
+	sig, err := kybertbls.Recover(s.suite, pubPoly.PubPoly, msg, partials, t, n)
+	if err != nil {
+		return nil, fmt.Errorf("recover signature: %w", err)
+	}
+	return sig, nil
+}
+
+// Verify checks the signature as a plain BLS signature of the ring key.
+func (s *Scheme) Verify(pk crypto.PublicKey, msg []byte, sig []byte) error {
+	if pk.Type() != crypto.BLS12381 {
+		return fmt.Errorf("%w: %s ring key", tsig.ErrInvalidSignature, pk.Type())
+	}
+
+	ok, err := pk.Verify(msg, sig)
+	if err != nil {
+		return fmt.Errorf("%w: %w", tsig.ErrInvalidSignature, err)
+	}
+	if !ok {
+		return tsig.ErrInvalidSignature
+	}
+	return nil
+}
@@ -0,0 +1,93 @@
+package tbls
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.dedis.ch/kyber/v3/share"
+	"go.dedis.ch/kyber/v3/util/random"
+
+	"github.com/sourcenetwork/orbis-go/pkg/crypto"
+)
+
+// dealShares splits a random ring key into n shares, as the DKG would.
+func dealShares(t int, n int) ([]crypto.DistKeyShare, crypto.PubPoly) {
+	ste := crypto.PairingSuite()
+	priPoly := share.NewPriPoly(ste, t, nil, random.New())
+	pubPoly := priPoly.Commit(nil)
+	_, commits := pubPoly.Info()
+
+	shares := make([]crypto.DistKeyShare, n)
+	for i, s := range priPoly.Shares(n) {
+		shares[i] = crypto.DistKeyShare{
+			Commits:  commits,
+			PriShare: s,
+		}
+	}
+
+	return shares, crypto.PubPoly{PubPoly: pubPoly}
+}
+
+func TestThresholdSignature(t *testing.T) {
+	th, n := 3, 5
+	shares, pubPoly := dealShares(th, n)
+	scheme := New()
+	msg := []byte("ring attestation")
+
+	var partials [][]byte
+	for i := n - 1; i >= 0; i-- {
+		partial, err := scheme.Sign(shares[i], msg)
+		require.NoError(t, err)
+
+		idx, err := scheme.VerifyPartial(pubPoly, msg, partial)
+		require.NoError(t, err)
+		require.Equal(t, i, idx)
+
+		partials = append(partials, partial)
+	}
+
+	ringPk, err := crypto.PublicKeyFromPoint(crypto.PairingSuite(), pubPoly.Commit())
+	require.NoError(t, err)
+
+	// any threshold of partials recovers the same signature
+	sig, err := scheme.Recover(pubPoly, msg, partials[:th], th, n)
+	require.NoError(t, err)
+	sig2, err := scheme.Recover(pubPoly, msg, partials[n-th:], th, n)
+	require.NoError(t, err)
+	require.Equal(t, sig, sig2)
+
+	ok, err := ringPk.Verify(msg, sig)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	ok, err = ringPk.Verify([]byte("other message"), sig)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	_, err = scheme.Recover(pubPoly, msg, partials[:th-1], th, n)
+	require.Error(t, err)
+}
+
+func TestVerifyPartialInvalid(t *testing.T) {
+	shares, pubPoly := dealShares(2, 3)
+	scheme := New()
+	msg := []byte("ring attestation")
+
+	partial, err := scheme.Sign(shares[0], msg)
+	require.NoError(t, err)
+
+	_, err = scheme.VerifyPartial(pubPoly, []byte("other message"), partial)
+	require.Error(t, err)
+
+	// claiming the index of another share
+	forged := append([]byte{}, partial...)
+	forged[1] = 1
+	_, err = scheme.VerifyPartial(pubPoly, msg, forged)
+	require.Error(t, err)
+
+	_, err = scheme.VerifyPartial(pubPoly, msg, partial[:1])
+	require.Error(t, err)
+
+	_, err = scheme.Sign(crypto.DistKeyShare{}, msg)
+	require.Error(t, err)
+}
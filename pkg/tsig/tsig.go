@@ -0,0 +1,79 @@
+package tsig
+
+import (
+	"fmt"
+
+	"github.com/sourcenetwork/orbis-go/pkg/crypto"
+)
+
+var (
+	// For P2P handlers
+	SignRequest    string = "tsigrequest"
+	SignReply      string = "tsigreply"
+	SigningPackage string = "tsigpackage"
+
+	// For bulletin posts
+	NonceCommitment string = "tsignonce"
+)
+
+var ErrInvalidSignature = fmt.Errorf("invalid ring signature")
+
+// Verifier checks signatures of the ring key
+type Verifier interface {
+
+	// Name of the signature scheme
+	Name() string
+
+	// Verify the ring signature of the message
+	Verify(pk crypto.PublicKey, msg []byte, sig []byte) error
+}
+
+// Threshold signatures via the ring DKG shares
+type Scheme interface {
+	Verifier
+
+	// Sign the message using a nodes local private share
+	Sign(prishare crypto.DistKeyShare, msg []byte) ([]byte, error)
+
+	// VerifyPartial checks an incoming partial signature from another
+	// node, and returns the index of the share which signed it.
+	VerifyPartial(pubPoly crypto.PubPoly, msg []byte, partial []byte) (int, error)
+
+	// Recover the ring signature from a threshold of partial signatures
+	Recover(pubPoly crypto.PubPoly, msg []byte, partials [][]byte, t int, n int) ([]byte, error)
+}
+
+// Commitment is the public commitment of a signer
+// to its nonces for a signing session.
+type Commitment struct {
+	Index int // share index of the signer
+	Data  []byte
+}
+
+// Nonces are the secret nonces of a signer for a single
+// signing session. They must never be used twice.
+type Nonces interface {
+	Commitment() []byte
+}
+
+// Threshold signatures via the ring DKG shares, where the signers
+// first commit to single use nonces, before a threshold of them
+// sign the message.
+type InteractiveScheme interface {
+	Verifier
+
+	// Commit generates the nonces of a signing session
+	Commit(prishare crypto.DistKeyShare) (Nonces, error)
+
+	// Sign the message using a nodes local private share, its session
+	// nonces, and the nonce commitments of all the session signers
+	Sign(prishare crypto.DistKeyShare, nonces Nonces, msg []byte, cmts []Commitment) ([]byte, error)
+
+	// VerifyPartial checks an incoming partial signature from another
+	// node, and returns the index of the share which signed it.
+	VerifyPartial(pubPoly crypto.PubPoly, msg []byte, cmts []Commitment, partial []byte) (int, error)
+
+	// Recover the ring signature from the partial signatures of all the
+	// session signers
+	Recover(pubPoly crypto.PubPoly, msg []byte, cmts []Commitment, partials [][]byte) ([]byte, error)
+}
@@ -0,0 +1,233 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/fxamacker/cbor/v2"
+	cid "github.com/ipfs/go-cid"
+	ic "github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/peer"
+	ma "github.com/multiformats/go-multiaddr"
+	mc "github.com/multiformats/go-multicodec"
+	mh "github.com/multiformats/go-multihash"
+
+	ringv1alpha1 "github.com/sourcenetwork/orbis-go/gen/proto/orbis/ring/v1alpha1"
+	"github.com/sourcenetwork/orbis-go/pkg/crypto"
+)
+
+type manifest struct {
+	ID        string `json:"id"`
+	N         int32  `json:"n"`
+	T         int32  `json:"t"`
+	DKG       string `json:"dkg"`
+	PSS       string `json:"pss"`
+	PRE       string `json:"pre"`
+	Bulletin  string `json:"bulletin"`
+	Transport string `json:"transport"`
+	Nodes     []node `json:"nodes"`
+
+	Authorization  string `json:"authorization"`
+	Authentication string `json:"authentication"`
+}
+
+type node struct {
+	ID         string `json:"id"`
+	Address    string `json:"address"`
+	PairingKey []byte `json:"pairing_key,omitempty"`
+}
+
+// RingIDFromManifest returns the content addressed ID of the ring
+// described by the manifest. It is deterministic, so any party holding
+// the manifest can check it matches the ID of the ring.
+func RingIDFromManifest(r *ringv1alpha1.Manifest) RingID {
+
+	m := manifest{
+		N:              r.N,
+		T:              r.T,
+		DKG:            r.Dkg,
+		PSS:            r.Pss,
+		PRE:            r.Pre,
+		Bulletin:       r.Bulletin,
+		Transport:      r.Transport,
+		Nodes:          make([]node, len(r.Nodes)),
+		Authorization:  r.Authorization,
+		Authentication: r.Authentication,
+	}
+
+	for i, n := range r.Nodes {
+		m.Nodes[i] = node{
+			ID:         n.Id,
+			Address:    n.Address,
+			PairingKey: n.PairingKey.GetData(),
+		}
+	}
+
+	b, err := cbor.Marshal(m)
+	if err != nil {
+		panic(fmt.Errorf("marshal manifest: %w", err))
+	}
+
+	pref := cid.Prefix{
+		Version:  1,
+		Codec:    uint64(mc.Cbor),
+		MhType:   mh.SHA2_256,
+		MhLength: -1, // default length
+	}
+
+	cid, err := pref.Sum(b)
+	if err != nil {
+		panic(fmt.Errorf("create cid: %w", err))
+	}
+
+	return RingID(cid.String())
+}
+
+// ManifestError is a validation failure of a single manifest field.
+type ManifestError struct {
+	Field string
+	Err   error
+}
+
+func (e *ManifestError) Error() string {
+	return e.Field + ": " + e.Err.Error()
+}
+
+func (e *ManifestError) Unwrap() error {
+	return e.Err
+}
+
+// ManifestErrors returns the individual field errors of a
+// manifest validation error.
+func ManifestErrors(err error) []*ManifestError {
+	var errs []*ManifestError
+	var walk func(error)
+	walk = func(err error) {
+		var me *ManifestError
+		if joined, ok := err.(interface{ Unwrap() []error }); ok {
+			for _, e := range joined.Unwrap() {
+				walk(e)
+			}
+		} else if errors.As(err, &me) {
+			errs = append(errs, me)
+		} else if err != nil {
+			errs = append(errs, &ManifestError{Err: err})
+		}
+	}
+	walk(err)
+
+	return errs
+}
+
+type Manifest struct {
+	*ringv1alpha1.Manifest
+}
+
+// Validate statically checks the manifest is well formed. It reports
+// every invalid field, joined into a single error. It doesn't check
+// the named services are available on any node.
+func (m Manifest) Validate() error {
+	if m.Manifest == nil {
+		return &ManifestError{Field: "manifest", Err: fmt.Errorf("missing")}
+	}
+
+	var errs []error
+	fail := func(field string, format string, args ...any) {
+		errs = append(errs, &ManifestError{Field: field, Err: fmt.Errorf(format, args...)})
+	}
+
+	if m.N < 1 {
+		fail("n", "must be positive, got %d", m.N)
+	}
+	if m.T < 1 {
+		fail("t", "must be positive, got %d", m.T)
+	}
+	if m.T > m.N {
+		fail("t", "must be at most n (%d), got %d", m.N, m.T)
+	}
+	if len(m.Nodes) != int(m.N) {
+		fail("nodes", "expected n (%d) nodes, got %d", m.N, len(m.Nodes))
+	}
+
+	services := []struct {
+		field string
+		value string
+	}{
+		{"dkg", m.Dkg},
+		{"pss", m.Pss},
+		{"pre", m.Pre},
+		{"bulletin", m.Bulletin},
+		{"transport", m.Transport},
+		{"authorization", m.Authorization},
+		{"authentication", m.Authentication},
+	}
+	for _, s := range services {
+		if s.value == "" {
+			fail(s.field, "missing service name")
+		}
+	}
+
+	// either every node lists its pairing key, or none.
+	pairing := len(m.Nodes) > 0 && m.Nodes[0] != nil && m.Nodes[0].PairingKey != nil
+
+	seen := make(map[string]int)
+	for i, n := range m.Nodes {
+		field := fmt.Sprintf("nodes[%d]", i)
+		if n == nil {
+			fail(field, "missing node")
+			continue
+		}
+
+		if j, ok := seen[n.Id]; ok {
+			fail(field+".id", "duplicate of nodes[%d]", j)
+		}
+		seen[n.Id] = i
+
+		id, err := peer.Decode(n.Id)
+		if err != nil {
+			fail(field+".id", "invalid peer id %q: %w", n.Id, err)
+			continue
+		}
+
+		addr, err := ma.NewMultiaddr(n.Address)
+		if err != nil {
+			fail(field+".address", "invalid multiaddress %q: %w", n.Address, err)
+		} else if addrID, err := addr.ValueForProtocol(ma.P_P2P); err == nil && addrID != n.Id {
+			fail(field+".address", "peer id %s doesn't match node id %s", addrID, n.Id)
+		}
+
+		var identityKey ic.PubKey
+		if n.PublicKey != nil {
+			pk, err := ic.PublicKeyFromProto(n.PublicKey)
+			if err != nil {
+				fail(field+".public_key", "invalid public key: %w", err)
+			} else if !id.MatchesPublicKey(pk) {
+				fail(field+".public_key", "doesn't match node id %s", n.Id)
+			} else {
+				identityKey = pk
+			}
+		} else if pk, err := id.ExtractPublicKey(); err == nil {
+			identityKey = pk
+		}
+
+		if n.PairingKey == nil {
+			if pairing {
+				fail(field+".pairing_key", "missing, other nodes have pairing keys")
+			}
+		} else if !pairing {
+			fail(field+".pairing_key", "unexpected, nodes[0] has no pairing key")
+		} else if pk, err := crypto.PubKeyFromProto(n.PairingKey); err != nil {
+			fail(field+".pairing_key", "invalid pairing key: %w", err)
+		} else if pk.Type() != crypto.BLS12381 {
+			fail(field+".pairing_key", "expected a BLS12-381 key, got %s", pk.Type())
+		} else if len(n.PairingKeySignature) == 0 {
+			fail(field+".pairing_key_signature", "missing")
+		} else if identityKey == nil {
+			fail(field+".pairing_key_signature", "no public key of node %s to verify it", n.Id)
+		} else if err := crypto.VerifyPairingKey(identityKey, pk, n.PairingKeySignature); err != nil {
+			fail(field+".pairing_key_signature", "%w", err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,154 @@
+package types
+
+import (
+	"crypto/rand"
+	"testing"
+
+	ic "github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/require"
+
+	ringv1alpha1 "github.com/sourcenetwork/orbis-go/gen/proto/orbis/ring/v1alpha1"
+	"github.com/sourcenetwork/orbis-go/pkg/crypto"
+)
+
+func testNode(t *testing.T) *ringv1alpha1.Node {
+	_, pk, err := ic.GenerateEd25519Key(rand.Reader)
+	require.NoError(t, err)
+	id, err := peer.IDFromPublicKey(pk)
+	require.NoError(t, err)
+	pbPk, err := ic.PublicKeyToProto(pk)
+	require.NoError(t, err)
+
+	return &ringv1alpha1.Node{
+		Id:        id.String(),
+		Address:   "/ip4/127.0.0.1/tcp/9000",
+		PublicKey: pbPk,
+	}
+}
+
+func testManifest(t *testing.T) *ringv1alpha1.Manifest {
+	return &ringv1alpha1.Manifest{
+		N:              3,
+		T:              2,
+		Dkg:            "rabin",
+		Pss:            "avpss",
+		Pre:            "elgamal",
+		Bulletin:       "p2pbb",
+		Transport:      "p2p",
+		Authorization:  "zanzi",
+		Authentication: "jws-did",
+		Nodes:          []*ringv1alpha1.Node{testNode(t), testNode(t), testNode(t)},
+	}
+}
+
+func TestManifestValidate(t *testing.T) {
+	m := testManifest(t)
+	require.NoError(t, Manifest{m}.Validate())
+
+	other := testNode(t)
+
+	m.T = 4
+	m.Pre = ""
+	m.Nodes[0].Id = "not-a-peer-id"
+	m.Nodes[1].Address = m.Nodes[1].Address + "/p2p/" + other.Id
+	m.Nodes[2].PublicKey = other.PublicKey
+	m.Nodes = append(m.Nodes, m.Nodes[1])
+
+	err := Manifest{m}.Validate()
+	require.Error(t, err)
+
+	fields := make(map[string]bool)
+	for _, me := range ManifestErrors(err) {
+		fields[me.Field] = true
+	}
+	require.Equal(t, map[string]bool{
+		"t":                   true,
+		"nodes":               true,
+		"pre":                 true,
+		"nodes[0].id":         true,
+		"nodes[1].address":    true,
+		"nodes[2].public_key": true,
+		"nodes[3].id":         true,
+		"nodes[3].address":    true,
+	}, fields)
+
+	require.Error(t, Manifest{}.Validate())
+}
+
+func testPairingNode(t *testing.T) *ringv1alpha1.Node {
+	icsk, pk, err := ic.GenerateEd25519Key(rand.Reader)
+	require.NoError(t, err)
+	id, err := peer.IDFromPublicKey(pk)
+	require.NoError(t, err)
+
+	sk, err := crypto.PrivateKeyFromLibP2P(icsk)
+	require.NoError(t, err)
+	psk, err := crypto.PairingKey(sk)
+	require.NoError(t, err)
+	pbPk, err := ic.PublicKeyToProto(psk.GetPublic())
+	require.NoError(t, err)
+	sig, err := crypto.SignPairingKey(sk, psk.GetPublic())
+	require.NoError(t, err)
+
+	// the public key is extracted from the id of ed25519 nodes.
+	return &ringv1alpha1.Node{
+		Id:                  id.String(),
+		Address:             "/ip4/127.0.0.1/tcp/9000",
+		PairingKey:          pbPk,
+		PairingKeySignature: sig,
+	}
+}
+
+func TestManifestValidatePairingKeys(t *testing.T) {
+	m := testManifest(t)
+	m.Nodes = []*ringv1alpha1.Node{testPairingNode(t), testPairingNode(t), testPairingNode(t)}
+	require.NoError(t, Manifest{m}.Validate())
+
+	// pairing keys are part of the ring id
+	id := RingIDFromManifest(m)
+	m.Nodes[0] = testPairingNode(t)
+	require.NotEqual(t, id, RingIDFromManifest(m))
+
+	m.Nodes[1].PairingKey = nil
+	m.Nodes[2].PairingKey = m.Nodes[2].PublicKey
+
+	err := Manifest{m}.Validate()
+	fields := make(map[string]bool)
+	for _, me := range ManifestErrors(err) {
+		fields[me.Field] = true
+	}
+	require.Equal(t, map[string]bool{
+		"nodes[1].pairing_key": true,
+		"nodes[2].pairing_key": true,
+	}, fields)
+
+	m.Nodes[0].PairingKey = nil
+	m.Nodes[2].PairingKey = testPairingNode(t).PairingKey
+	err = Manifest{m}.Validate()
+	require.Len(t, ManifestErrors(err), 1)
+	require.Equal(t, "nodes[2].pairing_key", ManifestErrors(err)[0].Field)
+}
+
+func TestManifestValidatePairingKeySignatures(t *testing.T) {
+	m := testManifest(t)
+	m.Nodes = []*ringv1alpha1.Node{testPairingNode(t), testPairingNode(t), testPairingNode(t)}
+	require.NoError(t, Manifest{m}.Validate())
+
+	// a proposer can't list its own pairing key for another node,
+	// nor drop the signature.
+	other := testPairingNode(t)
+	m.Nodes[1].PairingKey = other.PairingKey
+	m.Nodes[1].PairingKeySignature = other.PairingKeySignature
+	m.Nodes[2].PairingKeySignature = nil
+
+	err := Manifest{m}.Validate()
+	fields := make(map[string]bool)
+	for _, me := range ManifestErrors(err) {
+		fields[me.Field] = true
+	}
+	require.Equal(t, map[string]bool{
+		"nodes[1].pairing_key_signature": true,
+		"nodes[2].pairing_key_signature": true,
+	}, fields)
+}